@@ -7,11 +7,15 @@ import (
 	"os"
 	"time"
 
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/cli"
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/grpc/handler"
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/sshagent"
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/storage"
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/tui"
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/vault"
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/sirupsen/logrus"
 )
 
@@ -30,15 +34,23 @@ func printVersion() {
 }
 
 func main() {
+	os.Exit(run())
+}
 
-	printVersion() // pring to stdout
-
+// run starts the terminal interface or executes the command given after the flags;
+// returns the process exit code.
+func run() int {
 	flagSet := flag.NewFlagSet("main", flag.ContinueOnError)
 	conf, err := config.LoadClientConf(flagSet)
 	if err != nil {
 		panic(err)
 	}
 
+	command := flagSet.Args()
+	if len(command) == 0 {
+		printVersion() // pring to stdout; the command prints JSON only
+	}
+
 	// logger
 	file, err := os.OpenFile(conf.LogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err == nil {
@@ -62,6 +74,15 @@ func main() {
 		panic(err)
 	}
 
+	deviceName := conf.DeviceName
+	if deviceName == "" {
+		deviceName, _ = os.Hostname()
+	}
+	helper.ClientInfo(&domain.ClientInfo{
+		DeviceName:    deviceName,
+		ClientVersion: buildVersion,
+	})
+
 	// controller
 	appCtrl := app.NewViewController(conf)
 
@@ -71,9 +92,17 @@ func main() {
 		appCtrl.Stop(stopCtx)
 	}()
 
-	statusWrapper := app.NewStatusWrapper(conf, helper)
+	statusWrapper := app.NewStatusWrapper(conf, helper).OnOnline(appCtrl.ReplayPendingChanges)
+
+	appCtrl.SetServer(statusWrapper).SetAppStorage(storage.NewStorage()).SetLocalVault(vault.NewFileVault(conf.VaultFile))
 
-	appCtrl.SetServer(statusWrapper).SetAppStorage(storage.NewStorage())
+	// command line
+	if len(command) > 0 {
+		cmdLine := cli.NewCommandLine(conf).SetController(appCtrl).SetTokenHolder(helper)
+		appCtrl.SetInfoView(cmdLine)
+		appCtrl.Start()
+		return cmdLine.Run(command)
+	}
 
 	// view
 	tView := tui.NewApplicationView(conf)
@@ -81,9 +110,20 @@ func main() {
 
 	appCtrl.SetInfoView(tView)
 
+	// ssh-agent
+	if conf.SSHAgentSocket != "" {
+		agentListener := sshagent.NewListener(conf.SSHAgentSocket, appCtrl)
+		if err := agentListener.Start(); err != nil {
+			log.Warnf("ssh-agent is not started - %v", err.Error())
+		} else {
+			defer agentListener.Stop()
+		}
+	}
+
 	// start application
 	appCtrl.Start()
 	if err := tView.Start(); err != nil {
 		panic(err)
 	}
+	return 0
 }
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/jwtkeys"
+)
+
+const keysUsage = "usage: server [-c config] keys rotate"
+
+// runKeys executes "keys rotate" command.
+func runKeys(conf *config.ServerConf, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%s", keysUsage)
+	}
+
+	switch args[0] {
+	case "rotate":
+		// retired keys are kept while tokens signed by them can be valid
+		keyID, err := jwtkeys.Rotate(conf.JWTKeysDir, domain.JWTKeyAlgorithm(conf.JWTKeyAlg), conf.TokenExp)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("new active jwt key %s (%s)\n", keyID, conf.JWTKeyAlg)
+		return nil
+	default:
+		return fmt.Errorf("unknown keys command %s; %s", args[0], keysUsage)
+	}
+}
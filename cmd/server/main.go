@@ -14,6 +14,8 @@ import (
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/bigfiles/fs"
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/email"
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/handler"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/jwtkeys"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/notifier"
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/ttlstorage"
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/usecases"
@@ -46,6 +48,14 @@ func main() {
 		panic(err)
 	}
 
+	if _, err := domain.NewPassHashParams(domain.PassHashAlgorithm(conf.PassHashAlg)); err != nil {
+		panic(err)
+	}
+
+	if err := domain.CheckJWTKeyAlgorithm(domain.JWTKeyAlgorithm(conf.JWTKeyAlg)); err != nil {
+		panic(err)
+	}
+
 	logger, err := zap.NewDevelopment()
 
 	if err != nil {
@@ -63,6 +73,32 @@ func main() {
 
 	domain.SetApplicationLogger(sugarLog)
 
+	if args := flagSet.Args(); len(args) > 0 {
+		switch args[0] {
+		case "migrate":
+			if err := runMigrate(context.Background(), conf, args[1:]); err != nil {
+				sugarLog.Errorw("migrate", "err", err.Error())
+				os.Exit(1)
+			}
+			return
+		case "keys":
+			if err := runKeys(conf, args[1:]); err != nil {
+				sugarLog.Errorw("keys", "err", err.Error())
+				os.Exit(1)
+			}
+			return
+		case "users":
+			if err := runUsers(context.Background(), conf, args[1:]); err != nil {
+				sugarLog.Errorw("users", "err", err.Error())
+				os.Exit(1)
+			}
+			return
+		default:
+			sugarLog.Errorw("main", "err", fmt.Sprintf("unknown command %s", args[0]))
+			os.Exit(1)
+		}
+	}
+
 	srvCtx, cancelFn := context.WithCancel(context.Background())
 
 	// stateless storage
@@ -72,6 +108,12 @@ func main() {
 	pgStorage := postgres.NewStorage(srvCtx, conf)
 	defer pgStorage.Close()
 
+	// jwt signing keys
+	jwtKeys, err := jwtkeys.NewKeyStore(srvCtx, conf)
+	if err != nil {
+		panic(err)
+	}
+
 	// fileStorage
 	fileStorage := fs.NewFileStorage(conf)
 
@@ -88,7 +130,7 @@ func main() {
 	}()
 
 	// application
-	helper := usecases.NewRegistrationHelper(conf, rand.Read)
+	helper := usecases.NewRegistrationHelper(conf, rand.Read).JWTKeys(jwtKeys)
 	registrator := usecases.NewRegistrator(conf).
 		RegistrationHelper(helper).
 		StateFullStorage(pgStorage).
@@ -98,9 +140,20 @@ func main() {
 		RegistrationHelper(helper).
 		StateFullStorage(pgStorage).
 		TemporaryStorage(memStorage)
+	changeNotifier := notifier.NewNotifier()
 	dataAccess := usecases.NewDataAccessor(conf).
-		StateFullStorage(pgStorage)
+		StateFullStorage(pgStorage).
+		ChangeNotifier(changeNotifier)
 	fileAcces := usecases.NewFileAccessor(conf).StateFullStorage(pgStorage).FileStorage(fileStorage)
+	sessionAccess := usecases.NewSessionAccessor(conf).
+		StateFullStorage(pgStorage)
+	trashAccess := usecases.NewTrashAccessor(conf).
+		StateFullStorage(pgStorage).
+		FileStorage(fileStorage).
+		ChangeNotifier(changeNotifier)
+
+	// deleted items and files are purged after the retention period
+	trashAccess.StartPurge(srvCtx)
 
 	exit := make(chan os.Signal, 1)
 	signal.Notify(exit, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
@@ -110,12 +163,18 @@ func main() {
 	grpcAuthService := handler.NewAuthService(autHelper)
 	grpcDataAccessor := handler.NewDataAccessor(dataAccess)
 	grpcFileAccessor := handler.NewFileAccessor(fileAcces)
+	grpcSessionService := handler.NewSessionService(sessionAccess)
+	grpcTrashService := handler.NewTrashService(trashAccess)
 
 	handler := handler.NewGRPCHandler(conf).
 		AuthService(grpcAuthService).
 		DataAccessor(grpcDataAccessor).
 		RegHandler(grpcRegHandler).
-		FileAccessor(grpcFileAccessor)
+		FileAccessor(grpcFileAccessor).
+		SessionService(grpcSessionService).
+		TrashService(grpcTrashService).
+		JWTKeys(jwtKeys).
+		RevocationChecker(autHelper)
 
 	handler.Start(srvCtx)
 
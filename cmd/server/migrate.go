@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+)
+
+const migrateUsage = "usage: server [-c config] migrate up|down|status"
+
+// runMigrate executes "migrate up|down|status" command.
+func runMigrate(ctx context.Context, conf *config.ServerConf, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%s", migrateUsage)
+	}
+
+	mgr, err := postgres.NewMigrator(ctx, conf)
+	if err != nil {
+		return err
+	}
+	defer mgr.Close()
+
+	switch args[0] {
+	case "up":
+		return mgr.Up(ctx)
+	case "down":
+		return mgr.Down(ctx)
+	case "status":
+		statuses, err := mgr.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, st := range statuses {
+			appliedAt := "pending"
+			if st.Applied {
+				appliedAt = st.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", st.Version, st.Name, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %s; %s", args[0], migrateUsage)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+)
+
+const usersUsage = "usage: server [-c config] users bind-cert <email> <cert.pem> | unbind-cert <email>"
+
+// runUsers executes "users bind-cert|unbind-cert" commands.
+func runUsers(ctx context.Context, conf *config.ServerConf, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", usersUsage)
+	}
+
+	var email, fingerprint string
+	switch args[0] {
+	case "bind-cert":
+		if len(args) != 3 {
+			return fmt.Errorf("%s", usersUsage)
+		}
+		pemData, err := os.ReadFile(args[2])
+		if err != nil {
+			return err
+		}
+		email = args[1]
+		fingerprint, err = domain.PEMCertFingerprint(pemData)
+		if err != nil {
+			return err
+		}
+	case "unbind-cert":
+		if len(args) != 2 {
+			return fmt.Errorf("%s", usersUsage)
+		}
+		email = args[1]
+	default:
+		return fmt.Errorf("unknown users command %s; %s", args[0], usersUsage)
+	}
+
+	storage, err := postgres.OpenStorage(ctx, conf)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	if err := storage.SetCertFingerprint(ctx, email, fingerprint); err != nil {
+		return err
+	}
+
+	if fingerprint == "" {
+		fmt.Printf("account %s is unbound from client certificate\n", email)
+	} else {
+		fmt.Printf("account %s is bound to client certificate %s\n", email, fingerprint)
+	}
+	return nil
+}
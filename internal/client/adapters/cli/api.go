@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+//go:generate mockgen -destination "./generated_mocks_test.go" -package ${GOPACKAGE}_test . Controller,TokenHolder
+
+// Controller is the part of the application controller used by the command line.
+type Controller interface {
+	LoginEMail(data *domain.EMailData)
+	LoginPassOTP(otpPass *domain.OTPPass)
+	LoginCheckMasterKey(masterKeyPassword string)
+	OfflineUnlock(masterKeyPassword string)
+
+	GetBankCardList()
+	GetBankCard(num string)
+	AddBankCard(bankCardView *domain.BankCardView)
+	DeleteBankCard(number string)
+
+	GetUserPasswordDataList()
+	GetUserPasswordData(hint string)
+	AddUserPasswordData(data *domain.UserPasswordData)
+	DeleteUpdatePasswordData(hint string)
+
+	GetSecureNoteList()
+	GetSecureNote(title string)
+	AddSecureNote(note *domain.SecureNote)
+	DeleteSecureNote(title string)
+
+	GetAuthenticatorList()
+	GetAuthenticator(name string)
+	AddAuthenticator(auth *domain.Authenticator)
+	DeleteAuthenticator(name string)
+
+	GetSSHKeyList()
+	GetSSHKey(name string)
+	AddSSHKey(key *domain.SSHKey)
+	DeleteSSHKey(name string)
+
+	GetFilesInfoList()
+	GetFileInfo(name string)
+	UploadFile(info *domain.FileInfo)
+	SaveFile(info *domain.FileInfo)
+	DeleteFile(name string)
+
+	// SecretItem returns the decrypted item synchronously.
+	SecretItem(ctx context.Context, itemType domain.SecretItemType, name string) (any, error)
+}
+
+// TokenHolder keeps the server tokens; they are saved between the command line runs.
+type TokenHolder interface {
+	JWTToken() string
+	RefreshToken() string
+	SetJWTToken(jwtToken string)
+	SetRefreshToken(refreshToken string)
+}
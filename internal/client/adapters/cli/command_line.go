@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+)
+
+// process exit codes
+const (
+	ExitOK     = 0
+	ExitError  = 1 // the operation is failed
+	ExitUsage  = 2 // unknown command or wrong arguments
+	ExitLocked = 3 // not logged in or the vault is locked
+)
+
+// secrets are not passed by the arguments - they are visible in the process list
+const (
+	EnvPassword       = "GOPHKEEPER_PASSWORD"
+	EnvMasterPassword = "GOPHKEEPER_MASTER_PASSWORD"
+	EnvSession        = "GOPHKEEPER_SESSION"
+)
+
+var (
+	errUsage     = errors.New("usage error")
+	errLocked    = errors.New("vault is locked")
+	errOperation = errors.New("operation error")
+)
+
+const usage = `usage: client [-c config] <command> [arguments]
+  help
+  login --email <email> --otp <code>   password from GOPHKEEPER_PASSWORD or stdin
+  unlock                               master password from GOPHKEEPER_MASTER_PASSWORD or stdin,
+                                       prints the session key for GOPHKEEPER_SESSION
+  lock
+  logout
+  list <type>
+  get <type> <name> [--field <field>]
+  add <type>                           item JSON from stdin
+  delete <type> <name>
+  upload <path> [--name <name>]
+  download <name> [-o <path>]
+  run --env VAR=<type>:<name>.<field> [--env ...] [--scrub] -- <command> [arguments]
+                                       starts the command with the fields in the environment,
+                                       exits with the command exit code
+types: card, password, note, authenticator, ssh-key, file`
+
+// NewCommandLine create the non-interactive client view; the command is executed by Run.
+func NewCommandLine(conf *config.ClientConf) *commandLine {
+	return &commandLine{
+		sessionFile: conf.SessionFile,
+		stdin:       os.Stdin,
+		stdout:      os.Stdout,
+		stderr:      os.Stderr,
+		eventNotify: make(chan struct{}, 1),
+	}
+}
+
+func (cl *commandLine) SetController(controller Controller) *commandLine {
+	cl.controller = controller
+	return cl
+}
+
+func (cl *commandLine) SetTokenHolder(tokens TokenHolder) *commandLine {
+	cl.tokens = tokens
+	return cl
+}
+
+// SetIO replaces the standard input and output.
+func (cl *commandLine) SetIO(stdin io.Reader, stdout io.Writer, stderr io.Writer) *commandLine {
+	cl.stdin = stdin
+	cl.stdout = stdout
+	cl.stderr = stderr
+	return cl
+}
+
+type commandLine struct {
+	controller  Controller
+	tokens      TokenHolder
+	sessionFile string
+	sess        *session
+	logout      bool // the session file is removed, the tokens are revoked when the client is stopped
+	hint        string
+
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	eventsMx    sync.Mutex
+	events      []viewEvent
+	eventNotify chan struct{}
+}
+
+// Run executes the command and returns the process exit code. The result is written to stdout as JSON,
+// the error is written to stderr as {"error": "..."}. The exit code of the command started by run
+// is returned as is.
+func (cl *commandLine) Run(args []string) int {
+	log := app.GetMainLogger()
+
+	err := cl.run(args)
+	var childErr *childExitError
+	if errors.As(err, &childErr) {
+		log.Infof("command %v - %v", args[0], err.Error())
+		return childErr.code
+	}
+	if err != nil {
+		log.Warnf("command %v error - %v", args, err.Error())
+		_ = json.NewEncoder(cl.stderr).Encode(map[string]string{"error": err.Error()})
+	}
+
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, errUsage):
+		return ExitUsage
+	case errors.Is(err, errLocked):
+		return ExitLocked
+	default:
+		return ExitError
+	}
+}
+
+func (cl *commandLine) run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w - command is not set, see help", errUsage)
+	}
+
+	command, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("%w - unknown command %v, see help", errUsage, args[0])
+	}
+
+	sess, err := loadSession(cl.sessionFile)
+	if err != nil {
+		return err
+	}
+	cl.sess = sess
+	cl.tokens.SetJWTToken(sess.JWTToken)
+	cl.tokens.SetRefreshToken(sess.RefreshToken)
+
+	cmdErr := command(cl, args[1:])
+	if err := cl.saveSession(); err != nil {
+		if cmdErr == nil {
+			return err
+		}
+		app.GetMainLogger().Warn(err.Error())
+	}
+	return cmdErr
+}
+
+// saveSession stores the tokens refreshed during the run. The tokens are taken from the holder,
+// so the session is not revoked when the client is stopped.
+func (cl *commandLine) saveSession() error {
+	if cl.logout {
+		if err := os.Remove(cl.sessionFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%w - can't remove session file %v", errOperation, err.Error())
+		}
+		return nil
+	}
+
+	cl.sess.JWTToken = cl.tokens.JWTToken()
+	cl.sess.RefreshToken = cl.tokens.RefreshToken()
+	cl.tokens.SetJWTToken("")
+	cl.tokens.SetRefreshToken("")
+
+	if *cl.sess == (session{}) {
+		return nil // nothing to keep
+	}
+	return cl.sess.save(cl.sessionFile)
+}
+
+func (cl *commandLine) print(result any) error {
+	if err := json.NewEncoder(cl.stdout).Encode(result); err != nil {
+		return fmt.Errorf("%w - can't write result %v", errOperation, err.Error())
+	}
+	return nil
+}
+
+// secret returns the value of the environment variable or the first line of stdin.
+func (cl *commandLine) secret(env string) (string, error) {
+	if value := os.Getenv(env); value != "" {
+		return value, nil
+	}
+
+	line, err := bufio.NewReader(cl.stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("%w - can't read stdin %v", errOperation, err.Error())
+	}
+
+	line = strings.TrimRight(line, "\r\n")
+	if line == "" {
+		return "", fmt.Errorf("%w - %v is not set and stdin is empty", errUsage, env)
+	}
+	return line, nil
+}
+
+func newFlagSet(command string) *flag.FlagSet {
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// parseArgs parses the flags placed before or after the positional arguments;
+// exactly count positional arguments are expected.
+func parseArgs(fs *flag.FlagSet, args []string, count int) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, fmt.Errorf("%w - %v %v", errUsage, fs.Name(), err.Error())
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) != count {
+		return nil, fmt.Errorf("%w - %v expects %d arguments, see help", errUsage, fs.Name(), count)
+	}
+	return positional, nil
+}
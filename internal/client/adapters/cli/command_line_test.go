@@ -0,0 +1,296 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/cli"
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type commandLine interface {
+	app.AppView
+	Run(args []string) int
+}
+
+type testEnv struct {
+	sessionFile string
+	stdout      bytes.Buffer
+	stderr      bytes.Buffer
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	return &testEnv{
+		sessionFile: filepath.Join(t.TempDir(), "session.json"),
+	}
+}
+
+// newCommandLine creates the command line for one run; the tokens holder without saved tokens is used if tokens is nil.
+func (te *testEnv) newCommandLine(ctrl *gomock.Controller, stdin string, tokens *MockTokenHolder) (commandLine, *MockController) {
+	te.stdout.Reset()
+	te.stderr.Reset()
+
+	if tokens == nil {
+		tokens = NewMockTokenHolder(ctrl)
+		tokens.EXPECT().SetJWTToken(gomock.Any()).AnyTimes()
+		tokens.EXPECT().SetRefreshToken(gomock.Any()).AnyTimes()
+		tokens.EXPECT().JWTToken().Return("").AnyTimes()
+		tokens.EXPECT().RefreshToken().Return("").AnyTimes()
+	}
+
+	controller := NewMockController(ctrl)
+	cmdLine := cli.NewCommandLine(&config.ClientConf{SessionFile: te.sessionFile}).
+		SetController(controller).
+		SetTokenHolder(tokens).
+		SetIO(strings.NewReader(stdin), &te.stdout, &te.stderr)
+	return cmdLine, controller
+}
+
+func TestCommandLine(t *testing.T) {
+
+	t.Run("usage", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+
+		cmdLine, _ := te.newCommandLine(ctrl, "", nil)
+		assert.Equal(t, cli.ExitUsage, cmdLine.Run(nil))
+
+		cmdLine, _ = te.newCommandLine(ctrl, "", nil)
+		assert.Equal(t, cli.ExitUsage, cmdLine.Run([]string{"list", "unknown"}))
+		assert.Contains(t, te.stderr.String(), "unknown type")
+
+		cmdLine, _ = te.newCommandLine(ctrl, "", nil)
+		assert.Equal(t, cli.ExitUsage, cmdLine.Run([]string{"get", "password"}))
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+
+		cmdLine, _ := te.newCommandLine(ctrl, "", nil)
+		assert.Equal(t, cli.ExitLocked, cmdLine.Run([]string{"list", "cards"}))
+		assert.Contains(t, te.stderr.String(), cli.EnvSession)
+	})
+
+	t.Run("login_unlock_get", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+
+		// login
+		tokens := NewMockTokenHolder(ctrl)
+		tokens.EXPECT().SetJWTToken(gomock.Any()).AnyTimes()
+		tokens.EXPECT().SetRefreshToken(gomock.Any()).AnyTimes()
+		tokens.EXPECT().JWTToken().Return("jwt").Times(1)
+		tokens.EXPECT().RefreshToken().Return("refresh").Times(1)
+		cmdLine, controller := te.newCommandLine(ctrl, "password\n", tokens)
+		controller.EXPECT().LoginEMail(&domain.EMailData{EMail: "user@mail.ru", Password: "password"}).
+			Do(func(data *domain.EMailData) {
+				cmdLine.ShowLogOTPView()
+			}).Times(1)
+		controller.EXPECT().LoginPassOTP(&domain.OTPPass{Pass: "123456"}).
+			Do(func(otpPass *domain.OTPPass) {
+				cmdLine.ShowMasterKeyView("")
+			}).Times(1)
+
+		require.Equal(t, cli.ExitOK, cmdLine.Run([]string{"login", "--email", "user@mail.ru", "--otp", "123456"}))
+		assert.JSONEq(t, `{"email": "user@mail.ru"}`, te.stdout.String())
+
+		info, err := os.Stat(te.sessionFile)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+		// unlock
+		tokens = NewMockTokenHolder(ctrl)
+		tokens.EXPECT().SetJWTToken("jwt").Times(1)
+		tokens.EXPECT().SetRefreshToken("refresh").Times(1)
+		tokens.EXPECT().JWTToken().Return("jwt").Times(1)
+		tokens.EXPECT().RefreshToken().Return("refresh").Times(1)
+		tokens.EXPECT().SetJWTToken("").Times(1)
+		tokens.EXPECT().SetRefreshToken("").Times(1)
+		cmdLine, controller = te.newCommandLine(ctrl, "master\n", tokens)
+		controller.EXPECT().LoginCheckMasterKey("master").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowDataAccessView()
+			}).Times(1)
+
+		require.Equal(t, cli.ExitOK, cmdLine.Run([]string{"unlock"}))
+		var unlocked struct {
+			Session string `json:"session"`
+			Offline bool   `json:"offline"`
+		}
+		require.NoError(t, json.Unmarshal(te.stdout.Bytes(), &unlocked))
+		require.NotEmpty(t, unlocked.Session)
+		assert.False(t, unlocked.Offline)
+		assert.NotContains(t, readFile(t, te.sessionFile), `"master"`)
+
+		// get
+		t.Setenv(cli.EnvSession, unlocked.Session)
+		cmdLine, controller = te.newCommandLine(ctrl, "", nil)
+		controller.EXPECT().LoginCheckMasterKey("master").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowPendingChanges(0)
+				cmdLine.ShowDataAccessView()
+			}).Times(1)
+		controller.EXPECT().GetUserPasswordDataList().
+			Do(func() {
+				cmdLine.ShowUserPasswordDataListView([]string{"github"})
+			}).Times(1)
+		controller.EXPECT().GetUserPasswordData("github").
+			Do(func(hint string) {
+				cmdLine.ShowEditUserPasswordDataView(&domain.UserPasswordData{
+					Hint:     "github",
+					Login:    "user",
+					Passwrod: "secret",
+					Fields:   []domain.CustomField{{Name: "pin", Value: "1234"}},
+				})
+			}).Times(1)
+
+		require.Equal(t, cli.ExitOK, cmdLine.Run([]string{"get", "password", "github", "--field", "password"}))
+		assert.Equal(t, "secret\n", te.stdout.String())
+
+		cmdLine, controller = te.newCommandLine(ctrl, "", nil)
+		controller.EXPECT().LoginCheckMasterKey("master").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowDataAccessView()
+			}).Times(1)
+		controller.EXPECT().GetUserPasswordDataList().
+			Do(func() {
+				cmdLine.ShowUserPasswordDataListView([]string{"github"})
+			}).Times(1)
+		controller.EXPECT().GetUserPasswordData("github").
+			Do(func(hint string) {
+				cmdLine.ShowEditUserPasswordDataView(&domain.UserPasswordData{
+					Hint:   "github",
+					Fields: []domain.CustomField{{Name: "pin", Value: "1234"}},
+				})
+			}).Times(1)
+		require.Equal(t, cli.ExitOK, cmdLine.Run([]string{"get", "--field", "pin", "password", "github"}))
+		assert.Equal(t, "1234\n", te.stdout.String())
+	})
+
+	t.Run("offline_unlock_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+
+		cmdLine, controller := te.newCommandLine(ctrl, "", nil)
+		t.Setenv(cli.EnvMasterPassword, "wrong")
+		controller.EXPECT().LoginCheckMasterKey("wrong").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowMasterKeyView("my hint")
+				cmdLine.ShowMsg("wrong master password")
+			}).Times(1)
+		controller.EXPECT().OfflineUnlock("wrong").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowMsg("vault is not exists")
+			}).Times(1)
+
+		assert.Equal(t, cli.ExitLocked, cmdLine.Run([]string{"unlock"}))
+		assert.Contains(t, te.stderr.String(), "my hint")
+		assert.Empty(t, te.stdout.String())
+	})
+
+	t.Run("list_add_delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+		t.Setenv(cli.EnvMasterPassword, "master")
+
+		unlock := func(cmdLine commandLine, controller *MockController) {
+			controller.EXPECT().LoginCheckMasterKey("master").
+				Do(func(masterKeyPassword string) {
+					cmdLine.ShowDataAccessView()
+				}).Times(1)
+		}
+
+		cmdLine, controller := te.newCommandLine(ctrl, "", nil)
+		unlock(cmdLine, controller)
+		controller.EXPECT().GetBankCardList().
+			Do(func() {
+				cmdLine.ShowBankCardListView(nil)
+			}).Times(1)
+		require.Equal(t, cli.ExitOK, cmdLine.Run([]string{"list", "cards"}))
+		assert.JSONEq(t, `[]`, te.stdout.String())
+
+		cmdLine, controller = te.newCommandLine(ctrl, `{"name": "github", "uri": "otpauth://totp/github"}`, nil)
+		unlock(cmdLine, controller)
+		controller.EXPECT().AddAuthenticator(&domain.Authenticator{Name: "github", URI: "otpauth://totp/github"}).
+			Do(func(auth *domain.Authenticator) {
+				cmdLine.ShowAuthenticatorListView([]string{"github"})
+			}).Times(1)
+		require.Equal(t, cli.ExitOK, cmdLine.Run([]string{"add", "authenticator"}))
+		assert.JSONEq(t, `{"status": "ok"}`, te.stdout.String())
+
+		cmdLine, controller = te.newCommandLine(ctrl, "", nil)
+		unlock(cmdLine, controller)
+		controller.EXPECT().GetFilesInfoList().
+			Do(func() {
+				cmdLine.ShowFileInfoListView([]domain.FileInfo{{Name: "report.pdf"}})
+			}).Times(1)
+		controller.EXPECT().DeleteFile("report.pdf").
+			Do(func(name string) {
+				cmdLine.ShowMsg("file is not found")
+				cmdLine.ShowFileInfoListView(nil)
+			}).Times(1)
+		assert.Equal(t, cli.ExitError, cmdLine.Run([]string{"delete", "file", "report.pdf"}))
+		assert.Contains(t, te.stderr.String(), "file is not found")
+
+		cmdLine, _ = te.newCommandLine(ctrl, "", nil)
+		assert.Equal(t, cli.ExitUsage, cmdLine.Run([]string{"add", "file"}))
+	})
+
+	t.Run("download", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+		t.Setenv(cli.EnvMasterPassword, "master")
+		output := filepath.Join(t.TempDir(), "report.pdf")
+
+		cmdLine, controller := te.newCommandLine(ctrl, "", nil)
+		controller.EXPECT().LoginCheckMasterKey("master").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowMsg("server is not available")
+			}).Times(1)
+		controller.EXPECT().OfflineUnlock("master").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowDataAccessView()
+			}).Times(1)
+		controller.EXPECT().SaveFile(&domain.FileInfo{Name: "report.pdf", Path: output}).
+			Do(func(info *domain.FileInfo) {
+				cmdLine.CreateProgressBar("Loading", 50, "", nil)
+				cmdLine.CloseProgerssBar()
+				cmdLine.ShowFileInfoListView([]domain.FileInfo{{Name: "report.pdf"}})
+			}).Times(1)
+
+		require.Equal(t, cli.ExitOK, cmdLine.Run([]string{"download", "report.pdf", "-o", output}))
+		assert.JSONEq(t, `{"path": "`+output+`"}`, te.stdout.String())
+	})
+
+	t.Run("logout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+		require.NoError(t, os.WriteFile(te.sessionFile, []byte(`{"refreshToken": "refresh"}`), 0600))
+
+		tokens := NewMockTokenHolder(ctrl)
+		tokens.EXPECT().SetJWTToken("").Times(1)
+		tokens.EXPECT().SetRefreshToken("refresh").Times(1)
+		cmdLine, _ := te.newCommandLine(ctrl, "", tokens)
+		require.Equal(t, cli.ExitOK, cmdLine.Run([]string{"logout"}))
+
+		_, err := os.Stat(te.sessionFile)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
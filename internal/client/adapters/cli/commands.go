@@ -0,0 +1,481 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+var commands = map[string]func(cl *commandLine, args []string) error{
+	"help":     (*commandLine).help,
+	"login":    (*commandLine).login,
+	"unlock":   (*commandLine).unlock,
+	"lock":     (*commandLine).lock,
+	"logout":   (*commandLine).logoutSession,
+	"list":     (*commandLine).list,
+	"get":      (*commandLine).get,
+	"add":      (*commandLine).add,
+	"delete":   (*commandLine).delete,
+	"upload":   (*commandLine).upload,
+	"download": (*commandLine).download,
+	"run":      (*commandLine).runCommand,
+}
+
+// itemKind invokes the controller for the item type given in the command line.
+type itemKind struct {
+	itemType domain.SecretItemType // empty if the item fields are not exported by run
+	list     func(c Controller)
+	get      func(c Controller, name string)
+	add      func(c Controller, data []byte) error // nil if the items are not added from JSON
+	delete   func(c Controller, name string)
+}
+
+var itemKinds = map[string]itemKind{
+	"card": {
+		itemType: domain.SecretItemBankCard,
+		list:     Controller.GetBankCardList,
+		get:      Controller.GetBankCard,
+		add: func(c Controller, data []byte) error {
+			var card domain.BankCard
+			if err := json.Unmarshal(data, &card); err != nil {
+				return err
+			}
+			c.AddBankCard(card.ToView())
+			return nil
+		},
+		delete: Controller.DeleteBankCard,
+	},
+	"password": {
+		itemType: domain.SecretItemUserPassword,
+		list:     Controller.GetUserPasswordDataList,
+		get:      Controller.GetUserPasswordData,
+		add: func(c Controller, data []byte) error {
+			var upData domain.UserPasswordData
+			if err := json.Unmarshal(data, &upData); err != nil {
+				return err
+			}
+			c.AddUserPasswordData(&upData)
+			return nil
+		},
+		delete: Controller.DeleteUpdatePasswordData,
+	},
+	"note": {
+		itemType: domain.SecretItemSecureNote,
+		list:     Controller.GetSecureNoteList,
+		get:      Controller.GetSecureNote,
+		add: func(c Controller, data []byte) error {
+			var note domain.SecureNote
+			if err := json.Unmarshal(data, &note); err != nil {
+				return err
+			}
+			c.AddSecureNote(&note)
+			return nil
+		},
+		delete: Controller.DeleteSecureNote,
+	},
+	"authenticator": {
+		itemType: domain.SecretItemAuthenticator,
+		list:     Controller.GetAuthenticatorList,
+		get:      Controller.GetAuthenticator,
+		add: func(c Controller, data []byte) error {
+			var auth domain.Authenticator
+			if err := json.Unmarshal(data, &auth); err != nil {
+				return err
+			}
+			c.AddAuthenticator(&auth)
+			return nil
+		},
+		delete: Controller.DeleteAuthenticator,
+	},
+	"ssh-key": {
+		itemType: domain.SecretItemSSHKey,
+		list:     Controller.GetSSHKeyList,
+		get:      Controller.GetSSHKey,
+		add: func(c Controller, data []byte) error {
+			var key domain.SSHKey
+			if err := json.Unmarshal(data, &key); err != nil {
+				return err
+			}
+			c.AddSSHKey(&key)
+			return nil
+		},
+		delete: Controller.DeleteSSHKey,
+	},
+	"file": {
+		list:   Controller.GetFilesInfoList,
+		get:    Controller.GetFileInfo,
+		delete: Controller.DeleteFile,
+	},
+}
+
+// getItemKind accepts both singular and plural type names: card or cards.
+func getItemKind(name string) (*itemKind, error) {
+	if kind, ok := itemKinds[name]; ok {
+		return &kind, nil
+	}
+	if kind, ok := itemKinds[strings.TrimSuffix(name, "s")]; ok {
+		return &kind, nil
+	}
+	return nil, fmt.Errorf("%w - unknown type %v, see help", errUsage, name)
+}
+
+// file info and metadata shown by get and list
+type fileItem struct {
+	Name   string               `json:"name"`
+	Upload time.Time            `json:"upload"`
+	Fields []domain.CustomField `json:"fields,omitempty"`
+	Notes  string               `json:"notes,omitempty"`
+}
+
+// authenticator with the current code
+type authenticatorItem struct {
+	domain.Authenticator
+	Code     string `json:"code,omitempty"`
+	ValidFor int    `json:"validFor,omitempty"` // seconds until the code is changed
+}
+
+type status struct {
+	Status string `json:"status"`
+}
+
+var statusOK = status{Status: "ok"}
+
+func (cl *commandLine) help(args []string) error {
+	if _, err := fmt.Fprintln(cl.stdout, usage); err != nil {
+		return fmt.Errorf("%w - can't write result %v", errOperation, err.Error())
+	}
+	return nil
+}
+
+func (cl *commandLine) login(args []string) error {
+	fs := newFlagSet("login")
+	email := fs.String("email", "", "account email")
+	otp := fs.String("otp", "", "one-time password")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	if *email == "" || *otp == "" {
+		return fmt.Errorf("%w - login expects --email and --otp", errUsage)
+	}
+
+	password, err := cl.secret(EnvPassword)
+	if err != nil {
+		return err
+	}
+
+	cl.controller.LoginEMail(&domain.EMailData{EMail: *email, Password: password})
+	if _, err := cl.wait(viewOTP); err != nil {
+		return err
+	}
+
+	cl.controller.LoginPassOTP(&domain.OTPPass{Pass: *otp})
+	if _, err := cl.wait(viewMasterKey); err != nil {
+		return err
+	}
+
+	// the vault of the previous session is locked
+	cl.sess = &session{EMail: *email}
+	return cl.print(map[string]string{"email": *email})
+}
+
+// unlock checks the master password and keeps it encrypted with the printed session key.
+func (cl *commandLine) unlock(args []string) error {
+	if _, err := parseArgs(newFlagSet("unlock"), args, 0); err != nil {
+		return err
+	}
+
+	masterPassword, err := cl.secret(EnvMasterPassword)
+	if err != nil {
+		return err
+	}
+
+	offline, err := cl.unlockVault(masterPassword)
+	if err != nil {
+		return err
+	}
+
+	key := domain.Random32ByteString()
+	encrypted, err := domain.EncryptShortData(key, masterPassword)
+	if err != nil {
+		return fmt.Errorf("%w - can't encrypt master password %v", errOperation, err.Error())
+	}
+	cl.sess.MasterPassword = encrypted
+
+	return cl.print(struct {
+		Session string `json:"session"`
+		Offline bool   `json:"offline"`
+	}{
+		Session: key,
+		Offline: offline,
+	})
+}
+
+func (cl *commandLine) lock(args []string) error {
+	if _, err := parseArgs(newFlagSet("lock"), args, 0); err != nil {
+		return err
+	}
+	cl.sess.MasterPassword = ""
+	return cl.print(statusOK)
+}
+
+func (cl *commandLine) logoutSession(args []string) error {
+	if _, err := parseArgs(newFlagSet("logout"), args, 0); err != nil {
+		return err
+	}
+	cl.logout = true
+	return cl.print(statusOK)
+}
+
+// unlockVault checks the master password on the server; the local vault is opened read-only
+// if the server check is failed.
+func (cl *commandLine) unlockVault(masterPassword string) (bool, error) {
+	cl.controller.LoginCheckMasterKey(masterPassword)
+	_, onlineErr := cl.wait(viewDataAccess)
+	if onlineErr == nil {
+		return false, nil
+	}
+
+	cl.controller.OfflineUnlock(masterPassword)
+	if _, err := cl.wait(viewDataAccess); err != nil {
+		if cl.hint != "" {
+			return false, fmt.Errorf("%w - %v (hint: %s)", errLocked, onlineErr.Error(), cl.hint)
+		}
+		return false, fmt.Errorf("%w - %v", errLocked, onlineErr.Error())
+	}
+	return true, nil
+}
+
+// openVault unlocks the vault with the master password saved by unlock or taken from GOPHKEEPER_MASTER_PASSWORD.
+func (cl *commandLine) openVault() error {
+	masterPassword := ""
+	if key := os.Getenv(EnvSession); key != "" && cl.sess.MasterPassword != "" {
+		decrypted, err := domain.DecryptShortData(key, cl.sess.MasterPassword)
+		if err != nil {
+			return fmt.Errorf("%w - wrong %v", errLocked, EnvSession)
+		}
+		masterPassword = decrypted
+	}
+	if masterPassword == "" {
+		masterPassword = os.Getenv(EnvMasterPassword)
+	}
+	if masterPassword == "" {
+		return fmt.Errorf("%w - run unlock and set %v", errLocked, EnvSession)
+	}
+
+	_, err := cl.unlockVault(masterPassword)
+	return err
+}
+
+func (cl *commandLine) list(args []string) error {
+	positional, err := parseArgs(newFlagSet("list"), args, 1)
+	if err != nil {
+		return err
+	}
+	kind, err := getItemKind(positional[0])
+	if err != nil {
+		return err
+	}
+	if err := cl.openVault(); err != nil {
+		return err
+	}
+
+	kind.list(cl.controller)
+	event, err := cl.wait(viewList)
+	if err != nil {
+		return err
+	}
+	return cl.print(event.data)
+}
+
+// loadList loads the items like the list page of the terminal interface does;
+// the item is found in the loaded data by get and delete.
+func (cl *commandLine) loadList(kind *itemKind) error {
+	kind.list(cl.controller)
+	_, err := cl.wait(viewList)
+	return err
+}
+
+func (cl *commandLine) get(args []string) error {
+	fs := newFlagSet("get")
+	field := fs.String("field", "", "print the field value only")
+	positional, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return err
+	}
+	kind, err := getItemKind(positional[0])
+	if err != nil {
+		return err
+	}
+	if err := cl.openVault(); err != nil {
+		return err
+	}
+
+	if err := cl.loadList(kind); err != nil {
+		return err
+	}
+
+	kind.get(cl.controller, positional[1])
+	event, err := cl.wait(viewItem)
+	if err != nil {
+		return err
+	}
+
+	if *field == "" {
+		return cl.print(event.data)
+	}
+
+	value, err := itemField(event.data, *field)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(cl.stdout, value); err != nil {
+		return fmt.Errorf("%w - can't write result %v", errOperation, err.Error())
+	}
+	return nil
+}
+
+// itemField returns the value of the item JSON field or of the custom field with the name;
+// strings are returned as is, other values as JSON.
+func itemField(item any, name string) (string, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return "", fmt.Errorf("%w - can't marshal item %v", errOperation, err.Error())
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", fmt.Errorf("%w - can't unmarshal item %v", errOperation, err.Error())
+	}
+
+	if value, ok := fields[name]; ok {
+		var str string
+		if err := json.Unmarshal(value, &str); err == nil {
+			return str, nil
+		}
+		return string(value), nil
+	}
+
+	var custom struct {
+		Fields []domain.CustomField `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &custom); err == nil {
+		for _, field := range custom.Fields {
+			if field.Name == name {
+				return field.Value, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w - field %v is not found", errOperation, name)
+}
+
+func (cl *commandLine) add(args []string) error {
+	positional, err := parseArgs(newFlagSet("add"), args, 1)
+	if err != nil {
+		return err
+	}
+	kind, err := getItemKind(positional[0])
+	if err != nil {
+		return err
+	}
+	if kind.add == nil {
+		return fmt.Errorf("%w - %v can't be added, use upload", errUsage, positional[0])
+	}
+
+	data, err := io.ReadAll(cl.stdin)
+	if err != nil {
+		return fmt.Errorf("%w - can't read stdin %v", errOperation, err.Error())
+	}
+	if err := cl.openVault(); err != nil {
+		return err
+	}
+
+	if err := kind.add(cl.controller, data); err != nil {
+		return fmt.Errorf("%w - wrong item JSON %v", errUsage, err.Error())
+	}
+	if _, err := cl.wait(viewList); err != nil {
+		return err
+	}
+	return cl.print(statusOK)
+}
+
+func (cl *commandLine) delete(args []string) error {
+	positional, err := parseArgs(newFlagSet("delete"), args, 2)
+	if err != nil {
+		return err
+	}
+	kind, err := getItemKind(positional[0])
+	if err != nil {
+		return err
+	}
+	if err := cl.openVault(); err != nil {
+		return err
+	}
+
+	if err := cl.loadList(kind); err != nil {
+		return err
+	}
+
+	kind.delete(cl.controller, positional[1])
+	if _, err := cl.wait(viewList); err != nil {
+		return err
+	}
+	return cl.print(statusOK)
+}
+
+func (cl *commandLine) upload(args []string) error {
+	fs := newFlagSet("upload")
+	name := fs.String("name", "", "file name in the vault, the base name of the path by default")
+	positional, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if err := cl.openVault(); err != nil {
+		return err
+	}
+
+	info := &domain.FileInfo{
+		Name: *name,
+		Path: positional[0],
+	}
+	if info.Name == "" {
+		info.Name = filepath.Base(info.Path)
+	}
+
+	cl.controller.UploadFile(info)
+	if _, err := cl.wait(viewList); err != nil {
+		return err
+	}
+	return cl.print(map[string]string{"name": info.Name})
+}
+
+func (cl *commandLine) download(args []string) error {
+	fs := newFlagSet("download")
+	output := fs.String("o", "", "output path, the file name in the current directory by default")
+	positional, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if err := cl.openVault(); err != nil {
+		return err
+	}
+
+	info := &domain.FileInfo{
+		Name: positional[0],
+		Path: *output,
+	}
+	if info.Path == "" {
+		info.Path = filepath.Base(info.Name)
+	}
+
+	cl.controller.SaveFile(info)
+	if _, err := cl.wait(viewList); err != nil {
+		return err
+	}
+	return cl.print(map[string]string{"path": info.Path})
+}
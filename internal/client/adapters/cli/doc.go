@@ -0,0 +1,2 @@
+// Package cli is responsible for client non-interactive command line mode
+package cli
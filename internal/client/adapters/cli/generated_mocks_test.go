@@ -0,0 +1,474 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/cli (interfaces: Controller,TokenHolder)
+
+// Package cli_test is a generated GoMock package.
+package cli_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockController is a mock of Controller interface.
+type MockController struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerMockRecorder
+}
+
+// MockControllerMockRecorder is the mock recorder for MockController.
+type MockControllerMockRecorder struct {
+	mock *MockController
+}
+
+// NewMockController creates a new mock instance.
+func NewMockController(ctrl *gomock.Controller) *MockController {
+	mock := &MockController{ctrl: ctrl}
+	mock.recorder = &MockControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockController) EXPECT() *MockControllerMockRecorder {
+	return m.recorder
+}
+
+// AddAuthenticator mocks base method.
+func (m *MockController) AddAuthenticator(arg0 *domain.Authenticator) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddAuthenticator", arg0)
+}
+
+// AddAuthenticator indicates an expected call of AddAuthenticator.
+func (mr *MockControllerMockRecorder) AddAuthenticator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuthenticator", reflect.TypeOf((*MockController)(nil).AddAuthenticator), arg0)
+}
+
+// AddBankCard mocks base method.
+func (m *MockController) AddBankCard(arg0 *domain.BankCardView) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddBankCard", arg0)
+}
+
+// AddBankCard indicates an expected call of AddBankCard.
+func (mr *MockControllerMockRecorder) AddBankCard(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBankCard", reflect.TypeOf((*MockController)(nil).AddBankCard), arg0)
+}
+
+// AddSSHKey mocks base method.
+func (m *MockController) AddSSHKey(arg0 *domain.SSHKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddSSHKey", arg0)
+}
+
+// AddSSHKey indicates an expected call of AddSSHKey.
+func (mr *MockControllerMockRecorder) AddSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSSHKey", reflect.TypeOf((*MockController)(nil).AddSSHKey), arg0)
+}
+
+// AddSecureNote mocks base method.
+func (m *MockController) AddSecureNote(arg0 *domain.SecureNote) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddSecureNote", arg0)
+}
+
+// AddSecureNote indicates an expected call of AddSecureNote.
+func (mr *MockControllerMockRecorder) AddSecureNote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecureNote", reflect.TypeOf((*MockController)(nil).AddSecureNote), arg0)
+}
+
+// AddUserPasswordData mocks base method.
+func (m *MockController) AddUserPasswordData(arg0 *domain.UserPasswordData) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddUserPasswordData", arg0)
+}
+
+// AddUserPasswordData indicates an expected call of AddUserPasswordData.
+func (mr *MockControllerMockRecorder) AddUserPasswordData(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserPasswordData", reflect.TypeOf((*MockController)(nil).AddUserPasswordData), arg0)
+}
+
+// DeleteAuthenticator mocks base method.
+func (m *MockController) DeleteAuthenticator(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteAuthenticator", arg0)
+}
+
+// DeleteAuthenticator indicates an expected call of DeleteAuthenticator.
+func (mr *MockControllerMockRecorder) DeleteAuthenticator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthenticator", reflect.TypeOf((*MockController)(nil).DeleteAuthenticator), arg0)
+}
+
+// DeleteBankCard mocks base method.
+func (m *MockController) DeleteBankCard(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteBankCard", arg0)
+}
+
+// DeleteBankCard indicates an expected call of DeleteBankCard.
+func (mr *MockControllerMockRecorder) DeleteBankCard(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankCard", reflect.TypeOf((*MockController)(nil).DeleteBankCard), arg0)
+}
+
+// DeleteFile mocks base method.
+func (m *MockController) DeleteFile(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteFile", arg0)
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockControllerMockRecorder) DeleteFile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockController)(nil).DeleteFile), arg0)
+}
+
+// DeleteSSHKey mocks base method.
+func (m *MockController) DeleteSSHKey(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteSSHKey", arg0)
+}
+
+// DeleteSSHKey indicates an expected call of DeleteSSHKey.
+func (mr *MockControllerMockRecorder) DeleteSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSHKey", reflect.TypeOf((*MockController)(nil).DeleteSSHKey), arg0)
+}
+
+// DeleteSecureNote mocks base method.
+func (m *MockController) DeleteSecureNote(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteSecureNote", arg0)
+}
+
+// DeleteSecureNote indicates an expected call of DeleteSecureNote.
+func (mr *MockControllerMockRecorder) DeleteSecureNote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecureNote", reflect.TypeOf((*MockController)(nil).DeleteSecureNote), arg0)
+}
+
+// DeleteUpdatePasswordData mocks base method.
+func (m *MockController) DeleteUpdatePasswordData(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteUpdatePasswordData", arg0)
+}
+
+// DeleteUpdatePasswordData indicates an expected call of DeleteUpdatePasswordData.
+func (mr *MockControllerMockRecorder) DeleteUpdatePasswordData(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpdatePasswordData", reflect.TypeOf((*MockController)(nil).DeleteUpdatePasswordData), arg0)
+}
+
+// GetAuthenticator mocks base method.
+func (m *MockController) GetAuthenticator(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetAuthenticator", arg0)
+}
+
+// GetAuthenticator indicates an expected call of GetAuthenticator.
+func (mr *MockControllerMockRecorder) GetAuthenticator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthenticator", reflect.TypeOf((*MockController)(nil).GetAuthenticator), arg0)
+}
+
+// GetAuthenticatorList mocks base method.
+func (m *MockController) GetAuthenticatorList() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetAuthenticatorList")
+}
+
+// GetAuthenticatorList indicates an expected call of GetAuthenticatorList.
+func (mr *MockControllerMockRecorder) GetAuthenticatorList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthenticatorList", reflect.TypeOf((*MockController)(nil).GetAuthenticatorList))
+}
+
+// GetBankCard mocks base method.
+func (m *MockController) GetBankCard(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetBankCard", arg0)
+}
+
+// GetBankCard indicates an expected call of GetBankCard.
+func (mr *MockControllerMockRecorder) GetBankCard(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankCard", reflect.TypeOf((*MockController)(nil).GetBankCard), arg0)
+}
+
+// GetBankCardList mocks base method.
+func (m *MockController) GetBankCardList() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetBankCardList")
+}
+
+// GetBankCardList indicates an expected call of GetBankCardList.
+func (mr *MockControllerMockRecorder) GetBankCardList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankCardList", reflect.TypeOf((*MockController)(nil).GetBankCardList))
+}
+
+// GetFileInfo mocks base method.
+func (m *MockController) GetFileInfo(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetFileInfo", arg0)
+}
+
+// GetFileInfo indicates an expected call of GetFileInfo.
+func (mr *MockControllerMockRecorder) GetFileInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfo", reflect.TypeOf((*MockController)(nil).GetFileInfo), arg0)
+}
+
+// GetFilesInfoList mocks base method.
+func (m *MockController) GetFilesInfoList() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetFilesInfoList")
+}
+
+// GetFilesInfoList indicates an expected call of GetFilesInfoList.
+func (mr *MockControllerMockRecorder) GetFilesInfoList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesInfoList", reflect.TypeOf((*MockController)(nil).GetFilesInfoList))
+}
+
+// GetSSHKey mocks base method.
+func (m *MockController) GetSSHKey(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSSHKey", arg0)
+}
+
+// GetSSHKey indicates an expected call of GetSSHKey.
+func (mr *MockControllerMockRecorder) GetSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKey", reflect.TypeOf((*MockController)(nil).GetSSHKey), arg0)
+}
+
+// GetSSHKeyList mocks base method.
+func (m *MockController) GetSSHKeyList() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSSHKeyList")
+}
+
+// GetSSHKeyList indicates an expected call of GetSSHKeyList.
+func (mr *MockControllerMockRecorder) GetSSHKeyList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKeyList", reflect.TypeOf((*MockController)(nil).GetSSHKeyList))
+}
+
+// GetSecureNote mocks base method.
+func (m *MockController) GetSecureNote(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSecureNote", arg0)
+}
+
+// GetSecureNote indicates an expected call of GetSecureNote.
+func (mr *MockControllerMockRecorder) GetSecureNote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecureNote", reflect.TypeOf((*MockController)(nil).GetSecureNote), arg0)
+}
+
+// GetSecureNoteList mocks base method.
+func (m *MockController) GetSecureNoteList() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSecureNoteList")
+}
+
+// GetSecureNoteList indicates an expected call of GetSecureNoteList.
+func (mr *MockControllerMockRecorder) GetSecureNoteList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecureNoteList", reflect.TypeOf((*MockController)(nil).GetSecureNoteList))
+}
+
+// GetUserPasswordData mocks base method.
+func (m *MockController) GetUserPasswordData(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserPasswordData", arg0)
+}
+
+// GetUserPasswordData indicates an expected call of GetUserPasswordData.
+func (mr *MockControllerMockRecorder) GetUserPasswordData(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordData", reflect.TypeOf((*MockController)(nil).GetUserPasswordData), arg0)
+}
+
+// GetUserPasswordDataList mocks base method.
+func (m *MockController) GetUserPasswordDataList() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserPasswordDataList")
+}
+
+// GetUserPasswordDataList indicates an expected call of GetUserPasswordDataList.
+func (mr *MockControllerMockRecorder) GetUserPasswordDataList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordDataList", reflect.TypeOf((*MockController)(nil).GetUserPasswordDataList))
+}
+
+// LoginCheckMasterKey mocks base method.
+func (m *MockController) LoginCheckMasterKey(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LoginCheckMasterKey", arg0)
+}
+
+// LoginCheckMasterKey indicates an expected call of LoginCheckMasterKey.
+func (mr *MockControllerMockRecorder) LoginCheckMasterKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginCheckMasterKey", reflect.TypeOf((*MockController)(nil).LoginCheckMasterKey), arg0)
+}
+
+// LoginEMail mocks base method.
+func (m *MockController) LoginEMail(arg0 *domain.EMailData) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LoginEMail", arg0)
+}
+
+// LoginEMail indicates an expected call of LoginEMail.
+func (mr *MockControllerMockRecorder) LoginEMail(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginEMail", reflect.TypeOf((*MockController)(nil).LoginEMail), arg0)
+}
+
+// LoginPassOTP mocks base method.
+func (m *MockController) LoginPassOTP(arg0 *domain.OTPPass) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LoginPassOTP", arg0)
+}
+
+// LoginPassOTP indicates an expected call of LoginPassOTP.
+func (mr *MockControllerMockRecorder) LoginPassOTP(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginPassOTP", reflect.TypeOf((*MockController)(nil).LoginPassOTP), arg0)
+}
+
+// OfflineUnlock mocks base method.
+func (m *MockController) OfflineUnlock(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OfflineUnlock", arg0)
+}
+
+// OfflineUnlock indicates an expected call of OfflineUnlock.
+func (mr *MockControllerMockRecorder) OfflineUnlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfflineUnlock", reflect.TypeOf((*MockController)(nil).OfflineUnlock), arg0)
+}
+
+// SaveFile mocks base method.
+func (m *MockController) SaveFile(arg0 *domain.FileInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SaveFile", arg0)
+}
+
+// SaveFile indicates an expected call of SaveFile.
+func (mr *MockControllerMockRecorder) SaveFile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFile", reflect.TypeOf((*MockController)(nil).SaveFile), arg0)
+}
+
+// SecretItem mocks base method.
+func (m *MockController) SecretItem(arg0 context.Context, arg1 domain.SecretItemType, arg2 string) (interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretItem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretItem indicates an expected call of SecretItem.
+func (mr *MockControllerMockRecorder) SecretItem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretItem", reflect.TypeOf((*MockController)(nil).SecretItem), arg0, arg1, arg2)
+}
+
+// UploadFile mocks base method.
+func (m *MockController) UploadFile(arg0 *domain.FileInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UploadFile", arg0)
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockControllerMockRecorder) UploadFile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockController)(nil).UploadFile), arg0)
+}
+
+// MockTokenHolder is a mock of TokenHolder interface.
+type MockTokenHolder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenHolderMockRecorder
+}
+
+// MockTokenHolderMockRecorder is the mock recorder for MockTokenHolder.
+type MockTokenHolderMockRecorder struct {
+	mock *MockTokenHolder
+}
+
+// NewMockTokenHolder creates a new mock instance.
+func NewMockTokenHolder(ctrl *gomock.Controller) *MockTokenHolder {
+	mock := &MockTokenHolder{ctrl: ctrl}
+	mock.recorder = &MockTokenHolderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenHolder) EXPECT() *MockTokenHolderMockRecorder {
+	return m.recorder
+}
+
+// JWTToken mocks base method.
+func (m *MockTokenHolder) JWTToken() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWTToken")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// JWTToken indicates an expected call of JWTToken.
+func (mr *MockTokenHolderMockRecorder) JWTToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWTToken", reflect.TypeOf((*MockTokenHolder)(nil).JWTToken))
+}
+
+// RefreshToken mocks base method.
+func (m *MockTokenHolder) RefreshToken() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockTokenHolderMockRecorder) RefreshToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockTokenHolder)(nil).RefreshToken))
+}
+
+// SetJWTToken mocks base method.
+func (m *MockTokenHolder) SetJWTToken(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetJWTToken", arg0)
+}
+
+// SetJWTToken indicates an expected call of SetJWTToken.
+func (mr *MockTokenHolderMockRecorder) SetJWTToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJWTToken", reflect.TypeOf((*MockTokenHolder)(nil).SetJWTToken), arg0)
+}
+
+// SetRefreshToken mocks base method.
+func (m *MockTokenHolder) SetRefreshToken(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRefreshToken", arg0)
+}
+
+// SetRefreshToken indicates an expected call of SetRefreshToken.
+func (mr *MockTokenHolderMockRecorder) SetRefreshToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRefreshToken", reflect.TypeOf((*MockTokenHolder)(nil).SetRefreshToken), arg0)
+}
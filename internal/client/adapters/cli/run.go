@@ -0,0 +1,276 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// SecretTimeout limits the loading of every item referenced by run.
+const SecretTimeout = 30 * time.Second
+
+// scrubbed is written to the child output instead of the secret values
+const scrubbed = "***"
+
+// childExitError keeps the exit code of the command started by run; the code is returned by Run as is.
+type childExitError struct {
+	code int
+}
+
+func (ce *childExitError) Error() string {
+	return fmt.Sprintf("command exited with code %d", ce.code)
+}
+
+// secretRef is the item field exported to the child environment: VAR=<type>:<name>.<field>.
+type secretRef struct {
+	env   string
+	kind  *itemKind
+	name  string
+	field string
+}
+
+// parseSecretRef splits the reference; the field is separated by the last dot, so the name may contain dots.
+func parseSecretRef(value string) (*secretRef, error) {
+	env, ref, ok := strings.Cut(value, "=")
+	if !ok || env == "" {
+		return nil, fmt.Errorf("%w - wrong --env %v, expected VAR=<type>:<name>.<field>", errUsage, value)
+	}
+
+	kindName, item, ok := strings.Cut(ref, ":")
+	dot := strings.LastIndex(item, ".")
+	if !ok || dot <= 0 || dot == len(item)-1 {
+		return nil, fmt.Errorf("%w - wrong --env %v, expected VAR=<type>:<name>.<field>", errUsage, value)
+	}
+
+	kind, err := getItemKind(kindName)
+	if err != nil {
+		return nil, err
+	}
+	if kind.itemType == "" {
+		return nil, fmt.Errorf("%w - %v fields can't be exported", errUsage, kindName)
+	}
+
+	return &secretRef{
+		env:   env,
+		kind:  kind,
+		name:  item[:dot],
+		field: item[dot+1:],
+	}, nil
+}
+
+// envFlag collects the repeated --env flags.
+type envFlag []string
+
+func (ef *envFlag) String() string {
+	return strings.Join(*ef, ",")
+}
+
+func (ef *envFlag) Set(value string) error {
+	*ef = append(*ef, value)
+	return nil
+}
+
+// runCommand starts the command with the referenced item fields in the environment; the values are kept
+// in memory only. The command follows -- and gets stdin; the client exits with the exit code of the command.
+func (cl *commandLine) runCommand(args []string) error {
+	fs := newFlagSet("run")
+	var envs envFlag
+	fs.Var(&envs, "env", "VAR=<type>:<name>.<field>, repeatable")
+	scrub := fs.Bool("scrub", false, "replace the secret values in the command output")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w - run %v", errUsage, err.Error())
+	}
+
+	command := fs.Args()
+	if len(envs) == 0 || len(command) == 0 {
+		return fmt.Errorf("%w - run expects --env and the command after --, see help", errUsage)
+	}
+
+	refs := make([]*secretRef, 0, len(envs))
+	for _, env := range envs {
+		ref, err := parseSecretRef(env)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, ref)
+	}
+
+	if err := cl.openVault(); err != nil {
+		return err
+	}
+
+	environ := childEnviron()
+	secrets := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		value, err := cl.secretField(ref)
+		if err != nil {
+			return err
+		}
+		environ = append(environ, ref.env+"="+value)
+		secrets = append(secrets, value)
+	}
+
+	return cl.startChild(command, environ, secrets, *scrub)
+}
+
+// secretField decrypts the referenced item and returns the field value.
+func (cl *commandLine) secretField(ref *secretRef) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), SecretTimeout)
+	defer cancel()
+
+	item, err := cl.controller.SecretItem(ctx, ref.kind.itemType, ref.name)
+	if err != nil {
+		return "", fmt.Errorf("%w - can't read %v - %v", errOperation, ref.env, err.Error())
+	}
+
+	// the current code is exported like it is shown by get
+	if auth, ok := item.(*domain.Authenticator); ok {
+		item = newAuthenticatorItem(auth)
+	}
+
+	value, err := itemField(item, ref.field)
+	if err != nil {
+		return "", fmt.Errorf("%w - %v", err, ref.env)
+	}
+	return value, nil
+}
+
+// childEnviron returns the client environment without the client secrets.
+func childEnviron() []string {
+	return slices.DeleteFunc(os.Environ(), func(env string) bool {
+		name, _, _ := strings.Cut(env, "=")
+		return name == EnvPassword || name == EnvMasterPassword || name == EnvSession
+	})
+}
+
+func (cl *commandLine) startChild(command []string, environ []string, secrets []string, scrub bool) error {
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Env = environ
+	cmd.Stdin = cl.stdin
+	cmd.Stdout = cl.stdout
+	cmd.Stderr = cl.stderr
+
+	var outputs []*scrubWriter
+	if scrub {
+		stdout := newScrubWriter(cl.stdout, secrets)
+		stderr := newScrubWriter(cl.stderr, secrets)
+		cmd.Stdout, cmd.Stderr = stdout, stderr
+		outputs = append(outputs, stdout, stderr)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("%w - can't start %v - %v", errOperation, command[0], err.Error())
+	}
+
+	// the signals sent to the client are passed to the command
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer func() {
+		signal.Stop(signals)
+		close(signals)
+	}()
+	go func() {
+		for sig := range signals {
+			_ = cmd.Process.Signal(sig)
+		}
+	}()
+
+	err := cmd.Wait()
+	for _, output := range outputs {
+		if flushErr := output.Flush(); flushErr != nil && err == nil {
+			err = flushErr
+		}
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &exitErr) && exitErr.ExitCode() > 0:
+		return &childExitError{code: exitErr.ExitCode()}
+	default:
+		return fmt.Errorf("%w - %v - %v", errOperation, command[0], err.Error())
+	}
+}
+
+// scrubWriter replaces the secret values in the output. The end of the output that may be the beginning
+// of a secret is kept until the next write, so a value split between writes is replaced too.
+type scrubWriter struct {
+	w       io.Writer
+	secrets []string
+	buf     string
+}
+
+func newScrubWriter(w io.Writer, secrets []string) *scrubWriter {
+	// the longer value is replaced first if one value contains another
+	secrets = slices.DeleteFunc(slices.Clone(secrets), func(secret string) bool {
+		return secret == ""
+	})
+	slices.SortFunc(secrets, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	return &scrubWriter{
+		w:       w,
+		secrets: secrets,
+	}
+}
+
+func (sw *scrubWriter) Write(p []byte) (int, error) {
+	out, rest := sw.scrub(sw.buf+string(p), false)
+	if _, err := io.WriteString(sw.w, out); err != nil {
+		return 0, err
+	}
+	sw.buf = rest
+	return len(p), nil
+}
+
+// Flush writes the kept output; invoked after the command is exited.
+func (sw *scrubWriter) Flush() error {
+	out, _ := sw.scrub(sw.buf, true)
+	_, err := io.WriteString(sw.w, out)
+	sw.buf = ""
+	return err
+}
+
+// scrub replaces the secrets in data and returns the output and the rest kept until the next write.
+// The rest starts at the first position where a secret may continue beyond data: the replacement is not done
+// before the longest possible match is known, so the shorter secret doesn't hide the beginning of the longer one.
+// Nothing is kept if data is final.
+func (sw *scrubWriter) scrub(data string, final bool) (string, string) {
+	var out strings.Builder
+	i := 0
+scan:
+	for i < len(data) {
+		rest := data[i:]
+		if !final {
+			for _, secret := range sw.secrets {
+				if len(rest) < len(secret) && strings.HasPrefix(secret, rest) {
+					break scan
+				}
+			}
+		}
+
+		for _, secret := range sw.secrets {
+			if strings.HasPrefix(rest, secret) {
+				out.WriteString(scrubbed)
+				i += len(secret)
+				continue scan
+			}
+		}
+
+		out.WriteByte(data[i])
+		i++
+	}
+	return out.String(), data[i:]
+}
@@ -0,0 +1,114 @@
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/cli"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	t.Setenv(cli.EnvMasterPassword, "master")
+
+	// unlocked creates the command line with the vault unlocked and the password item returned by SecretItem
+	unlocked := func(t *testing.T, te *testEnv) (commandLine, *MockController) {
+		ctrl := gomock.NewController(t)
+		cmdLine, controller := te.newCommandLine(ctrl, "", nil)
+		controller.EXPECT().LoginCheckMasterKey("master").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowDataAccessView()
+			}).Times(1)
+		controller.EXPECT().SecretItem(gomock.Any(), domain.SecretItemUserPassword, "db.prod").
+			DoAndReturn(func(ctx context.Context, itemType domain.SecretItemType, name string) (any, error) {
+				return &domain.UserPasswordData{Hint: "db.prod", Login: "admin", Passwrod: "secret"}, nil
+			}).Times(1)
+		return cmdLine, controller
+	}
+
+	t.Run("exit_code", func(t *testing.T) {
+		te := newTestEnv(t)
+		cmdLine, _ := unlocked(t, te)
+
+		code := cmdLine.Run([]string{"run", "--env", "DB_PASS=password:db.prod.password", "--",
+			"sh", "-c", `echo "$DB_PASS"; test -z "$` + cli.EnvMasterPassword + `" || echo leaked; exit 7`})
+		assert.Equal(t, 7, code)
+		assert.Equal(t, "secret\n", te.stdout.String())
+		assert.Empty(t, te.stderr.String())
+	})
+
+	t.Run("scrub", func(t *testing.T) {
+		te := newTestEnv(t)
+		cmdLine, _ := unlocked(t, te)
+
+		code := cmdLine.Run([]string{"run", "--scrub", "--env", "DB_PASS=password:db.prod.password", "--",
+			"sh", "-c", `echo "pass=$DB_PASS"; printf sec; printf 'ret\n'; echo "$DB_PASS" >&2; printf se`})
+		assert.Equal(t, cli.ExitOK, code)
+		assert.Equal(t, "pass=***\n***\nse", te.stdout.String())
+		assert.Equal(t, "***\n", te.stderr.String())
+	})
+
+	t.Run("scrub_overlapping", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+		cmdLine, controller := te.newCommandLine(ctrl, "", nil)
+		controller.EXPECT().LoginCheckMasterKey("master").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowDataAccessView()
+			}).Times(1)
+		controller.EXPECT().SecretItem(gomock.Any(), domain.SecretItemUserPassword, "db.prod").
+			Return(&domain.UserPasswordData{Hint: "db.prod", Login: "abc", Passwrod: "abcdef"}, nil).Times(2)
+
+		// the shorter secret is written first, the longer one is completed by the next write
+		code := cmdLine.Run([]string{"run", "--scrub", "--env", "DB_USER=password:db.prod.login",
+			"--env", "DB_PASS=password:db.prod.password", "--",
+			"sh", "-c", `printf abc; printf 'def\n'; printf abc; printf 'x\n'; printf abc`})
+		assert.Equal(t, cli.ExitOK, code)
+		assert.Equal(t, "***\n***x\n***", te.stdout.String())
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+		cmdLine, controller := te.newCommandLine(ctrl, "", nil)
+		controller.EXPECT().LoginCheckMasterKey("master").
+			Do(func(masterKeyPassword string) {
+				cmdLine.ShowDataAccessView()
+			}).Times(1)
+		controller.EXPECT().SecretItem(gomock.Any(), domain.SecretItemBankCard, "100").
+			Return(nil, domain.ErrDataNotExists).Times(1)
+
+		code := cmdLine.Run([]string{"run", "--env", "CARD=card:100.cvv", "--", "sh", "-c", "echo started"})
+		assert.Equal(t, cli.ExitError, code)
+		assert.Contains(t, te.stderr.String(), "CARD")
+		assert.Empty(t, te.stdout.String())
+	})
+
+	t.Run("usage", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		te := newTestEnv(t)
+
+		for _, args := range [][]string{
+			{"run", "--", "true"},
+			{"run", "--env", "DB_PASS=password:db.password"},
+			{"run", "--env", "DB_PASS=password:db", "--", "true"},
+			{"run", "--env", "=password:db.password", "--", "true"},
+			{"run", "--env", "REPORT=file:report.name", "--", "true"},
+		} {
+			cmdLine, _ := te.newCommandLine(ctrl, "", nil)
+			assert.Equal(t, cli.ExitUsage, cmdLine.Run(args), args)
+		}
+	})
+
+	t.Run("start_failed", func(t *testing.T) {
+		te := newTestEnv(t)
+		cmdLine, _ := unlocked(t, te)
+
+		code := cmdLine.Run([]string{"run", "--env", "DB_PASS=password:db.prod.password", "--", "/not/existing/command"})
+		require.Equal(t, cli.ExitError, code)
+		assert.Contains(t, te.stderr.String(), "can't start")
+	})
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// session is kept in the file between the command line runs. The master password is encrypted
+// with the session key printed by unlock; the key is passed to the next runs by GOPHKEEPER_SESSION.
+type session struct {
+	EMail          string `json:"email,omitempty"`
+	JWTToken       string `json:"jwtToken,omitempty"`
+	RefreshToken   string `json:"refreshToken,omitempty"`
+	MasterPassword string `json:"masterPassword,omitempty"`
+}
+
+// loadSession returns the empty session if the file is not exists.
+func loadSession(path string) (*session, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &session{}, nil
+		}
+		return nil, fmt.Errorf("%w - can't read session file %v", domain.ErrClientInternal, err.Error())
+	}
+
+	var sess session
+	if err := json.Unmarshal(data, &sess); err != nil {
+		return nil, fmt.Errorf("%w - can't decode session file %v", domain.ErrClientDataIncorrect, err.Error())
+	}
+	return &sess, nil
+}
+
+// save replaces the session file; the file is readable only by the current user.
+func (sess *session) save(path string) error {
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return fmt.Errorf("%w - can't marshal session %v", domain.ErrClientInternal, err.Error())
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("%w - can't create session file %v", domain.ErrClientInternal, err.Error())
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("%w - can't write session file %v", domain.ErrClientInternal, err.Error())
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("%w - can't write session file %v", domain.ErrClientInternal, err.Error())
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("%w - can't replace session file %v", domain.ErrClientInternal, err.Error())
+	}
+	return nil
+}
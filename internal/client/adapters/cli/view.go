@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+var _ app.AppView = (*commandLine)(nil)
+
+// the views shown by the controller are turned into the events the command waits for
+type viewKind int
+
+const (
+	viewOther      viewKind = iota // not interesting for the command
+	viewMsg                        // the operation is failed
+	viewOTP                        // the login OTP is expected
+	viewMasterKey                  // the master password is expected; data is the hint
+	viewDataAccess                 // the vault is unlocked
+	viewList                       // data is the list of names or []domain.FileInfo
+	viewItem                       // data is the item
+)
+
+type viewEvent struct {
+	kind viewKind
+	data any
+	msg  string
+}
+
+// namesEvent returns the list event; the empty list is printed as [].
+func namesEvent(names []string) viewEvent {
+	if names == nil {
+		names = []string{}
+	}
+	return viewEvent{kind: viewList, data: names}
+}
+
+func (cl *commandLine) emit(event viewEvent) {
+	cl.eventsMx.Lock()
+	cl.events = append(cl.events, event)
+	cl.eventsMx.Unlock()
+
+	select {
+	case cl.eventNotify <- struct{}{}:
+	default:
+	}
+}
+
+// wait returns the first event of the kind; the message shown by the controller is returned as error.
+// The events of the other kinds are skipped.
+func (cl *commandLine) wait(kind viewKind) (*viewEvent, error) {
+	for {
+		cl.eventsMx.Lock()
+		for len(cl.events) > 0 {
+			event := cl.events[0]
+			cl.events = cl.events[1:]
+			switch {
+			case event.kind == viewMsg:
+				cl.eventsMx.Unlock()
+				return nil, fmt.Errorf("%w - %s", errOperation, event.msg)
+			case event.kind == viewMasterKey && kind != viewMasterKey:
+				if hint, ok := event.data.(string); ok && hint != "" {
+					cl.hint = hint
+				}
+			case event.kind == kind:
+				cl.eventsMx.Unlock()
+				return &event, nil
+			}
+		}
+		cl.eventsMx.Unlock()
+		<-cl.eventNotify
+	}
+}
+
+func (cl *commandLine) ShowError(err error) {
+	cl.emit(viewEvent{kind: viewMsg, msg: err.Error()})
+}
+
+func (cl *commandLine) ShowMsg(msg string) {
+	cl.emit(viewEvent{kind: viewMsg, msg: msg})
+}
+
+func (cl *commandLine) ShowLogOTPView() {
+	cl.emit(viewEvent{kind: viewOTP})
+}
+
+func (cl *commandLine) ShowMasterKeyView(hint string) {
+	cl.emit(viewEvent{kind: viewMasterKey, data: hint})
+}
+
+func (cl *commandLine) ShowDataAccessView() {
+	cl.emit(viewEvent{kind: viewDataAccess})
+}
+
+func (cl *commandLine) ShowLoginView() {}
+
+func (cl *commandLine) ShowRegView() {}
+
+func (cl *commandLine) ShowRegOTPView() {}
+
+func (cl *commandLine) ShowRegMasterKeyView() {}
+
+func (cl *commandLine) ShowBankCardListView(cardsNumber []string) {
+	cl.emit(namesEvent(cardsNumber))
+}
+
+func (cl *commandLine) ShowEditBankCardView(bankCard *domain.BankCard) {
+	cl.emit(viewEvent{kind: viewItem, data: bankCard})
+}
+
+func (cl *commandLine) ShowNewBankCardView() {}
+
+func (cl *commandLine) ShowBankCardConflictView(bankCard *domain.BankCardView) {}
+
+func (cl *commandLine) ShowUserPasswordDataListView(hints []string) {
+	cl.emit(namesEvent(hints))
+}
+
+func (cl *commandLine) ShowEditUserPasswordDataView(data *domain.UserPasswordData) {
+	cl.emit(viewEvent{kind: viewItem, data: data})
+}
+
+func (cl *commandLine) ShowNewUserPasswordDataView() {}
+
+func (cl *commandLine) ShowUserPasswordDataConflictView(data *domain.UserPasswordData) {}
+
+func (cl *commandLine) ShowFileInfoView(info *domain.FileInfo, meta *domain.FileMetadata) {
+	cl.emit(viewEvent{kind: viewItem, data: fileItem{
+		Name:   info.Name,
+		Upload: info.Upload,
+		Fields: meta.Fields,
+		Notes:  meta.Notes,
+	}})
+}
+
+func (cl *commandLine) ShowSecureNoteListView(titles []string) {
+	cl.emit(namesEvent(titles))
+}
+
+func (cl *commandLine) ShowEditSecureNoteView(note *domain.SecureNote) {
+	cl.emit(viewEvent{kind: viewItem, data: note})
+}
+
+func (cl *commandLine) ShowNewSecureNoteView() {}
+
+func (cl *commandLine) ShowSecureNoteConflictView(note *domain.SecureNote) {}
+
+func (cl *commandLine) ShowSecureNoteTooLargeView(note *domain.SecureNote) {
+	cl.emit(viewEvent{kind: viewMsg, msg: fmt.Sprintf("secure note %v is larger than %d bytes, upload it as file",
+		note.Title, domain.MaxSecureNoteSize)})
+}
+
+func (cl *commandLine) ShowAuthenticatorListView(names []string) {
+	cl.emit(namesEvent(names))
+}
+
+func (cl *commandLine) ShowEditAuthenticatorView(auth *domain.Authenticator) {
+	cl.emit(viewEvent{kind: viewItem, data: newAuthenticatorItem(auth)})
+}
+
+func newAuthenticatorItem(auth *domain.Authenticator) authenticatorItem {
+	item := authenticatorItem{Authenticator: *auth}
+	code, left, err := domain.AuthenticatorCode(auth.URI, time.Now())
+	if err == nil {
+		item.Code = code
+		item.ValidFor = int(left.Seconds())
+	}
+	return item
+}
+
+func (cl *commandLine) ShowNewAuthenticatorView(auth *domain.Authenticator) {}
+
+func (cl *commandLine) ShowAuthenticatorConflictView(auth *domain.Authenticator) {}
+
+func (cl *commandLine) ShowSSHKeyListView(names []string) {
+	cl.emit(namesEvent(names))
+}
+
+func (cl *commandLine) ShowEditSSHKeyView(key *domain.SSHKey) {
+	cl.emit(viewEvent{kind: viewItem, data: key})
+}
+
+func (cl *commandLine) ShowNewSSHKeyView() {}
+
+func (cl *commandLine) ShowSSHKeyConflictView(key *domain.SSHKey) {}
+
+// ShowSSHSignConfirmView refuses the signing - the ssh-agent is not started in the command line mode.
+func (cl *commandLine) ShowSSHSignConfirmView(ctx context.Context, name string, answer func(allowed bool)) {
+	answer(false)
+}
+
+func (cl *commandLine) ShowFileInfoListView(filesInfoList []domain.FileInfo) {
+	files := make([]fileItem, 0, len(filesInfoList))
+	for _, info := range filesInfoList {
+		files = append(files, fileItem{Name: info.Name, Upload: info.Upload})
+	}
+	cl.emit(viewEvent{kind: viewList, data: files})
+}
+
+func (cl *commandLine) ShowSessionListView(sessions []domain.SessionInfo) {}
+
+func (cl *commandLine) ShowTrashListView(entries []domain.TrashEntry) {}
+
+func (cl *commandLine) ShowItemHistoryView(itemType domain.SecretItemType, name string, revisions []domain.ItemRevision) {
+}
+
+func (cl *commandLine) RefreshItemsView(itemType domain.SecretItemType) {}
+
+func (cl *commandLine) ShowOfflineMode(savedAt time.Time) {}
+
+func (cl *commandLine) ShowPendingChanges(count int) {}
+
+// ShowDeleteConflictView keeps the change in the journal; the conflict is resolved in the interactive mode.
+func (cl *commandLine) ShowDeleteConflictView(itemType domain.SecretItemType, name string) {}
+
+func (cl *commandLine) CreateProgressBar(title string, percentage float64, progressText string, cancelFn func()) {
+}
+
+func (cl *commandLine) CloseProgerssBar() {}
@@ -2,7 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
@@ -22,121 +24,250 @@ func (h *handler) GetHelloData(ctx context.Context) (*domain.HelloData, error) {
 	return data, nil
 }
 
-func (h *handler) GetBankCardList(ctx context.Context) ([]domain.EncryptedBankCard, error) {
+func (h *handler) ListItems(ctx context.Context, itemType domain.SecretItemType) ([]domain.EncryptedSecretItem, error) {
 
-	list, err := h.dataAccessor.GetBankCardList(ctx, nil)
+	list, err := h.dataAccessor.ListItems(ctx, &proto.ListItemsRequest{
+		Type: secretItemTypeToProto(itemType),
+	})
 	if err != nil {
 		action := domain.GetAction(1)
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
 
-	resp := []domain.EncryptedBankCard{}
-	for _, card := range list.Cards {
-		resp = append(resp, domain.EncryptedBankCard{
-			Number:  card.Number,
-			Content: card.Content,
-		})
+	resp := []domain.EncryptedSecretItem{}
+	for _, item := range list.Items {
+		resp = append(resp, *secretItemFromProto(item))
 	}
 	return resp, nil
 }
 
-func (h *handler) CreateBankCard(ctx context.Context, req *domain.EncryptedBankCard) error {
-	encrBanckCard := &proto.CreateBankCardRequest{
-		Number:  req.Number,
-		Content: req.Content,
-	}
-	_, err := h.dataAccessor.CreateBankCard(ctx, encrBanckCard)
+func (h *handler) GetItem(ctx context.Context, itemID string) (*domain.EncryptedSecretItem, error) {
+
+	item, err := h.dataAccessor.GetItem(ctx, &proto.GetItemRequest{
+		Id: itemID,
+	})
 	if err != nil {
 		action := domain.GetAction(1)
-		return fmt.Errorf("%v err - %w", action, err)
+		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
-	return nil
+	return secretItemFromProto(item), nil
 }
 
-func (h *handler) DeleteBankCard(ctx context.Context, number string) error {
-	_, err := h.dataAccessor.DeleteBankCard(ctx, &proto.DeleteBankCardRequest{
-		Number: number,
-	})
+func (h *handler) CreateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 
+	resp, err := h.dataAccessor.CreateItem(ctx, &proto.CreateItemRequest{
+		Type:      secretItemTypeToProto(item.Type),
+		Name:      item.Name,
+		LookupKey: item.LookupKey,
+		Content:   item.Content,
+	})
 	if err != nil {
 		action := domain.GetAction(1)
-		return fmt.Errorf("%v err - %w", action, err)
+		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
-	return nil
+	return &domain.SecretItemRevision{
+		ID:       resp.Id,
+		Revision: resp.Revision,
+	}, nil
 }
 
-func (h *handler) UpdateBankCard(ctx context.Context, card *domain.EncryptedBankCard) error {
+func (h *handler) UpdateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 
-	_, err := h.dataAccessor.UpdateBankCard(ctx, &proto.UpdateBankCardRequest{
-		Number:  card.Number,
-		Content: card.Content,
+	resp, err := h.dataAccessor.UpdateItem(ctx, &proto.UpdateItemRequest{
+		Id:        item.ID,
+		Name:      item.Name,
+		LookupKey: item.LookupKey,
+		Content:   item.Content,
+		Revision:  item.Revision,
 	})
+	if err != nil {
+		action := domain.GetAction(1)
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &domain.SecretItemRevision{
+		ID:       item.ID,
+		Revision: resp.Revision,
+	}, nil
+}
+
+func (h *handler) DeleteItem(ctx context.Context, itemID string, revision int64) error {
 
+	_, err := h.dataAccessor.DeleteItem(ctx, &proto.DeleteItemRequest{
+		Id:       itemID,
+		Revision: revision,
+	})
 	if err != nil {
 		action := domain.GetAction(1)
 		return fmt.Errorf("%v err - %w", action, err)
 	}
-
 	return nil
 }
 
-func (h *handler) GetUserPasswordDataList(ctx context.Context) ([]domain.EncryptedUserPasswordData, error) {
+func (h *handler) GetChangesSince(ctx context.Context, seq int64) (*domain.SecretItemChanges, error) {
 
-	list, err := h.dataAccessor.GetUserPasswordDataList(ctx, nil)
+	resp, err := h.dataAccessor.GetChangesSince(ctx, &proto.GetChangesSinceRequest{
+		Seq: seq,
+	})
 	if err != nil {
 		action := domain.GetAction(1)
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
 
-	resp := []domain.EncryptedUserPasswordData{}
-	for _, card := range list.Datas {
-		resp = append(resp, domain.EncryptedUserPasswordData{
-			Hint:    card.Hint,
-			Content: card.Content,
+	changes := &domain.SecretItemChanges{
+		Seq:   resp.Seq,
+		Reset: resp.Reset_,
+	}
+	for _, item := range resp.Items {
+		changes.Items = append(changes.Items, *secretItemFromProto(item))
+	}
+	for _, item := range resp.Deleted {
+		changes.Deleted = append(changes.Deleted, domain.DeletedSecretItem{
+			ID:   item.Id,
+			Type: secretItemTypeFromProto(item.Type),
 		})
 	}
-	return resp, nil
+	return changes, nil
 }
 
-func (h *handler) CreateUserPasswordData(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
+// Watch receives item change events until ctx is done or the stream is broken.
+func (h *handler) Watch(ctx context.Context, seq int64, onEvent func(event *domain.ItemChangeEvent)) error {
+	action := domain.GetAction(1)
 
-	req := &proto.CreateUserPasswordDataRequest{
-		Hint:    data.Hint,
-		Content: data.Content,
+	stream, err := h.dataAccessor.Watch(ctx, &proto.WatchRequest{
+		Seq: seq,
+	})
+	if err != nil {
+		return fmt.Errorf("%v err - %w", action, err)
 	}
 
-	_, err := h.dataAccessor.CreateUserPasswordData(ctx, req)
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%v err - %w", action, err)
+		}
+		onEvent(&domain.ItemChangeEvent{
+			Seq:    event.Seq,
+			Kind:   itemChangeKindFromProto(event.Kind),
+			ItemID: event.Id,
+			Type:   secretItemTypeFromProto(event.Type),
+		})
+	}
+}
+
+func (h *handler) ListRevisions(ctx context.Context, itemID string) ([]domain.SecretItemHistoryEntry, error) {
+
+	resp, err := h.dataAccessor.ListRevisions(ctx, &proto.ListRevisionsRequest{
+		Id: itemID,
+	})
 	if err != nil {
 		action := domain.GetAction(1)
-		return fmt.Errorf("%v err - %w", action, err)
+		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
-	return nil
+
+	revisions := []domain.SecretItemHistoryEntry{}
+	for _, rev := range resp.Revisions {
+		revisions = append(revisions, domain.SecretItemHistoryEntry{
+			ItemID:     itemID,
+			Revision:   rev.Revision,
+			UpdatedAt:  rev.UpdatedAt.AsTime(),
+			ReplacedAt: rev.ReplacedAt.AsTime(),
+		})
+	}
+	return revisions, nil
 }
 
-func (h *handler) DeleteUserPasswordData(ctx context.Context, hint string) error {
+func (h *handler) GetRevision(ctx context.Context, itemID string, revision int64) (*domain.EncryptedSecretItem, error) {
 
-	_, err := h.dataAccessor.DeleteUserPasswordData(ctx, &proto.DeleteUserPasswordDataRequest{
-		Hint: hint,
+	item, err := h.dataAccessor.GetRevision(ctx, &proto.GetRevisionRequest{
+		Id:       itemID,
+		Revision: revision,
 	})
-
 	if err != nil {
 		action := domain.GetAction(1)
-		return fmt.Errorf("%v err - %w", action, err)
+		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
-	return nil
+	return secretItemFromProto(item), nil
 }
 
-func (h *handler) UpdateUserPasswordData(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
+func (h *handler) RestoreRevision(ctx context.Context, itemID string, revision int64, expected int64) (*domain.SecretItemRevision, error) {
 
-	_, err := h.dataAccessor.UpdateUserPasswordData(ctx, &proto.UpdateUserPasswordDataRequest{
-		Hint:    data.Hint,
-		Content: data.Content,
+	resp, err := h.dataAccessor.RestoreRevision(ctx, &proto.RestoreRevisionRequest{
+		Id:              itemID,
+		Revision:        revision,
+		CurrentRevision: expected,
 	})
-
 	if err != nil {
 		action := domain.GetAction(1)
-		return fmt.Errorf("%v err - %w", action, err)
+		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
+	return &domain.SecretItemRevision{
+		ID:       itemID,
+		Revision: resp.Revision,
+	}, nil
+}
 
-	return nil
+func itemChangeKindFromProto(kind proto.ItemChangeKind) domain.ItemChangeKind {
+	switch kind {
+	case proto.ItemChangeKind_ITEM_CHANGE_KIND_CREATED:
+		return domain.ItemCreated
+	case proto.ItemChangeKind_ITEM_CHANGE_KIND_UPDATED:
+		return domain.ItemUpdated
+	case proto.ItemChangeKind_ITEM_CHANGE_KIND_DELETED:
+		return domain.ItemDeleted
+	default:
+		return domain.ItemsChanged
+	}
+}
+
+func secretItemFromProto(item *proto.SecretItem) *domain.EncryptedSecretItem {
+	return &domain.EncryptedSecretItem{
+		ID:        item.Id,
+		Type:      secretItemTypeFromProto(item.Type),
+		Name:      item.Name,
+		LookupKey: item.LookupKey,
+		Content:   item.Content,
+		Revision:  item.Revision,
+		UpdatedAt: item.UpdatedAt.AsTime(),
+	}
+}
+
+func secretItemTypeFromProto(itemType proto.SecretItemType) domain.SecretItemType {
+	switch itemType {
+	case proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD:
+		return domain.SecretItemBankCard
+	case proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD:
+		return domain.SecretItemUserPassword
+	case proto.SecretItemType_SECRET_ITEM_TYPE_FILE_METADATA:
+		return domain.SecretItemFileMetadata
+	case proto.SecretItemType_SECRET_ITEM_TYPE_SECURE_NOTE:
+		return domain.SecretItemSecureNote
+	case proto.SecretItemType_SECRET_ITEM_TYPE_AUTHENTICATOR:
+		return domain.SecretItemAuthenticator
+	case proto.SecretItemType_SECRET_ITEM_TYPE_SSH_KEY:
+		return domain.SecretItemSSHKey
+	default:
+		return ""
+	}
+}
+
+func secretItemTypeToProto(itemType domain.SecretItemType) proto.SecretItemType {
+	switch itemType {
+	case domain.SecretItemBankCard:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD
+	case domain.SecretItemUserPassword:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD
+	case domain.SecretItemFileMetadata:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_FILE_METADATA
+	case domain.SecretItemSecureNote:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_SECURE_NOTE
+	case domain.SecretItemAuthenticator:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_AUTHENTICATOR
+	case domain.SecretItemSSHKey:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_SSH_KEY
+	default:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_UNSPECIFIED
+	}
 }
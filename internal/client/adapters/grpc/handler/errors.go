@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// decodeError converts grpc status to [domain.DetailedError]; errors.Is works with the domain error from errdetails.ErrorInfo.
+// Statuses without ErrorInfo (transport errors or an older server) are mapped by code.
+func decodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var dErr *domain.DetailedError
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == domain.ErrorDomain {
+			dErr = domain.NewDetailedErrorByReason(domain.ErrorReason(info.Reason), st.Message())
+			break
+		}
+	}
+
+	if dErr == nil {
+		base := errorByCode(st.Code())
+		if base == nil {
+			return err
+		}
+		dErr = domain.NewDetailedError(base, st.Message())
+		if dErr.Message == "" {
+			dErr.Message = base.Error()
+		}
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				dErr.WithFields(domain.FieldViolation{
+					Field:       v.Field,
+					Description: v.Description,
+				})
+			}
+		case *errdetails.RetryInfo:
+			dErr.WithRetryAfter(d.RetryDelay.AsDuration())
+		}
+	}
+	return dErr
+}
+
+func errorByCode(code codes.Code) error {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return domain.ErrServerIsNotResponding
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return domain.ErrNotAuthorized
+	case codes.ResourceExhausted:
+		return domain.ErrAuthTooManyAttempts
+	case codes.Aborted:
+		return domain.ErrDataConflict
+	case codes.InvalidArgument:
+		return domain.ErrClientDataIncorrect
+	case codes.Internal:
+		return domain.ErrServerInternal
+	default:
+		return nil
+	}
+}
+
+// errorDecodingClientStream decodes errors of sent and received messages.
+type errorDecodingClientStream struct {
+	grpc.ClientStream
+}
+
+func (s *errorDecodingClientStream) SendMsg(m any) error {
+	return decodeError(s.ClientStream.SendMsg(m))
+}
+
+func (s *errorDecodingClientStream) RecvMsg(m any) error {
+	return decodeError(s.ClientStream.RecvMsg(m))
+}
+
+func newErrorDecodingClientStream(cs grpc.ClientStream, err error) (grpc.ClientStream, error) {
+	if err != nil {
+		return nil, decodeError(err)
+	}
+	return &errorDecodingClientStream{ClientStream: cs}, nil
+}
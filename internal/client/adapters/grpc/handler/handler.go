@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
@@ -18,9 +20,9 @@ import (
 )
 
 func NewHandler(conf *config.ClientConf) (*handler, error) {
-	cred, err := loadTLSCredentials(conf.CACert)
+	cred, err := loadTLSCredentials(conf)
 	if err != nil {
-		return nil, fmt.Errorf("%w can't read CACert - %v", domain.ErrClientInternal, err.Error())
+		return nil, fmt.Errorf("%w can't load tls credentials - %v", domain.ErrClientInternal, err.Error())
 	}
 
 	h := &handler{}
@@ -40,27 +42,55 @@ func NewHandler(conf *config.ClientConf) (*handler, error) {
 	h.dataAccessor = proto.NewDataAccessorClient(client)
 	h.registrator = proto.NewRegistrationServiceClient(client)
 	h.fileAccessor = proto.NewFileAccessorClient(client)
+	h.sessionAccessor = proto.NewSessionServiceClient(client)
+	h.trashAccessor = proto.NewTrashServiceClient(client)
 
 	return h, nil
 }
 
 var _ app.AppServer = (*handler)(nil)
 
+const (
+	// jwt token is refreshed if it expires earlier
+	tokenRefreshBefore = 30 * time.Second
+	logoutTimeout      = 2 * time.Second
+)
+
 type handler struct {
-	conn         *grpc.ClientConn
-	pinger       proto.PingerClient
-	dataAccessor proto.DataAccessorClient
-	loginer      proto.AuthServiceClient
-	registrator  proto.RegistrationServiceClient
-	fileAccessor proto.FileAccessorClient
-	sessionID    string
-	jwtToken     string
+	conn            *grpc.ClientConn
+	pinger          proto.PingerClient
+	dataAccessor    proto.DataAccessorClient
+	loginer         proto.AuthServiceClient
+	registrator     proto.RegistrationServiceClient
+	fileAccessor    proto.FileAccessorClient
+	sessionAccessor proto.SessionServiceClient
+	trashAccessor   proto.TrashServiceClient
+	clientInfo      domain.ClientInfo
+	sessionID       string
+	tokenMtx        sync.Mutex
+	jwtToken        string
+	refreshToken    string
+}
+
+// ClientInfo sets device name and client version; they are sent to the server and shown in the session list.
+func (h *handler) ClientInfo(clientInfo *domain.ClientInfo) *handler {
+	h.clientInfo = *clientInfo
+	return h
 }
 
 func (h *handler) SetJWTToken(jwtToken string) {
+	h.tokenMtx.Lock()
+	defer h.tokenMtx.Unlock()
 	h.jwtToken = jwtToken
 }
 
+// SetRefreshToken restores the refresh token saved by the previous run of the command line client.
+func (h *handler) SetRefreshToken(refreshToken string) {
+	h.tokenMtx.Lock()
+	defer h.tokenMtx.Unlock()
+	h.refreshToken = refreshToken
+}
+
 func (h *handler) SetSessionID(sessionID string) {
 	h.sessionID = sessionID
 }
@@ -70,18 +100,47 @@ func (h *handler) SessionID() string {
 }
 
 func (h *handler) JWTToken() string {
+	h.tokenMtx.Lock()
+	defer h.tokenMtx.Unlock()
 	return h.jwtToken
 }
 
+func (h *handler) RefreshToken() string {
+	h.tokenMtx.Lock()
+	defer h.tokenMtx.Unlock()
+	return h.refreshToken
+}
+
 func (h *handler) Start() {
 }
 
 func (h *handler) Stop() {
+	h.logout()
 	if err := h.conn.Close(); err != nil {
 		panic(err)
 	}
 }
 
+// logout revokes tokens on server side; errors are ignored - the application is stopped anyway.
+func (h *handler) logout() {
+	refreshToken := h.RefreshToken()
+	if refreshToken == "" {
+		return
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), logoutTimeout)
+	defer cancelFn()
+
+	_, _ = h.loginer.Logout(ctx, &proto.LogoutRequest{
+		RefreshToken: refreshToken,
+	})
+
+	h.tokenMtx.Lock()
+	defer h.tokenMtx.Unlock()
+	h.jwtToken = ""
+	h.refreshToken = ""
+}
+
 func (h *handler) Ping(ctx context.Context) error {
 	_, err := h.pinger.Ping(ctx, nil)
 	return err
@@ -174,28 +233,76 @@ func (h *handler) PassLoginOTP(ctx context.Context, otpPass string) error {
 		return fmt.Errorf("%w: passOTP err ", err)
 	}
 	h.sessionID = ""
+
+	h.tokenMtx.Lock()
+	defer h.tokenMtx.Unlock()
 	h.jwtToken = resp.Token
+	h.refreshToken = resp.RefreshToken
 	return nil
 }
 
-func (h *handler) JWTInterceptor(ctx context.Context, method string, req interface{}, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+// actualJWTToken returns jwt token; the token is refreshed if it is about to expire.
+func (h *handler) actualJWTToken(ctx context.Context, method string) string {
+	if method == proto.AuthService_Refresh_FullMethodName {
+		return ""
+	}
 
-	if h.jwtToken != "" {
-		ctx = metadata.AppendToOutgoingContext(ctx, domain.AuthorizationMetadataTokenName, h.jwtToken)
+	// the lock is held during refresh, so parallel calls don't rotate the refresh token twice
+	h.tokenMtx.Lock()
+	defer h.tokenMtx.Unlock()
+
+	if h.jwtToken == "" || h.refreshToken == "" {
+		return h.jwtToken
 	}
-	return invoker(ctx, method, req, reply, cc, opts...)
+
+	expiresAt, err := domain.GetJWTExpiresAt(domain.JWTToken(h.jwtToken))
+	if err != nil || time.Until(expiresAt) > tokenRefreshBefore {
+		return h.jwtToken
+	}
+
+	resp, err := h.loginer.Refresh(ctx, &proto.RefreshRequest{
+		RefreshToken: h.refreshToken,
+	})
+	if err != nil {
+		// the call is made with the old token; server returns authorization error if it is expired
+		return h.jwtToken
+	}
+
+	h.jwtToken = resp.Token
+	h.refreshToken = resp.RefreshToken
+	return h.jwtToken
+}
+
+// withClientInfo appends device name and client version to the outgoing metadata.
+func (h *handler) withClientInfo(ctx context.Context) context.Context {
+	if h.clientInfo.DeviceName != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, domain.DeviceNameMetadataName, h.clientInfo.DeviceName)
+	}
+	if h.clientInfo.ClientVersion != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, domain.ClientVersionMetadataName, h.clientInfo.ClientVersion)
+	}
+	return ctx
+}
+
+func (h *handler) JWTInterceptor(ctx context.Context, method string, req interface{}, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx = h.withClientInfo(ctx)
+	if jwtToken := h.actualJWTToken(ctx, method); jwtToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, domain.AuthorizationMetadataTokenName, jwtToken)
+	}
+	return decodeError(invoker(ctx, method, req, reply, cc, opts...))
 }
 
 func (h *handler) JWTStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
-	if h.jwtToken != "" {
-		ctx = metadata.AppendToOutgoingContext(ctx, domain.AuthorizationMetadataTokenName, h.jwtToken)
+	ctx = h.withClientInfo(ctx)
+	if jwtToken := h.actualJWTToken(ctx, method); jwtToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, domain.AuthorizationMetadataTokenName, jwtToken)
 	}
 
-	return streamer(ctx, desc, cc, method, opts...)
+	return newErrorDecodingClientStream(streamer(ctx, desc, cc, method, opts...))
 }
 
-func loadTLSCredentials(caFile string) (credentials.TransportCredentials, error) {
-	file, err := os.Open(caFile)
+func loadTLSCredentials(conf *config.ClientConf) (credentials.TransportCredentials, error) {
+	file, err := os.Open(conf.CACert)
 	if err != nil {
 		return nil, err
 	}
@@ -217,9 +324,18 @@ func loadTLSCredentials(caFile string) (credentials.TransportCredentials, error)
 	}
 
 	// Create the credentials and return it
-	config := &tls.Config{
+	tlsConfig := &tls.Config{
 		RootCAs: certPool,
 	}
 
-	return credentials.NewTLS(config), nil
+	// client certificate is sent if server requires mutual TLS
+	if conf.TLSCert != "" {
+		clientCert, err := tls.LoadX509KeyPair(conf.TLSCert, conf.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
 }
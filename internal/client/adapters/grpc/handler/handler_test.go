@@ -14,9 +14,12 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	gp "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestHandler(t *testing.T) {
@@ -73,9 +76,47 @@ func TestHandler(t *testing.T) {
 		ctx := context.Background()
 		_, err := hnd.CheckEMail(ctx, email)
 		require.Error(t, err)
-		st, ok := status.FromError(err)
-		require.True(t, ok)
-		require.Equal(t, gp.Internal, st.Code())
+		require.ErrorIs(t, err, domain.ErrServerInternal)
+	})
+
+	t.Run("login_err_details", func(t *testing.T) {
+		athService.loginFn = func(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error) {
+			st, err := status.New(gp.ResourceExhausted, "locked").WithDetails(
+				&errdetails.ErrorInfo{
+					Reason: string(domain.ReasonTooManyAttempts),
+					Domain: domain.ErrorDomain,
+				},
+				&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequest_FieldViolation{
+						{Field: "Email", Description: "locked"},
+					},
+				},
+				&errdetails.RetryInfo{
+					RetryDelay: durationpb.New(time.Minute),
+				},
+			)
+			require.NoError(t, err)
+			return nil, st.Err()
+		}
+
+		err := hnd.Login(context.Background(), &domain.EMailData{EMail: "test@email", Password: "pass"})
+		require.ErrorIs(t, err, domain.ErrAuthTooManyAttempts)
+
+		var dErr *domain.DetailedError
+		require.ErrorAs(t, err, &dErr)
+		assert.Equal(t, domain.ReasonTooManyAttempts, dErr.Reason)
+		assert.Equal(t, "locked", dErr.Message)
+		assert.Equal(t, []domain.FieldViolation{{Field: "Email", Description: "locked"}}, dErr.Fields)
+		assert.Equal(t, time.Minute, dErr.RetryAfter)
+	})
+
+	t.Run("login_err_without_details", func(t *testing.T) {
+		athService.loginFn = func(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error) {
+			return nil, status.Error(gp.PermissionDenied, "")
+		}
+
+		err := hnd.Login(context.Background(), &domain.EMailData{EMail: "test@email", Password: "pass"})
+		require.ErrorIs(t, err, domain.ErrNotAuthorized)
 	})
 
 	t.Run("registrate_ok", func(t *testing.T) {
@@ -219,4 +260,163 @@ func TestHandler(t *testing.T) {
 		assert.Equal(t, resp.HelloEncrypted, data.HelloEncrypted)
 		assert.Equal(t, resp.MasterPasswordHint, data.MasterPasswordHint)
 	})
+
+	t.Run("refresh_ok", func(t *testing.T) {
+		// token expires earlier than it will be refreshed
+		signingKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmEdDSA)
+		require.NoError(t, err)
+		keys := domain.NewJWTKeySet(signingKey)
+
+		oldToken, err := domain.CreateJWTToken(keys, 10*time.Second, 1, "sessionID")
+		require.NoError(t, err)
+		newToken, err := domain.CreateJWTToken(keys, time.Hour, 1, "sessionID")
+		require.NoError(t, err)
+
+		hnd.SetSessionID("sessionID")
+		athService.passOTPFn = func(ctx context.Context, req *proto.PassOTPRequest) (*proto.AuthResponse, error) {
+			return &proto.AuthResponse{
+				Token:        string(oldToken),
+				RefreshToken: "refreshToken",
+			}, nil
+		}
+
+		athService.refreshFn = func(ctx context.Context, req *proto.RefreshRequest) (*proto.AuthResponse, error) {
+			assert.Equal(t, "refreshToken", req.RefreshToken)
+			return &proto.AuthResponse{
+				Token:        string(newToken),
+				RefreshToken: "newRefreshToken",
+			}, nil
+		}
+
+		athService.logoutFn = func(ctx context.Context, req *proto.LogoutRequest) (*empty.Empty, error) {
+			assert.Equal(t, "newRefreshToken", req.RefreshToken)
+			return &empty.Empty{}, nil
+		}
+
+		dtAccessor.helloFn = func(ctx context.Context, empty *empty.Empty) (*proto.HelloResponse, error) {
+			md, ok := metadata.FromIncomingContext(ctx)
+			require.True(t, ok)
+			values := md[domain.AuthorizationMetadataTokenName]
+			require.Equal(t, 1, len(values))
+			assert.Equal(t, string(newToken), values[0])
+			return &proto.HelloResponse{}, nil
+		}
+
+		ctx := context.Background()
+		err = hnd.PassLoginOTP(ctx, "otpPass")
+		require.NoError(t, err)
+		assert.Equal(t, "refreshToken", hnd.RefreshToken())
+
+		_, err = hnd.GetHelloData(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, string(newToken), hnd.JWTToken())
+		assert.Equal(t, "newRefreshToken", hnd.RefreshToken())
+	})
+
+	t.Run("sessionList_ok", func(t *testing.T) {
+		createdAt := time.Now().Add(-time.Hour).UTC()
+		lastSeenAt := time.Now().UTC()
+
+		hnd.ClientInfo(&domain.ClientInfo{
+			DeviceName:    "laptop",
+			ClientVersion: "v1.0.0",
+		})
+
+		ssService.getSessionListFn = func(ctx context.Context, req *empty.Empty) (*proto.SessionListResponse, error) {
+			md, ok := metadata.FromIncomingContext(ctx)
+			require.True(t, ok)
+			assert.Equal(t, []string{"laptop"}, md[domain.DeviceNameMetadataName])
+			assert.Equal(t, []string{"v1.0.0"}, md[domain.ClientVersionMetadataName])
+			return &proto.SessionListResponse{
+				Sessions: []*proto.SessionInfo{
+					{
+						SessionId:     "sessionID",
+						DeviceName:    "laptop",
+						ClientVersion: "v1.0.0",
+						PeerIp:        "127.0.0.1",
+						CreatedAt:     timestamppb.New(createdAt),
+						LastSeenAt:    timestamppb.New(lastSeenAt),
+						Current:       true,
+					},
+				},
+			}, nil
+		}
+
+		list, err := hnd.GetSessionList(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, len(list))
+		assert.Equal(t, "sessionID", list[0].SessionID)
+		assert.Equal(t, "127.0.0.1", list[0].PeerIP)
+		assert.True(t, createdAt.Equal(list[0].CreatedAt))
+		assert.True(t, lastSeenAt.Equal(list[0].LastSeenAt))
+		assert.True(t, list[0].Current)
+	})
+
+	t.Run("terminateSession_ok", func(t *testing.T) {
+		ssService.terminateSessionFn = func(ctx context.Context, req *proto.TerminateSessionRequest) (*empty.Empty, error) {
+			assert.Equal(t, "sessionID", req.SessionId)
+			return &empty.Empty{}, nil
+		}
+
+		err := hnd.TerminateSession(context.Background(), "sessionID")
+		require.NoError(t, err)
+	})
+
+	t.Run("listTrash_ok", func(t *testing.T) {
+		deletedAt := time.Now().Add(-time.Hour).UTC()
+		expiresAt := time.Now().Add(time.Hour).UTC()
+
+		trService.listTrashFn = func(ctx context.Context, req *empty.Empty) (*proto.ListTrashResponse, error) {
+			return &proto.ListTrashResponse{
+				Entries: []*proto.TrashEntry{
+					{
+						Id:        "fileID",
+						File:      true,
+						Name:      "file",
+						DeletedAt: timestamppb.New(deletedAt),
+						ExpiresAt: timestamppb.New(expiresAt),
+					},
+					{
+						Id:        "itemID",
+						Type:      proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD,
+						Name:      "encrypted",
+						DeletedAt: timestamppb.New(deletedAt),
+						ExpiresAt: timestamppb.New(expiresAt),
+					},
+				},
+			}, nil
+		}
+
+		list, err := hnd.ListTrash(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 2, len(list))
+		assert.Equal(t, domain.TrashFile, list[0].Type)
+		assert.Equal(t, "file", list[0].Name)
+		assert.True(t, deletedAt.Equal(list[0].DeletedAt))
+		assert.True(t, expiresAt.Equal(list[0].ExpiresAt))
+		assert.Equal(t, domain.SecretItemUserPassword, list[1].Type)
+		assert.Equal(t, "itemID", list[1].ID)
+	})
+
+	t.Run("restore_ok", func(t *testing.T) {
+		trService.restoreFn = func(ctx context.Context, req *proto.RestoreRequest) (*empty.Empty, error) {
+			assert.Equal(t, "fileID", req.Id)
+			assert.True(t, req.File)
+			return &empty.Empty{}, nil
+		}
+
+		err := hnd.RestoreFromTrash(context.Background(), domain.TrashFile, "fileID")
+		require.NoError(t, err)
+	})
+
+	t.Run("purgeTrash_ok", func(t *testing.T) {
+		trService.purgeTrashFn = func(ctx context.Context, req *proto.PurgeTrashRequest) (*empty.Empty, error) {
+			assert.Equal(t, "itemID", req.Id)
+			assert.False(t, req.File)
+			return &empty.Empty{}, nil
+		}
+
+		err := hnd.PurgeTrash(context.Background(), domain.SecretItemBankCard, "itemID")
+		require.NoError(t, err)
+	})
 }
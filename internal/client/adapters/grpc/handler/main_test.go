@@ -22,6 +22,8 @@ var srvPort int
 var dtAccessor = &dataAccessor{}
 var rgHandler = &regHandler{}
 var athService = &authService{}
+var ssService = &sessionService{}
+var trService = &trashService{}
 
 var wg sync.WaitGroup
 
@@ -72,6 +74,8 @@ func setup() error {
 	proto.RegisterRegistrationServiceServer(srv, rgHandler)
 	proto.RegisterDataAccessorServer(srv, dtAccessor)
 	proto.RegisterAuthServiceServer(srv, athService)
+	proto.RegisterSessionServiceServer(srv, ssService)
+	proto.RegisterTrashServiceServer(srv, trService)
 
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", srvPort))
 	if err != nil {
@@ -143,6 +147,8 @@ type authService struct {
 	proto.UnimplementedAuthServiceServer
 	loginFn   func(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error)
 	passOTPFn func(ctx context.Context, req *proto.PassOTPRequest) (*proto.AuthResponse, error)
+	refreshFn func(ctx context.Context, req *proto.RefreshRequest) (*proto.AuthResponse, error)
+	logoutFn  func(ctx context.Context, req *proto.LogoutRequest) (*empty.Empty, error)
 }
 
 func (aS *authService) Login(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error) {
@@ -152,3 +158,44 @@ func (aS *authService) Login(ctx context.Context, req *proto.LoginRequest) (*pro
 func (aS *authService) PassOTP(ctx context.Context, req *proto.PassOTPRequest) (*proto.AuthResponse, error) {
 	return aS.passOTPFn(ctx, req)
 }
+
+func (aS *authService) Refresh(ctx context.Context, req *proto.RefreshRequest) (*proto.AuthResponse, error) {
+	return aS.refreshFn(ctx, req)
+}
+
+func (aS *authService) Logout(ctx context.Context, req *proto.LogoutRequest) (*empty.Empty, error) {
+	return aS.logoutFn(ctx, req)
+}
+
+type sessionService struct {
+	proto.UnimplementedSessionServiceServer
+	getSessionListFn   func(ctx context.Context, req *empty.Empty) (*proto.SessionListResponse, error)
+	terminateSessionFn func(ctx context.Context, req *proto.TerminateSessionRequest) (*empty.Empty, error)
+}
+
+func (sS *sessionService) GetSessionList(ctx context.Context, req *empty.Empty) (*proto.SessionListResponse, error) {
+	return sS.getSessionListFn(ctx, req)
+}
+
+func (sS *sessionService) TerminateSession(ctx context.Context, req *proto.TerminateSessionRequest) (*empty.Empty, error) {
+	return sS.terminateSessionFn(ctx, req)
+}
+
+type trashService struct {
+	proto.UnimplementedTrashServiceServer
+	listTrashFn  func(ctx context.Context, req *empty.Empty) (*proto.ListTrashResponse, error)
+	restoreFn    func(ctx context.Context, req *proto.RestoreRequest) (*empty.Empty, error)
+	purgeTrashFn func(ctx context.Context, req *proto.PurgeTrashRequest) (*empty.Empty, error)
+}
+
+func (tS *trashService) ListTrash(ctx context.Context, req *empty.Empty) (*proto.ListTrashResponse, error) {
+	return tS.listTrashFn(ctx, req)
+}
+
+func (tS *trashService) Restore(ctx context.Context, req *proto.RestoreRequest) (*empty.Empty, error) {
+	return tS.restoreFn(ctx, req)
+}
+
+func (tS *trashService) PurgeTrash(ctx context.Context, req *proto.PurgeTrashRequest) (*empty.Empty, error) {
+	return tS.purgeTrashFn(ctx, req)
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+)
+
+func (h *handler) ListSecureNotes(ctx context.Context) ([]domain.EncryptedSecretItem, error) {
+
+	list, err := h.dataAccessor.ListSecureNotes(ctx, nil)
+	if err != nil {
+		action := domain.GetAction(1)
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := []domain.EncryptedSecretItem{}
+	for _, note := range list.Notes {
+		resp = append(resp, domain.EncryptedSecretItem{
+			ID:        note.Id,
+			Type:      domain.SecretItemSecureNote,
+			Name:      note.Title,
+			LookupKey: note.LookupKey,
+			Content:   note.Content,
+			Revision:  note.Revision,
+			UpdatedAt: note.UpdatedAt.AsTime(),
+		})
+	}
+	return resp, nil
+}
+
+func (h *handler) CreateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+
+	resp, err := h.dataAccessor.CreateSecureNote(ctx, &proto.CreateSecureNoteRequest{
+		Title:     note.Name,
+		LookupKey: note.LookupKey,
+		Content:   note.Content,
+	})
+	if err != nil {
+		action := domain.GetAction(1)
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &domain.SecretItemRevision{
+		ID:       resp.Id,
+		Type:     domain.SecretItemSecureNote,
+		Revision: resp.Revision,
+	}, nil
+}
+
+func (h *handler) UpdateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+
+	resp, err := h.dataAccessor.UpdateSecureNote(ctx, &proto.UpdateSecureNoteRequest{
+		Id:        note.ID,
+		Title:     note.Name,
+		LookupKey: note.LookupKey,
+		Content:   note.Content,
+		Revision:  note.Revision,
+	})
+	if err != nil {
+		action := domain.GetAction(1)
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &domain.SecretItemRevision{
+		ID:       note.ID,
+		Type:     domain.SecretItemSecureNote,
+		Revision: resp.Revision,
+	}, nil
+}
+
+func (h *handler) DeleteSecureNote(ctx context.Context, noteID string, revision int64) error {
+
+	_, err := h.dataAccessor.DeleteSecureNote(ctx, &proto.DeleteSecureNoteRequest{
+		Id:       noteID,
+		Revision: revision,
+	})
+	if err != nil {
+		action := domain.GetAction(1)
+		return fmt.Errorf("%v err - %w", action, err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+)
+
+func (h *handler) GetSessionList(ctx context.Context) ([]domain.SessionInfo, error) {
+
+	list, err := h.sessionAccessor.GetSessionList(ctx, nil)
+	if err != nil {
+		action := domain.GetAction(1)
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := []domain.SessionInfo{}
+	for _, session := range list.Sessions {
+		resp = append(resp, domain.SessionInfo{
+			SessionID:     session.SessionId,
+			DeviceName:    session.DeviceName,
+			ClientVersion: session.ClientVersion,
+			PeerIP:        session.PeerIp,
+			CreatedAt:     session.CreatedAt.AsTime(),
+			LastSeenAt:    session.LastSeenAt.AsTime(),
+			Current:       session.Current,
+		})
+	}
+	return resp, nil
+}
+
+func (h *handler) TerminateSession(ctx context.Context, sessionID string) error {
+	_, err := h.sessionAccessor.TerminateSession(ctx, &proto.TerminateSessionRequest{
+		SessionId: sessionID,
+	})
+
+	if err != nil {
+		action := domain.GetAction(1)
+		return fmt.Errorf("%v err - %w", action, err)
+	}
+	return nil
+}
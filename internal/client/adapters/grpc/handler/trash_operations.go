@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+)
+
+func (h *handler) ListTrash(ctx context.Context) ([]domain.TrashEntry, error) {
+
+	list, err := h.trashAccessor.ListTrash(ctx, nil)
+	if err != nil {
+		action := domain.GetAction(1)
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := []domain.TrashEntry{}
+	for _, entry := range list.Entries {
+		itemType := domain.TrashFile
+		if !entry.File {
+			itemType = secretItemTypeFromProto(entry.Type)
+		}
+		resp = append(resp, domain.TrashEntry{
+			ID:        entry.Id,
+			Type:      itemType,
+			Name:      entry.Name,
+			DeletedAt: entry.DeletedAt.AsTime(),
+			ExpiresAt: entry.ExpiresAt.AsTime(),
+		})
+	}
+	return resp, nil
+}
+
+func (h *handler) RestoreFromTrash(ctx context.Context, itemType domain.SecretItemType, id string) error {
+	_, err := h.trashAccessor.Restore(ctx, &proto.RestoreRequest{
+		Id:   id,
+		File: itemType == domain.TrashFile,
+	})
+
+	if err != nil {
+		action := domain.GetAction(1)
+		return fmt.Errorf("%v err - %w", action, err)
+	}
+	return nil
+}
+
+func (h *handler) PurgeTrash(ctx context.Context, itemType domain.SecretItemType, id string) error {
+	_, err := h.trashAccessor.PurgeTrash(ctx, &proto.PurgeTrashRequest{
+		Id:   id,
+		File: itemType == domain.TrashFile,
+	})
+
+	if err != nil {
+		action := domain.GetAction(1)
+		return fmt.Errorf("%v err - %w", action, err)
+	}
+	return nil
+}
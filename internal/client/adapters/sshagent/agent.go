@@ -0,0 +1,169 @@
+package sshagent
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// KeyProvider gives the ssh keys of the unlocked vault and asks the user to confirm the signing.
+type KeyProvider interface {
+	// SSHKeys returns no keys while the vault is locked.
+	SSHKeys(ctx context.Context) []domain.SSHKey
+	// ConfirmSSHSign returns false if the user refused the signing or did not answer until ctx is done.
+	ConfirmSSHSign(ctx context.Context, key *domain.SSHKey) bool
+}
+
+const (
+	// KeysTimeout limits the keys loading for one agent request.
+	KeysTimeout = 5 * time.Second
+	// ConfirmTimeout is the time the user has to confirm the signing request.
+	ConfirmTimeout = 30 * time.Second
+)
+
+// NewAgent create ssh-agent without own key storage: the keys are taken from the provider on every request
+// and are not kept in memory between requests.
+func NewAgent(ctx context.Context, provider KeyProvider) *keyAgent {
+	return &keyAgent{
+		ctx:      ctx,
+		provider: provider,
+	}
+}
+
+var _ agent.ExtendedAgent = (*keyAgent)(nil)
+
+type keyAgent struct {
+	ctx      context.Context // is done when the agent is stopped
+	provider KeyProvider
+}
+
+type vaultSigner struct {
+	key    domain.SSHKey
+	signer ssh.Signer
+}
+
+func (ka *keyAgent) signers() []vaultSigner {
+	log := app.GetMainLogger()
+	ctx, cancelFn := context.WithTimeout(ka.ctx, KeysTimeout)
+	defer cancelFn()
+
+	keys := ka.provider.SSHKeys(ctx)
+	signers := make([]vaultSigner, 0, len(keys))
+	for _, key := range keys {
+		signer, err := domain.SSHKeySigner(&key)
+		if err != nil {
+			log.Warnf("ssh key %v is skipped - %v", key.Name, err.Error())
+			continue
+		}
+		signers = append(signers, vaultSigner{key: key, signer: signer})
+	}
+	return signers
+}
+
+// List returns the public keys of the unlocked vault.
+func (ka *keyAgent) List() ([]*agent.Key, error) {
+	var ids []*agent.Key
+	for _, vs := range ka.signers() {
+		pub := vs.signer.PublicKey()
+		comment := vs.key.Comment
+		if comment == "" {
+			comment = vs.key.Name
+		}
+		ids = append(ids, &agent.Key{
+			Format:  pub.Type(),
+			Blob:    pub.Marshal(),
+			Comment: comment,
+		})
+	}
+	return ids, nil
+}
+
+func (ka *keyAgent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return ka.SignWithFlags(key, data, 0)
+}
+
+// SignWithFlags signs the data by the vault key; the user is asked to confirm the signing if the key requires it.
+func (ka *keyAgent) SignWithFlags(key ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	log := app.GetMainLogger()
+	wanted := key.Marshal()
+	for _, vs := range ka.signers() {
+		if !bytes.Equal(vs.signer.PublicKey().Marshal(), wanted) {
+			continue
+		}
+
+		if vs.key.Confirm {
+			ctx, cancelFn := context.WithTimeout(ka.ctx, ConfirmTimeout)
+			allowed := ka.provider.ConfirmSSHSign(ctx, &vs.key)
+			cancelFn()
+			if !allowed {
+				log.Infof("signing by ssh key %v is refused", vs.key.Name)
+				return nil, fmt.Errorf("agent: signing by key %v is refused", vs.key.Name)
+			}
+		}
+
+		if flags == 0 {
+			return vs.signer.Sign(rand.Reader, data)
+		}
+
+		algorithmSigner, ok := vs.signer.(ssh.AlgorithmSigner)
+		if !ok {
+			return nil, fmt.Errorf("agent: signature does not support non-default signature algorithm: %T", vs.signer)
+		}
+		var algorithm string
+		switch flags {
+		case agent.SignatureFlagRsaSha256:
+			algorithm = ssh.KeyAlgoRSASHA256
+		case agent.SignatureFlagRsaSha512:
+			algorithm = ssh.KeyAlgoRSASHA512
+		default:
+			return nil, fmt.Errorf("agent: unsupported signature flags: %d", flags)
+		}
+		return algorithmSigner.SignWithAlgorithm(rand.Reader, data, algorithm)
+	}
+	return nil, fmt.Errorf("agent: key is not found")
+}
+
+// Signers is not used by the agent protocol server.
+func (ka *keyAgent) Signers() ([]ssh.Signer, error) {
+	var signers []ssh.Signer
+	for _, vs := range ka.signers() {
+		signers = append(signers, vs.signer)
+	}
+	return signers, nil
+}
+
+// Add is not supported - the keys are added in the client.
+func (ka *keyAgent) Add(key agent.AddedKey) error {
+	return fmt.Errorf("agent: keys are managed by gophkeeper")
+}
+
+// Remove is not supported - the keys are deleted in the client.
+func (ka *keyAgent) Remove(key ssh.PublicKey) error {
+	return fmt.Errorf("agent: keys are managed by gophkeeper")
+}
+
+// RemoveAll is not supported - the keys are deleted in the client.
+func (ka *keyAgent) RemoveAll() error {
+	return fmt.Errorf("agent: keys are managed by gophkeeper")
+}
+
+// Lock is not supported - the keys are available while the vault is unlocked.
+func (ka *keyAgent) Lock(passphrase []byte) error {
+	return fmt.Errorf("agent: lock is not supported, keys are available while the vault is unlocked")
+}
+
+// Unlock is not supported - the keys are available while the vault is unlocked.
+func (ka *keyAgent) Unlock(passphrase []byte) error {
+	return fmt.Errorf("agent: unlock is not supported, keys are available while the vault is unlocked")
+}
+
+func (ka *keyAgent) Extension(extensionType string, contents []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
@@ -0,0 +1,133 @@
+package sshagent_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/sshagent"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+type testProvider struct {
+	keys    []domain.SSHKey
+	allowed bool
+	asked   int
+}
+
+func (tp *testProvider) SSHKeys(ctx context.Context) []domain.SSHKey {
+	return tp.keys
+}
+
+func (tp *testProvider) ConfirmSSHSign(ctx context.Context, key *domain.SSHKey) bool {
+	tp.asked++
+	return tp.allowed
+}
+
+func newKey(t *testing.T, name string, confirm bool) domain.SSHKey {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.NoError(t, err)
+	key := domain.SSHKey{
+		Name:       name,
+		PrivateKey: string(pem.EncodeToMemory(block)),
+		Confirm:    confirm,
+	}
+	require.NoError(t, domain.CheckSSHKey(&key))
+	return key
+}
+
+func startAgent(t *testing.T, provider sshagent.KeyProvider) agent.ExtendedAgent {
+	t.Helper()
+	// unix socket path length is limited, t.TempDir() may be too long
+	dir, err := os.MkdirTemp("", "agent")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "agent.sock")
+
+	ln := sshagent.NewListener(path, provider)
+	require.NoError(t, ln.Start())
+	t.Cleanup(ln.Stop)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return agent.NewClient(conn)
+}
+
+func TestAgent(t *testing.T) {
+
+	t.Run("list_sign", func(t *testing.T) {
+		key := newKey(t, "github", false)
+		provider := &testProvider{keys: []domain.SSHKey{key, {Name: "broken", PrivateKey: "wrong"}}}
+		client := startAgent(t, provider)
+
+		keys, err := client.List()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, "github", keys[0].Comment)
+
+		data := []byte("data")
+		sig, err := client.Sign(keys[0], data)
+		require.NoError(t, err)
+		require.NoError(t, keys[0].Verify(data, sig))
+		assert.Equal(t, 0, provider.asked)
+	})
+
+	t.Run("confirm", func(t *testing.T) {
+		key := newKey(t, "prod", true)
+		provider := &testProvider{keys: []domain.SSHKey{key}, allowed: true}
+		client := startAgent(t, provider)
+
+		keys, err := client.List()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+
+		_, err = client.Sign(keys[0], []byte("data"))
+		require.NoError(t, err)
+
+		provider.allowed = false
+		_, err = client.Sign(keys[0], []byte("data"))
+		require.Error(t, err)
+		assert.Equal(t, 2, provider.asked)
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		key := newKey(t, "github", false)
+		provider := &testProvider{keys: []domain.SSHKey{key}}
+		client := startAgent(t, provider)
+
+		keys, err := client.List()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+
+		provider.keys = nil
+		_, err = client.Sign(keys[0], []byte("data"))
+		require.Error(t, err)
+
+		keys, err = client.List()
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("not_supported", func(t *testing.T) {
+		client := startAgent(t, &testProvider{})
+		assert.Error(t, client.RemoveAll())
+		assert.Error(t, client.Lock([]byte("pass")))
+	})
+}
@@ -0,0 +1,2 @@
+// Package sshagent define ssh-agent serving the ssh keys of the unlocked vault on the local unix socket
+package sshagent
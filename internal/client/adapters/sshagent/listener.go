@@ -0,0 +1,121 @@
+package sshagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// NewListener create ssh-agent listener on the unix socket; the socket path is passed to ssh by SSH_AUTH_SOCK.
+func NewListener(path string, provider KeyProvider) *listener {
+	return &listener{
+		path:     path,
+		provider: provider,
+	}
+}
+
+type listener struct {
+	path     string
+	provider KeyProvider
+	ln       net.Listener
+	cancelFn context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// Start creates the socket available only for the current user and starts serving the agent requests;
+// the socket left by the previous run is removed.
+func (l *listener) Start() error {
+	if err := os.Remove(l.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w - can't remove ssh-agent socket %v", domain.ErrClientInternal, err.Error())
+	}
+
+	ln, err := listenPrivate(l.path)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	l.ln = ln
+	l.cancelFn = cancelFn
+	keyAgent := NewAgent(ctx, l.provider)
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		log := app.GetMainLogger()
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					log.Warnf("ssh-agent accept err %v", err.Error())
+				}
+				return
+			}
+
+			l.wg.Add(1)
+			go func() {
+				defer l.wg.Done()
+				defer conn.Close()
+				stop := context.AfterFunc(ctx, func() {
+					_ = conn.Close()
+				})
+				defer stop()
+				if err := agent.ServeAgent(keyAgent, conn); err != nil && !errors.Is(err, net.ErrClosed) {
+					log.Debugf("ssh-agent connection closed %v", err.Error())
+				}
+			}()
+		}
+	}()
+
+	app.GetMainLogger().Infof("ssh-agent is listening on %v", l.path)
+	return nil
+}
+
+// Stop closes the socket and the open connections, waits the requests completion and removes the socket.
+func (l *listener) Stop() {
+	if l.ln == nil {
+		return
+	}
+	l.cancelFn()
+	_ = l.ln.Close()
+	l.wg.Wait()
+	_ = os.Remove(l.path)
+}
+
+// listenPrivate creates the socket in a new directory available only for the current user, restricts the socket
+// mode and moves the socket to the path; other users can't connect between the creation and the mode change.
+func listenPrivate(path string) (net.Listener, error) {
+	// the directory is created next to the socket - the rename stays in the same file system
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".agent")
+	if err != nil {
+		return nil, fmt.Errorf("%w - can't create ssh-agent socket directory %v", domain.ErrClientInternal, err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, "s")
+	ln, err := net.Listen("unix", tmpPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w - can't listen ssh-agent socket %v", domain.ErrClientInternal, err.Error())
+	}
+	// the socket is moved; Stop removes it by the path
+	ln.(*net.UnixListener).SetUnlinkOnClose(false)
+
+	if err := os.Chmod(tmpPath, 0600); err != nil {
+		_ = ln.Close()
+		return nil, fmt.Errorf("%w - can't change ssh-agent socket mode %v", domain.ErrClientInternal, err.Error())
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = ln.Close()
+		return nil, fmt.Errorf("%w - can't move ssh-agent socket %v", domain.ErrClientInternal, err.Error())
+	}
+	return ln, nil
+}
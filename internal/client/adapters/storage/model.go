@@ -15,9 +15,18 @@ func NewStorage() *simpleStorage {
 		userPasswordData:   make(map[string]domain.UserPasswordData),
 		bankCards:          make(map[string]domain.BankCard),
 		filesInfo:          make(map[string]domain.FileInfo),
+		fileMetadata:       make(map[string]domain.FileMetadata),
+		secureNotes:        make(map[string]domain.SecureNote),
+		authenticators:     make(map[string]domain.Authenticator),
+		sshKeys:            make(map[string]domain.SSHKey),
 		userPasswordDataMx: &sync.Mutex{},
 		bankCardsMx:        &sync.Mutex{},
 		filesInfoMx:        &sync.Mutex{},
+		fileMetadataMx:     &sync.Mutex{},
+		secureNotesMx:      &sync.Mutex{},
+		authenticatorsMx:   &sync.Mutex{},
+		sshKeysMx:          &sync.Mutex{},
+		changeSeqMx:        &sync.Mutex{},
 	}
 }
 
@@ -32,6 +41,16 @@ type simpleStorage struct {
 	bankCardsMx        *sync.Mutex
 	filesInfo          map[string]domain.FileInfo
 	filesInfoMx        *sync.Mutex
+	fileMetadata       map[string]domain.FileMetadata
+	fileMetadataMx     *sync.Mutex
+	secureNotes        map[string]domain.SecureNote
+	secureNotesMx      *sync.Mutex
+	authenticators     map[string]domain.Authenticator
+	authenticatorsMx   *sync.Mutex
+	sshKeys            map[string]domain.SSHKey
+	sshKeysMx          *sync.Mutex
+	changeSeq          int64
+	changeSeqMx        *sync.Mutex
 }
 
 func (ss *simpleStorage) SetMasterPassword(masterPassword string) {
@@ -42,6 +61,18 @@ func (ss *simpleStorage) GetMasterPassword() string {
 	return ss.masterPassword
 }
 
+func (ss *simpleStorage) SetChangeSeq(seq int64) {
+	ss.changeSeqMx.Lock()
+	defer ss.changeSeqMx.Unlock()
+	ss.changeSeq = seq
+}
+
+func (ss *simpleStorage) GetChangeSeq() int64 {
+	ss.changeSeqMx.Lock()
+	defer ss.changeSeqMx.Unlock()
+	return ss.changeSeq
+}
+
 func (ss *simpleStorage) AddBankCard(bankCard *domain.BankCard) error {
 	ss.bankCardsMx.Lock()
 	defer ss.bankCardsMx.Unlock()
@@ -228,6 +259,55 @@ func (ss *simpleStorage) SetUserPasswordDatas(datas []domain.UserPasswordData) {
 	}
 }
 
+// ApplyBankCardChanges replaces the changed cards and removes the deleted ones; cards are matched by id
+// because the number of the changed card may differ from the stored one.
+func (ss *simpleStorage) ApplyBankCardChanges(changed []domain.BankCard, deletedIDs []string) {
+	ss.bankCardsMx.Lock()
+	defer ss.bankCardsMx.Unlock()
+
+	ids := make(map[string]struct{})
+	for _, card := range changed {
+		ids[card.ID] = struct{}{}
+	}
+	for _, id := range deletedIDs {
+		ids[id] = struct{}{}
+	}
+
+	for number, card := range ss.bankCards {
+		if _, ok := ids[card.ID]; ok {
+			delete(ss.bankCards, number)
+		}
+	}
+
+	for _, card := range changed {
+		ss.bankCards[card.Number] = card
+	}
+}
+
+// ApplyUserPasswordDataChanges replaces the changed data and removes the deleted ones; data are matched by id.
+func (ss *simpleStorage) ApplyUserPasswordDataChanges(changed []domain.UserPasswordData, deletedIDs []string) {
+	ss.userPasswordDataMx.Lock()
+	defer ss.userPasswordDataMx.Unlock()
+
+	ids := make(map[string]struct{})
+	for _, data := range changed {
+		ids[data.ID] = struct{}{}
+	}
+	for _, id := range deletedIDs {
+		ids[id] = struct{}{}
+	}
+
+	for hint, data := range ss.userPasswordData {
+		if _, ok := ids[data.ID]; ok {
+			delete(ss.userPasswordData, hint)
+		}
+	}
+
+	for _, data := range changed {
+		ss.userPasswordData[data.Hint] = data
+	}
+}
+
 func (ss *simpleStorage) SetFilesInfo(infs []domain.FileInfo) {
 	ss.filesInfoMx.Lock()
 	defer ss.filesInfoMx.Unlock()
@@ -243,3 +323,310 @@ func (ss *simpleStorage) IsFileInfoExists(name string) bool {
 	_, ok := ss.filesInfo[name]
 	return ok
 }
+
+func (ss *simpleStorage) SetFileMetadatas(metas []domain.FileMetadata) {
+	ss.fileMetadataMx.Lock()
+	defer ss.fileMetadataMx.Unlock()
+	ss.fileMetadata = make(map[string]domain.FileMetadata)
+	for _, meta := range metas {
+		ss.fileMetadata[meta.Name] = meta
+	}
+}
+
+// ApplyFileMetadataChanges replaces the changed metadata and removes the deleted ones; metadata are matched by id.
+func (ss *simpleStorage) ApplyFileMetadataChanges(changed []domain.FileMetadata, deletedIDs []string) {
+	ss.fileMetadataMx.Lock()
+	defer ss.fileMetadataMx.Unlock()
+
+	ids := make(map[string]struct{})
+	for _, meta := range changed {
+		ids[meta.ID] = struct{}{}
+	}
+	for _, id := range deletedIDs {
+		ids[id] = struct{}{}
+	}
+
+	for name, meta := range ss.fileMetadata {
+		if _, ok := ids[meta.ID]; ok {
+			delete(ss.fileMetadata, name)
+		}
+	}
+
+	for _, meta := range changed {
+		ss.fileMetadata[meta.Name] = meta
+	}
+}
+
+// GetFileMetadata returns the metadata of the file; the empty metadata is returned for the file without one.
+func (ss *simpleStorage) GetFileMetadata(name string) *domain.FileMetadata {
+	ss.fileMetadataMx.Lock()
+	defer ss.fileMetadataMx.Unlock()
+	if meta, ok := ss.fileMetadata[name]; ok {
+		return &meta
+	}
+	return &domain.FileMetadata{Name: name}
+}
+
+func (ss *simpleStorage) SetFileMetadata(meta *domain.FileMetadata) {
+	ss.fileMetadataMx.Lock()
+	defer ss.fileMetadataMx.Unlock()
+	ss.fileMetadata[meta.Name] = *meta
+}
+
+func (ss *simpleStorage) AddSecureNote(note *domain.SecureNote) error {
+	ss.secureNotesMx.Lock()
+	defer ss.secureNotesMx.Unlock()
+	if _, ok := ss.secureNotes[note.Title]; ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w secureNote with title %v exists, reopen client", domain.ErrClientInternal, note.Title)
+	}
+	ss.secureNotes[note.Title] = *note
+	return nil
+}
+
+func (ss *simpleStorage) UpdateSecureNote(note *domain.SecureNote) error {
+	ss.secureNotesMx.Lock()
+	defer ss.secureNotesMx.Unlock()
+	if _, ok := ss.secureNotes[note.Title]; !ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w secureNote with title %v is not exists, reopen client", domain.ErrClientInternal, note.Title)
+	}
+	ss.secureNotes[note.Title] = *note
+	return nil
+}
+
+func (ss *simpleStorage) DeleteSecureNote(title string) error {
+	ss.secureNotesMx.Lock()
+	defer ss.secureNotesMx.Unlock()
+	if _, ok := ss.secureNotes[title]; !ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w secureNote with title %v is not exists, reopen client", domain.ErrClientInternal, title)
+	}
+	delete(ss.secureNotes, title)
+	return nil
+}
+
+func (ss *simpleStorage) GetSecureNote(title string) (*domain.SecureNote, error) {
+	ss.secureNotesMx.Lock()
+	defer ss.secureNotesMx.Unlock()
+	if note, ok := ss.secureNotes[title]; !ok {
+		return nil, fmt.Errorf("%w secureNote with title %v is not exists, reopen client", domain.ErrClientInternal, title)
+	} else {
+		return &note, nil
+	}
+}
+
+func (ss *simpleStorage) GetSecureNoteList() []string {
+	ss.secureNotesMx.Lock()
+	defer ss.secureNotesMx.Unlock()
+	keys := make([]string, 0, len(ss.secureNotes))
+	for k := range ss.secureNotes {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (ss *simpleStorage) SetSecureNotes(notes []domain.SecureNote) {
+	ss.secureNotesMx.Lock()
+	defer ss.secureNotesMx.Unlock()
+	ss.secureNotes = make(map[string]domain.SecureNote)
+	for _, note := range notes {
+		ss.secureNotes[note.Title] = note
+	}
+}
+
+// ApplySecureNoteChanges replaces the changed notes and removes the deleted ones; notes are matched by id.
+func (ss *simpleStorage) ApplySecureNoteChanges(changed []domain.SecureNote, deletedIDs []string) {
+	ss.secureNotesMx.Lock()
+	defer ss.secureNotesMx.Unlock()
+
+	ids := make(map[string]struct{})
+	for _, note := range changed {
+		ids[note.ID] = struct{}{}
+	}
+	for _, id := range deletedIDs {
+		ids[id] = struct{}{}
+	}
+
+	for title, note := range ss.secureNotes {
+		if _, ok := ids[note.ID]; ok {
+			delete(ss.secureNotes, title)
+		}
+	}
+
+	for _, note := range changed {
+		ss.secureNotes[note.Title] = note
+	}
+}
+
+func (ss *simpleStorage) AddAuthenticator(auth *domain.Authenticator) error {
+	ss.authenticatorsMx.Lock()
+	defer ss.authenticatorsMx.Unlock()
+	if _, ok := ss.authenticators[auth.Name]; ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w authenticator with name %v exists, reopen client", domain.ErrClientInternal, auth.Name)
+	}
+	ss.authenticators[auth.Name] = *auth
+	return nil
+}
+
+func (ss *simpleStorage) UpdateAuthenticator(auth *domain.Authenticator) error {
+	ss.authenticatorsMx.Lock()
+	defer ss.authenticatorsMx.Unlock()
+	if _, ok := ss.authenticators[auth.Name]; !ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w authenticator with name %v is not exists, reopen client", domain.ErrClientInternal, auth.Name)
+	}
+	ss.authenticators[auth.Name] = *auth
+	return nil
+}
+
+func (ss *simpleStorage) DeleteAuthenticator(name string) error {
+	ss.authenticatorsMx.Lock()
+	defer ss.authenticatorsMx.Unlock()
+	if _, ok := ss.authenticators[name]; !ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w authenticator with name %v is not exists, reopen client", domain.ErrClientInternal, name)
+	}
+	delete(ss.authenticators, name)
+	return nil
+}
+
+func (ss *simpleStorage) GetAuthenticator(name string) (*domain.Authenticator, error) {
+	ss.authenticatorsMx.Lock()
+	defer ss.authenticatorsMx.Unlock()
+	if auth, ok := ss.authenticators[name]; !ok {
+		return nil, fmt.Errorf("%w authenticator with name %v is not exists, reopen client", domain.ErrClientInternal, name)
+	} else {
+		return &auth, nil
+	}
+}
+
+func (ss *simpleStorage) GetAuthenticatorList() []string {
+	ss.authenticatorsMx.Lock()
+	defer ss.authenticatorsMx.Unlock()
+	keys := make([]string, 0, len(ss.authenticators))
+	for k := range ss.authenticators {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (ss *simpleStorage) SetAuthenticators(authenticators []domain.Authenticator) {
+	ss.authenticatorsMx.Lock()
+	defer ss.authenticatorsMx.Unlock()
+	ss.authenticators = make(map[string]domain.Authenticator)
+	for _, auth := range authenticators {
+		ss.authenticators[auth.Name] = auth
+	}
+}
+
+// ApplyAuthenticatorChanges replaces the changed authenticators and removes the deleted ones; authenticators are matched by id.
+func (ss *simpleStorage) ApplyAuthenticatorChanges(changed []domain.Authenticator, deletedIDs []string) {
+	ss.authenticatorsMx.Lock()
+	defer ss.authenticatorsMx.Unlock()
+
+	ids := make(map[string]struct{})
+	for _, auth := range changed {
+		ids[auth.ID] = struct{}{}
+	}
+	for _, id := range deletedIDs {
+		ids[id] = struct{}{}
+	}
+
+	for name, auth := range ss.authenticators {
+		if _, ok := ids[auth.ID]; ok {
+			delete(ss.authenticators, name)
+		}
+	}
+
+	for _, auth := range changed {
+		ss.authenticators[auth.Name] = auth
+	}
+}
+
+func (ss *simpleStorage) AddSSHKey(key *domain.SSHKey) error {
+	ss.sshKeysMx.Lock()
+	defer ss.sshKeysMx.Unlock()
+	if _, ok := ss.sshKeys[key.Name]; ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w sshKey with name %v exists, reopen client", domain.ErrClientInternal, key.Name)
+	}
+	ss.sshKeys[key.Name] = *key
+	return nil
+}
+
+func (ss *simpleStorage) UpdateSSHKey(key *domain.SSHKey) error {
+	ss.sshKeysMx.Lock()
+	defer ss.sshKeysMx.Unlock()
+	if _, ok := ss.sshKeys[key.Name]; !ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w sshKey with name %v is not exists, reopen client", domain.ErrClientInternal, key.Name)
+	}
+	ss.sshKeys[key.Name] = *key
+	return nil
+}
+
+func (ss *simpleStorage) DeleteSSHKey(name string) error {
+	ss.sshKeysMx.Lock()
+	defer ss.sshKeysMx.Unlock()
+	if _, ok := ss.sshKeys[name]; !ok {
+		// Method on client invoked after success server method invokaction, so it's client error.
+		return fmt.Errorf("%w sshKey with name %v is not exists, reopen client", domain.ErrClientInternal, name)
+	}
+	delete(ss.sshKeys, name)
+	return nil
+}
+
+func (ss *simpleStorage) GetSSHKey(name string) (*domain.SSHKey, error) {
+	ss.sshKeysMx.Lock()
+	defer ss.sshKeysMx.Unlock()
+	if key, ok := ss.sshKeys[name]; !ok {
+		return nil, fmt.Errorf("%w sshKey with name %v is not exists, reopen client", domain.ErrClientInternal, name)
+	} else {
+		return &key, nil
+	}
+}
+
+func (ss *simpleStorage) GetSSHKeyList() []string {
+	ss.sshKeysMx.Lock()
+	defer ss.sshKeysMx.Unlock()
+	keys := make([]string, 0, len(ss.sshKeys))
+	for k := range ss.sshKeys {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (ss *simpleStorage) SetSSHKeys(sshKeys []domain.SSHKey) {
+	ss.sshKeysMx.Lock()
+	defer ss.sshKeysMx.Unlock()
+	ss.sshKeys = make(map[string]domain.SSHKey)
+	for _, key := range sshKeys {
+		ss.sshKeys[key.Name] = key
+	}
+}
+
+// ApplySSHKeyChanges replaces the changed sshKeys and removes the deleted ones; sshKeys are matched by id.
+func (ss *simpleStorage) ApplySSHKeyChanges(changed []domain.SSHKey, deletedIDs []string) {
+	ss.sshKeysMx.Lock()
+	defer ss.sshKeysMx.Unlock()
+
+	ids := make(map[string]struct{})
+	for _, key := range changed {
+		ids[key.ID] = struct{}{}
+	}
+	for _, id := range deletedIDs {
+		ids[id] = struct{}{}
+	}
+
+	for name, key := range ss.sshKeys {
+		if _, ok := ids[key.ID]; ok {
+			delete(ss.sshKeys, name)
+		}
+	}
+
+	for _, key := range changed {
+		ss.sshKeys[key.Name] = key
+	}
+}
@@ -18,6 +18,146 @@ func TestApp(t *testing.T) {
 		assert.Equal(t, mKey, app.GetMasterPassword())
 	})
 
+	t.Run("change_seq", func(t *testing.T) {
+		app := storage.NewStorage()
+		require.Equal(t, int64(0), app.GetChangeSeq())
+		app.SetChangeSeq(5)
+		assert.Equal(t, int64(5), app.GetChangeSeq())
+	})
+
+	t.Run("apply_bank_card_changes", func(t *testing.T) {
+		app := storage.NewStorage()
+		app.SetBankCards([]domain.BankCard{
+			{ID: "id1", Number: "100"},
+			{ID: "id2", Number: "200"},
+			{ID: "id3", Number: "300"},
+		})
+
+		app.ApplyBankCardChanges([]domain.BankCard{
+			{ID: "id1", Number: "101"},
+			{ID: "id4", Number: "400"},
+		}, []string{"id2"})
+
+		list := app.GetBankCardNumberList()
+		require.ElementsMatch(t, []string{"101", "300", "400"}, list)
+	})
+
+	t.Run("file_metadata", func(t *testing.T) {
+		app := storage.NewStorage()
+		app.SetFileMetadatas([]domain.FileMetadata{
+			{ID: "id1", Name: "file1", Notes: "notes1"},
+			{ID: "id2", Name: "file2"},
+		})
+
+		app.ApplyFileMetadataChanges([]domain.FileMetadata{
+			{ID: "id3", Name: "file3", Notes: "notes3"},
+		}, []string{"id2"})
+		app.SetFileMetadata(&domain.FileMetadata{ID: "id1", Name: "file1", Notes: "notes4"})
+
+		assert.Equal(t, "notes4", app.GetFileMetadata("file1").Notes)
+		assert.Equal(t, "notes3", app.GetFileMetadata("file3").Notes)
+
+		empty := app.GetFileMetadata("file2")
+		assert.Equal(t, "file2", empty.Name)
+		assert.Equal(t, "", empty.ID)
+	})
+
+	t.Run("secure_note_operations", func(t *testing.T) {
+		app := storage.NewStorage()
+		require.Equal(t, 0, len(app.GetSecureNoteList()))
+
+		note := &domain.SecureNote{ID: "id1", Title: "recovery", Text: "word1 word2"}
+		require.NoError(t, app.AddSecureNote(note))
+		require.ErrorIs(t, app.AddSecureNote(note), domain.ErrClientInternal)
+
+		note.Text = "word3"
+		require.NoError(t, app.UpdateSecureNote(note))
+
+		stored, err := app.GetSecureNote("recovery")
+		require.NoError(t, err)
+		assert.Equal(t, "word3", stored.Text)
+
+		app.ApplySecureNoteChanges([]domain.SecureNote{
+			{ID: "id1", Title: "license", Text: "key"},
+		}, nil)
+		require.ElementsMatch(t, []string{"license"}, app.GetSecureNoteList())
+
+		require.NoError(t, app.DeleteSecureNote("license"))
+		_, err = app.GetSecureNote("license")
+		require.ErrorIs(t, err, domain.ErrClientInternal)
+
+		app.SetSecureNotes([]domain.SecureNote{{ID: "id2", Title: "server"}})
+		require.ElementsMatch(t, []string{"server"}, app.GetSecureNoteList())
+	})
+
+	t.Run("authenticator_operations", func(t *testing.T) {
+		app := storage.NewStorage()
+		require.Equal(t, 0, len(app.GetAuthenticatorList()))
+
+		auth := &domain.Authenticator{ID: "id1", Name: "github", URI: "otpauth://totp/github?secret=A"}
+		require.NoError(t, app.AddAuthenticator(auth))
+		require.ErrorIs(t, app.AddAuthenticator(auth), domain.ErrClientInternal)
+
+		auth.URI = "otpauth://totp/github?secret=B"
+		require.NoError(t, app.UpdateAuthenticator(auth))
+
+		stored, err := app.GetAuthenticator("github")
+		require.NoError(t, err)
+		assert.Equal(t, auth.URI, stored.URI)
+
+		app.ApplyAuthenticatorChanges([]domain.Authenticator{
+			{ID: "id1", Name: "gitlab"},
+		}, nil)
+		require.ElementsMatch(t, []string{"gitlab"}, app.GetAuthenticatorList())
+
+		app.ApplyAuthenticatorChanges(nil, []string{"id1"})
+		require.Equal(t, 0, len(app.GetAuthenticatorList()))
+		require.ErrorIs(t, app.DeleteAuthenticator("gitlab"), domain.ErrClientInternal)
+
+		app.SetAuthenticators([]domain.Authenticator{{ID: "id2", Name: "mail"}})
+		require.ElementsMatch(t, []string{"mail"}, app.GetAuthenticatorList())
+	})
+
+	t.Run("ssh_key_operations", func(t *testing.T) {
+		app := storage.NewStorage()
+		require.Equal(t, 0, len(app.GetSSHKeyList()))
+
+		key := &domain.SSHKey{ID: "id1", Name: "work", PrivateKey: "key1"}
+		require.NoError(t, app.AddSSHKey(key))
+		require.ErrorIs(t, app.AddSSHKey(key), domain.ErrClientInternal)
+
+		key.Confirm = true
+		require.NoError(t, app.UpdateSSHKey(key))
+
+		stored, err := app.GetSSHKey("work")
+		require.NoError(t, err)
+		assert.True(t, stored.Confirm)
+
+		app.ApplySSHKeyChanges(nil, []string{"id1"})
+		require.Equal(t, 0, len(app.GetSSHKeyList()))
+		require.ErrorIs(t, app.DeleteSSHKey("work"), domain.ErrClientInternal)
+
+		app.SetSSHKeys([]domain.SSHKey{{ID: "id2", Name: "home"}})
+		require.ElementsMatch(t, []string{"home"}, app.GetSSHKeyList())
+	})
+
+	t.Run("apply_user_password_data_changes", func(t *testing.T) {
+		app := storage.NewStorage()
+		app.SetUserPasswordDatas([]domain.UserPasswordData{
+			{ID: "id1", Hint: "ya.ru"},
+			{ID: "id2", Hint: "google.com"},
+		})
+
+		app.ApplyUserPasswordDataChanges([]domain.UserPasswordData{
+			{ID: "id2", Hint: "mail.ru", Login: "login"},
+		}, []string{"id1"})
+
+		require.ElementsMatch(t, []string{"mail.ru"}, app.GetUserPasswordDataList())
+		data, err := app.GetUserPasswordData("mail.ru")
+		require.NoError(t, err)
+		assert.Equal(t, "login", data.Login)
+	})
+
 	t.Run("bank_card_operations", func(t *testing.T) {
 		app := storage.NewStorage()
 
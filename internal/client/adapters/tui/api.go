@@ -6,6 +6,7 @@ type ViewController interface {
 	LoginEMail(data *domain.EMailData)
 	LoginPassOTP(otpPass *domain.OTPPass)
 	LoginCheckMasterKey(masterPassword string)
+	OfflineUnlock(masterPassword string)
 	RegEMail(data *domain.EMailData)
 	RegPassOTP(otpPass *domain.OTPPass)
 	RegInitMasterKey(mKey *domain.UnencryptedMasterKeyData)
@@ -16,6 +17,8 @@ type ViewController interface {
 	DeleteBankCard(number string)
 	GetBankCard(number string)
 	NewBankCard()
+	ResolveBankCardConflict(bankCard *domain.BankCardView, resolution domain.ConflictResolution)
+	GetBankCardHistory(number string)
 
 	GetUserPasswordDataList()
 	AddUserPasswordData(data *domain.UserPasswordData)
@@ -23,10 +26,52 @@ type ViewController interface {
 	DeleteUpdatePasswordData(hint string)
 	GetUserPasswordData(hint string)
 	NewUserPasswordData()
+	ResolveUserPasswordDataConflict(data *domain.UserPasswordData, resolution domain.ConflictResolution)
+	ResolveDeleteConflict(itemType domain.SecretItemType, name string, remove bool)
+	GetUserPasswordDataHistory(hint string)
+	RestoreItemRevision(rev domain.ItemRevision)
+
+	GetSecureNoteList()
+	AddSecureNote(note *domain.SecureNote)
+	UpdateSecureNote(note *domain.SecureNote)
+	DeleteSecureNote(title string)
+	GetSecureNote(title string)
+	NewSecureNote()
+	ResolveSecureNoteConflict(note *domain.SecureNote, resolution domain.ConflictResolution)
+	GetSecureNoteHistory(title string)
+	StoreSecureNoteAsFile(note *domain.SecureNote)
+
+	GetAuthenticatorList()
+	AddAuthenticator(auth *domain.Authenticator)
+	UpdateAuthenticator(auth *domain.Authenticator)
+	DeleteAuthenticator(name string)
+	GetAuthenticator(name string)
+	NewAuthenticator()
+	ReadAuthenticatorQR(auth *domain.Authenticator, path string)
+	ResolveAuthenticatorConflict(auth *domain.Authenticator, resolution domain.ConflictResolution)
+	GetAuthenticatorHistory(name string)
+
+	GetSSHKeyList()
+	AddSSHKey(key *domain.SSHKey)
+	UpdateSSHKey(key *domain.SSHKey)
+	DeleteSSHKey(name string)
+	GetSSHKey(name string)
+	NewSSHKey()
+	ResolveSSHKeyConflict(key *domain.SSHKey, resolution domain.ConflictResolution)
+	GetSSHKeyHistory(name string)
 
 	UploadFile(info *domain.FileInfo)
 	SaveFile(info *domain.FileInfo)
 	GetFileInfo(name string)
+	SaveFileMetadata(meta *domain.FileMetadata)
 	DeleteFile(name string)
 	GetFilesInfoList()
+
+	GetSessionList()
+	TerminateSession(sessionID string)
+
+	GetTrashList()
+	RestoreTrashEntry(entry domain.TrashEntry)
+	PurgeTrashEntry(entry domain.TrashEntry)
+	EmptyTrash()
 }
@@ -1,8 +1,12 @@
 package tui
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/gdamore/tcell/v2"
 
 	"github.com/rivo/tview"
@@ -15,6 +19,8 @@ const (
 	LoginOTPPage   = "LoginOTPPage"
 	LoginMKeyPage  = "LoginMKeyPage"
 
+	OfflineMKeyPage = "OfflineMKeyPage"
+
 	RegEMailPage = "RegPage"
 	RegOTPPage   = "RegOTPPage"
 	RegMKeyPage  = "RegMKeyPage"
@@ -31,10 +37,29 @@ const (
 
 	UserPasswordDataListPage = "UserPasswordDataListPage"
 
+	NewSecureNotePage  = "NewSecureNotePage"
+	EditSecureNotePage = "EditSecureNotePage"
+
+	SecureNoteListPage = "SecureNoteListPage"
+
+	NewAuthenticatorPage  = "NewAuthenticatorPage"
+	EditAuthenticatorPage = "EditAuthenticatorPage"
+
+	AuthenticatorListPage = "AuthenticatorListPage"
+
+	NewSSHKeyPage  = "NewSSHKeyPage"
+	EditSSHKeyPage = "EditSSHKeyPage"
+
+	SSHKeyListPage = "SSHKeyListPage"
+
 	UploadFilePage   = "UploadFilePage"
 	FileInfoPage     = "FileInfoPage"
 	FileTreePagh     = "FileTreePagh"
 	FileInfoListPage = "FileInfoListPath"
+
+	SessionListPage = "SessionListPage"
+	TrashListPage   = "TrashListPage"
+	ItemHistoryPage = "ItemHistoryPage"
 )
 
 func NewApplicationView(conf *config.ClientConf) *tuiApp {
@@ -51,13 +76,18 @@ func (tApp *tuiApp) SetController(controller ViewController) *tuiApp {
 type tuiApp struct {
 	app         *tview.Application
 	progressBar *ProgressBar
+	root        *tview.Flex // pages and the status bar
 	pages       *tview.Pages
+	statusBar   *tview.TextView
 	controller  ViewController
 
 	loginFlex     *tview.Flex
 	loginOTPFlex  *tview.Flex
 	loginMKeyFlex *tview.Flex
 
+	offlineMKeyFlex *tview.Flex
+	offlineNote     string // appended to the data page titles in offline mode
+
 	regFlex     *tview.Flex
 	regOTPFlex  *tview.Flex
 	regMKeyFlex *tview.Flex
@@ -72,11 +102,28 @@ type tuiApp struct {
 	newUserPasswordDataFlex  *tview.Flex
 	editUserPasswordDataFlex *tview.Flex
 
+	secureNoteListFlex *tview.Flex
+	newSecureNoteFlex  *tview.Flex
+	editSecureNoteFlex *tview.Flex
+
+	authenticatorListFlex *tview.Flex
+	newAuthenticatorFlex  *tview.Flex
+	editAuthenticatorFlex *tview.Flex
+	codeTickerStop        chan struct{} // stops the refresh of the shown authenticator code
+
+	sshKeyListFlex *tview.Flex
+	newSSHKeyFlex  *tview.Flex
+	editSSHKeyFlex *tview.Flex
+
 	uploadFilePageFlex *tview.Flex
 	fileInfoListFlex   *tview.Flex
 
 	fileTreeView *tview.Flex
 	fileInfoFlex *tview.Flex
+
+	sessionListFlex *tview.Flex
+	trashListFlex   *tview.Flex
+	itemHistoryFlex *tview.Flex
 }
 
 func (tApp *tuiApp) ShowError(err error) {
@@ -90,7 +137,7 @@ func (tApp *tuiApp) ShowError(err error) {
 					case "Quit":
 						tApp.app.Stop()
 					case "Cancel":
-						tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+						tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 					}
 				})
 			modal.SetTitle("Error")
@@ -107,7 +154,7 @@ func (tApp *tuiApp) ShowMsg(msg string) {
 				SetText(msg).
 				AddButtons([]string{"Ok"}).
 				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
-					tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+					tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 				})
 			modal.SetTitle("Info")
 			tApp.app.SetRoot(modal, true).SetFocus(modal)
@@ -115,10 +162,59 @@ func (tApp *tuiApp) ShowMsg(msg string) {
 	}()
 }
 
+// RefreshItemsView reloads the shown list if the items of the type were changed by another client;
+// edit pages are not touched - the update conflict is resolved on save.
+func (tApp *tuiApp) RefreshItemsView(itemType domain.SecretItemType) {
+	go func() {
+		tApp.app.QueueUpdate(func() {
+			page, _ := tApp.pages.GetFrontPage()
+			switch {
+			case page == BankCardListPage && (itemType == "" || itemType == domain.SecretItemBankCard):
+				tApp.controller.GetBankCardList()
+			case page == UserPasswordDataListPage && (itemType == "" || itemType == domain.SecretItemUserPassword):
+				tApp.controller.GetUserPasswordDataList()
+			case page == SecureNoteListPage && (itemType == "" || itemType == domain.SecretItemSecureNote):
+				tApp.controller.GetSecureNoteList()
+			case page == AuthenticatorListPage && (itemType == "" || itemType == domain.SecretItemAuthenticator):
+				tApp.controller.GetAuthenticatorList()
+			case page == SSHKeyListPage && (itemType == "" || itemType == domain.SecretItemSSHKey):
+				tApp.controller.GetSSHKeyList()
+			}
+		})
+	}()
+}
+
+// ShowOfflineMode marks the data pages as the local copy of the data.
+func (tApp *tuiApp) ShowOfflineMode(savedAt time.Time) {
+	go func() {
+		tApp.app.QueueUpdate(func() {
+			tApp.offlineNote = fmt.Sprintf(" [OFFLINE, saved %s]", savedAt.Local().Format(time.DateTime))
+		})
+	}()
+}
+
+// ShowPendingChanges shows the number of the changes not sent to the server in the status bar.
+func (tApp *tuiApp) ShowPendingChanges(count int) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			if count == 0 {
+				tApp.statusBar.SetText("")
+				return
+			}
+			tApp.statusBar.SetText(fmt.Sprintf("pending changes: %d - will be sent when the server is available", count))
+		})
+	}()
+}
+
+// dataTitle returns the data page title with the offline mark.
+func (tApp *tuiApp) dataTitle(title string) string {
+	return title + tApp.offlineNote
+}
+
 func (tApp *tuiApp) CloseProgerssBar() {
 	go func() {
 		tApp.app.QueueUpdateDraw(func() {
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 		})
 	}()
 }
@@ -147,10 +243,16 @@ func (tApp *tuiApp) Start() error {
 	tApp.app = tview.NewApplication()
 
 	tApp.pages = tview.NewPages()
+	tApp.statusBar = tview.NewTextView().SetTextColor(tcell.ColorYellow)
+	tApp.root = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(tApp.pages, 0, 1, true).
+		AddItem(tApp.statusBar, 1, 0, false)
 
 	tApp.loginFlex = tview.NewFlex()
 	tApp.loginMKeyFlex = tview.NewFlex()
 	tApp.loginOTPFlex = tview.NewFlex()
+	tApp.offlineMKeyFlex = tview.NewFlex()
 
 	tApp.regFlex = tview.NewFlex()
 	tApp.regMKeyFlex = tview.NewFlex()
@@ -165,10 +267,25 @@ func (tApp *tuiApp) Start() error {
 	tApp.newUserPasswordDataFlex = tview.NewFlex()
 	tApp.editUserPasswordDataFlex = tview.NewFlex()
 
+	tApp.secureNoteListFlex = tview.NewFlex()
+	tApp.newSecureNoteFlex = tview.NewFlex()
+	tApp.editSecureNoteFlex = tview.NewFlex()
+
+	tApp.authenticatorListFlex = tview.NewFlex()
+	tApp.newAuthenticatorFlex = tview.NewFlex()
+	tApp.editAuthenticatorFlex = tview.NewFlex()
+
+	tApp.sshKeyListFlex = tview.NewFlex()
+	tApp.newSSHKeyFlex = tview.NewFlex()
+	tApp.editSSHKeyFlex = tview.NewFlex()
+
 	tApp.uploadFilePageFlex = tview.NewFlex()
 	tApp.fileTreeView = tview.NewFlex()
 	tApp.fileInfoListFlex = tview.NewFlex()
 	tApp.fileInfoFlex = tview.NewFlex()
+	tApp.sessionListFlex = tview.NewFlex()
+	tApp.trashListFlex = tview.NewFlex()
+	tApp.itemHistoryFlex = tview.NewFlex()
 	tApp.progressBar = NewProgressBar()
 
 	tApp.pages.AddPage(InitPage, tApp.createStartForm(), true, true)
@@ -176,6 +293,7 @@ func (tApp *tuiApp) Start() error {
 	tApp.pages.AddPage(LoginEMailPage, tApp.loginFlex, true, false)
 	tApp.pages.AddPage(LoginOTPPage, tApp.loginOTPFlex, true, false)
 	tApp.pages.AddPage(LoginMKeyPage, tApp.loginMKeyFlex, true, false)
+	tApp.pages.AddPage(OfflineMKeyPage, tApp.offlineMKeyFlex, true, false)
 
 	tApp.pages.AddPage(RegEMailPage, tApp.regFlex, true, false)
 	tApp.pages.AddPage(RegOTPPage, tApp.regOTPFlex, true, false)
@@ -191,12 +309,28 @@ func (tApp *tuiApp) Start() error {
 	tApp.pages.AddPage(NewUserPasswordDataPage, tApp.newUserPasswordDataFlex, true, false)
 	tApp.pages.AddPage(EditUserPasswordDataPage, tApp.editUserPasswordDataFlex, true, false)
 
+	tApp.pages.AddPage(SecureNoteListPage, tApp.secureNoteListFlex, true, false)
+	tApp.pages.AddPage(NewSecureNotePage, tApp.newSecureNoteFlex, true, false)
+	tApp.pages.AddPage(EditSecureNotePage, tApp.editSecureNoteFlex, true, false)
+
+	tApp.pages.AddPage(AuthenticatorListPage, tApp.authenticatorListFlex, true, false)
+	tApp.pages.AddPage(NewAuthenticatorPage, tApp.newAuthenticatorFlex, true, false)
+	tApp.pages.AddPage(EditAuthenticatorPage, tApp.editAuthenticatorFlex, true, false)
+
+	tApp.pages.AddPage(SSHKeyListPage, tApp.sshKeyListFlex, true, false)
+	tApp.pages.AddPage(NewSSHKeyPage, tApp.newSSHKeyFlex, true, false)
+	tApp.pages.AddPage(EditSSHKeyPage, tApp.editSSHKeyFlex, true, false)
+
 	tApp.pages.AddPage(FileInfoListPage, tApp.fileInfoListFlex, true, false)
 	tApp.pages.AddPage(UploadFilePage, tApp.uploadFilePageFlex, true, false)
 	tApp.pages.AddPage(FileTreePagh, tApp.fileTreeView, true, false)
 	tApp.pages.AddPage(FileInfoPage, tApp.fileInfoFlex, true, false)
 
-	if err := tApp.app.SetRoot(tApp.pages, true).EnableMouse(false).Run(); err != nil {
+	tApp.pages.AddPage(SessionListPage, tApp.sessionListFlex, true, false)
+	tApp.pages.AddPage(TrashListPage, tApp.trashListFlex, true, false)
+	tApp.pages.AddPage(ItemHistoryPage, tApp.itemHistoryFlex, true, false)
+
+	if err := tApp.app.SetRoot(tApp.root, true).EnableMouse(false).Run(); err != nil {
 		log := app.GetMainLogger()
 		log.Error(err)
 		return err
@@ -209,7 +343,7 @@ func (tApp *tuiApp) createStartForm() *tview.Flex {
 	var flex = tview.NewFlex()
 	var text = tview.NewTextView().
 		SetTextColor(tcell.ColorGreen).
-		SetText("(l) to login \n(r) to registrate\n(q) to quit")
+		SetText("(l) to login \n(r) to registrate\n(o) to open offline copy\n(q) to quit")
 	flex.SetDirection(tview.FlexRow).
 		AddItem(text, 0, 1, false)
 	flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
@@ -220,6 +354,8 @@ func (tApp *tuiApp) createStartForm() *tview.Flex {
 			tApp.ShowRegView()
 		case 'l':
 			tApp.ShowLoginView()
+		case 'o':
+			tApp.ShowOfflineMasterKeyView()
 		}
 
 		return event
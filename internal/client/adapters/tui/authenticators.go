@@ -0,0 +1,248 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func (tApp *tuiApp) ShowAuthenticatorListView(names []string) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debug("ShowAuthenticatorListView start")
+			tApp.authenticatorListFlex.Clear()
+
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle("AuthenticatorList"))
+			tApp.authenticatorListFlex.Box = box
+
+			nameList := tview.NewList().ShowSecondaryText(false)
+			for index, name := range names {
+				nameList.AddItem(name, "", rune(49+index), nil)
+			}
+
+			nameList.SetSelectedFunc(func(index int, name string, second_name string, shortcut rune) {
+				tApp.controller.GetAuthenticator(name)
+			})
+
+			tApp.authenticatorListFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(nameList, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-n) new\n(Ctrl-b) to back\n(Ctrl-q) to quit"), 0, 1, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlN:
+						tApp.controller.NewAuthenticator()
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.ShowDataAccessView()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(AuthenticatorListPage)
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowEditAuthenticatorView(auth *domain.Authenticator) {
+	edited := *auth
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowEditAuthenticatorView start %v", edited.Name)
+
+			tApp.editAuthenticatorFlex.Clear()
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle(fmt.Sprintf("EditAuthenticator %v", edited.Name)))
+			tApp.editAuthenticatorFlex.Box = box
+
+			// the code is generated by the saved uri, the edited one is checked on save
+			codeView := tview.NewTextView().SetTextColor(tcell.ColorYellow)
+			showCode := func() {
+				codeView.SetText(authenticatorCodeText(auth.URI, time.Now()))
+			}
+			showCode()
+
+			form := tview.NewForm().
+				AddPasswordField("URI", edited.URI, 60, '#', func(uri string) {
+					edited.URI = uri
+				}).
+				AddTextArea("Notes", edited.Notes, 60, 4, 0, func(text string) {
+					edited.Notes = text
+				}).
+				AddButton("Save", func() {
+					tApp.controller.UpdateAuthenticator(&edited)
+				}).
+				AddButton("Delete", func() {
+					tApp.controller.DeleteAuthenticator(edited.Name)
+				}).
+				AddButton("History", func() {
+					tApp.controller.GetAuthenticatorHistory(edited.Name)
+				})
+
+			tApp.editAuthenticatorFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(codeView, 1, 0, false).
+				AddItem(form, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-b) to back\n(Ctrl-q) to quit"), 3, 0, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.controller.GetAuthenticatorList()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(EditAuthenticatorPage)
+			tApp.startCodeTicker(showCode)
+		})
+	}()
+}
+
+// authenticatorCodeText formats the current code and the seconds left until the code is changed.
+func authenticatorCodeText(uri string, now time.Time) string {
+	code, left, err := domain.AuthenticatorCode(uri, now)
+	if err != nil {
+		return fmt.Sprintf("Code: %v", err.Error())
+	}
+	return fmt.Sprintf("Code: %s (%ds left)", code, int(math.Ceil(left.Seconds())))
+}
+
+// startCodeTicker invokes update every second while the authenticator page is shown;
+// the ticker of the previously shown authenticator is stopped. Must be invoked in the application goroutine.
+func (tApp *tuiApp) startCodeTicker(update func()) {
+	tApp.stopCodeTicker()
+	stop := make(chan struct{})
+	tApp.codeTickerStop = stop
+
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				tApp.app.QueueUpdateDraw(func() {
+					if tApp.codeTickerStop != stop {
+						return // replaced by another authenticator view
+					}
+					if page, _ := tApp.pages.GetFrontPage(); page != EditAuthenticatorPage {
+						tApp.stopCodeTicker()
+						return
+					}
+					update()
+				})
+			}
+		}
+	}()
+}
+
+func (tApp *tuiApp) stopCodeTicker() {
+	if tApp.codeTickerStop != nil {
+		close(tApp.codeTickerStop)
+		tApp.codeTickerStop = nil
+	}
+}
+
+func (tApp *tuiApp) ShowNewAuthenticatorView(auth *domain.Authenticator) {
+	if auth == nil {
+		auth = &domain.Authenticator{}
+	}
+	tApp.showNewAuthenticatorForm(auth, "")
+}
+
+func (tApp *tuiApp) showNewAuthenticatorForm(auth *domain.Authenticator, qrPath string) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debug("NewAuthenticator")
+			tApp.newAuthenticatorFlex.Clear()
+			box := tview.NewBox().SetBorder(true).SetTitle("NewAuthenticator")
+			tApp.newAuthenticatorFlex.Box = box
+
+			form := tview.NewForm().
+				AddInputField("Name", auth.Name, 40, nil, func(name string) {
+					auth.Name = name
+				}).
+				AddPasswordField("URI", auth.URI, 60, '#', func(uri string) {
+					auth.URI = uri
+				}).
+				AddInputField("QR code PNG", qrPath, 60, nil, func(path string) {
+					qrPath = path
+				}).
+				AddTextArea("Notes", auth.Notes, 60, 4, 0, func(text string) {
+					auth.Notes = text
+				}).
+				AddButton("Save", func() {
+					tApp.controller.AddAuthenticator(auth)
+				}).
+				AddButton("Read QR", func() {
+					tApp.controller.ReadAuthenticatorQR(auth, qrPath)
+				}).
+				AddButton("Select QR", func() {
+					tApp.SelectFileView(&domain.FileInfo{Path: qrPath}, func(info *domain.FileInfo) {
+						tApp.showNewAuthenticatorForm(auth, info.Path)
+					})
+				})
+
+			tApp.newAuthenticatorFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(form, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-b) to back\n(Ctrl-q) to quit"), 3, 0, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.controller.GetAuthenticatorList()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(NewAuthenticatorPage)
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowAuthenticatorConflictView(auth *domain.Authenticator) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowAuthenticatorConflictView start %v", auth.Name)
+			modal := tview.NewModal().
+				SetText(fmt.Sprintf("Authenticator %v was changed on another device", auth.Name)).
+				AddButtons([]string{"Reload", "Overwrite", "Keep both"}).
+				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+					switch buttonLabel {
+					case "Reload":
+						tApp.controller.ResolveAuthenticatorConflict(auth, domain.ConflictReload)
+					case "Overwrite":
+						tApp.controller.ResolveAuthenticatorConflict(auth, domain.ConflictOverwrite)
+					case "Keep both":
+						tApp.controller.ResolveAuthenticatorConflict(auth, domain.ConflictKeepBoth)
+					}
+				})
+			modal.SetTitle("Conflict")
+			tApp.app.SetRoot(modal, true).SetFocus(modal)
+			log.Debug("ShowAuthenticatorConflictView shown")
+		})
+	}()
+}
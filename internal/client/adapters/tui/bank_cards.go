@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
@@ -16,7 +17,7 @@ func (tApp *tuiApp) ShowBankCardListView(cardsNumber []string) {
 			log.Debug("ShowBankCardListView start")
 			tApp.bankCardListFlex.Clear()
 
-			box := tview.NewBox().SetBorder(true).SetTitle("BankCardList")
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle("BankCardList"))
 			tApp.bankCardListFlex.Box = box
 
 			cardNumberList := tview.NewList().ShowSecondaryText(false)
@@ -46,7 +47,7 @@ func (tApp *tuiApp) ShowBankCardListView(cardsNumber []string) {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(BankCardListPage)
 			log.Debug("ShowBankCardListView shown")
 		})
@@ -54,6 +55,10 @@ func (tApp *tuiApp) ShowBankCardListView(cardsNumber []string) {
 }
 
 func (tApp *tuiApp) ShowNewBankCardView() {
+	tApp.showNewBankCardForm(&domain.BankCardView{})
+}
+
+func (tApp *tuiApp) showNewBankCardForm(bankCardView *domain.BankCardView) {
 	go func() {
 		tApp.app.QueueUpdateDraw(func() {
 			log := app.GetMainLogger()
@@ -62,28 +67,30 @@ func (tApp *tuiApp) ShowNewBankCardView() {
 			tApp.newBankCardFlex.Clear()
 			tApp.newBankCardFlex.Box = box
 
-			bankCardView := &domain.BankCardView{}
+			form := tview.NewForm().
+				AddInputField("Number", bankCardView.Number, 40, nil, func(number string) {
+					bankCardView.Number = number
+				}).
+				AddInputField("ExpiryMonth", bankCardView.ExpiryMonth, 40, nil, func(month string) {
+					bankCardView.ExpiryMonth = month
+				}).
+				AddInputField("ExpiryYear", bankCardView.ExpiryYear, 40, nil, func(year string) {
+					bankCardView.ExpiryYear = year
+				}).
+				AddPasswordField("CVV", bankCardView.CVV, 6, '#', func(cvv string) {
+					bankCardView.CVV = cvv
+				})
+			addCustomFields(form, bankCardView.Fields, &bankCardView.Notes).
+				AddButton("Save", func() {
+					tApp.controller.AddBankCard(bankCardView)
+				})
+			tApp.addCustomFieldButtons(form, &bankCardView.Fields, func() {
+				tApp.showNewBankCardForm(bankCardView)
+			})
 
 			tApp.newBankCardFlex.
 				SetDirection(tview.FlexRow).
-				AddItem(
-					tview.NewForm().
-						AddInputField("Number", "", 40, nil, func(number string) {
-							bankCardView.Number = number
-						}).
-						AddInputField("ExpiryMonth", "", 40, nil, func(month string) {
-							bankCardView.ExpiryMonth = month
-						}).
-						AddInputField("ExpiryYear", "", 40, nil, func(year string) {
-							bankCardView.ExpiryYear = year
-						}).
-						AddPasswordField("CVV", "", 6, '#', func(cvv string) {
-							bankCardView.CVV = cvv
-						}).
-						AddButton("Save", func() {
-							tApp.controller.AddBankCard(bankCardView)
-						}), 0, 1, true,
-				).
+				AddItem(form, 0, 1, true).
 				AddItem(
 					tview.NewTextView().
 						SetTextColor(tcell.ColorGreen).
@@ -97,7 +104,7 @@ func (tApp *tuiApp) ShowNewBankCardView() {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(NewBankCardPage)
 			log.Debug("NewBankCard shown")
 		})
@@ -105,42 +112,53 @@ func (tApp *tuiApp) ShowNewBankCardView() {
 }
 
 func (tApp *tuiApp) ShowEditBankCardView(bankCard *domain.BankCard) {
+	tApp.showEditBankCardForm(&domain.BankCardView{
+		Number:      bankCard.Number,
+		ExpiryMonth: fmt.Sprintf("%02v", bankCard.ExpiryMonth),
+		ExpiryYear:  fmt.Sprintf("%v", bankCard.ExpiryYear),
+		CVV:         bankCard.CVV,
+		Fields:      slices.Clone(bankCard.Fields),
+		Notes:       bankCard.Notes,
+	})
+}
+
+func (tApp *tuiApp) showEditBankCardForm(bankCardView *domain.BankCardView) {
 	go func() {
 		tApp.app.QueueUpdateDraw(func() {
 			log := app.GetMainLogger()
-			log.Debugf("ShowBankCard start %v", bankCard.Number)
+			log.Debugf("ShowBankCard start %v", bankCardView.Number)
 			tApp.editBankCardFlex.Clear()
 
-			box := tview.NewBox().SetBorder(true).SetTitle(fmt.Sprintf("EditBankCard %v", bankCard.Number))
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle(fmt.Sprintf("EditBankCard %v", bankCardView.Number)))
 			tApp.editBankCardFlex.Box = box
 
-			bankCardView := &domain.BankCardView{
-				Number:      bankCard.Number,
-				ExpiryMonth: fmt.Sprintf("%02v", bankCard.ExpiryMonth),
-				ExpiryYear:  fmt.Sprintf("%v", bankCard.ExpiryYear),
-				CVV:         bankCard.CVV,
-			}
+			form := tview.NewForm().
+				AddInputField("ExpiryMonth", bankCardView.ExpiryMonth, 40, nil, func(month string) {
+					bankCardView.ExpiryMonth = month
+				}).
+				AddInputField("ExpiryYear", bankCardView.ExpiryYear, 40, nil, func(year string) {
+					bankCardView.ExpiryYear = year
+				}).
+				AddPasswordField("CVV", bankCardView.CVV, 6, '#', func(cvv string) {
+					bankCardView.CVV = cvv
+				})
+			addCustomFields(form, bankCardView.Fields, &bankCardView.Notes).
+				AddButton("Save", func() {
+					tApp.controller.UpdateBankCard(bankCardView)
+				}).
+				AddButton("Delete", func() {
+					tApp.controller.DeleteBankCard(bankCardView.Number)
+				}).
+				AddButton("History", func() {
+					tApp.controller.GetBankCardHistory(bankCardView.Number)
+				})
+			tApp.addCustomFieldButtons(form, &bankCardView.Fields, func() {
+				tApp.showEditBankCardForm(bankCardView)
+			})
 
 			tApp.editBankCardFlex.
 				SetDirection(tview.FlexRow).
-				AddItem(
-					tview.NewForm().
-						AddInputField("ExpiryMonth", bankCardView.ExpiryMonth, 40, nil, func(month string) {
-							bankCardView.ExpiryMonth = month
-						}).
-						AddInputField("ExpiryYear", bankCardView.ExpiryYear, 40, nil, func(year string) {
-							bankCardView.ExpiryYear = year
-						}).
-						AddPasswordField("CVV", bankCardView.CVV, 6, '#', func(cvv string) {
-							bankCardView.CVV = cvv
-						}).
-						AddButton("Save", func() {
-							tApp.controller.UpdateBankCard(bankCardView)
-						}).
-						AddButton("Delete", func() {
-							tApp.controller.DeleteBankCard(bankCardView.Number)
-						}), 0, 1, true,
-				).
+				AddItem(form, 0, 1, true).
 				AddItem(
 					tview.NewTextView().
 						SetTextColor(tcell.ColorGreen).
@@ -154,9 +172,32 @@ func (tApp *tuiApp) ShowEditBankCardView(bankCard *domain.BankCard) {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(EditBankCardPage)
 			log.Debug("EditBankCard shown")
 		})
 	}()
 }
+
+func (tApp *tuiApp) ShowBankCardConflictView(bankCardView *domain.BankCardView) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowBankCardConflictView start %v", bankCardView.Number)
+			modal := tview.NewModal().
+				SetText(fmt.Sprintf("BankCard %v was changed on another device", bankCardView.Number)).
+				AddButtons([]string{"Reload", "Overwrite"}).
+				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+					switch buttonLabel {
+					case "Reload":
+						tApp.controller.ResolveBankCardConflict(bankCardView, domain.ConflictReload)
+					case "Overwrite":
+						tApp.controller.ResolveBankCardConflict(bankCardView, domain.ConflictOverwrite)
+					}
+				})
+			modal.SetTitle("Conflict")
+			tApp.app.SetRoot(modal, true).SetFocus(modal)
+			log.Debug("ShowBankCardConflictView shown")
+		})
+	}()
+}
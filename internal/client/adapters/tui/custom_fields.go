@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"slices"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// addCustomFields adds the custom fields and the notes to the form.
+func addCustomFields(form *tview.Form, fields []domain.CustomField, notes *string) *tview.Form {
+	return addFields(form, fields).AddTextArea("Notes", *notes, 40, 4, 0, func(text string) {
+		*notes = text
+	})
+}
+
+// addFields adds the custom fields to the form; hidden fields are shown as password fields.
+func addFields(form *tview.Form, fields []domain.CustomField) *tview.Form {
+	for i := range fields {
+		field := &fields[i]
+		if field.Hidden {
+			form.AddPasswordField(field.Name, field.Value, 40, '#', func(value string) {
+				field.Value = value
+			})
+		} else {
+			form.AddInputField(field.Name, field.Value, 40, nil, func(value string) {
+				field.Value = value
+			})
+		}
+	}
+	return form
+}
+
+// addCustomFieldButtons adds buttons to add and remove custom fields; redraw is invoked to show the changed form,
+// the entered but unsaved data is kept.
+func (tApp *tuiApp) addCustomFieldButtons(form *tview.Form, fields *[]domain.CustomField, redraw func()) *tview.Form {
+	return form.
+		AddButton("Add field", func() {
+			tApp.showAddCustomFieldView(fields, redraw)
+		}).
+		AddButton("Remove field", func() {
+			if len(*fields) > 0 {
+				tApp.showRemoveCustomFieldView(fields, redraw)
+			}
+		})
+}
+
+func (tApp *tuiApp) showAddCustomFieldView(fields *[]domain.CustomField, redraw func()) {
+	field := domain.CustomField{}
+	back := func() {
+		tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+		redraw()
+	}
+
+	form := tview.NewForm().
+		AddInputField("Name", "", 40, nil, func(name string) {
+			field.Name = name
+		}).
+		AddInputField("Value", "", 40, nil, func(value string) {
+			field.Value = value
+		}).
+		AddCheckbox("Hidden", false, func(checked bool) {
+			field.Hidden = checked
+		})
+
+	form.
+		AddButton("Add", func() {
+			changed := append(slices.Clone(*fields), field)
+			if err := domain.CheckCustomFields(changed); err != nil {
+				modal := tview.NewModal().
+					SetText(err.Error()).
+					AddButtons([]string{"Ok"}).
+					SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+						tApp.app.SetRoot(form, true).SetFocus(form)
+					})
+				tApp.app.SetRoot(modal, true).SetFocus(modal)
+				return
+			}
+			*fields = changed
+			back()
+		}).
+		AddButton("Cancel", back).
+		SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if event.Key() == tcell.KeyCtrlB {
+				back()
+			}
+			return event
+		})
+
+	form.SetBorder(true).SetTitle("AddField")
+	tApp.app.SetRoot(form, true).SetFocus(form)
+}
+
+func (tApp *tuiApp) showRemoveCustomFieldView(fields *[]domain.CustomField, redraw func()) {
+	back := func() {
+		tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+		redraw()
+	}
+
+	fieldList := tview.NewList().ShowSecondaryText(false)
+	for index, field := range *fields {
+		fieldList.AddItem(field.Name, "", rune(49+index), nil)
+	}
+
+	fieldList.SetSelectedFunc(func(index int, name string, second_name string, shortcut rune) {
+		*fields = slices.Delete(slices.Clone(*fields), index, index+1)
+		back()
+	})
+
+	fieldList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyCtrlB {
+			back()
+		}
+		return event
+	})
+
+	fieldList.SetBorder(true).SetTitle("RemoveField (Enter) to remove, (Ctrl-b) to back")
+	tApp.app.SetRoot(fieldList, true).SetFocus(fieldList)
+}
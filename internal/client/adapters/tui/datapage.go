@@ -1,7 +1,10 @@
 package tui
 
 import (
+	"fmt"
+
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -15,13 +18,13 @@ func (tApp *tuiApp) ShowDataAccessView() {
 
 			tApp.dataMainFlex.Clear()
 
-			box := tview.NewBox().SetBorder(true).SetTitle("UserData")
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle("UserData"))
 			tApp.dataMainFlex.Box = box
 
 			dataTypesList := tview.NewList().ShowSecondaryText(false)
 
 			dataTypes := []string{
-				"Bank cards", "UserPasswordData", "Files",
+				"Bank cards", "UserPasswordData", "Secure notes", "Authenticators", "SSH keys", "Files", "Trash", "Sessions",
 			}
 			for index, number := range dataTypes {
 				dataTypesList.AddItem(number, "", rune(49+index), nil)
@@ -33,8 +36,18 @@ func (tApp *tuiApp) ShowDataAccessView() {
 					tApp.controller.GetBankCardList()
 				case 1:
 					tApp.controller.GetUserPasswordDataList()
-				default:
+				case 2:
+					tApp.controller.GetSecureNoteList()
+				case 3:
+					tApp.controller.GetAuthenticatorList()
+				case 4:
+					tApp.controller.GetSSHKeyList()
+				case 5:
 					tApp.controller.GetFilesInfoList()
+				case 6:
+					tApp.controller.GetTrashList()
+				default:
+					tApp.controller.GetSessionList()
 				}
 			})
 
@@ -54,9 +67,28 @@ func (tApp *tuiApp) ShowDataAccessView() {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(DataPageMain)
 			log.Debug("DataPageMain shown")
 		})
 	}()
 }
+
+func (tApp *tuiApp) ShowDeleteConflictView(itemType domain.SecretItemType, name string) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowDeleteConflictView start %v %v", itemType, name)
+			modal := tview.NewModal().
+				SetText(fmt.Sprintf("%v was deleted offline and changed on another device", name)).
+				AddButtons([]string{"Keep", "Delete"}).
+				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+					tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+					tApp.controller.ResolveDeleteConflict(itemType, name, buttonLabel == "Delete")
+				})
+			modal.SetTitle("Conflict")
+			tApp.app.SetRoot(modal, true).SetFocus(modal)
+			log.Debug("ShowDeleteConflictView shown")
+		})
+	}()
+}
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
@@ -16,7 +17,7 @@ func (tApp *tuiApp) ShowFileInfoListView(filesInfoList []domain.FileInfo) {
 			log.Debug("ShowFileInfListView start")
 			tApp.fileInfoListFlex.Clear()
 
-			box := tview.NewBox().SetBorder(true).SetTitle("FileInfoList")
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle("FileInfoList"))
 			tApp.fileInfoListFlex.Box = box
 
 			cardNumberList := tview.NewList().ShowSecondaryText(false)
@@ -51,7 +52,7 @@ func (tApp *tuiApp) ShowFileInfoListView(filesInfoList []domain.FileInfo) {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(FileInfoListPage)
 			log.Debug("ShowFileInfListView shown")
 		})
@@ -104,46 +105,61 @@ func (tApp *tuiApp) ShowUploadFileView(info *domain.FileInfo) {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(UploadFilePage)
 			log.Debug("ShowUploadFileView complete")
 		})
 	}()
 }
 
-func (tApp *tuiApp) ShowFileInfoView(info *domain.FileInfo) {
+func (tApp *tuiApp) ShowFileInfoView(info *domain.FileInfo, meta *domain.FileMetadata) {
+	if info == nil {
+		info = &domain.FileInfo{}
+	}
+	edited := domain.FileMetadata{Name: info.Name}
+	if meta != nil {
+		edited = *meta
+		edited.Fields = slices.Clone(meta.Fields)
+	}
+	tApp.showFileInfoForm(info, &edited)
+}
+
+func (tApp *tuiApp) showFileInfoForm(info *domain.FileInfo, meta *domain.FileMetadata) {
 	go func() {
 		tApp.app.QueueUpdateDraw(func() {
 			log := app.GetMainLogger()
 			log.Debugf("ShowFileInfoView start")
 			tApp.fileInfoFlex.Clear()
 
-			box := tview.NewBox().SetBorder(true).SetTitle(fmt.Sprintf("FileInfo %s", info.Name))
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle(fmt.Sprintf("FileInfo %s", info.Name)))
 			tApp.fileInfoFlex.Box = box
 
-			if info == nil {
-				info = &domain.FileInfo{}
-			}
+			form := tview.NewForm().
+				AddInputField("Path to store", info.Path, 40, nil, func(path string) {
+					info.Path = path
+				})
+			addCustomFields(form, meta.Fields, &meta.Notes).
+				AddButton("Delete", func() {
+					tApp.controller.DeleteFile(info.Name)
+				}).
+				AddButton("Save", func() {
+					tApp.controller.SaveFile(info)
+				}).
+				AddButton("Select directory to save", func() {
+					tApp.SelectDirectoryView(info, func(info *domain.FileInfo) {
+						tApp.showFileInfoForm(info, meta)
+					})
+				}).
+				AddButton("Save metadata", func() {
+					tApp.controller.SaveFileMetadata(meta)
+				})
+			tApp.addCustomFieldButtons(form, &meta.Fields, func() {
+				tApp.showFileInfoForm(info, meta)
+			})
 
 			tApp.fileInfoFlex.
 				SetDirection(tview.FlexRow).
-				AddItem(
-					tview.NewForm().
-						AddInputField("Path to store", info.Path, 40, nil, func(path string) {
-							info.Path = path
-						}).
-						AddButton("Delete", func() {
-							tApp.controller.DeleteFile(info.Name)
-						}).
-						AddButton("Save", func() {
-							tApp.controller.SaveFile(info)
-						}).
-						AddButton("Select directory to save", func() {
-							tApp.SelectDirectoryView(info, func(info *domain.FileInfo) {
-								tApp.ShowFileInfoView(info)
-							})
-						}), 0, 1, true,
-				).
+				AddItem(form, 0, 1, true).
 				AddItem(
 					tview.NewTextView().
 						SetTextColor(tcell.ColorGreen).
@@ -157,10 +173,9 @@ func (tApp *tuiApp) ShowFileInfoView(info *domain.FileInfo) {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(FileInfoPage)
 			log.Debug("ShowFileInfoView complete")
 		})
 	}()
-
 }
@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func (tApp *tuiApp) ShowItemHistoryView(itemType domain.SecretItemType, name string, revisions []domain.ItemRevision) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowItemHistoryView start %v", name)
+			tApp.itemHistoryFlex.Clear()
+
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle(fmt.Sprintf("History %v", name)))
+			tApp.itemHistoryFlex.Box = box
+
+			historyList := tview.NewList()
+			for index, rev := range revisions {
+				historyList.AddItem(itemRevisionTitle(&rev), itemRevisionDescription(&rev), rune(49+index), nil)
+			}
+
+			historyList.SetSelectedFunc(func(index int, name string, second_name string, shortcut rune) {
+				rev := revisions[index]
+				tApp.showItemRevisionModal(&rev)
+			})
+
+			back := func() {
+				switch itemType {
+				case domain.SecretItemBankCard:
+					tApp.controller.GetBankCard(name)
+				case domain.SecretItemUserPassword:
+					tApp.controller.GetUserPasswordData(name)
+				case domain.SecretItemSecureNote:
+					tApp.controller.GetSecureNote(name)
+				case domain.SecretItemAuthenticator:
+					tApp.controller.GetAuthenticator(name)
+				case domain.SecretItemSSHKey:
+					tApp.controller.GetSSHKey(name)
+				}
+			}
+
+			tApp.itemHistoryFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(historyList, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Enter) to show and restore the revision\n(Ctrl-b) to back\n(Ctrl-q) to quit"), 0, 1, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						back()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(ItemHistoryPage)
+			log.Debug("ShowItemHistoryView shown")
+		})
+	}()
+}
+
+func (tApp *tuiApp) showItemRevisionModal(rev *domain.ItemRevision) {
+	selected := *rev
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("%s\n\n%s", itemRevisionTitle(rev), itemRevisionContent(rev))).
+		AddButtons([]string{"Restore", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			if buttonLabel == "Restore" {
+				tApp.controller.RestoreItemRevision(selected)
+			}
+		})
+	modal.SetTitle("Revision")
+	tApp.app.SetRoot(modal, true).SetFocus(modal)
+}
+
+func itemRevisionTitle(rev *domain.ItemRevision) string {
+	return fmt.Sprintf("revision %d: saved %s", rev.Revision, rev.UpdatedAt.Local().Format(sessionTimeFormat))
+}
+
+func itemRevisionDescription(rev *domain.ItemRevision) string {
+	return fmt.Sprintf("replaced: %s", rev.ReplacedAt.Local().Format(sessionTimeFormat))
+}
+
+func itemRevisionContent(rev *domain.ItemRevision) string {
+	switch {
+	case rev.BankCard != nil:
+		return fmt.Sprintf("Number: %s\nExpiry: %02d/%d\nCVV: %s",
+			rev.BankCard.Number, rev.BankCard.ExpiryMonth, rev.BankCard.ExpiryYear, rev.BankCard.CVV)
+	case rev.UserPasswordData != nil:
+		return fmt.Sprintf("Hint: %s\nLogin: %s\nPassword: %s",
+			rev.UserPasswordData.Hint, rev.UserPasswordData.Login, rev.UserPasswordData.Passwrod)
+	case rev.SecureNote != nil:
+		return fmt.Sprintf("Title: %s\n%s", rev.SecureNote.Title, rev.SecureNote.Text)
+	case rev.Authenticator != nil:
+		return fmt.Sprintf("Name: %s\nURI: %s", rev.Authenticator.Name, rev.Authenticator.URI)
+	case rev.SSHKey != nil:
+		// the private key is not shown, the revision is identified by the public key
+		return fmt.Sprintf("Name: %s\nComment: %s\nPublic key: %s", rev.SSHKey.Name, rev.SSHKey.Comment, rev.SSHKey.PublicKey)
+	default:
+		return ""
+	}
+}
@@ -39,7 +39,7 @@ func (tApp *tuiApp) ShowLogOTPView() {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(LoginOTPPage)
 		})
 	}()
@@ -81,7 +81,7 @@ func (tApp *tuiApp) ShowLoginView() {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(LoginEMailPage)
 		})
 	}()
@@ -126,8 +126,50 @@ func (tApp *tuiApp) ShowMasterKeyView(hint string) {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(LoginMKeyPage)
 		})
 	}()
 }
+
+// ShowOfflineMasterKeyView opens the data saved by the previous online session without the server.
+func (tApp *tuiApp) ShowOfflineMasterKeyView() {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			tApp.offlineMKeyFlex.Clear()
+			var masterPassword string
+
+			form := tview.NewForm().
+				AddPasswordField("MasterPassword", "", 40, '#', func(mKey string) {
+					masterPassword = mKey
+				}).
+				AddButton("Enter", func() {
+					tApp.controller.OfflineUnlock(masterPassword)
+				})
+
+			box := tview.NewBox().SetBorder(true).SetTitle("Offline access")
+			tApp.offlineMKeyFlex.Box = box
+
+			tApp.offlineMKeyFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(
+					form, 0, 1, true,
+				).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("The data saved by the last online session; changes are sent after the next online login\n(Ctrl-b) to back\n(Ctrl-q) to quit"), 0, 1, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.pages.SwitchToPage(InitPage)
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(OfflineMKeyPage)
+		})
+	}()
+}
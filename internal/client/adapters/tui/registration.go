@@ -38,7 +38,7 @@ func (tApp *tuiApp) ShowRegOTPView() {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(RegOTPPage)
 		})
 	}()
@@ -89,7 +89,7 @@ func (tApp *tuiApp) ShowRegView() {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(RegEMailPage)
 		})
 	}()
@@ -140,7 +140,7 @@ func (tApp *tuiApp) ShowRegMasterKeyView() {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(RegMKeyPage)
 		})
 	}()
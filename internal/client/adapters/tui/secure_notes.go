@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func (tApp *tuiApp) ShowSecureNoteListView(titles []string) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debug("ShowSecureNoteListView start")
+			tApp.secureNoteListFlex.Clear()
+
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle("SecureNoteList"))
+			tApp.secureNoteListFlex.Box = box
+
+			titleList := tview.NewList().ShowSecondaryText(false)
+			for index, title := range titles {
+				titleList.AddItem(title, "", rune(49+index), nil)
+			}
+
+			titleList.SetSelectedFunc(func(index int, title string, second_name string, shortcut rune) {
+				tApp.controller.GetSecureNote(title)
+			})
+
+			tApp.secureNoteListFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(titleList, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-n) new\n(Ctrl-b) to back\n(Ctrl-q) to quit"), 0, 1, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlN:
+						tApp.controller.NewSecureNote()
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.ShowDataAccessView()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(SecureNoteListPage)
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowEditSecureNoteView(note *domain.SecureNote) {
+	edited := *note
+	edited.Fields = slices.Clone(note.Fields)
+	tApp.showEditSecureNoteForm(&edited)
+}
+
+func (tApp *tuiApp) showEditSecureNoteForm(note *domain.SecureNote) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowEditSecureNoteView start %v", note.Title)
+
+			tApp.editSecureNoteFlex.Clear()
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle(fmt.Sprintf("EditSecureNote %v", note.Title)))
+			tApp.editSecureNoteFlex.Box = box
+
+			form := tview.NewForm().
+				AddTextArea("Text", note.Text, 60, 10, 0, func(text string) {
+					note.Text = text
+				})
+			addFields(form, note.Fields)
+			form.
+				AddButton("Save", func() {
+					tApp.controller.UpdateSecureNote(note)
+				}).
+				AddButton("Delete", func() {
+					tApp.controller.DeleteSecureNote(note.Title)
+				}).
+				AddButton("History", func() {
+					tApp.controller.GetSecureNoteHistory(note.Title)
+				})
+			tApp.addCustomFieldButtons(form, &note.Fields, func() {
+				tApp.showEditSecureNoteForm(note)
+			})
+
+			tApp.editSecureNoteFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(form, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-b) to back\n(Ctrl-q) to quit"), 3, 0, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.controller.GetSecureNoteList()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(EditSecureNotePage)
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowNewSecureNoteView() {
+	tApp.showNewSecureNoteForm(&domain.SecureNote{})
+}
+
+func (tApp *tuiApp) showNewSecureNoteForm(note *domain.SecureNote) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debug("NewSecureNote")
+			tApp.newSecureNoteFlex.Clear()
+			box := tview.NewBox().SetBorder(true).SetTitle("NewSecureNote")
+			tApp.newSecureNoteFlex.Box = box
+
+			form := tview.NewForm().
+				AddInputField("Title", note.Title, 40, nil, func(title string) {
+					note.Title = title
+				}).
+				AddTextArea("Text", note.Text, 60, 10, 0, func(text string) {
+					note.Text = text
+				})
+			addFields(form, note.Fields)
+			form.AddButton("Save", func() {
+				tApp.controller.AddSecureNote(note)
+			})
+			tApp.addCustomFieldButtons(form, &note.Fields, func() {
+				tApp.showNewSecureNoteForm(note)
+			})
+
+			tApp.newSecureNoteFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(form, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-b) to back\n(Ctrl-q) to quit"), 3, 0, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.controller.GetSecureNoteList()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(NewSecureNotePage)
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowSecureNoteConflictView(note *domain.SecureNote) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowSecureNoteConflictView start %v", note.Title)
+			modal := tview.NewModal().
+				SetText(fmt.Sprintf("SecureNote %v was changed on another device", note.Title)).
+				AddButtons([]string{"Reload", "Overwrite", "Keep both"}).
+				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+					switch buttonLabel {
+					case "Reload":
+						tApp.controller.ResolveSecureNoteConflict(note, domain.ConflictReload)
+					case "Overwrite":
+						tApp.controller.ResolveSecureNoteConflict(note, domain.ConflictOverwrite)
+					case "Keep both":
+						tApp.controller.ResolveSecureNoteConflict(note, domain.ConflictKeepBoth)
+					}
+				})
+			modal.SetTitle("Conflict")
+			tApp.app.SetRoot(modal, true).SetFocus(modal)
+			log.Debug("ShowSecureNoteConflictView shown")
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowSecureNoteTooLargeView(note *domain.SecureNote) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowSecureNoteTooLargeView start %v", note.Title)
+			modal := tview.NewModal().
+				SetText(fmt.Sprintf("SecureNote %v is larger than %d bytes. Store it as file %v?",
+					note.Title, domain.MaxSecureNoteSize, domain.SecureNoteFileName(note.Title))).
+				AddButtons([]string{"Store as file", "Cancel"}).
+				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+					tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+					if buttonLabel == "Store as file" {
+						tApp.controller.StoreSecureNoteAsFile(note)
+					}
+				})
+			modal.SetTitle("SecureNote")
+			tApp.app.SetRoot(modal, true).SetFocus(modal)
+			log.Debug("ShowSecureNoteTooLargeView shown")
+		})
+	}()
+}
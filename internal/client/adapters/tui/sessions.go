@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const sessionTimeFormat = time.DateTime
+
+func (tApp *tuiApp) ShowSessionListView(sessions []domain.SessionInfo) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debug("ShowSessionListView start")
+			tApp.sessionListFlex.Clear()
+
+			box := tview.NewBox().SetBorder(true).SetTitle("Sessions")
+			tApp.sessionListFlex.Box = box
+
+			sessionList := tview.NewList()
+			for index, session := range sessions {
+				sessionList.AddItem(sessionTitle(&session), sessionDescription(&session), rune(49+index), nil)
+			}
+
+			sessionList.SetSelectedFunc(func(index int, name string, second_name string, shortcut rune) {
+				session := sessions[index]
+				if session.Current {
+					tApp.ShowMsg("current session can't be terminated")
+					return
+				}
+				tApp.showTerminateSessionModal(&session)
+			})
+
+			tApp.sessionListFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(sessionList, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Enter) to terminate\n(Ctrl-r) to refresh\n(Ctrl-b) to back\n(Ctrl-q) to quit"), 0, 1, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlR:
+						tApp.controller.GetSessionList()
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.ShowDataAccessView()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(SessionListPage)
+			log.Debug("ShowSessionListView shown")
+		})
+	}()
+}
+
+func (tApp *tuiApp) showTerminateSessionModal(session *domain.SessionInfo) {
+	sessionID := session.SessionID
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Terminate session %s?", sessionTitle(session))).
+		AddButtons([]string{"Terminate", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			if buttonLabel == "Terminate" {
+				tApp.controller.TerminateSession(sessionID)
+			}
+		})
+	modal.SetTitle("Sessions")
+	tApp.app.SetRoot(modal, true).SetFocus(modal)
+}
+
+func sessionTitle(session *domain.SessionInfo) string {
+	deviceName := session.DeviceName
+	if deviceName == "" {
+		deviceName = "unknown device"
+	}
+	title := fmt.Sprintf("%s (%s)", deviceName, session.PeerIP)
+	if session.Current {
+		title += " - current"
+	}
+	return title
+}
+
+func sessionDescription(session *domain.SessionInfo) string {
+	return fmt.Sprintf("version: %s, created: %s, last seen: %s",
+		session.ClientVersion,
+		session.CreatedAt.Local().Format(sessionTimeFormat),
+		session.LastSeenAt.Local().Format(sessionTimeFormat))
+}
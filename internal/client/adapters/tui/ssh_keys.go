@@ -0,0 +1,216 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func (tApp *tuiApp) ShowSSHKeyListView(names []string) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debug("ShowSSHKeyListView start")
+			tApp.sshKeyListFlex.Clear()
+
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle("SSHKeyList"))
+			tApp.sshKeyListFlex.Box = box
+
+			nameList := tview.NewList().ShowSecondaryText(false)
+			for index, name := range names {
+				nameList.AddItem(name, "", rune(49+index), nil)
+			}
+
+			nameList.SetSelectedFunc(func(index int, name string, second_name string, shortcut rune) {
+				tApp.controller.GetSSHKey(name)
+			})
+
+			tApp.sshKeyListFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(nameList, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-n) new\n(Ctrl-b) to back\n(Ctrl-q) to quit"), 0, 1, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlN:
+						tApp.controller.NewSSHKey()
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.ShowDataAccessView()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(SSHKeyListPage)
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowEditSSHKeyView(key *domain.SSHKey) {
+	edited := *key
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowEditSSHKeyView start %v", edited.Name)
+
+			tApp.editSSHKeyFlex.Clear()
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle(fmt.Sprintf("EditSSHKey %v", edited.Name)))
+			tApp.editSSHKeyFlex.Box = box
+
+			// the public key is derived from the private one on save
+			publicKeyView := tview.NewTextView().SetWrap(true).SetText(edited.PublicKey)
+
+			form := tview.NewForm()
+			tApp.addSSHKeyFields(form, &edited)
+			form.
+				AddButton("Save", func() {
+					edited.PublicKey = ""
+					tApp.controller.UpdateSSHKey(&edited)
+				}).
+				AddButton("Delete", func() {
+					tApp.controller.DeleteSSHKey(edited.Name)
+				}).
+				AddButton("History", func() {
+					tApp.controller.GetSSHKeyHistory(edited.Name)
+				})
+
+			tApp.editSSHKeyFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(publicKeyView, 3, 0, false).
+				AddItem(form, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-b) to back\n(Ctrl-q) to quit"), 3, 0, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.controller.GetSSHKeyList()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(EditSSHKeyPage)
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowNewSSHKeyView() {
+	key := &domain.SSHKey{}
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debug("NewSSHKey")
+			tApp.newSSHKeyFlex.Clear()
+			box := tview.NewBox().SetBorder(true).SetTitle("NewSSHKey")
+			tApp.newSSHKeyFlex.Box = box
+
+			form := tview.NewForm().
+				AddInputField("Name", key.Name, 40, nil, func(name string) {
+					key.Name = name
+				})
+			tApp.addSSHKeyFields(form, key)
+			form.AddButton("Save", func() {
+				tApp.controller.AddSSHKey(key)
+			})
+
+			tApp.newSSHKeyFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(form, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Ctrl-b) to back\n(Ctrl-q) to quit"), 3, 0, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.controller.GetSSHKeyList()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(NewSSHKeyPage)
+		})
+	}()
+}
+
+func (tApp *tuiApp) addSSHKeyFields(form *tview.Form, key *domain.SSHKey) {
+	form.
+		AddTextArea("Private key", key.PrivateKey, 70, 8, 0, func(text string) {
+			key.PrivateKey = text
+		}).
+		AddPasswordField("Passphrase", key.Passphrase, 40, '*', func(passphrase string) {
+			key.Passphrase = passphrase
+		}).
+		AddInputField("Comment", key.Comment, 40, nil, func(comment string) {
+			key.Comment = comment
+		}).
+		AddCheckbox("Confirm each use", key.Confirm, func(checked bool) {
+			key.Confirm = checked
+		})
+}
+
+func (tApp *tuiApp) ShowSSHKeyConflictView(key *domain.SSHKey) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowSSHKeyConflictView start %v", key.Name)
+			modal := tview.NewModal().
+				SetText(fmt.Sprintf("SSH key %v was changed on another device", key.Name)).
+				AddButtons([]string{"Reload", "Overwrite", "Keep both"}).
+				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+					switch buttonLabel {
+					case "Reload":
+						tApp.controller.ResolveSSHKeyConflict(key, domain.ConflictReload)
+					case "Overwrite":
+						tApp.controller.ResolveSSHKeyConflict(key, domain.ConflictOverwrite)
+					case "Keep both":
+						tApp.controller.ResolveSSHKeyConflict(key, domain.ConflictKeepBoth)
+					}
+				})
+			modal.SetTitle("Conflict")
+			tApp.app.SetRoot(modal, true).SetFocus(modal)
+			log.Debug("ShowSSHKeyConflictView shown")
+		})
+	}()
+}
+
+func (tApp *tuiApp) ShowSSHSignConfirmView(ctx context.Context, name string, answer func(allowed bool)) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowSSHSignConfirmView start %v", name)
+			modal := tview.NewModal().
+				SetText(fmt.Sprintf("ssh-agent: allow signing by key %v?", name))
+			// the modal is closed only if it is still shown - another view may have replaced it
+			closeModal := func() {
+				if tApp.app.GetFocus() == modal {
+					tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+				}
+			}
+			stop := context.AfterFunc(ctx, func() {
+				tApp.app.QueueUpdateDraw(closeModal)
+			})
+			modal.AddButtons([]string{"Allow", "Deny"}).
+				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+					stop()
+					closeModal()
+					answer(buttonLabel == "Allow")
+				})
+			modal.SetTitle("SSH")
+			tApp.app.SetRoot(modal, true).SetFocus(modal)
+			log.Debug("ShowSSHSignConfirmView shown")
+		})
+	}()
+}
@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func (tApp *tuiApp) ShowTrashListView(entries []domain.TrashEntry) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debug("ShowTrashListView start")
+			tApp.trashListFlex.Clear()
+
+			box := tview.NewBox().SetBorder(true).SetTitle("Trash")
+			tApp.trashListFlex.Box = box
+
+			trashList := tview.NewList()
+			for index, entry := range entries {
+				trashList.AddItem(trashEntryTitle(&entry), trashEntryDescription(&entry), rune(49+index), nil)
+			}
+
+			trashList.SetSelectedFunc(func(index int, name string, second_name string, shortcut rune) {
+				entry := entries[index]
+				tApp.showTrashEntryModal(&entry)
+			})
+
+			tApp.trashListFlex.
+				SetDirection(tview.FlexRow).
+				AddItem(trashList, 0, 1, true).
+				AddItem(
+					tview.NewTextView().
+						SetTextColor(tcell.ColorGreen).
+						SetText("(Enter) to restore or delete forever\n(Ctrl-e) to empty trash\n(Ctrl-r) to refresh\n(Ctrl-b) to back\n(Ctrl-q) to quit"), 0, 1, false).
+				SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+					switch event.Key() {
+					case tcell.KeyCtrlE:
+						if len(entries) > 0 {
+							tApp.showEmptyTrashModal()
+						}
+					case tcell.KeyCtrlR:
+						tApp.controller.GetTrashList()
+					case tcell.KeyCtrlQ:
+						tApp.app.Stop()
+					case tcell.KeyCtrlB:
+						tApp.ShowDataAccessView()
+					}
+					return event
+				})
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			tApp.pages.SwitchToPage(TrashListPage)
+			log.Debug("ShowTrashListView shown")
+		})
+	}()
+}
+
+func (tApp *tuiApp) showTrashEntryModal(entry *domain.TrashEntry) {
+	selected := *entry
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("%s\ndeleted: %s", trashEntryTitle(entry), entry.DeletedAt.Local().Format(sessionTimeFormat))).
+		AddButtons([]string{"Restore", "Delete forever", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			switch buttonLabel {
+			case "Restore":
+				tApp.controller.RestoreTrashEntry(selected)
+			case "Delete forever":
+				tApp.controller.PurgeTrashEntry(selected)
+			}
+		})
+	modal.SetTitle("Trash")
+	tApp.app.SetRoot(modal, true).SetFocus(modal)
+}
+
+func (tApp *tuiApp) showEmptyTrashModal() {
+	modal := tview.NewModal().
+		SetText("Delete all items and files in the trash forever?").
+		AddButtons([]string{"Empty trash", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
+			if buttonLabel == "Empty trash" {
+				tApp.controller.EmptyTrash()
+			}
+		})
+	modal.SetTitle("Trash")
+	tApp.app.SetRoot(modal, true).SetFocus(modal)
+}
+
+func trashEntryTitle(entry *domain.TrashEntry) string {
+	switch entry.Type {
+	case domain.TrashFile:
+		return fmt.Sprintf("file: %s", entry.Name)
+	case domain.SecretItemBankCard:
+		return fmt.Sprintf("bank card: %s", entry.Name)
+	case domain.SecretItemUserPassword:
+		return fmt.Sprintf("password: %s", entry.Name)
+	case domain.SecretItemSecureNote:
+		return fmt.Sprintf("note: %s", entry.Name)
+	case domain.SecretItemAuthenticator:
+		return fmt.Sprintf("authenticator: %s", entry.Name)
+	case domain.SecretItemSSHKey:
+		return fmt.Sprintf("ssh key: %s", entry.Name)
+	default:
+		return fmt.Sprintf("%s: %s", entry.Type, entry.Name)
+	}
+}
+
+func trashEntryDescription(entry *domain.TrashEntry) string {
+	return fmt.Sprintf("deleted: %s, purged after: %s",
+		entry.DeletedAt.Local().Format(sessionTimeFormat),
+		entry.ExpiresAt.Local().Format(sessionTimeFormat))
+}
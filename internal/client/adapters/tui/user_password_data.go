@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
@@ -16,7 +17,7 @@ func (tApp *tuiApp) ShowUserPasswordDataListView(hintList []string) {
 			log.Debug("ShowUserPasswordDataListView start")
 			tApp.userPasswordDataListFlex.Clear()
 
-			box := tview.NewBox().SetBorder(true).SetTitle("PasswordDataList")
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle("PasswordDataList"))
 			tApp.userPasswordDataListFlex.Box = box
 
 			cardNumberList := tview.NewList().ShowSecondaryText(false)
@@ -46,39 +47,52 @@ func (tApp *tuiApp) ShowUserPasswordDataListView(hintList []string) {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(UserPasswordDataListPage)
 		})
 	}()
 }
 
 func (tApp *tuiApp) ShowEditUserPasswordDataView(data *domain.UserPasswordData) {
+	edited := *data
+	edited.Fields = slices.Clone(data.Fields)
+	tApp.showEditUserPasswordDataForm(&edited)
+}
+
+func (tApp *tuiApp) showEditUserPasswordDataForm(data *domain.UserPasswordData) {
 	go func() {
 		tApp.app.QueueUpdateDraw(func() {
 			log := app.GetMainLogger()
 			log.Debugf("UserPasswordData start %v", data.Hint)
 
 			tApp.editUserPasswordDataFlex.Clear()
-			box := tview.NewBox().SetBorder(true).SetTitle(fmt.Sprintf("EditPasswordData %v", data.Hint))
+			box := tview.NewBox().SetBorder(true).SetTitle(tApp.dataTitle(fmt.Sprintf("EditPasswordData %v", data.Hint)))
 			tApp.editUserPasswordDataFlex.Box = box
 
+			form := tview.NewForm().
+				AddInputField("Login", data.Login, 40, nil, func(login string) {
+					data.Login = login
+				}).
+				AddPasswordField("Password", data.Passwrod, 40, '#', func(pass string) {
+					data.Passwrod = pass
+				})
+			addCustomFields(form, data.Fields, &data.Notes).
+				AddButton("Save", func() {
+					tApp.controller.UpdatePasswordData(data)
+				}).
+				AddButton("Delete", func() {
+					tApp.controller.DeleteUpdatePasswordData(data.Hint)
+				}).
+				AddButton("History", func() {
+					tApp.controller.GetUserPasswordDataHistory(data.Hint)
+				})
+			tApp.addCustomFieldButtons(form, &data.Fields, func() {
+				tApp.showEditUserPasswordDataForm(data)
+			})
+
 			tApp.editUserPasswordDataFlex.
 				SetDirection(tview.FlexRow).
-				AddItem(
-					tview.NewForm().
-						AddInputField("Login", data.Login, 40, nil, func(login string) {
-							data.Login = login
-						}).
-						AddPasswordField("Password", data.Passwrod, 40, '#', func(pass string) {
-							data.Passwrod = pass
-						}).
-						AddButton("Save", func() {
-							tApp.controller.UpdatePasswordData(data)
-						}).
-						AddButton("Delete", func() {
-							tApp.controller.DeleteUpdatePasswordData(data.Hint)
-						}), 0, 1, true,
-				).
+				AddItem(form, 0, 1, true).
 				AddItem(
 					tview.NewTextView().
 						SetTextColor(tcell.ColorGreen).
@@ -92,13 +106,17 @@ func (tApp *tuiApp) ShowEditUserPasswordDataView(data *domain.UserPasswordData)
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(EditUserPasswordDataPage)
 		})
 	}()
 }
 
 func (tApp *tuiApp) ShowNewUserPasswordDataView() {
+	tApp.showNewUserPasswordDataForm(&domain.UserPasswordData{})
+}
+
+func (tApp *tuiApp) showNewUserPasswordDataForm(data *domain.UserPasswordData) {
 	go func() {
 		tApp.app.QueueUpdateDraw(func() {
 			log := app.GetMainLogger()
@@ -107,25 +125,27 @@ func (tApp *tuiApp) ShowNewUserPasswordDataView() {
 			box := tview.NewBox().SetBorder(true).SetTitle("NewPasswordData")
 			tApp.newUserPasswordDataFlex.Box = box
 
-			data := &domain.UserPasswordData{}
+			form := tview.NewForm().
+				AddInputField("Hint", data.Hint, 40, nil, func(hint string) {
+					data.Hint = hint
+				}).
+				AddInputField("Login", data.Login, 40, nil, func(login string) {
+					data.Login = login
+				}).
+				AddPasswordField("Password", data.Passwrod, 40, '#', func(pass string) {
+					data.Passwrod = pass
+				})
+			addCustomFields(form, data.Fields, &data.Notes).
+				AddButton("Save", func() {
+					tApp.controller.AddUserPasswordData(data)
+				})
+			tApp.addCustomFieldButtons(form, &data.Fields, func() {
+				tApp.showNewUserPasswordDataForm(data)
+			})
 
 			tApp.newUserPasswordDataFlex.
 				SetDirection(tview.FlexRow).
-				AddItem(
-					tview.NewForm().
-						AddInputField("Hint", "", 40, nil, func(hint string) {
-							data.Hint = hint
-						}).
-						AddInputField("Login", "", 40, nil, func(login string) {
-							data.Login = login
-						}).
-						AddPasswordField("Password", "", 40, '#', func(pass string) {
-							data.Passwrod = pass
-						}).
-						AddButton("Save", func() {
-							tApp.controller.AddUserPasswordData(data)
-						}), 0, 1, true,
-				).
+				AddItem(form, 0, 1, true).
 				AddItem(
 					tview.NewTextView().
 						SetTextColor(tcell.ColorGreen).
@@ -139,8 +159,33 @@ func (tApp *tuiApp) ShowNewUserPasswordDataView() {
 					}
 					return event
 				})
-			tApp.app.SetRoot(tApp.pages, true).SetFocus(tApp.pages)
+			tApp.app.SetRoot(tApp.root, true).SetFocus(tApp.pages)
 			tApp.pages.SwitchToPage(NewUserPasswordDataPage)
 		})
 	}()
 }
+
+func (tApp *tuiApp) ShowUserPasswordDataConflictView(data *domain.UserPasswordData) {
+	go func() {
+		tApp.app.QueueUpdateDraw(func() {
+			log := app.GetMainLogger()
+			log.Debugf("ShowUserPasswordDataConflictView start %v", data.Hint)
+			modal := tview.NewModal().
+				SetText(fmt.Sprintf("UserPasswordData %v was changed on another device", data.Hint)).
+				AddButtons([]string{"Reload", "Overwrite", "Keep both"}).
+				SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+					switch buttonLabel {
+					case "Reload":
+						tApp.controller.ResolveUserPasswordDataConflict(data, domain.ConflictReload)
+					case "Overwrite":
+						tApp.controller.ResolveUserPasswordDataConflict(data, domain.ConflictOverwrite)
+					case "Keep both":
+						tApp.controller.ResolveUserPasswordDataConflict(data, domain.ConflictKeepBoth)
+					}
+				})
+			modal.SetTitle("Conflict")
+			tApp.app.SetRoot(modal, true).SetFocus(modal)
+			log.Debug("ShowUserPasswordDataConflictView shown")
+		})
+	}()
+}
@@ -0,0 +1,2 @@
+// Package vault define file storage of the encrypted client data for offline access
+package vault
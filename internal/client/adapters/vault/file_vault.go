@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// NewFileVault create vault stored in the file; the data is written by the client already encrypted.
+func NewFileVault(path string) *fileVault {
+	return &fileVault{
+		path: path,
+	}
+}
+
+var _ app.LocalVault = (*fileVault)(nil)
+
+type fileVault struct {
+	path string
+	mtx  sync.Mutex
+}
+
+// Save replaces the vault file; the data is written to the temporary file first, so the previous
+// vault is kept if the client is stopped while writing.
+func (fv *fileVault) Save(vault *domain.EncryptedVault) error {
+	fv.mtx.Lock()
+	defer fv.mtx.Unlock()
+
+	data, err := json.Marshal(vault)
+	if err != nil {
+		return fmt.Errorf("%w - can't marshal vault %v", domain.ErrClientInternal, err.Error())
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fv.path), filepath.Base(fv.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("%w - can't create vault file %v", domain.ErrClientInternal, err.Error())
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("%w - can't write vault file %v", domain.ErrClientInternal, err.Error())
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("%w - can't write vault file %v", domain.ErrClientInternal, err.Error())
+	}
+
+	if err := os.Rename(tmp.Name(), fv.path); err != nil {
+		return fmt.Errorf("%w - can't replace vault file %v", domain.ErrClientInternal, err.Error())
+	}
+	return nil
+}
+
+// Load reads the vault file.
+// Returns:
+//
+//	nil, [domain.ErrDataNotExists] if the vault is not saved yet.
+func (fv *fileVault) Load() (*domain.EncryptedVault, error) {
+	fv.mtx.Lock()
+	defer fv.mtx.Unlock()
+
+	data, err := os.ReadFile(fv.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w - vault file %v is not exists", domain.ErrDataNotExists, fv.path)
+		}
+		return nil, fmt.Errorf("%w - can't read vault file %v", domain.ErrClientInternal, err.Error())
+	}
+
+	var vault domain.EncryptedVault
+	if err := json.Unmarshal(data, &vault); err != nil {
+		return nil, fmt.Errorf("%w - can't decode vault file %v", domain.ErrClientDataIncorrect, err.Error())
+	}
+	return &vault, nil
+}
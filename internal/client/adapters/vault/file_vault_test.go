@@ -0,0 +1,58 @@
+package vault_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/adapters/vault"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileVault(t *testing.T) {
+
+	t.Run("not_exists", func(t *testing.T) {
+		fv := vault.NewFileVault(filepath.Join(t.TempDir(), "vault.json"))
+		_, err := fv.Load()
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+
+	t.Run("save_load", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "vault.json")
+		fv := vault.NewFileVault(path)
+
+		saved := &domain.EncryptedVault{
+			Hello: domain.HelloData{
+				HelloEncrypted:     "hello",
+				MasterPasswordHint: "hint",
+			},
+			Data: "data",
+		}
+		require.NoError(t, fv.Save(saved))
+
+		saved.Data = "data2"
+		require.NoError(t, fv.Save(saved))
+
+		loaded, err := vault.NewFileVault(path).Load()
+		require.NoError(t, err)
+		assert.Equal(t, saved, loaded)
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+		entries, err := os.ReadDir(filepath.Dir(path))
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(entries))
+	})
+
+	t.Run("broken", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "vault.json")
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+
+		_, err := vault.NewFileVault(path).Load()
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
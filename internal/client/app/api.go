@@ -2,11 +2,12 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 )
 
-//go:generate mockgen -destination "./generated_mocks_test.go" -package ${GOPACKAGE}_test . AppServer,AppView,Pinger,DomainHelper,AppStorage
+//go:generate mockgen -destination "./generated_mocks_test.go" -package ${GOPACKAGE}_test . AppServer,AppView,Pinger,DomainHelper,AppStorage,LocalVault
 
 type Pinger interface {
 	Ping(ctx context.Context) error
@@ -16,12 +17,17 @@ type AppStorage interface {
 	SetMasterPassword(masterPassword string)
 	GetMasterPassword() string
 
+	// the change sequence number of the last applied server changes
+	SetChangeSeq(seq int64)
+	GetChangeSeq() int64
+
 	SetBankCards(cards []domain.BankCard)
 	AddBankCard(bankCard *domain.BankCard) error
 	UpdateBankCard(bankCard *domain.BankCard) error
 	DeleteBankCard(number string) error
 	GetBankCard(number string) (*domain.BankCard, error)
 	GetBankCardNumberList() []string
+	ApplyBankCardChanges(changed []domain.BankCard, deletedIDs []string)
 
 	GetUserPasswordData(hint string) (*domain.UserPasswordData, error)
 	UpdateUserPasswordData(data *domain.UserPasswordData) error
@@ -29,6 +35,7 @@ type AppStorage interface {
 	SetUserPasswordDatas(datas []domain.UserPasswordData)
 	AddUserPasswordData(data *domain.UserPasswordData) error
 	GetUserPasswordDataList() []string
+	ApplyUserPasswordDataChanges(changed []domain.UserPasswordData, deletedIDs []string)
 
 	AddFileInfo(fileInfo *domain.FileInfo) error
 	UpdateFileInfo(data *domain.FileInfo) error
@@ -37,6 +44,42 @@ type AppStorage interface {
 	GetFileInfoList() []domain.FileInfo
 	SetFilesInfo(infs []domain.FileInfo)
 	IsFileInfoExists(name string) bool
+
+	SetFileMetadatas(metas []domain.FileMetadata)
+	SetFileMetadata(meta *domain.FileMetadata)
+	// GetFileMetadata returns the empty metadata for the file without one.
+	GetFileMetadata(name string) *domain.FileMetadata
+	ApplyFileMetadataChanges(changed []domain.FileMetadata, deletedIDs []string)
+
+	GetSecureNote(title string) (*domain.SecureNote, error)
+	UpdateSecureNote(note *domain.SecureNote) error
+	DeleteSecureNote(title string) error
+	SetSecureNotes(notes []domain.SecureNote)
+	AddSecureNote(note *domain.SecureNote) error
+	GetSecureNoteList() []string
+	ApplySecureNoteChanges(changed []domain.SecureNote, deletedIDs []string)
+
+	GetAuthenticator(name string) (*domain.Authenticator, error)
+	UpdateAuthenticator(auth *domain.Authenticator) error
+	DeleteAuthenticator(name string) error
+	SetAuthenticators(authenticators []domain.Authenticator)
+	AddAuthenticator(auth *domain.Authenticator) error
+	GetAuthenticatorList() []string
+	ApplyAuthenticatorChanges(changed []domain.Authenticator, deletedIDs []string)
+
+	GetSSHKey(name string) (*domain.SSHKey, error)
+	UpdateSSHKey(key *domain.SSHKey) error
+	DeleteSSHKey(name string) error
+	SetSSHKeys(sshKeys []domain.SSHKey)
+	AddSSHKey(key *domain.SSHKey) error
+	GetSSHKeyList() []string
+	ApplySSHKeyChanges(changed []domain.SSHKey, deletedIDs []string)
+}
+
+// LocalVault keeps the encrypted client data between runs for offline access.
+type LocalVault interface {
+	Save(vault *domain.EncryptedVault) error
+	Load() (*domain.EncryptedVault, error)
 }
 
 type DomainHelper interface {
@@ -48,13 +91,19 @@ type DomainHelper interface {
 	DecryptHello(masterPassword string, helloEncrypted string) error
 	CheckBankCardData(data *domain.BankCard) error
 	CheckUserPasswordData(data *domain.UserPasswordData) error
+	CheckSecureNote(data *domain.SecureNote) error
+	CheckAuthenticator(data *domain.Authenticator) error
+	ReadOTPAuthQR(path string) (string, error)
+	CheckSSHKey(data *domain.SSHKey) error
 	EncryptShortData(masterKey string, data string) (string, error)
 	DecryptShortData(masterKey string, ciphertext string) (string, error)
+	BlindIndex(masterPass string, itemType domain.SecretItemType, value string) string
 
 	CheckFileForRead(info *domain.FileInfo) error
 	CheckFileForWrite(inf *domain.FileInfo) error
 
 	CreateStreamFileReader(info *domain.FileInfo) (domain.StreamFileReader, error)
+	CreateStreamBytesReader(data []byte) domain.StreamFileReader
 	CreateStreamFileWriter(dir string) (domain.StreamFileWriter, error)
 
 	CreateChunkEncrypter(password string) (domain.ChunkEncrypter, error)
@@ -74,11 +123,45 @@ type AppView interface {
 	ShowBankCardListView(cardsNumber []string)
 	ShowEditBankCardView(bankCard *domain.BankCard)
 	ShowNewBankCardView()
+	ShowBankCardConflictView(bankCard *domain.BankCardView)
 	ShowUserPasswordDataListView(hints []string)
 	ShowEditUserPasswordDataView(data *domain.UserPasswordData)
 	ShowNewUserPasswordDataView()
-	ShowFileInfoView(info *domain.FileInfo)
+	ShowUserPasswordDataConflictView(data *domain.UserPasswordData)
+	// ShowFileInfoView shows the file info with the custom fields and notes of the file.
+	ShowFileInfoView(info *domain.FileInfo, meta *domain.FileMetadata)
+	ShowSecureNoteListView(titles []string)
+	ShowEditSecureNoteView(note *domain.SecureNote)
+	ShowNewSecureNoteView()
+	ShowSecureNoteConflictView(note *domain.SecureNote)
+	// ShowSecureNoteTooLargeView offers to store the note bigger than domain.MaxSecureNoteSize as a file.
+	ShowSecureNoteTooLargeView(note *domain.SecureNote)
+	ShowAuthenticatorListView(names []string)
+	// ShowEditAuthenticatorView shows the authenticator with the current code.
+	ShowEditAuthenticatorView(auth *domain.Authenticator)
+	// ShowNewAuthenticatorView shows the form filled with the data, the uri read from the QR code for example.
+	ShowNewAuthenticatorView(auth *domain.Authenticator)
+	ShowAuthenticatorConflictView(auth *domain.Authenticator)
+	ShowSSHKeyListView(names []string)
+	ShowEditSSHKeyView(key *domain.SSHKey)
+	ShowNewSSHKeyView()
+	ShowSSHKeyConflictView(key *domain.SSHKey)
+	// ShowSSHSignConfirmView asks the user to allow the ssh-agent signing request; answer may be invoked once.
+	// The request is closed without the answer when ctx is done.
+	ShowSSHSignConfirmView(ctx context.Context, name string, answer func(allowed bool))
 	ShowFileInfoListView(filesInfoList []domain.FileInfo)
+	ShowSessionListView(sessions []domain.SessionInfo)
+	ShowTrashListView(entries []domain.TrashEntry)
+	// ShowItemHistoryView shows the previous revisions of the card or the user password data with the name.
+	ShowItemHistoryView(itemType domain.SecretItemType, name string, revisions []domain.ItemRevision)
+	// RefreshItemsView is invoked after items of the type were changed by another client; "" - any type.
+	RefreshItemsView(itemType domain.SecretItemType)
+	// ShowOfflineMode marks the shown data as read-only copy saved at savedAt.
+	ShowOfflineMode(savedAt time.Time)
+	// ShowPendingChanges shows the number of the changes made offline and not sent to the server yet.
+	ShowPendingChanges(count int)
+	// ShowDeleteConflictView asks whether to delete the item changed by another client after it was deleted offline.
+	ShowDeleteConflictView(itemType domain.SecretItemType, name string)
 
 	CreateProgressBar(title string, percentage float64, progressText string, cancelFn func())
 	CloseProgerssBar()
@@ -96,18 +179,35 @@ type AppServer interface {
 	PassLoginOTP(ctx context.Context, otpPass string) error
 	GetHelloData(ctx context.Context) (*domain.HelloData, error)
 
-	GetBankCardList(ctx context.Context) ([]domain.EncryptedBankCard, error)
-	CreateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error
-	UpdateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error
-	DeleteBankCard(ctx context.Context, number string) error
-
-	GetUserPasswordDataList(ctx context.Context) ([]domain.EncryptedUserPasswordData, error)
-	CreateUserPasswordData(ctx context.Context, data *domain.EncryptedUserPasswordData) error
-	UpdateUserPasswordData(ctx context.Context, data *domain.EncryptedUserPasswordData) error
-	DeleteUserPasswordData(ctx context.Context, hint string) error
+	ListItems(ctx context.Context, itemType domain.SecretItemType) ([]domain.EncryptedSecretItem, error)
+	GetItem(ctx context.Context, itemID string) (*domain.EncryptedSecretItem, error)
+	CreateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	UpdateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	DeleteItem(ctx context.Context, itemID string, revision int64) error
+	GetChangesSince(ctx context.Context, seq int64) (*domain.SecretItemChanges, error)
+	// Watch blocks until ctx is done or the stream is broken; onEvent is invoked for every received event.
+	Watch(ctx context.Context, seq int64, onEvent func(event *domain.ItemChangeEvent)) error
+
+	ListRevisions(ctx context.Context, itemID string) ([]domain.SecretItemHistoryEntry, error)
+	GetRevision(ctx context.Context, itemID string, revision int64) (*domain.EncryptedSecretItem, error)
+	RestoreRevision(ctx context.Context, itemID string, revision int64, expected int64) (*domain.SecretItemRevision, error)
+
+	// secure notes are stored by the server apart from the other items; a note is passed as the item of type secure_note
+	ListSecureNotes(ctx context.Context) ([]domain.EncryptedSecretItem, error)
+	CreateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	UpdateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	DeleteSecureNote(ctx context.Context, noteID string, revision int64) error
 
 	GetFileInfoList(ctx context.Context) ([]domain.FileInfo, error)
 	DeleteFileInfo(ctx context.Context, name string) error
 	CreateFileSender(ctx context.Context) (domain.StreamFileWriter, error)
 	CreateFileReceiver(ctx context.Context, name string) (domain.StreamFileReader, error)
+
+	GetSessionList(ctx context.Context) ([]domain.SessionInfo, error)
+	TerminateSession(ctx context.Context, sessionID string) error
+
+	ListTrash(ctx context.Context) ([]domain.TrashEntry, error)
+	RestoreFromTrash(ctx context.Context, itemType domain.SecretItemType, id string) error
+	// PurgeTrash deletes the entry permanently; the whole trash is purged for the empty id.
+	PurgeTrash(ctx context.Context, itemType domain.SecretItemType, id string) error
 }
@@ -3,7 +3,11 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 )
@@ -27,10 +31,20 @@ func (dcc *dataAccessor) AppStorage(appStorage AppStorage) *dataAccessor {
 	return dcc
 }
 
+func (dcc *dataAccessor) VaultKeeper(keeper *vaultKeeper) *dataAccessor {
+	dcc.keeper = keeper
+	return dcc
+}
+
 type dataAccessor struct {
 	appServer  AppServer
 	helper     DomainHelper
 	appStorage AppStorage
+	keeper     *vaultKeeper
+	syncMx     sync.Mutex
+	replayMx   sync.Mutex
+	conflict   *domain.PendingChange // the rejected change shown to the user, guarded by replayMx
+	offline    atomic.Bool           // the data is unlocked from the local vault, changes are journaled
 }
 
 func (dcc *dataAccessor) GetBankCardList(ctx context.Context) error {
@@ -38,37 +52,12 @@ func (dcc *dataAccessor) GetBankCardList(ctx context.Context) error {
 	action := domain.GetAction(1)
 	log.Debugf("%v start", action)
 
-	masterPass := dcc.appStorage.GetMasterPassword()
-
-	encrList, err := dcc.appServer.GetBankCardList(ctx)
-	if err != nil {
+	if err := dcc.syncChanges(ctx); err != nil {
 		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
 
-	var decryptedList []domain.BankCard
-	for _, encr := range encrList {
-		content := encr.Content
-
-		encrypted, err := dcc.helper.DecryptShortData(masterPass, content)
-		if err != nil {
-			err := fmt.Errorf("%w - %v error - %v - can't encrypt card with number %v", domain.ErrClientDataIncorrect, action, err.Error(), encr.Number)
-			log.Warn(err.Error())
-			return err
-		}
-
-		var bankCard domain.BankCard
-		if err := json.Unmarshal([]byte(encrypted), &bankCard); err != nil {
-			err := fmt.Errorf("%w - %v error - %v - can't decode card data with number %v", domain.ErrClientDataIncorrect, action, err.Error(), encr.Number)
-			log.Warn(err.Error())
-			return err
-		}
-		decryptedList = append(decryptedList, bankCard)
-	}
-
-	dcc.appStorage.SetBankCards(decryptedList)
-
 	log.Debugf("%v success", action)
 	return nil
 }
@@ -84,27 +73,26 @@ func (dcc *dataAccessor) AddBankCard(ctx context.Context, bankCard *domain.BankC
 		return err
 	}
 
-	res, err := json.Marshal(bankCard)
+	item, err := dcc.encryptItem(domain.SecretItemBankCard, bankCard.Number, bankCard)
 	if err != nil {
-		err := fmt.Errorf("%w - %v error - can't marshal data %v", domain.ErrClientInternal, action, err.Error())
-		log.Warn(err.Error())
-		return err
-	}
-	masterPass := dcc.appStorage.GetMasterPassword()
-
-	content, err := dcc.helper.EncryptShortData(masterPass, string(res))
-
-	if err != nil {
-		err := fmt.Errorf("%w - %v error - can't encrypt data %v", domain.ErrClientInternal, action, err.Error())
+		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
 
-	err = dcc.appServer.CreateBankCard(ctx, &domain.EncryptedBankCard{
-		Number:  bankCard.Number,
-		Content: content,
-	})
-
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemCreated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.CreateItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			bankCard.ID = rev.ID
+			bankCard.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.AddBankCard(bankCard)
+		})
 	if err != nil {
 		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
@@ -126,27 +114,64 @@ func (dcc *dataAccessor) UpdateBankCard(ctx context.Context, bankCard *domain.Ba
 		return err
 	}
 
-	res, err := json.Marshal(bankCard)
+	if bankCard.ID == "" {
+		stored, err := dcc.appStorage.GetBankCard(bankCard.Number)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		bankCard.ID = stored.ID
+		bankCard.Revision = stored.Revision
+	}
+
+	item, err := dcc.encryptItem(domain.SecretItemBankCard, bankCard.Number, bankCard)
 	if err != nil {
-		err := fmt.Errorf("%w - %v error - can't marshal data %v", domain.ErrClientInternal, action, err.Error())
+		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
-	masterPass := dcc.appStorage.GetMasterPassword()
-
-	content, err := dcc.helper.EncryptShortData(masterPass, string(res))
+	item.ID = bankCard.ID
+	item.Revision = bankCard.Revision
 
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemUpdated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.UpdateItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			bankCard.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.UpdateBankCard(bankCard)
+		})
 	if err != nil {
-		err := fmt.Errorf("%w - %v error - can't encrypt data %v", domain.ErrClientInternal, action, err.Error())
+		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
 
-	err = dcc.appServer.UpdateBankCard(ctx, &domain.EncryptedBankCard{
-		Number:  bankCard.Number,
-		Content: content,
-	})
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) DeleteBankCard(ctx context.Context, number string) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
 
+	stored, err := dcc.appStorage.GetBankCard(number)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	err = dcc.sendOrJournalDelete(ctx, domain.SecretItemBankCard, number, stored, stored.ID, stored.Revision,
+		func() error {
+			return dcc.appStorage.DeleteBankCard(number)
+		})
 	if err != nil {
 		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
@@ -157,12 +182,12 @@ func (dcc *dataAccessor) UpdateBankCard(ctx context.Context, bankCard *domain.Ba
 	return nil
 }
 
-func (dcc *dataAccessor) DeleteBankCard(ctx context.Context, number string) error {
+func (dcc *dataAccessor) GetUserPasswordDataList(ctx context.Context) error {
 	log := GetMainLogger()
 	action := domain.GetAction(1)
 	log.Debugf("%v start", action)
 
-	if err := dcc.appServer.DeleteBankCard(ctx, number); err != nil {
+	if err := dcc.syncChanges(ctx); err != nil {
 		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
@@ -172,141 +197,831 @@ func (dcc *dataAccessor) DeleteBankCard(ctx context.Context, number string) erro
 	return nil
 }
 
-func (dcc *dataAccessor) GetUserPasswordDataList(ctx context.Context) error {
+func (dcc *dataAccessor) AddUserPasswordData(ctx context.Context, data *domain.UserPasswordData) error {
 	log := GetMainLogger()
 	action := domain.GetAction(1)
 	log.Debugf("%v start", action)
 
-	masterPass := dcc.appStorage.GetMasterPassword()
+	if err := dcc.helper.CheckUserPasswordData(data); err != nil {
+		err := fmt.Errorf("%w - %v error - wrong userPassword data %v", domain.ErrClientDataIncorrect, action, err.Error())
+		log.Warn(err.Error())
+		return err
+	}
 
-	encrList, err := dcc.appServer.GetUserPasswordDataList(ctx)
+	item, err := dcc.encryptItem(domain.SecretItemUserPassword, data.Hint, data)
 	if err != nil {
 		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
 
-	var decryptedList []domain.UserPasswordData
-	for _, encr := range encrList {
-		content := encr.Content
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemCreated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.CreateItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			data.ID = rev.ID
+			data.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.AddUserPasswordData(data)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
 
-		encrypted, err := dcc.helper.DecryptShortData(masterPass, content)
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) UpdateUserPasswordData(ctx context.Context, data *domain.UserPasswordData) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.helper.CheckUserPasswordData(data); err != nil {
+		err := fmt.Errorf("%w - %v error - wrong userPassword data %v", domain.ErrClientDataIncorrect, action, err.Error())
+		log.Warn(err.Error())
+		return err
+	}
+
+	if data.ID == "" {
+		stored, err := dcc.appStorage.GetUserPasswordData(data.Hint)
 		if err != nil {
-			err := fmt.Errorf("%w - %v error - %s - can't encrypt userPassData with hint %v", domain.ErrClientDataIncorrect, action, err.Error(), encr.Hint)
+			err := fmt.Errorf("%w - %v error", err, action)
 			log.Warn(err.Error())
 			return err
 		}
+		data.ID = stored.ID
+		data.Revision = stored.Revision
+	}
 
-		var uPassData domain.UserPasswordData
-		if err := json.Unmarshal([]byte(encrypted), &uPassData); err != nil {
-			err := fmt.Errorf("%w - %v error - %s - can't decode userPassData with hint %v", domain.ErrClientDataIncorrect, action, err.Error(), encr.Hint)
-			log.Warn(err.Error())
-			return err
-		}
-		decryptedList = append(decryptedList, uPassData)
+	item, err := dcc.encryptItem(domain.SecretItemUserPassword, data.Hint, data)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
 	}
+	item.ID = data.ID
+	item.Revision = data.Revision
 
-	dcc.appStorage.SetUserPasswordDatas(decryptedList)
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemUpdated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.UpdateItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			data.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.UpdateUserPasswordData(data)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
 
 	log.Debugf("%v success", action)
 	return nil
 }
 
-func (dcc *dataAccessor) AddUserPasswordData(ctx context.Context, data *domain.UserPasswordData) error {
+func (dcc *dataAccessor) DeleteUserPasswordData(ctx context.Context, hint string) error {
 	log := GetMainLogger()
 	action := domain.GetAction(1)
 	log.Debugf("%v start", action)
 
-	if err := dcc.helper.CheckUserPasswordData(data); err != nil {
-		err := fmt.Errorf("%w - %v error - wrong userPassword data %v", domain.ErrClientDataIncorrect, action, err.Error())
+	stored, err := dcc.appStorage.GetUserPasswordData(hint)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
 
-	res, err := json.Marshal(data)
+	err = dcc.sendOrJournalDelete(ctx, domain.SecretItemUserPassword, hint, stored, stored.ID, stored.Revision,
+		func() error {
+			return dcc.appStorage.DeleteUserPasswordData(hint)
+		})
 	if err != nil {
-		err := fmt.Errorf("%w - %v error - can't marshal data %v", domain.ErrClientInternal, action, err.Error())
+		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
-	masterPass := dcc.appStorage.GetMasterPassword()
 
-	content, err := dcc.helper.EncryptShortData(masterPass, string(res))
+	log.Debugf("%v success", action)
+	return nil
+}
 
-	if err != nil {
-		err := fmt.Errorf("%w - %v error - can't encrypt data %v", domain.ErrClientInternal, action, err.Error())
+// ResolveBankCardConflict applies the user choice after the card update returned [domain.ErrDataConflict]:
+// ConflictReload drops local changes (the caller reloads the list), ConflictOverwrite stores local changes
+// over the current revision or recreates the card deleted by another client.
+// ConflictKeepBoth is not supported - card number is the unique key.
+func (dcc *dataAccessor) ResolveBankCardConflict(ctx context.Context, bankCard *domain.BankCard, resolution domain.ConflictResolution) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	switch resolution {
+	case domain.ConflictReload:
+		return nil
+	case domain.ConflictOverwrite:
+	default:
+		err := fmt.Errorf("%w - %v error - card can't be duplicated", domain.ErrClientDataIncorrect, action)
 		log.Warn(err.Error())
 		return err
 	}
 
-	err = dcc.appServer.CreateUserPasswordData(ctx, &domain.EncryptedUserPasswordData{
-		Hint:    data.Hint,
-		Content: content,
-	})
+	if bankCard.ID == "" {
+		stored, err := dcc.appStorage.GetBankCard(bankCard.Number)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		bankCard.ID = stored.ID
+	}
 
+	revision, err := dcc.storedRevision(ctx, bankCard.ID)
 	if err != nil {
 		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
 
-	log.Debugf("%v success", action)
-	return nil
+	if revision == 0 {
+		bankCard.ID = ""
+		return dcc.AddBankCard(ctx, bankCard)
+	}
+	bankCard.Revision = revision
+	return dcc.UpdateBankCard(ctx, bankCard)
 }
 
-func (dcc *dataAccessor) UpdateUserPasswordData(ctx context.Context, data *domain.UserPasswordData) error {
+// ResolveUserPasswordDataConflict applies the user choice after the update returned [domain.ErrDataConflict]:
+// ConflictReload drops local changes (the caller reloads the list), ConflictOverwrite stores local changes
+// over the current revision or recreates the data deleted by another client,
+// ConflictKeepBoth stores local changes as a new record with the changed hint.
+func (dcc *dataAccessor) ResolveUserPasswordDataConflict(ctx context.Context, data *domain.UserPasswordData, resolution domain.ConflictResolution) error {
 	log := GetMainLogger()
 	action := domain.GetAction(1)
 	log.Debugf("%v start", action)
 
-	if err := dcc.helper.CheckUserPasswordData(data); err != nil {
-		err := fmt.Errorf("%w - %v error - wrong userPassword data %v", domain.ErrClientDataIncorrect, action, err.Error())
+	switch resolution {
+	case domain.ConflictReload:
+		return nil
+	case domain.ConflictKeepBoth:
+		data.ID = ""
+		data.Revision = 0
+		data.Hint = fmt.Sprintf("%s (conflict %s)", data.Hint, time.Now().Format(time.DateTime))
+		return dcc.AddUserPasswordData(ctx, data)
+	case domain.ConflictOverwrite:
+	default:
+		err := fmt.Errorf("%w - %v error - unknown resolution %v", domain.ErrClientDataIncorrect, action, resolution)
 		log.Warn(err.Error())
 		return err
 	}
 
-	res, err := json.Marshal(data)
+	if data.ID == "" {
+		stored, err := dcc.appStorage.GetUserPasswordData(data.Hint)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		data.ID = stored.ID
+	}
+
+	revision, err := dcc.storedRevision(ctx, data.ID)
 	if err != nil {
-		err := fmt.Errorf("%w - %v error - can't marshal data %v", domain.ErrClientInternal, action, err.Error())
+		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
-	masterPass := dcc.appStorage.GetMasterPassword()
 
-	content, err := dcc.helper.EncryptShortData(masterPass, string(res))
+	if revision == 0 {
+		data.ID = ""
+		return dcc.AddUserPasswordData(ctx, data)
+	}
+	data.Revision = revision
+	return dcc.UpdateUserPasswordData(ctx, data)
+}
 
+// storedRevision returns the current revision of the item; 0 is returned if the item was deleted.
+func (dcc *dataAccessor) storedRevision(ctx context.Context, itemID string) (int64, error) {
+	item, err := dcc.appServer.GetItem(ctx, itemID)
 	if err != nil {
-		err := fmt.Errorf("%w - %v error - can't encrypt data %v", domain.ErrClientInternal, action, err.Error())
-		log.Warn(err.Error())
-		return err
+		if errors.Is(err, domain.ErrDataNotExists) {
+			return 0, nil
+		}
+		return 0, err
 	}
+	return item.Revision, nil
+}
+
+// MigrateItems re-uploads items stored without lookup key: records created by the old clients have the card number
+// or the hint in clear text; the server wiped them, the values are restored from the encrypted content.
+func (dcc *dataAccessor) MigrateItems(ctx context.Context) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
 
-	err = dcc.appServer.UpdateUserPasswordData(ctx, &domain.EncryptedUserPasswordData{
-		Hint:    data.Hint,
-		Content: content,
-	})
+	masterPass := dcc.appStorage.GetMasterPassword()
 
+	items, err := dcc.appServer.ListItems(ctx, "")
 	if err != nil {
 		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
 		return err
 	}
 
+	migrated := 0
+	for _, item := range items {
+		if item.LookupKey != "" {
+			continue
+		}
+
+		var name string
+		var data any
+		switch item.Type {
+		case domain.SecretItemBankCard:
+			var bankCard domain.BankCard
+			if err := dcc.decryptItemContent(masterPass, &item, &bankCard); err != nil {
+				err := fmt.Errorf("%w - %v error - can't decrypt card", err, action)
+				log.Warn(err.Error())
+				return err
+			}
+			name, data = bankCard.Number, &bankCard
+		case domain.SecretItemUserPassword:
+			var uPassData domain.UserPasswordData
+			if err := dcc.decryptItemContent(masterPass, &item, &uPassData); err != nil {
+				err := fmt.Errorf("%w - %v error - can't decrypt userPassData", err, action)
+				log.Warn(err.Error())
+				return err
+			}
+			name, data = uPassData.Hint, &uPassData
+		default:
+			log.Warnf("%v - item %v of unknown type %v is skipped", action, item.ID, item.Type)
+			continue
+		}
+
+		encrypted, err := dcc.encryptItem(item.Type, name, data)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		encrypted.ID = item.ID
+		encrypted.Revision = item.Revision
+
+		if _, err := dcc.appServer.UpdateItem(ctx, encrypted); err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		migrated++
+	}
+
+	log.Debugf("%v success, %d items migrated", action, migrated)
+	return nil
+}
+
+// WatchChanges listens to the item change events of the user until ctx is done. Changes made by other clients
+// are loaded into the storage and onChange is invoked with the changed item type ("" - any type).
+// The stream is reopened from the last applied sequence number after retryInterval if it is broken.
+func (dcc *dataAccessor) WatchChanges(ctx context.Context, retryInterval time.Duration, onChange func(itemType domain.SecretItemType)) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	for {
+		err := dcc.appServer.Watch(ctx, dcc.appStorage.GetChangeSeq(), func(event *domain.ItemChangeEvent) {
+			if event.Seq <= dcc.appStorage.GetChangeSeq() {
+				// the change is already loaded, e.g. made by this client
+				return
+			}
+			if err := dcc.syncChanges(ctx); err != nil {
+				log.Warnf("%v - can't load changes %v", action, err.Error())
+				return
+			}
+			onChange(event.Type)
+		})
+
+		if ctx.Err() != nil {
+			log.Debugf("%v finished", action)
+			return
+		}
+		if err != nil {
+			log.Warnf("%v - watch err %v", action, err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Debugf("%v finished", action)
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// syncChanges loads the items changed since the last known change sequence number and applies them to the local storage;
+// all the items are loaded on the first call or if the server doesn't know the sequence number (reset).
+func (dcc *dataAccessor) syncChanges(ctx context.Context) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+
+	// the list loading and the watch events must not apply the same changes concurrently
+	dcc.syncMx.Lock()
+	defer dcc.syncMx.Unlock()
+
+	masterPass := dcc.appStorage.GetMasterPassword()
+	seq := dcc.appStorage.GetChangeSeq()
+
+	changes, err := dcc.appServer.GetChangesSince(ctx, seq)
+	if err != nil {
+		return err
+	}
+
+	items, err := dcc.decryptItems(masterPass, changes.Items)
+	if err != nil {
+		return err
+	}
+
+	if seq == 0 || changes.Reset {
+		dcc.setItems(items)
+	} else {
+		deleted := make(map[domain.SecretItemType][]string)
+		for _, item := range changes.Deleted {
+			deleted[item.Type] = append(deleted[item.Type], item.ID)
+		}
+		dcc.applyItemChanges(items, deleted)
+	}
+	dcc.appStorage.SetChangeSeq(changes.Seq)
+
+	if err := dcc.applyPending(masterPass); err != nil {
+		return err
+	}
+
+	dcc.keeper.ApplyChanges(changes, seq == 0 || changes.Reset)
+	if err := dcc.keeper.Save(masterPass); err != nil {
+		// the data is loaded; only the offline access is affected
+		log.Warnf("%v - can't save local vault: %v", action, err.Error())
+	}
+
+	log.Debugf("%v - seq %v -> %v, reset %v, %d changed, %d deleted",
+		action, seq, changes.Seq, changes.Reset, len(changes.Items), len(changes.Deleted))
+	return nil
+}
+
+// sendOrJournal sends the change to the server; if the server is not available the change is applied
+// to the local storage with apply and journaled to be sent on reconnect by [dataAccessor.ReplayPending].
+func (dcc *dataAccessor) sendOrJournal(ctx context.Context, change *domain.PendingChange,
+	send func(ctx context.Context) error, apply func() error) error {
+	return dcc.sendOrJournalFn(ctx, send, func() (*domain.PendingChange, error) {
+		return change, nil
+	}, apply)
+}
+
+// sendOrJournalDelete deletes the item on the server or journals the delete; the stored data is journaled
+// to be shown to the user if the item was changed by another client before the delete was replayed.
+func (dcc *dataAccessor) sendOrJournalDelete(ctx context.Context, itemType domain.SecretItemType, name string,
+	data any, itemID string, revision int64, apply func() error) error {
+	return dcc.sendOrJournalFn(ctx,
+		func(ctx context.Context) error {
+			return dcc.deleteOnServer(ctx, itemType, itemID, revision)
+		},
+		func() (*domain.PendingChange, error) {
+			item, err := dcc.encryptItem(itemType, name, data)
+			if err != nil {
+				return nil, err
+			}
+			item.ID = itemID
+			item.Revision = revision
+			return &domain.PendingChange{Kind: domain.ItemDeleted, Item: *item}, nil
+		}, apply)
+}
+
+// deleteOnServer deletes the item on the server; secure notes are deleted by their own operation.
+func (dcc *dataAccessor) deleteOnServer(ctx context.Context, itemType domain.SecretItemType, itemID string, revision int64) error {
+	if itemType == domain.SecretItemSecureNote {
+		return dcc.appServer.DeleteSecureNote(ctx, itemID, revision)
+	}
+	return dcc.appServer.DeleteItem(ctx, itemID, revision)
+}
+
+func (dcc *dataAccessor) sendOrJournalFn(ctx context.Context, send func(ctx context.Context) error,
+	newChange func() (*domain.PendingChange, error), apply func() error) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+
+	if !dcc.offline.Load() {
+		err := send(ctx)
+		if err == nil || dcc.keeper == nil || !errors.Is(err, domain.ErrServerIsNotResponding) {
+			return err
+		}
+	}
+
+	change, err := newChange()
+	if err != nil {
+		return err
+	}
+
+	if err := apply(); err != nil {
+		return err
+	}
+
+	dcc.keeper.AddPending(change)
+	if err := dcc.keeper.Save(dcc.appStorage.GetMasterPassword()); err != nil {
+		log.Warnf("%v - can't save local vault: %v", action, err.Error())
+	}
+	log.Debugf("%v - %v change of %v item is journaled", action, change.Kind, change.Item.Type)
+	return nil
+}
+
+// PendingCount returns the number of the journaled changes.
+func (dcc *dataAccessor) PendingCount() int {
+	return len(dcc.keeper.Pending())
+}
+
+// ReplayPending sends the changes journaled while the server was not available in the order they were made.
+// A change rejected because the item was changed by another client or because the server will never accept it
+// is marked as a conflict and kept in the journal until the user resolves it, see [dataAccessor.ResolvePendingConflict];
+// the other changes are still sent. Returns the first conflict with the stored item loaded to be shown to the user.
+// Nothing is sent in offline mode - the changes are replayed after the next login.
+func (dcc *dataAccessor) ReplayPending(ctx context.Context) (*domain.PendingConflict, error) {
+	if dcc.keeper == nil || dcc.offline.Load() {
+		return nil, nil
+	}
+
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	dcc.replayMx.Lock()
+	defer dcc.replayMx.Unlock()
+
+	masterPass := dcc.appStorage.GetMasterPassword()
+	var conflicts []domain.PendingChange
+	for _, change := range dcc.keeper.Pending() {
+		if change.Conflict {
+			conflicts = append(conflicts, change)
+			continue
+		}
+
+		err := dcc.sendPending(ctx, &change)
+		switch {
+		case err == nil:
+			dcc.keeper.RemovePending(&change)
+		case isPendingConflict(change.Kind, err) || isPendingRejected(err):
+			log.Infof("%v - %v change of item %v is rejected: %v", action, change.Kind, change.Item.ID, err.Error())
+			dcc.keeper.MarkPendingConflict(&change)
+			change.Conflict = true
+			conflicts = append(conflicts, change)
+		default:
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return nil, err
+		}
+
+		if err := dcc.keeper.Save(masterPass); err != nil {
+			log.Warnf("%v - can't save local vault: %v", action, err.Error())
+		}
+	}
+
+	for _, change := range conflicts {
+		conflict, err := dcc.pendingConflict(ctx, masterPass, &change)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return nil, err
+		}
+		if conflict != nil {
+			dcc.conflict = &change
+			return conflict, nil
+		}
+
+		// nothing to show to the user
+		dcc.keeper.RemovePending(&change)
+		if err := dcc.keeper.Save(masterPass); err != nil {
+			log.Warnf("%v - can't save local vault: %v", action, err.Error())
+		}
+	}
+
 	log.Debugf("%v success", action)
+	return nil, nil
+}
+
+// ResolvePendingConflict removes the rejected change returned by [dataAccessor.ReplayPending] from the journal;
+// invoked after the user resolved the conflict.
+func (dcc *dataAccessor) ResolvePendingConflict() {
+	dcc.replayMx.Lock()
+	defer dcc.replayMx.Unlock()
+
+	if dcc.conflict == nil {
+		return
+	}
+
+	dcc.keeper.RemovePending(dcc.conflict)
+	dcc.conflict = nil
+	if err := dcc.keeper.Save(dcc.appStorage.GetMasterPassword()); err != nil {
+		GetMainLogger().Warnf("%v - can't save local vault: %v", domain.GetAction(1), err.Error())
+	}
+}
+
+func (dcc *dataAccessor) sendPending(ctx context.Context, change *domain.PendingChange) error {
+	switch change.Kind {
+	case domain.ItemCreated:
+		var err error
+		if change.Item.Type == domain.SecretItemSecureNote {
+			_, err = dcc.appServer.CreateSecureNote(ctx, &change.Item)
+		} else {
+			_, err = dcc.appServer.CreateItem(ctx, &change.Item)
+		}
+		return err
+	case domain.ItemUpdated:
+		var err error
+		if change.Item.Type == domain.SecretItemSecureNote {
+			_, err = dcc.appServer.UpdateSecureNote(ctx, &change.Item)
+		} else {
+			_, err = dcc.appServer.UpdateItem(ctx, &change.Item)
+		}
+		return err
+	case domain.ItemDeleted:
+		err := dcc.deleteOnServer(ctx, change.Item.Type, change.Item.ID, change.Item.Revision)
+		if errors.Is(err, domain.ErrDataNotExists) {
+			return nil // deleted by another client
+		}
+		return err
+	default:
+		return fmt.Errorf("%w - unknown change kind %v", domain.ErrClientInternal, change.Kind)
+	}
+}
+
+// isPendingConflict checks if the change was rejected because of the changes of another client:
+// the item with the same name was created or the item was updated or deleted.
+func isPendingConflict(kind domain.ItemChangeKind, err error) bool {
+	if kind == domain.ItemCreated {
+		return errors.Is(err, domain.ErrClientDataIncorrect)
+	}
+	return errors.Is(err, domain.ErrDataConflict) || errors.Is(err, domain.ErrDataNotExists)
+}
+
+// isPendingRejected checks if the change is rejected by the server for a reason that doesn't go away on retry.
+func isPendingRejected(err error) bool {
+	return errors.Is(err, domain.ErrClientDataIncorrect) ||
+		errors.Is(err, domain.ErrDeprecatedOperation) ||
+		errors.Is(err, domain.ErrClientInternal)
+}
+
+// pendingConflict loads the stored items and decodes the rejected change. The rejected version is kept
+// in the local storage if the stored item was deleted, so the user can recreate it.
+// The rejected file metadata change is not shown to the user - nil conflict is returned.
+func (dcc *dataAccessor) pendingConflict(ctx context.Context, masterPass string, change *domain.PendingChange) (*domain.PendingConflict, error) {
+	if err := dcc.syncChanges(ctx); err != nil {
+		return nil, err
+	}
+
+	items, err := dcc.decryptItems(masterPass, []domain.EncryptedSecretItem{change.Item})
+	if err != nil {
+		return nil, err
+	}
+
+	conflict := &domain.PendingConflict{Kind: change.Kind}
+	switch {
+	case len(items.cards) > 0:
+		conflict.BankCard = &items.cards[0]
+		if _, err := dcc.appStorage.GetBankCard(conflict.BankCard.Number); err != nil && change.Kind != domain.ItemDeleted {
+			_ = dcc.appStorage.AddBankCard(conflict.BankCard)
+		}
+	case len(items.datas) > 0:
+		conflict.UserPasswordData = &items.datas[0]
+		if _, err := dcc.appStorage.GetUserPasswordData(conflict.UserPasswordData.Hint); err != nil && change.Kind != domain.ItemDeleted {
+			_ = dcc.appStorage.AddUserPasswordData(conflict.UserPasswordData)
+		}
+	case len(items.notes) > 0:
+		conflict.SecureNote = &items.notes[0]
+		if _, err := dcc.appStorage.GetSecureNote(conflict.SecureNote.Title); err != nil && change.Kind != domain.ItemDeleted {
+			_ = dcc.appStorage.AddSecureNote(conflict.SecureNote)
+		}
+	case len(items.auths) > 0:
+		conflict.Authenticator = &items.auths[0]
+		if _, err := dcc.appStorage.GetAuthenticator(conflict.Authenticator.Name); err != nil && change.Kind != domain.ItemDeleted {
+			_ = dcc.appStorage.AddAuthenticator(conflict.Authenticator)
+		}
+	case len(items.keys) > 0:
+		conflict.SSHKey = &items.keys[0]
+		if _, err := dcc.appStorage.GetSSHKey(conflict.SSHKey.Name); err != nil && change.Kind != domain.ItemDeleted {
+			_ = dcc.appStorage.AddSSHKey(conflict.SSHKey)
+		}
+	case len(items.metas) > 0:
+		// the stored file metadata is kept, the rejected change is dropped
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%w - item %v of unknown type %v", domain.ErrClientDataIncorrect, change.Item.ID, change.Item.Type)
+	}
+	return conflict, nil
+}
+
+// applyPending shows the journaled changes over the items loaded from the server.
+func (dcc *dataAccessor) applyPending(masterPass string) error {
+	pending := dcc.keeper.Pending()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	var changed []domain.EncryptedSecretItem
+	deleted := make(map[domain.SecretItemType][]string)
+	for _, change := range pending {
+		if change.Conflict {
+			continue // the stored version is shown until the conflict is resolved
+		}
+		if change.Kind != domain.ItemDeleted {
+			changed = append(changed, change.Item)
+			continue
+		}
+		deleted[change.Item.Type] = append(deleted[change.Item.Type], change.Item.ID)
+	}
+	if len(changed) == 0 && len(deleted) == 0 {
+		return nil
+	}
+
+	items, err := dcc.decryptItems(masterPass, changed)
+	if err != nil {
+		return err
+	}
+	dcc.applyItemChanges(items, deleted)
 	return nil
 }
 
-func (dcc *dataAccessor) DeleteUserPasswordData(ctx context.Context, hint string) error {
+// UnlockOffline fills the local storage from the local vault when the server is not available.
+// Returns the time the vault was saved; the hint is returned if the master password is wrong.
+func (dcc *dataAccessor) UnlockOffline(masterPass string) (time.Time, string, error) {
 	log := GetMainLogger()
 	action := domain.GetAction(1)
 	log.Debugf("%v start", action)
 
-	if err := dcc.appServer.DeleteUserPasswordData(ctx, hint); err != nil {
+	data, hint, err := dcc.keeper.Unlock(masterPass)
+	if err != nil {
 		err := fmt.Errorf("%w - %v error", err, action)
 		log.Warn(err.Error())
-		return err
+		return time.Time{}, hint, err
 	}
 
-	log.Debugf("%v success", action)
+	items, err := dcc.decryptItems(masterPass, data.Items)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return time.Time{}, "", err
+	}
+
+	dcc.appStorage.SetMasterPassword(masterPass)
+	dcc.setItems(items)
+	dcc.appStorage.SetFilesInfo(data.Files)
+	dcc.appStorage.SetChangeSeq(data.Seq)
+
+	if err := dcc.applyPending(masterPass); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return time.Time{}, "", err
+	}
+	dcc.offline.Store(true)
+
+	log.Debugf("%v success, vault saved at %v", action, data.SavedAt)
+	return data.SavedAt, "", nil
+}
+
+// decryptedItems are the decrypted items grouped by type.
+type decryptedItems struct {
+	cards []domain.BankCard
+	datas []domain.UserPasswordData
+	metas []domain.FileMetadata
+	notes []domain.SecureNote
+	auths []domain.Authenticator
+	keys  []domain.SSHKey
+}
+
+// decryptItems decodes the items of the known types; the items of unknown types are skipped.
+func (dcc *dataAccessor) decryptItems(masterPass string, items []domain.EncryptedSecretItem) (*decryptedItems, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+
+	res := &decryptedItems{}
+	for _, item := range items {
+		switch item.Type {
+		case domain.SecretItemBankCard:
+			var bankCard domain.BankCard
+			if err := dcc.decryptItemContent(masterPass, &item, &bankCard); err != nil {
+				return nil, fmt.Errorf("%w - can't decrypt card", err)
+			}
+			bankCard.ID = item.ID
+			bankCard.Revision = item.Revision
+			res.cards = append(res.cards, bankCard)
+		case domain.SecretItemUserPassword:
+			var uPassData domain.UserPasswordData
+			if err := dcc.decryptItemContent(masterPass, &item, &uPassData); err != nil {
+				return nil, fmt.Errorf("%w - can't decrypt userPassData", err)
+			}
+			uPassData.ID = item.ID
+			uPassData.Revision = item.Revision
+			res.datas = append(res.datas, uPassData)
+		case domain.SecretItemFileMetadata:
+			var meta domain.FileMetadata
+			if err := dcc.decryptItemContent(masterPass, &item, &meta); err != nil {
+				return nil, fmt.Errorf("%w - can't decrypt file metadata", err)
+			}
+			meta.ID = item.ID
+			meta.Revision = item.Revision
+			res.metas = append(res.metas, meta)
+		case domain.SecretItemSecureNote:
+			var note domain.SecureNote
+			if err := dcc.decryptItemContent(masterPass, &item, &note); err != nil {
+				return nil, fmt.Errorf("%w - can't decrypt secure note", err)
+			}
+			note.ID = item.ID
+			note.Revision = item.Revision
+			res.notes = append(res.notes, note)
+		case domain.SecretItemAuthenticator:
+			var auth domain.Authenticator
+			if err := dcc.decryptItemContent(masterPass, &item, &auth); err != nil {
+				return nil, fmt.Errorf("%w - can't decrypt authenticator", err)
+			}
+			auth.ID = item.ID
+			auth.Revision = item.Revision
+			res.auths = append(res.auths, auth)
+		case domain.SecretItemSSHKey:
+			var key domain.SSHKey
+			if err := dcc.decryptItemContent(masterPass, &item, &key); err != nil {
+				return nil, fmt.Errorf("%w - can't decrypt ssh key", err)
+			}
+			key.ID = item.ID
+			key.Revision = item.Revision
+			res.keys = append(res.keys, key)
+		default:
+			log.Warnf("%v - item %v of unknown type %v is skipped", action, item.ID, item.Type)
+		}
+	}
+	return res, nil
+}
+
+// setItems replaces all the items in the local storage.
+func (dcc *dataAccessor) setItems(items *decryptedItems) {
+	dcc.appStorage.SetBankCards(items.cards)
+	dcc.appStorage.SetUserPasswordDatas(items.datas)
+	dcc.appStorage.SetFileMetadatas(items.metas)
+	dcc.appStorage.SetSecureNotes(items.notes)
+	dcc.appStorage.SetAuthenticators(items.auths)
+	dcc.appStorage.SetSSHKeys(items.keys)
+}
+
+// applyItemChanges replaces the changed items in the local storage and removes the deleted ones.
+func (dcc *dataAccessor) applyItemChanges(items *decryptedItems, deleted map[domain.SecretItemType][]string) {
+	dcc.appStorage.ApplyBankCardChanges(items.cards, deleted[domain.SecretItemBankCard])
+	dcc.appStorage.ApplyUserPasswordDataChanges(items.datas, deleted[domain.SecretItemUserPassword])
+	dcc.appStorage.ApplyFileMetadataChanges(items.metas, deleted[domain.SecretItemFileMetadata])
+	dcc.appStorage.ApplySecureNoteChanges(items.notes, deleted[domain.SecretItemSecureNote])
+	dcc.appStorage.ApplyAuthenticatorChanges(items.auths, deleted[domain.SecretItemAuthenticator])
+	dcc.appStorage.ApplySSHKeyChanges(items.keys, deleted[domain.SecretItemSSHKey])
+}
+
+// encryptItem encrypts item content and display name with the master password;
+// the lookup key is the blind index of the name, so the server can check uniqueness without knowing the name.
+func (dcc *dataAccessor) encryptItem(itemType domain.SecretItemType, name string, data any) (*domain.EncryptedSecretItem, error) {
+	res, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w - can't marshal data %v", domain.ErrClientInternal, err.Error())
+	}
+	masterPass := dcc.appStorage.GetMasterPassword()
+
+	content, err := dcc.helper.EncryptShortData(masterPass, string(res))
+	if err != nil {
+		return nil, fmt.Errorf("%w - can't encrypt data %v", domain.ErrClientInternal, err.Error())
+	}
+
+	encryptedName, err := dcc.helper.EncryptShortData(masterPass, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w - can't encrypt name %v", domain.ErrClientInternal, err.Error())
+	}
+
+	return &domain.EncryptedSecretItem{
+		Type:      itemType,
+		Name:      encryptedName,
+		LookupKey: dcc.helper.BlindIndex(masterPass, itemType, name),
+		Content:   content,
+	}, nil
+}
+
+// decryptItemContent decodes item content; the name is not used because the content contains all the data.
+func (dcc *dataAccessor) decryptItemContent(masterPass string, item *domain.EncryptedSecretItem, data any) error {
+	decrypted, err := dcc.helper.DecryptShortData(masterPass, item.Content)
+	if err != nil {
+		return fmt.Errorf("%w - can't decrypt item %v - %v", domain.ErrClientDataIncorrect, item.ID, err.Error())
+	}
+
+	if err := json.Unmarshal([]byte(decrypted), data); err != nil {
+		return fmt.Errorf("%w - can't decode item %v - %v", domain.ErrClientDataIncorrect, item.ID, err.Error())
+	}
 	return nil
 }
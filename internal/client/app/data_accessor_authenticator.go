@@ -0,0 +1,211 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+func (dcc *dataAccessor) GetAuthenticatorList(ctx context.Context) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.syncChanges(ctx); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+// ReadAuthenticatorQR fills the authenticator uri with the otpauth uri read from the QR code PNG file.
+func (dcc *dataAccessor) ReadAuthenticatorQR(auth *domain.Authenticator, path string) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	uri, err := dcc.helper.ReadOTPAuthQR(path)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+	auth.URI = uri
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) AddAuthenticator(ctx context.Context, auth *domain.Authenticator) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.helper.CheckAuthenticator(auth); err != nil {
+		err := fmt.Errorf("%w - %v error - wrong authenticator", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	item, err := dcc.encryptItem(domain.SecretItemAuthenticator, auth.Name, auth)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemCreated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.CreateItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			auth.ID = rev.ID
+			auth.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.AddAuthenticator(auth)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) UpdateAuthenticator(ctx context.Context, auth *domain.Authenticator) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.helper.CheckAuthenticator(auth); err != nil {
+		err := fmt.Errorf("%w - %v error - wrong authenticator", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if auth.ID == "" {
+		stored, err := dcc.appStorage.GetAuthenticator(auth.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		auth.ID = stored.ID
+		auth.Revision = stored.Revision
+	}
+
+	item, err := dcc.encryptItem(domain.SecretItemAuthenticator, auth.Name, auth)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+	item.ID = auth.ID
+	item.Revision = auth.Revision
+
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemUpdated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.UpdateItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			auth.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.UpdateAuthenticator(auth)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) DeleteAuthenticator(ctx context.Context, name string) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	stored, err := dcc.appStorage.GetAuthenticator(name)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	err = dcc.sendOrJournalDelete(ctx, domain.SecretItemAuthenticator, name, stored, stored.ID, stored.Revision,
+		func() error {
+			return dcc.appStorage.DeleteAuthenticator(name)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+// ResolveAuthenticatorConflict applies the user choice after the update returned [domain.ErrDataConflict]:
+// ConflictReload drops local changes (the caller reloads the list), ConflictOverwrite stores local changes
+// over the current revision or recreates the authenticator deleted by another client,
+// ConflictKeepBoth stores local changes as a new authenticator with the changed name.
+func (dcc *dataAccessor) ResolveAuthenticatorConflict(ctx context.Context, auth *domain.Authenticator, resolution domain.ConflictResolution) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	switch resolution {
+	case domain.ConflictReload:
+		return nil
+	case domain.ConflictKeepBoth:
+		auth.ID = ""
+		auth.Revision = 0
+		auth.Name = fmt.Sprintf("%s (conflict %s)", auth.Name, time.Now().Format(time.DateTime))
+		return dcc.AddAuthenticator(ctx, auth)
+	case domain.ConflictOverwrite:
+	default:
+		err := fmt.Errorf("%w - %v error - unknown resolution %v", domain.ErrClientDataIncorrect, action, resolution)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if auth.ID == "" {
+		stored, err := dcc.appStorage.GetAuthenticator(auth.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		auth.ID = stored.ID
+	}
+
+	revision, err := dcc.storedRevision(ctx, auth.ID)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if revision == 0 {
+		auth.ID = ""
+		return dcc.AddAuthenticator(ctx, auth)
+	}
+	auth.Revision = revision
+	return dcc.UpdateAuthenticator(ctx, auth)
+}
@@ -0,0 +1,148 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOTPAuthURI = "otpauth://totp/Example:alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&issuer=Example"
+
+func TestAddAuthenticator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		auth := &domain.Authenticator{
+			Name: "example",
+			URI:  testOTPAuthURI,
+		}
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().CheckAuthenticator(auth).Return(nil).Times(1)
+
+		masterKey := "masterKey"
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+
+		mockHelper.EXPECT().EncryptShortData(masterKey, auth.Name).Return("encryptedName", nil).Times(1)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemAuthenticator, auth.Name).Return("lookupKey").Times(1)
+		mockHelper.EXPECT().EncryptShortData(masterKey, gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
+			cnt, err := json.Marshal(auth)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(cnt), content)
+			return "encrypted", nil
+		}).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateItem(gomock.Any(), &domain.EncryptedSecretItem{
+			Type:      domain.SecretItemAuthenticator,
+			Name:      "encryptedName",
+			LookupKey: "lookupKey",
+			Content:   "encrypted",
+		}).Return(&domain.SecretItemRevision{ID: "id1", Revision: 1}, nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.AddAuthenticator(context.Background(), auth)
+		require.NoError(t, err)
+		assert.Equal(t, "id1", auth.ID)
+		assert.Equal(t, int64(1), auth.Revision)
+	})
+
+	t.Run("wrong_uri", func(t *testing.T) {
+		auth := &domain.Authenticator{
+			Name: "example",
+			URI:  "https://example.com",
+		}
+
+		da := app.NewDataAccessor().DomainHelper(app.NewHelper(nil))
+		err := da.AddAuthenticator(context.Background(), auth)
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestReadAuthenticatorQR(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().ReadOTPAuthQR("qr.png").Return(testOTPAuthURI, nil).Times(1)
+
+		auth := &domain.Authenticator{Name: "example"}
+		da := app.NewDataAccessor().DomainHelper(mockHelper)
+		require.NoError(t, da.ReadAuthenticatorQR(auth, "qr.png"))
+		assert.Equal(t, testOTPAuthURI, auth.URI)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().ReadOTPAuthQR("qr.png").Return("", domain.ErrClientDataIncorrect).Times(1)
+
+		auth := &domain.Authenticator{Name: "example"}
+		da := app.NewDataAccessor().DomainHelper(mockHelper)
+		require.ErrorIs(t, da.ReadAuthenticatorQR(auth, "qr.png"), domain.ErrClientDataIncorrect)
+		assert.Equal(t, "", auth.URI)
+	})
+}
+
+func TestDeleteAuthenticator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := NewMockAppStorage(ctrl)
+	mockStorage.EXPECT().GetAuthenticator("example").Return(&domain.Authenticator{
+		ID:       "id1",
+		Revision: 2,
+		Name:     "example",
+	}, nil).Times(1)
+
+	mockServer := NewMockAppServer(ctrl)
+	mockServer.EXPECT().DeleteItem(gomock.Any(), "id1", int64(2)).Return(nil).Times(1)
+
+	da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
+	err := da.DeleteAuthenticator(context.Background(), "example")
+	require.NoError(t, err)
+}
+
+func TestGetAuthenticatorList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterKey := "masterKey"
+	mockStorage := NewMockAppStorage(ctrl)
+	mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+	mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+
+	mockServer := NewMockAppServer(ctrl)
+	mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(&domain.SecretItemChanges{
+		Seq: 1,
+		Items: []domain.EncryptedSecretItem{
+			{ID: "id1", Type: domain.SecretItemAuthenticator, Content: "content", Revision: 1},
+		},
+	}, nil).Times(1)
+
+	mockHelper := NewMockDomainHelper(ctrl)
+	mockHelper.EXPECT().DecryptShortData(masterKey, "content").
+		Return(`{"name":"example","uri":"`+testOTPAuthURI+`"}`, nil).Times(1)
+
+	mockStorage.EXPECT().SetBankCards(nil).Times(1)
+	mockStorage.EXPECT().SetUserPasswordDatas(nil).Times(1)
+	mockStorage.EXPECT().SetFileMetadatas(nil).Times(1)
+	mockStorage.EXPECT().SetSecureNotes(nil).Times(1)
+	mockStorage.EXPECT().SetAuthenticators([]domain.Authenticator{
+		{ID: "id1", Revision: 1, Name: "example", URI: testOTPAuthURI},
+	}).Times(1)
+	mockStorage.EXPECT().SetSSHKeys(nil).Times(1)
+	mockStorage.EXPECT().SetChangeSeq(int64(1)).Times(1)
+
+	da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+	err := da.GetAuthenticatorList(context.Background())
+	require.NoError(t, err)
+}
@@ -25,24 +25,31 @@ func TestGetBankCardList(t *testing.T) {
 
 		mockServer := NewMockAppServer(ctrl)
 
-		bankCardList := []domain.EncryptedBankCard{
+		itemList := []domain.EncryptedSecretItem{
 			{
-				Number:  "100",
+				ID:      "id1",
+				Type:    domain.SecretItemBankCard,
+				Name:    "encryptedName",
 				Content: "content",
 			},
 		}
-		mockServer.EXPECT().GetBankCardList(gomock.Any()).Return(bankCardList, nil).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(&domain.SecretItemChanges{
+			Seq:   1,
+			Items: itemList,
+		}, nil).Times(1)
 
 		mockHelper := NewMockDomainHelper(ctrl)
 		mockHelper.EXPECT().DecryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, cnt string) (string, error) {
 			assert.Equal(t, masterKey, key)
-			assert.Equal(t, bankCardList[0].Content, cnt)
+			assert.Equal(t, itemList[0].Content, cnt)
 			return `{"number":"100", "type":"MIR"}`, nil
 		}).Times(1)
 
 		mockStorage.EXPECT().SetBankCards(gomock.Any()).Do(func(crds []domain.BankCard) {
 			require.Equal(t, 1, len(crds))
 			crd := crds[0]
+			assert.Equal(t, "id1", crd.ID)
 			assert.Equal(t, "100", crd.Number)
 			assert.Equal(t, "MIR", crd.Type)
 
@@ -50,12 +57,90 @@ func TestGetBankCardList(t *testing.T) {
 			assert.Equal(t, 0, crd.ExpiryYear)
 			assert.Equal(t, "", crd.CVV)
 		}).Times(1)
+		mockStorage.EXPECT().SetFileMetadatas(nil).Times(1)
+		mockStorage.EXPECT().SetSecureNotes(nil).Times(1)
+		mockStorage.EXPECT().SetAuthenticators(nil).Times(1)
+		mockStorage.EXPECT().SetSSHKeys(nil).Times(1)
+		mockStorage.EXPECT().SetUserPasswordDatas(gomock.Any()).Times(1)
+		mockStorage.EXPECT().SetChangeSeq(int64(1)).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.GetBankCardList(context.Background())
 		require.NoError(t, err)
 	})
 
+	t.Run("delta", func(t *testing.T) {
+
+		masterKey := "masterKey"
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(3)).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(3)).Return(&domain.SecretItemChanges{
+			Seq: 5,
+			Items: []domain.EncryptedSecretItem{
+				{
+					ID:       "id1",
+					Type:     domain.SecretItemBankCard,
+					Content:  "content",
+					Revision: 2,
+				},
+			},
+			Deleted: []domain.DeletedSecretItem{
+				{
+					ID:   "id2",
+					Type: domain.SecretItemBankCard,
+				},
+			},
+		}, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().DecryptShortData(masterKey, "content").Return(`{"number":"101", "type":"MIR"}`, nil).Times(1)
+
+		mockStorage.EXPECT().ApplyBankCardChanges(gomock.Any(), []string{"id2"}).Do(func(changed []domain.BankCard, deletedIDs []string) {
+			require.Equal(t, 1, len(changed))
+			assert.Equal(t, "id1", changed[0].ID)
+			assert.Equal(t, "101", changed[0].Number)
+			assert.Equal(t, int64(2), changed[0].Revision)
+		}).Times(1)
+		mockStorage.EXPECT().ApplyFileMetadataChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplySecureNoteChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyAuthenticatorChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplySSHKeyChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyUserPasswordDataChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().SetChangeSeq(int64(5)).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.GetBankCardList(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(10)).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(10)).Return(&domain.SecretItemChanges{
+			Seq:   2,
+			Reset: true,
+		}, nil).Times(1)
+
+		mockStorage.EXPECT().SetBankCards(nil).Times(1)
+		mockStorage.EXPECT().SetFileMetadatas(nil).Times(1)
+		mockStorage.EXPECT().SetSecureNotes(nil).Times(1)
+		mockStorage.EXPECT().SetAuthenticators(nil).Times(1)
+		mockStorage.EXPECT().SetSSHKeys(nil).Times(1)
+		mockStorage.EXPECT().SetUserPasswordDatas(nil).Times(1)
+		mockStorage.EXPECT().SetChangeSeq(int64(2)).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
+		err := da.GetBankCardList(context.Background())
+		require.NoError(t, err)
+	})
+
 	t.Run("get_bank_card_list_err", func(t *testing.T) {
 
 		masterKey := "masterKey"
@@ -65,7 +150,8 @@ func TestGetBankCardList(t *testing.T) {
 		mockServer := NewMockAppServer(ctrl)
 
 		testErr := errors.New("testErr")
-		mockServer.EXPECT().GetBankCardList(gomock.Any()).Return(nil, testErr).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(nil, testErr).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
 		err := da.GetBankCardList(context.Background())
@@ -80,19 +166,24 @@ func TestGetBankCardList(t *testing.T) {
 
 		mockServer := NewMockAppServer(ctrl)
 
-		bankCardList := []domain.EncryptedBankCard{
+		itemList := []domain.EncryptedSecretItem{
 			{
-				Number:  "100",
+				ID:      "id1",
+				Type:    domain.SecretItemBankCard,
 				Content: "content",
 			},
 		}
-		mockServer.EXPECT().GetBankCardList(gomock.Any()).Return(bankCardList, nil).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(&domain.SecretItemChanges{
+			Seq:   1,
+			Items: itemList,
+		}, nil).Times(1)
 
 		mockHelper := NewMockDomainHelper(ctrl)
 		testErr := errors.New("testErr")
 		mockHelper.EXPECT().DecryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, cnt string) (string, error) {
 			assert.Equal(t, masterKey, key)
-			assert.Equal(t, bankCardList[0].Content, cnt)
+			assert.Equal(t, itemList[0].Content, cnt)
 			return `{"number":"100", "type":"MIR"}`, testErr
 		}).Times(1)
 
@@ -109,19 +200,24 @@ func TestGetBankCardList(t *testing.T) {
 
 		mockServer := NewMockAppServer(ctrl)
 
-		bankCardList := []domain.EncryptedBankCard{
+		itemList := []domain.EncryptedSecretItem{
 			{
-				Number:  "100",
+				ID:      "id1",
+				Type:    domain.SecretItemBankCard,
 				Content: "content",
 			},
 		}
-		mockServer.EXPECT().GetBankCardList(gomock.Any()).Return(bankCardList, nil).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(&domain.SecretItemChanges{
+			Seq:   1,
+			Items: itemList,
+		}, nil).Times(1)
 
 		mockHelper := NewMockDomainHelper(ctrl)
 		mockHelper.EXPECT().DecryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, cnt string) (string, error) {
 			assert.Equal(t, masterKey, key)
-			assert.Equal(t, bankCardList[0].Content, cnt)
-			return `-`, nil
+			assert.Equal(t, itemList[0].Content, cnt)
+			return `{"number":"100", "type":"MIR"`, nil
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
@@ -153,6 +249,9 @@ func TestAddBankCard(t *testing.T) {
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
 
 		encryptedCnt := "encrypted"
+		encryptedName := "encryptedName"
+		mockHelper.EXPECT().EncryptShortData(masterKey, bankCard.Number).Return(encryptedName, nil).Times(1)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemBankCard, bankCard.Number).Return("lookupKey").Times(1)
 		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
 
 			assert.Equal(t, masterKey, key)
@@ -163,16 +262,19 @@ func TestAddBankCard(t *testing.T) {
 		}).Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
-		mockServer.EXPECT().CreateBankCard(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, bnkCard *domain.EncryptedBankCard) error {
-			require.NotNil(t, bnkCard)
-			assert.Equal(t, bankCard.Number, bnkCard.Number)
-			assert.Equal(t, encryptedCnt, bnkCard.Content)
-			return nil
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			require.NotNil(t, item)
+			assert.Equal(t, domain.SecretItemBankCard, item.Type)
+			assert.Equal(t, encryptedName, item.Name)
+			assert.Equal(t, "lookupKey", item.LookupKey)
+			assert.Equal(t, encryptedCnt, item.Content)
+			return &domain.SecretItemRevision{ID: "id1", Revision: 1}, nil
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.AddBankCard(context.Background(), bankCard)
 		require.NoError(t, err)
+		require.Equal(t, "id1", bankCard.ID)
 	})
 
 	t.Run("check_card_err", func(t *testing.T) {
@@ -246,28 +348,17 @@ func TestAddBankCard(t *testing.T) {
 		mockStorage := NewMockAppStorage(ctrl)
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
 
-		encryptedCnt := "encrypted"
-		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
-
-			assert.Equal(t, masterKey, key)
-			cnt, err := json.Marshal(bankCard)
-			require.NoError(t, err)
-			assert.JSONEq(t, string(cnt), content)
-			return encryptedCnt, nil
-		}).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), gomock.Any(), gomock.Any()).Return("lookupKey").Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
 		testErr := errors.New("testErr")
-		mockServer.EXPECT().CreateBankCard(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, bnkCard *domain.EncryptedBankCard) error {
-			require.NotNil(t, bnkCard)
-			assert.Equal(t, bankCard.Number, bnkCard.Number)
-			assert.Equal(t, encryptedCnt, bnkCard.Content)
-			return testErr
-		}).Times(1)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(nil, testErr).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.AddBankCard(context.Background(), bankCard)
 		require.ErrorIs(t, err, testErr)
+		require.Empty(t, bankCard.ID)
 	})
 }
 
@@ -292,8 +383,16 @@ func TestUpdateBankCard(t *testing.T) {
 		masterKey := "masterKey"
 		mockStorage := NewMockAppStorage(ctrl)
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+		mockStorage.EXPECT().GetBankCard(bankCard.Number).Return(&domain.BankCard{
+			ID:       "id1",
+			Revision: 3,
+			Number:   "100",
+		}, nil).Times(1)
 
 		encryptedCnt := "encrypted"
+		encryptedName := "encryptedName"
+		mockHelper.EXPECT().EncryptShortData(masterKey, bankCard.Number).Return(encryptedName, nil).Times(1)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemBankCard, bankCard.Number).Return("lookupKey").Times(1)
 		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
 
 			assert.Equal(t, masterKey, key)
@@ -304,16 +403,20 @@ func TestUpdateBankCard(t *testing.T) {
 		}).Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
-		mockServer.EXPECT().UpdateBankCard(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, bnkCard *domain.EncryptedBankCard) error {
-			require.NotNil(t, bnkCard)
-			assert.Equal(t, bankCard.Number, bnkCard.Number)
-			assert.Equal(t, encryptedCnt, bnkCard.Content)
-			return nil
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			require.NotNil(t, item)
+			assert.Equal(t, "id1", item.ID)
+			assert.Equal(t, int64(3), item.Revision)
+			assert.Equal(t, encryptedName, item.Name)
+			assert.Equal(t, "lookupKey", item.LookupKey)
+			assert.Equal(t, encryptedCnt, item.Content)
+			return &domain.SecretItemRevision{ID: item.ID, Revision: item.Revision + 1}, nil
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.UpdateBankCard(context.Background(), bankCard)
 		require.NoError(t, err)
+		require.Equal(t, int64(4), bankCard.Revision)
 	})
 
 	t.Run("check_card_err", func(t *testing.T) {
@@ -332,15 +435,35 @@ func TestUpdateBankCard(t *testing.T) {
 		}).Times(1)
 
 		da := app.NewDataAccessor().DomainHelper(mockHelper)
-		err := da.AddBankCard(context.Background(), bankCard)
+		err := da.UpdateBankCard(context.Background(), bankCard)
 		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 	})
 
+	t.Run("not_exists_err", func(t *testing.T) {
+
+		mockHelper := NewMockDomainHelper(ctrl)
+
+		bankCard := &domain.BankCard{
+			Number: "100",
+			Type:   "MIR",
+		}
+
+		mockHelper.EXPECT().CheckBankCardData(gomock.Any()).Return(nil).Times(1)
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetBankCard(bankCard.Number).Return(nil, domain.ErrClientInternal).Times(1)
+
+		da := app.NewDataAccessor().AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.UpdateBankCard(context.Background(), bankCard)
+		require.ErrorIs(t, err, domain.ErrClientInternal)
+	})
+
 	t.Run("emcrypt_err", func(t *testing.T) {
 
 		mockHelper := NewMockDomainHelper(ctrl)
 
 		bankCard := &domain.BankCard{
+			ID:     "id1",
 			Number: "100",
 			Type:   "MIR",
 		}
@@ -365,7 +488,7 @@ func TestUpdateBankCard(t *testing.T) {
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppStorage(mockStorage).DomainHelper(mockHelper)
-		err := da.AddBankCard(context.Background(), bankCard)
+		err := da.UpdateBankCard(context.Background(), bankCard)
 		require.ErrorIs(t, err, domain.ErrClientInternal)
 	})
 
@@ -374,6 +497,7 @@ func TestUpdateBankCard(t *testing.T) {
 		mockHelper := NewMockDomainHelper(ctrl)
 
 		bankCard := &domain.BankCard{
+			ID:     "id1",
 			Number: "100",
 			Type:   "MIR",
 		}
@@ -387,23 +511,15 @@ func TestUpdateBankCard(t *testing.T) {
 		mockStorage := NewMockAppStorage(ctrl)
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
 
-		encryptedCnt := "encrypted"
-		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
-
-			assert.Equal(t, masterKey, key)
-			cnt, err := json.Marshal(bankCard)
-			require.NoError(t, err)
-			assert.JSONEq(t, string(cnt), content)
-			return encryptedCnt, nil
-		}).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), gomock.Any(), gomock.Any()).Return("lookupKey").Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
 		testErr := errors.New("testErr")
-		mockServer.EXPECT().UpdateBankCard(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, bnkCard *domain.EncryptedBankCard) error {
-			require.NotNil(t, bnkCard)
-			assert.Equal(t, bankCard.Number, bnkCard.Number)
-			assert.Equal(t, encryptedCnt, bnkCard.Content)
-			return testErr
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			require.NotNil(t, item)
+			assert.Equal(t, bankCard.ID, item.ID)
+			return nil, testErr
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
@@ -420,29 +536,49 @@ func TestDeleteBankCard(t *testing.T) {
 
 		number := "100"
 
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetBankCard(number).Return(&domain.BankCard{
+			ID:       "id1",
+			Revision: 1,
+			Number:   number,
+		}, nil).Times(1)
+
 		mockServer := NewMockAppServer(ctrl)
-		mockServer.EXPECT().DeleteBankCard(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, nmb string) error {
-			assert.Equal(t, number, nmb)
-			return nil
-		}).Times(1)
+		mockServer.EXPECT().DeleteItem(gomock.Any(), "id1", int64(1)).Return(nil).Times(1)
 
-		da := app.NewDataAccessor().AppSever(mockServer)
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
 		err := da.DeleteBankCard(context.Background(), number)
 		require.NoError(t, err)
 	})
 
+	t.Run("not_exists_err", func(t *testing.T) {
+
+		number := "100"
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetBankCard(number).Return(nil, domain.ErrClientInternal).Times(1)
+
+		da := app.NewDataAccessor().AppStorage(mockStorage)
+		err := da.DeleteBankCard(context.Background(), number)
+		require.ErrorIs(t, err, domain.ErrClientInternal)
+	})
+
 	t.Run("server_err", func(t *testing.T) {
 
 		number := "100"
 
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetBankCard(number).Return(&domain.BankCard{
+			ID:       "id1",
+			Revision: 1,
+			Number:   number,
+		}, nil).Times(1)
+
 		mockServer := NewMockAppServer(ctrl)
 		testErr := errors.New("testErr")
-		mockServer.EXPECT().DeleteBankCard(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, nmb string) error {
-			assert.Equal(t, number, nmb)
-			return testErr
-		}).Times(1)
+		mockServer.EXPECT().DeleteItem(gomock.Any(), "id1", int64(1)).Return(testErr).Times(1)
 
-		da := app.NewDataAccessor().AppSever(mockServer)
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
 		err := da.DeleteBankCard(context.Background(), number)
 		require.ErrorIs(t, err, testErr)
 
@@ -0,0 +1,174 @@
+package app_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveBankCardConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newEncryptHelper := func() *MockDomainHelper {
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().CheckBankCardData(gomock.Any()).Return(nil).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), gomock.Any(), gomock.Any()).Return("lookupKey").Times(1)
+		return mockHelper
+	}
+
+	t.Run("update_conflict", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("%w - revision mismatch", domain.ErrDataConflict)).Times(1)
+
+		bankCard := &domain.BankCard{
+			ID:       "id1",
+			Revision: 1,
+			Number:   "100",
+		}
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(newEncryptHelper())
+		err := da.UpdateBankCard(context.Background(), bankCard)
+		require.ErrorIs(t, err, domain.ErrDataConflict)
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		da := app.NewDataAccessor()
+		err := da.ResolveBankCardConflict(context.Background(), &domain.BankCard{Number: "100"}, domain.ConflictReload)
+		require.NoError(t, err)
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetBankCard("100").Return(&domain.BankCard{
+			ID:       "id1",
+			Revision: 1,
+			Number:   "100",
+		}, nil).Times(1)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetItem(gomock.Any(), "id1").Return(&domain.EncryptedSecretItem{
+			ID:       "id1",
+			Revision: 5,
+		}, nil).Times(1)
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			assert.Equal(t, "id1", item.ID)
+			assert.Equal(t, int64(5), item.Revision)
+			return &domain.SecretItemRevision{ID: item.ID, Revision: 6}, nil
+		}).Times(1)
+
+		bankCard := &domain.BankCard{
+			Number: "100",
+		}
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(newEncryptHelper())
+		err := da.ResolveBankCardConflict(context.Background(), bankCard, domain.ConflictOverwrite)
+		require.NoError(t, err)
+		require.Equal(t, int64(6), bankCard.Revision)
+	})
+
+	t.Run("overwrite_deleted", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetItem(gomock.Any(), "id1").Return(nil, domain.ErrDataNotExists).Times(1)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(&domain.SecretItemRevision{
+			ID:       "id2",
+			Revision: 1,
+		}, nil).Times(1)
+
+		bankCard := &domain.BankCard{
+			ID:       "id1",
+			Revision: 1,
+			Number:   "100",
+		}
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(newEncryptHelper())
+		err := da.ResolveBankCardConflict(context.Background(), bankCard, domain.ConflictOverwrite)
+		require.NoError(t, err)
+		require.Equal(t, "id2", bankCard.ID)
+	})
+
+	t.Run("keep_both", func(t *testing.T) {
+		da := app.NewDataAccessor()
+		err := da.ResolveBankCardConflict(context.Background(), &domain.BankCard{Number: "100"}, domain.ConflictKeepBoth)
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestResolveUserPasswordDataConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newEncryptHelper := func() *MockDomainHelper {
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().CheckUserPasswordData(gomock.Any()).Return(nil).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), gomock.Any(), gomock.Any()).Return("lookupKey").Times(1)
+		return mockHelper
+	}
+
+	t.Run("overwrite", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetItem(gomock.Any(), "id1").Return(&domain.EncryptedSecretItem{
+			ID:       "id1",
+			Revision: 3,
+		}, nil).Times(1)
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			assert.Equal(t, "id1", item.ID)
+			assert.Equal(t, int64(3), item.Revision)
+			return &domain.SecretItemRevision{ID: item.ID, Revision: 4}, nil
+		}).Times(1)
+
+		data := &domain.UserPasswordData{
+			ID:       "id1",
+			Revision: 1,
+			Hint:     "Hint",
+		}
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(newEncryptHelper())
+		err := da.ResolveUserPasswordDataConflict(context.Background(), data, domain.ConflictOverwrite)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), data.Revision)
+	})
+
+	t.Run("keep_both", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(&domain.SecretItemRevision{
+			ID:       "id2",
+			Revision: 1,
+		}, nil).Times(1)
+
+		data := &domain.UserPasswordData{
+			ID:       "id1",
+			Revision: 1,
+			Hint:     "Hint",
+		}
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(newEncryptHelper())
+		err := da.ResolveUserPasswordDataConflict(context.Background(), data, domain.ConflictKeepBoth)
+		require.NoError(t, err)
+		require.Equal(t, "id2", data.ID)
+		require.True(t, strings.HasPrefix(data.Hint, "Hint (conflict "))
+	})
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// SaveFileMetadata stores the custom fields and the notes of the file; the metadata item is created
+// on the first save. The metadata is encrypted as any other item, the file content is not changed.
+func (dcc *dataAccessor) SaveFileMetadata(ctx context.Context, meta *domain.FileMetadata) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := domain.CheckCustomFields(meta.Fields); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	item, err := dcc.encryptItem(domain.SecretItemFileMetadata, meta.Name, meta)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+	item.ID = meta.ID
+	item.Revision = meta.Revision
+
+	kind := domain.ItemUpdated
+	if meta.ID == "" {
+		kind = domain.ItemCreated
+	}
+
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: kind, Item: *item},
+		func(ctx context.Context) error {
+			var rev *domain.SecretItemRevision
+			var err error
+			if kind == domain.ItemCreated {
+				rev, err = dcc.appServer.CreateItem(ctx, item)
+			} else {
+				rev, err = dcc.appServer.UpdateItem(ctx, item)
+			}
+			if err != nil {
+				return err
+			}
+			meta.ID = rev.ID
+			meta.Revision = rev.Revision
+			dcc.appStorage.SetFileMetadata(meta)
+			return nil
+		},
+		func() error {
+			dcc.appStorage.SetFileMetadata(meta)
+			return nil
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
@@ -0,0 +1,139 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveFileMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterKey := "masterKey"
+
+	t.Run("create", func(t *testing.T) {
+		meta := &domain.FileMetadata{
+			Name:   "file.txt",
+			Fields: []domain.CustomField{{Name: "pin", Value: "1234", Hidden: true}},
+			Notes:  "notes",
+		}
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).AnyTimes()
+		mockStorage.EXPECT().SetFileMetadata(gomock.Any()).Do(func(stored *domain.FileMetadata) {
+			assert.Equal(t, "id1", stored.ID)
+			assert.Equal(t, int64(1), stored.Revision)
+		}).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().EncryptShortData(masterKey, gomock.Any()).DoAndReturn(func(key string, data string) (string, error) {
+			if data == "file.txt" {
+				return "encryptedName", nil
+			}
+			var content domain.FileMetadata
+			require.NoError(t, json.Unmarshal([]byte(data), &content))
+			assert.Equal(t, "file.txt", content.Name)
+			assert.Equal(t, meta.Fields, content.Fields)
+			assert.Equal(t, "notes", content.Notes)
+			return "encryptedContent", nil
+		}).Times(2)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemFileMetadata, "file.txt").Return("lookupKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateItem(gomock.Any(), &domain.EncryptedSecretItem{
+			Type:      domain.SecretItemFileMetadata,
+			Name:      "encryptedName",
+			LookupKey: "lookupKey",
+			Content:   "encryptedContent",
+		}).Return(&domain.SecretItemRevision{ID: "id1", Revision: 1}, nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.SaveFileMetadata(context.Background(), meta)
+		require.NoError(t, err)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		meta := &domain.FileMetadata{ID: "id1", Revision: 1, Name: "file.txt", Notes: "new notes"}
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).AnyTimes()
+		mockStorage.EXPECT().SetFileMetadata(meta).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().EncryptShortData(masterKey, gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemFileMetadata, "file.txt").Return("lookupKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+				assert.Equal(t, "id1", item.ID)
+				assert.Equal(t, int64(1), item.Revision)
+				return &domain.SecretItemRevision{ID: "id1", Revision: 2}, nil
+			}).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.SaveFileMetadata(context.Background(), meta)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), meta.Revision)
+	})
+
+	t.Run("wrong_fields", func(t *testing.T) {
+		da := app.NewDataAccessor()
+		err := da.SaveFileMetadata(context.Background(), &domain.FileMetadata{
+			Name:   "file.txt",
+			Fields: []domain.CustomField{{Name: "pin"}, {Name: "pin"}},
+		})
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestSyncFileMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterKey := "masterKey"
+	mockStorage := NewMockAppStorage(ctrl)
+	mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+	mockStorage.EXPECT().GetChangeSeq().Return(int64(3)).Times(1)
+
+	mockServer := NewMockAppServer(ctrl)
+	mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(3)).Return(&domain.SecretItemChanges{
+		Seq: 4,
+		Items: []domain.EncryptedSecretItem{
+			{ID: "id1", Type: domain.SecretItemFileMetadata, Content: "content", Revision: 3},
+		},
+		Deleted: []domain.DeletedSecretItem{
+			{ID: "id2", Type: domain.SecretItemFileMetadata},
+		},
+	}, nil).Times(1)
+
+	mockHelper := NewMockDomainHelper(ctrl)
+	mockHelper.EXPECT().DecryptShortData(masterKey, "content").
+		Return(`{"name":"file.txt","fields":[{"name":"pin","value":"1234","hidden":true}],"notes":"notes"}`, nil).Times(1)
+
+	mockStorage.EXPECT().ApplyBankCardChanges(nil, nil).Times(1)
+	mockStorage.EXPECT().ApplyUserPasswordDataChanges(nil, nil).Times(1)
+	mockStorage.EXPECT().ApplyFileMetadataChanges(gomock.Any(), []string{"id2"}).Do(func(changed []domain.FileMetadata, deletedIDs []string) {
+		require.Equal(t, 1, len(changed))
+		assert.Equal(t, "id1", changed[0].ID)
+		assert.Equal(t, int64(3), changed[0].Revision)
+		assert.Equal(t, "file.txt", changed[0].Name)
+		assert.Equal(t, "notes", changed[0].Notes)
+		assert.Equal(t, []domain.CustomField{{Name: "pin", Value: "1234", Hidden: true}}, changed[0].Fields)
+	}).Times(1)
+	mockStorage.EXPECT().ApplySecureNoteChanges(nil, nil).Times(1)
+	mockStorage.EXPECT().ApplyAuthenticatorChanges(nil, nil).Times(1)
+	mockStorage.EXPECT().ApplySSHKeyChanges(nil, nil).Times(1)
+	mockStorage.EXPECT().SetChangeSeq(int64(4)).Times(1)
+
+	da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+	err := da.GetBankCardList(context.Background())
+	require.NoError(t, err)
+}
@@ -0,0 +1,261 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// GetBankCardHistory returns the decrypted previous revisions of the card, the last one first.
+func (dcc *dataAccessor) GetBankCardHistory(ctx context.Context, number string) ([]domain.ItemRevision, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	stored, err := dcc.appStorage.GetBankCard(number)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	revisions, err := dcc.itemHistory(ctx, domain.SecretItemBankCard, stored.ID, number)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	log.Debugf("%v success", action)
+	return revisions, nil
+}
+
+// GetUserPasswordDataHistory returns the decrypted previous revisions of the data, the last one first.
+func (dcc *dataAccessor) GetUserPasswordDataHistory(ctx context.Context, hint string) ([]domain.ItemRevision, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	stored, err := dcc.appStorage.GetUserPasswordData(hint)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	revisions, err := dcc.itemHistory(ctx, domain.SecretItemUserPassword, stored.ID, hint)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	log.Debugf("%v success", action)
+	return revisions, nil
+}
+
+// GetSecureNoteHistory returns the decrypted previous revisions of the note, the last one first.
+func (dcc *dataAccessor) GetSecureNoteHistory(ctx context.Context, title string) ([]domain.ItemRevision, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	stored, err := dcc.appStorage.GetSecureNote(title)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	revisions, err := dcc.itemHistory(ctx, domain.SecretItemSecureNote, stored.ID, title)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	log.Debugf("%v success", action)
+	return revisions, nil
+}
+
+// GetAuthenticatorHistory returns the decrypted previous revisions of the authenticator, the last one first.
+func (dcc *dataAccessor) GetAuthenticatorHistory(ctx context.Context, name string) ([]domain.ItemRevision, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	stored, err := dcc.appStorage.GetAuthenticator(name)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	revisions, err := dcc.itemHistory(ctx, domain.SecretItemAuthenticator, stored.ID, name)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	log.Debugf("%v success", action)
+	return revisions, nil
+}
+
+// GetSSHKeyHistory returns the decrypted previous revisions of the ssh key, the last one first.
+func (dcc *dataAccessor) GetSSHKeyHistory(ctx context.Context, name string) ([]domain.ItemRevision, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	stored, err := dcc.appStorage.GetSSHKey(name)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	revisions, err := dcc.itemHistory(ctx, domain.SecretItemSSHKey, stored.ID, name)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	log.Debugf("%v success", action)
+	return revisions, nil
+}
+
+// RestoreItemRevision replaces the item with the content of the previous revision and loads the restored item;
+// domain.ErrDataConflict is returned if the item was changed by another client after the last load.
+func (dcc *dataAccessor) RestoreItemRevision(ctx context.Context, rev *domain.ItemRevision) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	var current int64
+	switch rev.Type {
+	case domain.SecretItemBankCard:
+		stored, err := dcc.appStorage.GetBankCard(rev.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		current = stored.Revision
+	case domain.SecretItemUserPassword:
+		stored, err := dcc.appStorage.GetUserPasswordData(rev.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		current = stored.Revision
+	case domain.SecretItemSecureNote:
+		stored, err := dcc.appStorage.GetSecureNote(rev.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		current = stored.Revision
+	case domain.SecretItemAuthenticator:
+		stored, err := dcc.appStorage.GetAuthenticator(rev.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		current = stored.Revision
+	case domain.SecretItemSSHKey:
+		stored, err := dcc.appStorage.GetSSHKey(rev.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		current = stored.Revision
+	default:
+		err := fmt.Errorf("%w - %v error - unknown item type %v", domain.ErrClientDataIncorrect, action, rev.Type)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if _, err := dcc.appServer.RestoreRevision(ctx, rev.ItemID, rev.Revision, current); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if err := dcc.syncChanges(ctx); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+// itemHistory loads and decrypts the previous revisions of the item; the content is encrypted by the master password
+// the same way as the current revision, the server never sees it in clear text.
+func (dcc *dataAccessor) itemHistory(ctx context.Context, itemType domain.SecretItemType, itemID string, name string) ([]domain.ItemRevision, error) {
+	entries, err := dcc.appServer.ListRevisions(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	masterPass := dcc.appStorage.GetMasterPassword()
+	revisions := []domain.ItemRevision{}
+	for _, entry := range entries {
+		item, err := dcc.appServer.GetRevision(ctx, itemID, entry.Revision)
+		if err != nil {
+			return nil, err
+		}
+
+		rev := domain.ItemRevision{
+			ItemID:     itemID,
+			Type:       itemType,
+			Name:       name,
+			Revision:   entry.Revision,
+			UpdatedAt:  entry.UpdatedAt,
+			ReplacedAt: entry.ReplacedAt,
+		}
+
+		switch itemType {
+		case domain.SecretItemBankCard:
+			var bankCard domain.BankCard
+			if err := dcc.decryptItemContent(masterPass, item, &bankCard); err != nil {
+				return nil, err
+			}
+			rev.BankCard = &bankCard
+		case domain.SecretItemUserPassword:
+			var data domain.UserPasswordData
+			if err := dcc.decryptItemContent(masterPass, item, &data); err != nil {
+				return nil, err
+			}
+			rev.UserPasswordData = &data
+		case domain.SecretItemSecureNote:
+			var note domain.SecureNote
+			if err := dcc.decryptItemContent(masterPass, item, &note); err != nil {
+				return nil, err
+			}
+			rev.SecureNote = &note
+		case domain.SecretItemAuthenticator:
+			var auth domain.Authenticator
+			if err := dcc.decryptItemContent(masterPass, item, &auth); err != nil {
+				return nil, err
+			}
+			rev.Authenticator = &auth
+		case domain.SecretItemSSHKey:
+			var key domain.SSHKey
+			if err := dcc.decryptItemContent(masterPass, item, &key); err != nil {
+				return nil, err
+			}
+			rev.SSHKey = &key
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, nil
+}
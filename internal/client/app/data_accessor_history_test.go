@@ -0,0 +1,146 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetItemHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updatedAt := time.Now().Add(-time.Hour)
+	replacedAt := time.Now()
+
+	t.Run("bank_card", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetBankCard("1234").Return(&domain.BankCard{ID: "id1", Number: "1234", Revision: 3}, nil).Times(1)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().ListRevisions(gomock.Any(), "id1").Return([]domain.SecretItemHistoryEntry{
+			{ItemID: "id1", Revision: 2, UpdatedAt: updatedAt, ReplacedAt: replacedAt},
+		}, nil).Times(1)
+		mockServer.EXPECT().GetRevision(gomock.Any(), "id1", int64(2)).Return(&domain.EncryptedSecretItem{
+			ID:       "id1",
+			Type:     domain.SecretItemBankCard,
+			Content:  "content2",
+			Revision: 2,
+		}, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().DecryptShortData("masterKey", "content2").
+			Return(`{"number":"1234","exporityMonth":1,"exporityYear":2030,"cvv":"123"}`, nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		revisions, err := da.GetBankCardHistory(context.Background(), "1234")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(revisions))
+
+		rev := revisions[0]
+		require.Equal(t, "id1", rev.ItemID)
+		require.Equal(t, domain.SecretItemBankCard, rev.Type)
+		require.Equal(t, "1234", rev.Name)
+		require.Equal(t, int64(2), rev.Revision)
+		require.Equal(t, updatedAt, rev.UpdatedAt)
+		require.Nil(t, rev.UserPasswordData)
+		require.NotNil(t, rev.BankCard)
+		require.Equal(t, 2030, rev.BankCard.ExpiryYear)
+	})
+
+	t.Run("decrypt_err", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetUserPasswordData("hint").Return(&domain.UserPasswordData{ID: "id2", Hint: "hint", Revision: 2}, nil).Times(1)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().ListRevisions(gomock.Any(), "id2").Return([]domain.SecretItemHistoryEntry{
+			{ItemID: "id2", Revision: 1},
+		}, nil).Times(1)
+		mockServer.EXPECT().GetRevision(gomock.Any(), "id2", int64(1)).Return(&domain.EncryptedSecretItem{
+			ID:      "id2",
+			Content: "content1",
+		}, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().DecryptShortData("masterKey", "content1").Return("not a json", nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		_, err := da.GetUserPasswordDataHistory(context.Background(), "hint")
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("not_exists", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetBankCard("1234").Return(nil, domain.ErrClientDataIncorrect).Times(1)
+
+		da := app.NewDataAccessor().AppStorage(mockStorage)
+		_, err := da.GetBankCardHistory(context.Background(), "1234")
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestRestoreItemRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rev := &domain.ItemRevision{
+		ItemID:   "id2",
+		Type:     domain.SecretItemUserPassword,
+		Name:     "hint",
+		Revision: 1,
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetUserPasswordData("hint").Return(&domain.UserPasswordData{ID: "id2", Hint: "hint", Revision: 3}, nil).Times(1)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(5)).Times(1)
+		mockStorage.EXPECT().ApplyBankCardChanges(gomock.Any(), gomock.Any()).Times(1)
+		mockStorage.EXPECT().ApplyFileMetadataChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplySecureNoteChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyAuthenticatorChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplySSHKeyChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyUserPasswordDataChanges(gomock.Any(), gomock.Any()).Do(func(changed []domain.UserPasswordData, deleted []string) {
+			require.Equal(t, 1, len(changed))
+			require.Equal(t, int64(4), changed[0].Revision)
+			require.Equal(t, "login1", changed[0].Login)
+		}).Times(1)
+		mockStorage.EXPECT().SetChangeSeq(int64(6)).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().RestoreRevision(gomock.Any(), "id2", int64(1), int64(3)).
+			Return(&domain.SecretItemRevision{ID: "id2", Revision: 4}, nil).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(5)).Return(&domain.SecretItemChanges{
+			Seq: 6,
+			Items: []domain.EncryptedSecretItem{
+				{ID: "id2", Type: domain.SecretItemUserPassword, Content: "content1", Revision: 4},
+			},
+		}, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().DecryptShortData("masterKey", "content1").Return(`{"hint":"hint","login":"login1"}`, nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.RestoreItemRevision(context.Background(), rev)
+		require.NoError(t, err)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetUserPasswordData("hint").Return(&domain.UserPasswordData{ID: "id2", Hint: "hint", Revision: 3}, nil).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().RestoreRevision(gomock.Any(), "id2", int64(1), int64(3)).Return(nil, domain.ErrDataConflict).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
+		err := da.RestoreItemRevision(context.Background(), rev)
+		require.ErrorIs(t, err, domain.ErrDataConflict)
+	})
+}
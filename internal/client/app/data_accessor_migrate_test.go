@@ -0,0 +1,117 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		masterKey := "masterKey"
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).AnyTimes()
+
+		itemList := []domain.EncryptedSecretItem{
+			{
+				ID:      "id1",
+				Type:    domain.SecretItemBankCard,
+				Content: "cardContent",
+			},
+			{
+				ID:        "id2",
+				Type:      domain.SecretItemBankCard,
+				Name:      "encryptedName",
+				LookupKey: "lookupKey",
+				Content:   "migratedContent",
+			},
+			{
+				ID:      "id3",
+				Type:    domain.SecretItemUserPassword,
+				Content: "passContent",
+			},
+			{
+				ID:      "id4",
+				Type:    domain.SecretItemType("unknown"),
+				Content: "unknownContent",
+			},
+		}
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().ListItems(gomock.Any(), domain.SecretItemType("")).Return(itemList, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().DecryptShortData(masterKey, "cardContent").Return(`{"number":"100", "type":"MIR"}`, nil).Times(1)
+		mockHelper.EXPECT().DecryptShortData(masterKey, "passContent").Return(`{"hint":"Hint", "login":"login"}`, nil).Times(1)
+
+		mockHelper.EXPECT().EncryptShortData(masterKey, "100").Return("encryptedNumber", nil).Times(1)
+		mockHelper.EXPECT().EncryptShortData(masterKey, "Hint").Return("encryptedHint", nil).Times(1)
+		mockHelper.EXPECT().EncryptShortData(masterKey, gomock.Any()).Return("encryptedContent", nil).Times(2)
+
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemBankCard, "100").Return("cardKey").Times(1)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemUserPassword, "Hint").Return("passKey").Times(1)
+
+		var updated []*domain.EncryptedSecretItem
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			updated = append(updated, item)
+			return &domain.SecretItemRevision{ID: item.ID, Revision: item.Revision + 1}, nil
+		}).Times(2)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.MigrateItems(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, 2, len(updated))
+		assert.Equal(t, "id1", updated[0].ID)
+		assert.Equal(t, "encryptedNumber", updated[0].Name)
+		assert.Equal(t, "cardKey", updated[0].LookupKey)
+		assert.Equal(t, "encryptedContent", updated[0].Content)
+
+		assert.Equal(t, "id3", updated[1].ID)
+		assert.Equal(t, "encryptedHint", updated[1].Name)
+		assert.Equal(t, "passKey", updated[1].LookupKey)
+	})
+
+	t.Run("list_err", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").AnyTimes()
+
+		testErr := errors.New("testErr")
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().ListItems(gomock.Any(), gomock.Any()).Return(nil, testErr).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
+		err := da.MigrateItems(context.Background())
+		require.ErrorIs(t, err, testErr)
+	})
+
+	t.Run("decrypt_err", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").AnyTimes()
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().ListItems(gomock.Any(), gomock.Any()).Return([]domain.EncryptedSecretItem{
+			{
+				ID:      "id1",
+				Type:    domain.SecretItemBankCard,
+				Content: "cardContent",
+			},
+		}, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().DecryptShortData(gomock.Any(), gomock.Any()).Return("", errors.New("testErr")).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.MigrateItems(context.Background())
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
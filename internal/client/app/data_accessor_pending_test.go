@@ -0,0 +1,260 @@
+package app_test
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pendingJournal interface {
+	AddPending(change *domain.PendingChange)
+}
+
+func TestVaultKeeper_AddPending(t *testing.T) {
+	created := domain.PendingChange{
+		Kind: domain.ItemCreated,
+		Item: domain.EncryptedSecretItem{Type: domain.SecretItemBankCard, LookupKey: "key1", Content: "v1"},
+	}
+
+	t.Run("update_created", func(t *testing.T) {
+		keeper := app.NewVaultKeeper()
+		keeper.AddPending(&created)
+		keeper.AddPending(&domain.PendingChange{
+			Kind: domain.ItemUpdated,
+			Item: domain.EncryptedSecretItem{Type: domain.SecretItemBankCard, LookupKey: "key1", Content: "v2"},
+		})
+
+		pending := keeper.Pending()
+		require.Equal(t, 1, len(pending))
+		assert.Equal(t, domain.ItemCreated, pending[0].Kind)
+		assert.Equal(t, "v2", pending[0].Item.Content)
+	})
+
+	t.Run("delete_created", func(t *testing.T) {
+		keeper := app.NewVaultKeeper()
+		keeper.AddPending(&created)
+		keeper.AddPending(&domain.PendingChange{
+			Kind: domain.ItemDeleted,
+			Item: domain.EncryptedSecretItem{Type: domain.SecretItemBankCard, LookupKey: "key1"},
+		})
+		assert.Equal(t, 0, len(keeper.Pending()))
+	})
+
+	t.Run("delete_updated", func(t *testing.T) {
+		keeper := app.NewVaultKeeper()
+		keeper.AddPending(&domain.PendingChange{
+			Kind: domain.ItemUpdated,
+			Item: domain.EncryptedSecretItem{ID: "id1", Revision: 3, Content: "v1"},
+		})
+		keeper.AddPending(&created)
+		keeper.AddPending(&domain.PendingChange{
+			Kind: domain.ItemDeleted,
+			Item: domain.EncryptedSecretItem{ID: "id1", Revision: 3},
+		})
+
+		pending := keeper.Pending()
+		require.Equal(t, 2, len(pending))
+		assert.Equal(t, domain.ItemDeleted, pending[0].Kind)
+		assert.Equal(t, "id1", pending[0].Item.ID)
+		assert.Equal(t, domain.ItemCreated, pending[1].Kind)
+
+		keeper.RemovePending(&pending[0])
+		pending = keeper.Pending()
+		require.Equal(t, 1, len(pending))
+		assert.Equal(t, domain.ItemCreated, pending[0].Kind)
+	})
+}
+
+func TestDataAccessor_Journal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("server_not_responding", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").AnyTimes()
+		mockStorage.EXPECT().AddBankCard(gomock.Any()).Return(nil).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("%w server is offline", domain.ErrServerIsNotResponding)).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().CheckBankCardData(gomock.Any()).Return(nil).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), gomock.Any(), gomock.Any()).Return("lookupKey").Times(1)
+
+		keeper := app.NewVaultKeeper()
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper).VaultKeeper(keeper)
+		err := da.AddBankCard(context.Background(), &domain.BankCard{Number: "100"})
+		require.NoError(t, err)
+
+		require.Equal(t, 1, da.PendingCount())
+		pending := keeper.Pending()
+		assert.Equal(t, domain.ItemCreated, pending[0].Kind)
+		assert.Equal(t, "lookupKey", pending[0].Item.LookupKey)
+	})
+
+	t.Run("other_error", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").AnyTimes()
+		mockStorage.EXPECT().GetBankCard("100").Return(&domain.BankCard{ID: "id1", Revision: 2, Number: "100"}, nil).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().DeleteItem(gomock.Any(), "id1", int64(2)).Return(domain.ErrDataConflict).Times(1)
+
+		keeper := app.NewVaultKeeper()
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).VaultKeeper(keeper)
+		err := da.DeleteBankCard(context.Background(), "100")
+		require.ErrorIs(t, err, domain.ErrDataConflict)
+		assert.Equal(t, 0, da.PendingCount())
+	})
+}
+
+func TestDataAccessor_ReplayPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterPass := "masterKey"
+	helper := app.NewHelper(rand.Read)
+
+	cardContent, err := helper.EncryptShortData(masterPass, `{"number":"100", "type":"MIR"}`)
+	require.NoError(t, err)
+	passContent, err := helper.EncryptShortData(masterPass, `{"hint":"Hint", "login":"login"}`)
+	require.NoError(t, err)
+
+	addPending := func(keeper pendingJournal) {
+		keeper.AddPending(&domain.PendingChange{
+			Kind: domain.ItemCreated,
+			Item: domain.EncryptedSecretItem{Type: domain.SecretItemUserPassword, LookupKey: "passKey", Content: passContent},
+		})
+		keeper.AddPending(&domain.PendingChange{
+			Kind: domain.ItemUpdated,
+			Item: domain.EncryptedSecretItem{ID: "id1", Revision: 2, Type: domain.SecretItemBankCard, Content: cardContent},
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterPass).AnyTimes()
+
+		mockServer := NewMockAppServer(ctrl)
+		gomock.InOrder(
+			mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+				assert.Equal(t, "passKey", item.LookupKey)
+				return &domain.SecretItemRevision{ID: "id2", Revision: 1}, nil
+			}),
+			mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+				assert.Equal(t, "id1", item.ID)
+				assert.Equal(t, int64(2), item.Revision)
+				return &domain.SecretItemRevision{ID: "id1", Revision: 3}, nil
+			}),
+		)
+
+		keeper := app.NewVaultKeeper().DomainHelper(helper)
+		addPending(keeper)
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(helper).VaultKeeper(keeper)
+		conflict, err := da.ReplayPending(context.Background())
+		require.NoError(t, err)
+		assert.Nil(t, conflict)
+		assert.Equal(t, 0, da.PendingCount())
+	})
+
+	t.Run("server_not_responding", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterPass).AnyTimes()
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(nil, domain.ErrServerIsNotResponding).Times(1)
+
+		keeper := app.NewVaultKeeper().DomainHelper(helper)
+		addPending(keeper)
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(helper).VaultKeeper(keeper)
+		_, err := da.ReplayPending(context.Background())
+		require.ErrorIs(t, err, domain.ErrServerIsNotResponding)
+		assert.Equal(t, 2, da.PendingCount())
+	})
+
+	t.Run("update_conflict", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterPass).AnyTimes()
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(5)).Times(1)
+		mockStorage.EXPECT().ApplyBankCardChanges(gomock.Any(), gomock.Any()).Times(1)
+		mockStorage.EXPECT().ApplyFileMetadataChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplySecureNoteChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyAuthenticatorChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplySSHKeyChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyUserPasswordDataChanges(gomock.Any(), gomock.Any()).Times(1)
+		mockStorage.EXPECT().SetChangeSeq(int64(6)).Times(1)
+		// the card was deleted by another client - the local version is kept to be recreated
+		mockStorage.EXPECT().GetBankCard("100").Return(nil, domain.ErrDataNotExists).Times(1)
+		mockStorage.EXPECT().AddBankCard(gomock.Any()).DoAndReturn(func(bankCard *domain.BankCard) error {
+			assert.Equal(t, "id1", bankCard.ID)
+			return nil
+		}).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(&domain.SecretItemRevision{ID: "id2", Revision: 1}, nil).Times(1)
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).Return(nil, domain.ErrDataNotExists).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(5)).Return(&domain.SecretItemChanges{
+			Seq:     6,
+			Deleted: []domain.DeletedSecretItem{{ID: "id1", Type: domain.SecretItemBankCard}},
+		}, nil).Times(1)
+
+		keeper := app.NewVaultKeeper().DomainHelper(helper)
+		addPending(keeper)
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(helper).VaultKeeper(keeper)
+		conflict, err := da.ReplayPending(context.Background())
+		require.NoError(t, err)
+		require.NotNil(t, conflict)
+		assert.Equal(t, domain.ItemUpdated, conflict.Kind)
+		require.NotNil(t, conflict.BankCard)
+		assert.Equal(t, "100", conflict.BankCard.Number)
+
+		// the change is kept until the user resolves the conflict
+		require.Equal(t, 1, da.PendingCount())
+		assert.True(t, keeper.Pending()[0].Conflict)
+		da.ResolvePendingConflict()
+		assert.Equal(t, 0, da.PendingCount())
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterPass).AnyTimes()
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(5)).Times(2)
+
+		mockServer := NewMockAppServer(ctrl)
+		// the rejected update is not sent again, the next change is sent
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).Return(nil, domain.ErrClientDataIncorrect).Times(1)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(&domain.SecretItemRevision{ID: "id2", Revision: 1}, nil).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(5)).Return(nil, domain.ErrServerIsNotResponding).Times(2)
+
+		keeper := app.NewVaultKeeper().DomainHelper(helper)
+		keeper.AddPending(&domain.PendingChange{
+			Kind: domain.ItemUpdated,
+			Item: domain.EncryptedSecretItem{ID: "id1", Revision: 2, Type: domain.SecretItemBankCard, Content: cardContent},
+		})
+		keeper.AddPending(&domain.PendingChange{
+			Kind: domain.ItemCreated,
+			Item: domain.EncryptedSecretItem{Type: domain.SecretItemUserPassword, LookupKey: "passKey", Content: passContent},
+		})
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(helper).VaultKeeper(keeper)
+
+		for i := 0; i < 2; i++ {
+			// the stored items can't be loaded - the conflict is shown on the next replay
+			_, err := da.ReplayPending(context.Background())
+			require.ErrorIs(t, err, domain.ErrServerIsNotResponding)
+
+			pending := keeper.Pending()
+			require.Equal(t, 1, len(pending))
+			assert.Equal(t, domain.ItemUpdated, pending[0].Kind)
+			assert.True(t, pending[0].Conflict)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// SecretItem returns the decrypted item found by the type and the name: the card number, the password hint,
+// the note title, the authenticator or the ssh key name. The item is found among the encrypted items
+// by the lookup key, so only the requested item is decrypted; the local copy is used in offline mode.
+func (dcc *dataAccessor) SecretItem(ctx context.Context, itemType domain.SecretItemType, name string) (any, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	masterPass := dcc.appStorage.GetMasterPassword()
+	if masterPass == "" {
+		err := fmt.Errorf("%w - %v error - vault is locked", domain.ErrClientDataIncorrect, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	if dcc.offline.Load() {
+		data, err := dcc.storedSecretItem(itemType, name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return nil, err
+		}
+		log.Debugf("%v success", action)
+		return data, nil
+	}
+
+	var items []domain.EncryptedSecretItem
+	var err error
+	if itemType == domain.SecretItemSecureNote {
+		items, err = dcc.appServer.ListSecureNotes(ctx)
+	} else {
+		items, err = dcc.appServer.ListItems(ctx, itemType)
+	}
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	lookupKey := dcc.helper.BlindIndex(masterPass, itemType, name)
+	for _, item := range items {
+		if item.LookupKey != lookupKey {
+			continue
+		}
+
+		data, err := newSecretItemData(itemType)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return nil, err
+		}
+		if err := dcc.decryptItemContent(masterPass, &item, data); err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return nil, err
+		}
+
+		log.Debugf("%v success", action)
+		return data, nil
+	}
+
+	err = fmt.Errorf("%w - %v error - %v %v is not found", domain.ErrDataNotExists, action, itemType, name)
+	log.Warn(err.Error())
+	return nil, err
+}
+
+func newSecretItemData(itemType domain.SecretItemType) (any, error) {
+	switch itemType {
+	case domain.SecretItemBankCard:
+		return &domain.BankCard{}, nil
+	case domain.SecretItemUserPassword:
+		return &domain.UserPasswordData{}, nil
+	case domain.SecretItemSecureNote:
+		return &domain.SecureNote{}, nil
+	case domain.SecretItemAuthenticator:
+		return &domain.Authenticator{}, nil
+	case domain.SecretItemSSHKey:
+		return &domain.SSHKey{}, nil
+	}
+	return nil, fmt.Errorf("%w - unsupported item type %v", domain.ErrClientDataIncorrect, itemType)
+}
+
+func (dcc *dataAccessor) storedSecretItem(itemType domain.SecretItemType, name string) (any, error) {
+	switch itemType {
+	case domain.SecretItemBankCard:
+		return dcc.appStorage.GetBankCard(name)
+	case domain.SecretItemUserPassword:
+		return dcc.appStorage.GetUserPasswordData(name)
+	case domain.SecretItemSecureNote:
+		return dcc.appStorage.GetSecureNote(name)
+	case domain.SecretItemAuthenticator:
+		return dcc.appStorage.GetAuthenticator(name)
+	case domain.SecretItemSSHKey:
+		return dcc.appStorage.GetSSHKey(name)
+	}
+	return nil, fmt.Errorf("%w - unsupported item type %v", domain.ErrClientDataIncorrect, itemType)
+}
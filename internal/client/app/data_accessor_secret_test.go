@@ -0,0 +1,85 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterKey := "masterKey"
+
+	t.Run("ok", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().ListItems(gomock.Any(), domain.SecretItemUserPassword).Return([]domain.EncryptedSecretItem{
+			{ID: "id1", Type: domain.SecretItemUserPassword, LookupKey: "otherKey", Content: "otherContent"},
+			{ID: "id2", Type: domain.SecretItemUserPassword, LookupKey: "lookupKey", Content: "content"},
+		}, nil).Times(1)
+
+		// the other item is not decrypted
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemUserPassword, "db").Return("lookupKey").Times(1)
+		mockHelper.EXPECT().DecryptShortData(masterKey, "content").Return(`{"hint":"db","password":"secret"}`, nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		item, err := da.SecretItem(context.Background(), domain.SecretItemUserPassword, "db")
+		require.NoError(t, err)
+		require.IsType(t, &domain.UserPasswordData{}, item)
+		assert.Equal(t, "secret", item.(*domain.UserPasswordData).Passwrod)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().ListItems(gomock.Any(), domain.SecretItemBankCard).Return(nil, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemBankCard, "100").Return("lookupKey").Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		_, err := da.SecretItem(context.Background(), domain.SecretItemBankCard, "100")
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+
+	t.Run("secure_note", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+
+		// notes are listed by their own operation
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().ListSecureNotes(gomock.Any()).Return([]domain.EncryptedSecretItem{
+			{ID: "id1", Type: domain.SecretItemSecureNote, LookupKey: "lookupKey", Content: "content"},
+		}, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemSecureNote, "todo").Return("lookupKey").Times(1)
+		mockHelper.EXPECT().DecryptShortData(masterKey, "content").Return(`{"title":"todo","text":"line1"}`, nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		item, err := da.SecretItem(context.Background(), domain.SecretItemSecureNote, "todo")
+		require.NoError(t, err)
+		require.IsType(t, &domain.SecureNote{}, item)
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("").Times(1)
+
+		da := app.NewDataAccessor().AppStorage(mockStorage)
+		_, err := da.SecretItem(context.Background(), domain.SecretItemBankCard, "100")
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
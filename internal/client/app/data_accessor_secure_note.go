@@ -0,0 +1,193 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+func (dcc *dataAccessor) GetSecureNoteList(ctx context.Context) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.syncChanges(ctx); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) AddSecureNote(ctx context.Context, note *domain.SecureNote) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.helper.CheckSecureNote(note); err != nil {
+		err := fmt.Errorf("%w - %v error - wrong secure note", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	item, err := dcc.encryptItem(domain.SecretItemSecureNote, note.Title, note)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemCreated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.CreateSecureNote(ctx, item)
+			if err != nil {
+				return err
+			}
+			note.ID = rev.ID
+			note.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.AddSecureNote(note)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) UpdateSecureNote(ctx context.Context, note *domain.SecureNote) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.helper.CheckSecureNote(note); err != nil {
+		err := fmt.Errorf("%w - %v error - wrong secure note", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if note.ID == "" {
+		stored, err := dcc.appStorage.GetSecureNote(note.Title)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		note.ID = stored.ID
+		note.Revision = stored.Revision
+	}
+
+	item, err := dcc.encryptItem(domain.SecretItemSecureNote, note.Title, note)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+	item.ID = note.ID
+	item.Revision = note.Revision
+
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemUpdated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.UpdateSecureNote(ctx, item)
+			if err != nil {
+				return err
+			}
+			note.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.UpdateSecureNote(note)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) DeleteSecureNote(ctx context.Context, title string) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	stored, err := dcc.appStorage.GetSecureNote(title)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	err = dcc.sendOrJournalDelete(ctx, domain.SecretItemSecureNote, title, stored, stored.ID, stored.Revision,
+		func() error {
+			return dcc.appStorage.DeleteSecureNote(title)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+// ResolveSecureNoteConflict applies the user choice after the update returned [domain.ErrDataConflict]:
+// ConflictReload drops local changes (the caller reloads the list), ConflictOverwrite stores local changes
+// over the current revision or recreates the note deleted by another client,
+// ConflictKeepBoth stores local changes as a new note with the changed title.
+func (dcc *dataAccessor) ResolveSecureNoteConflict(ctx context.Context, note *domain.SecureNote, resolution domain.ConflictResolution) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	switch resolution {
+	case domain.ConflictReload:
+		return nil
+	case domain.ConflictKeepBoth:
+		note.ID = ""
+		note.Revision = 0
+		note.Title = fmt.Sprintf("%s (conflict %s)", note.Title, time.Now().Format(time.DateTime))
+		return dcc.AddSecureNote(ctx, note)
+	case domain.ConflictOverwrite:
+	default:
+		err := fmt.Errorf("%w - %v error - unknown resolution %v", domain.ErrClientDataIncorrect, action, resolution)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if note.ID == "" {
+		stored, err := dcc.appStorage.GetSecureNote(note.Title)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		note.ID = stored.ID
+	}
+
+	revision, err := dcc.storedRevision(ctx, note.ID)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if revision == 0 {
+		note.ID = ""
+		return dcc.AddSecureNote(ctx, note)
+	}
+	note.Revision = revision
+	return dcc.UpdateSecureNote(ctx, note)
+}
@@ -0,0 +1,217 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSecureNote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		note := &domain.SecureNote{
+			Title: "recovery",
+			Text:  "word1 word2\nword3",
+		}
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().CheckSecureNote(note).Return(nil).Times(1)
+
+		masterKey := "masterKey"
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+
+		mockHelper.EXPECT().EncryptShortData(masterKey, note.Title).Return("encryptedName", nil).Times(1)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemSecureNote, note.Title).Return("lookupKey").Times(1)
+		mockHelper.EXPECT().EncryptShortData(masterKey, gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
+			cnt, err := json.Marshal(note)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(cnt), content)
+			return "encrypted", nil
+		}).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateSecureNote(gomock.Any(), &domain.EncryptedSecretItem{
+			Type:      domain.SecretItemSecureNote,
+			Name:      "encryptedName",
+			LookupKey: "lookupKey",
+			Content:   "encrypted",
+		}).Return(&domain.SecretItemRevision{ID: "id1", Revision: 1}, nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.AddSecureNote(context.Background(), note)
+		require.NoError(t, err)
+		assert.Equal(t, "id1", note.ID)
+		assert.Equal(t, int64(1), note.Revision)
+	})
+
+	t.Run("too_large", func(t *testing.T) {
+		note := &domain.SecureNote{
+			Title: "recovery",
+			Text:  strings.Repeat("a", domain.MaxSecureNoteSize+1),
+		}
+
+		da := app.NewDataAccessor().DomainHelper(app.NewHelper(nil))
+		err := da.AddSecureNote(context.Background(), note)
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+		require.ErrorIs(t, err, domain.ErrClientNoteTooLarge)
+	})
+}
+
+func TestUpdateSecureNote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	note := &domain.SecureNote{
+		Title: "recovery",
+		Text:  "word1",
+	}
+
+	mockHelper := NewMockDomainHelper(ctrl)
+	mockHelper.EXPECT().CheckSecureNote(note).Return(nil).Times(1)
+	mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+	mockHelper.EXPECT().BlindIndex(gomock.Any(), domain.SecretItemSecureNote, note.Title).Return("lookupKey").Times(1)
+
+	mockStorage := NewMockAppStorage(ctrl)
+	mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+	mockStorage.EXPECT().GetSecureNote(note.Title).Return(&domain.SecureNote{
+		ID:       "id1",
+		Revision: 2,
+		Title:    note.Title,
+	}, nil).Times(1)
+
+	mockServer := NewMockAppServer(ctrl)
+	mockServer.EXPECT().UpdateSecureNote(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			assert.Equal(t, "id1", item.ID)
+			assert.Equal(t, int64(2), item.Revision)
+			assert.Equal(t, domain.SecretItemSecureNote, item.Type)
+			return &domain.SecretItemRevision{ID: "id1", Revision: 3}, nil
+		}).Times(1)
+
+	da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+	err := da.UpdateSecureNote(context.Background(), note)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), note.Revision)
+}
+
+func TestDeleteSecureNote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := NewMockAppStorage(ctrl)
+	mockStorage.EXPECT().GetSecureNote("recovery").Return(&domain.SecureNote{
+		ID:       "id1",
+		Revision: 1,
+		Title:    "recovery",
+	}, nil).Times(1)
+
+	mockServer := NewMockAppServer(ctrl)
+	mockServer.EXPECT().DeleteSecureNote(gomock.Any(), "id1", int64(1)).Return(nil).Times(1)
+
+	da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
+	err := da.DeleteSecureNote(context.Background(), "recovery")
+	require.NoError(t, err)
+}
+
+func TestGetSecureNoteList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterKey := "masterKey"
+	mockStorage := NewMockAppStorage(ctrl)
+	mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+	mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+
+	mockServer := NewMockAppServer(ctrl)
+	mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(&domain.SecretItemChanges{
+		Seq: 1,
+		Items: []domain.EncryptedSecretItem{
+			{ID: "id1", Type: domain.SecretItemSecureNote, Content: "content", Revision: 1},
+		},
+	}, nil).Times(1)
+
+	mockHelper := NewMockDomainHelper(ctrl)
+	mockHelper.EXPECT().DecryptShortData(masterKey, "content").
+		Return(`{"title":"recovery","text":"word1\nword2"}`, nil).Times(1)
+
+	mockStorage.EXPECT().SetBankCards(nil).Times(1)
+	mockStorage.EXPECT().SetUserPasswordDatas(nil).Times(1)
+	mockStorage.EXPECT().SetFileMetadatas(nil).Times(1)
+	mockStorage.EXPECT().SetSecureNotes([]domain.SecureNote{
+		{ID: "id1", Revision: 1, Title: "recovery", Text: "word1\nword2"},
+	}).Times(1)
+	mockStorage.EXPECT().SetAuthenticators(nil).Times(1)
+	mockStorage.EXPECT().SetSSHKeys(nil).Times(1)
+	mockStorage.EXPECT().SetChangeSeq(int64(1)).Times(1)
+
+	da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+	err := da.GetSecureNoteList(context.Background())
+	require.NoError(t, err)
+}
+
+func TestResolveSecureNoteConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("reload", func(t *testing.T) {
+		da := app.NewDataAccessor()
+		err := da.ResolveSecureNoteConflict(context.Background(), &domain.SecureNote{Title: "recovery"}, domain.ConflictReload)
+		require.NoError(t, err)
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		note := &domain.SecureNote{ID: "id1", Revision: 1, Title: "recovery", Text: "word1"}
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetItem(gomock.Any(), "id1").Return(&domain.EncryptedSecretItem{ID: "id1", Revision: 4}, nil).Times(1)
+		mockServer.EXPECT().UpdateSecureNote(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+				assert.Equal(t, int64(4), item.Revision)
+				return &domain.SecretItemRevision{ID: "id1", Revision: 5}, nil
+			}).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().CheckSecureNote(note).Return(nil).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), domain.SecretItemSecureNote, note.Title).Return("lookupKey").Times(1)
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.ResolveSecureNoteConflict(context.Background(), note, domain.ConflictOverwrite)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), note.Revision)
+	})
+
+	t.Run("keep_both", func(t *testing.T) {
+		note := &domain.SecureNote{ID: "id1", Revision: 1, Title: "recovery", Text: "word1"}
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateSecureNote(gomock.Any(), gomock.Any()).Return(&domain.SecretItemRevision{ID: "id2", Revision: 1}, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().CheckSecureNote(note).Return(nil).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), domain.SecretItemSecureNote, gomock.Any()).Return("lookupKey").Times(1)
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.ResolveSecureNoteConflict(context.Background(), note, domain.ConflictKeepBoth)
+		require.NoError(t, err)
+		assert.Equal(t, "id2", note.ID)
+		assert.True(t, strings.HasPrefix(note.Title, "recovery (conflict "))
+	})
+}
@@ -0,0 +1,225 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+func (dcc *dataAccessor) GetSSHKeyList(ctx context.Context) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.syncChanges(ctx); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) AddSSHKey(ctx context.Context, key *domain.SSHKey) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.helper.CheckSSHKey(key); err != nil {
+		err := fmt.Errorf("%w - %v error - wrong ssh key", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	item, err := dcc.encryptItem(domain.SecretItemSSHKey, key.Name, key)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemCreated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.CreateItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			key.ID = rev.ID
+			key.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.AddSSHKey(key)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) UpdateSSHKey(ctx context.Context, key *domain.SSHKey) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := dcc.helper.CheckSSHKey(key); err != nil {
+		err := fmt.Errorf("%w - %v error - wrong ssh key", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if key.ID == "" {
+		stored, err := dcc.appStorage.GetSSHKey(key.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		key.ID = stored.ID
+		key.Revision = stored.Revision
+	}
+
+	item, err := dcc.encryptItem(domain.SecretItemSSHKey, key.Name, key)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+	item.ID = key.ID
+	item.Revision = key.Revision
+
+	err = dcc.sendOrJournal(ctx, &domain.PendingChange{Kind: domain.ItemUpdated, Item: *item},
+		func(ctx context.Context) error {
+			rev, err := dcc.appServer.UpdateItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			key.Revision = rev.Revision
+			return nil
+		},
+		func() error {
+			return dcc.appStorage.UpdateSSHKey(key)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+func (dcc *dataAccessor) DeleteSSHKey(ctx context.Context, name string) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	stored, err := dcc.appStorage.GetSSHKey(name)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	err = dcc.sendOrJournalDelete(ctx, domain.SecretItemSSHKey, name, stored, stored.ID, stored.Revision,
+		func() error {
+			return dcc.appStorage.DeleteSSHKey(name)
+		})
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+// ResolveSSHKeyConflict applies the user choice after the update returned [domain.ErrDataConflict]:
+// ConflictReload drops local changes (the caller reloads the list), ConflictOverwrite stores local changes
+// over the current revision or recreates the ssh key deleted by another client,
+// ConflictKeepBoth stores local changes as a new ssh key with the changed name.
+func (dcc *dataAccessor) ResolveSSHKeyConflict(ctx context.Context, key *domain.SSHKey, resolution domain.ConflictResolution) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	switch resolution {
+	case domain.ConflictReload:
+		return nil
+	case domain.ConflictKeepBoth:
+		key.ID = ""
+		key.Revision = 0
+		key.Name = fmt.Sprintf("%s (conflict %s)", key.Name, time.Now().Format(time.DateTime))
+		return dcc.AddSSHKey(ctx, key)
+	case domain.ConflictOverwrite:
+	default:
+		err := fmt.Errorf("%w - %v error - unknown resolution %v", domain.ErrClientDataIncorrect, action, resolution)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if key.ID == "" {
+		stored, err := dcc.appStorage.GetSSHKey(key.Name)
+		if err != nil {
+			err := fmt.Errorf("%w - %v error", err, action)
+			log.Warn(err.Error())
+			return err
+		}
+		key.ID = stored.ID
+	}
+
+	revision, err := dcc.storedRevision(ctx, key.ID)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	if revision == 0 {
+		key.ID = ""
+		return dcc.AddSSHKey(ctx, key)
+	}
+	key.Revision = revision
+	return dcc.UpdateSSHKey(ctx, key)
+}
+
+// SSHKeys returns the ssh keys served by the ssh-agent sorted by name; no keys are returned while the vault is locked.
+// The changes are loaded from the server first, the local copy is used if the server is not available.
+func (dcc *dataAccessor) SSHKeys(ctx context.Context) []domain.SSHKey {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if dcc.appStorage.GetMasterPassword() == "" {
+		log.Debugf("%v - vault is locked", action)
+		return nil
+	}
+
+	if !dcc.offline.Load() {
+		if err := dcc.syncChanges(ctx); err != nil {
+			log.Warnf("%v - can't load changes, local copy is used - %v", action, err.Error())
+		}
+	}
+
+	names := dcc.appStorage.GetSSHKeyList()
+	slices.Sort(names)
+	keys := make([]domain.SSHKey, 0, len(names))
+	for _, name := range names {
+		if key, err := dcc.appStorage.GetSSHKey(name); err == nil {
+			keys = append(keys, *key)
+		}
+	}
+
+	log.Debugf("%v success", action)
+	return keys
+}
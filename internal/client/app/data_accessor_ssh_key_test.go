@@ -0,0 +1,89 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSSHKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		key := &domain.SSHKey{
+			Name:       "work",
+			PrivateKey: "private",
+			Confirm:    true,
+		}
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().CheckSSHKey(key).Return(nil).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), domain.SecretItemSSHKey, key.Name).Return("lookupKey").Times(1)
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+				assert.Equal(t, domain.SecretItemSSHKey, item.Type)
+				return &domain.SecretItemRevision{ID: "id1", Revision: 1}, nil
+			}).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.AddSSHKey(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, "id1", key.ID)
+	})
+
+	t.Run("wrong_key", func(t *testing.T) {
+		key := &domain.SSHKey{
+			Name:       "work",
+			PrivateKey: "private",
+		}
+
+		da := app.NewDataAccessor().DomainHelper(app.NewHelper(nil))
+		err := da.AddSSHKey(context.Background(), key)
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestSSHKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("locked", func(t *testing.T) {
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("").Times(1)
+
+		da := app.NewDataAccessor().AppStorage(mockStorage)
+		assert.Empty(t, da.SSHKeys(context.Background()))
+	})
+
+	t.Run("server_is_not_available", func(t *testing.T) {
+		masterKey := "masterKey"
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(2)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(1)).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(1)).Return(nil, domain.ErrServerIsNotResponding).Times(1)
+
+		mockStorage.EXPECT().GetSSHKeyList().Return([]string{"work", "home"}).Times(1)
+		mockStorage.EXPECT().GetSSHKey("home").Return(&domain.SSHKey{ID: "id2", Name: "home"}, nil).Times(1)
+		mockStorage.EXPECT().GetSSHKey("work").Return(&domain.SSHKey{ID: "id1", Name: "work"}, nil).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(NewMockDomainHelper(ctrl))
+		keys := da.SSHKeys(context.Background())
+		require.Len(t, keys, 2)
+		assert.Equal(t, "home", keys[0].Name)
+		assert.Equal(t, "work", keys[1].Name)
+	})
+}
@@ -25,34 +25,125 @@ func TestGetUserPasswordDataList(t *testing.T) {
 
 		mockServer := NewMockAppServer(ctrl)
 
-		passDataList := []domain.EncryptedUserPasswordData{
+		itemList := []domain.EncryptedSecretItem{
 			{
-				Hint:    "100",
+				ID:      "id1",
+				Type:    domain.SecretItemUserPassword,
+				Name:    "encryptedName",
 				Content: "content",
 			},
 		}
-		mockServer.EXPECT().GetUserPasswordDataList(gomock.Any()).Return(passDataList, nil).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(&domain.SecretItemChanges{
+			Seq:   1,
+			Items: itemList,
+		}, nil).Times(1)
 
 		mockHelper := NewMockDomainHelper(ctrl)
 		mockHelper.EXPECT().DecryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, cnt string) (string, error) {
 			assert.Equal(t, masterKey, key)
-			assert.Equal(t, passDataList[0].Content, cnt)
+			assert.Equal(t, itemList[0].Content, cnt)
 			return `{"hint":"100", "login":"login","password":"pass"}`, nil
 		}).Times(1)
 
+		mockStorage.EXPECT().SetFileMetadatas(nil).Times(1)
+
+		mockStorage.EXPECT().SetSecureNotes(nil).Times(1)
+
+		mockStorage.EXPECT().SetAuthenticators(nil).Times(1)
+
+		mockStorage.EXPECT().SetSSHKeys(nil).Times(1)
 		mockStorage.EXPECT().SetUserPasswordDatas(gomock.Any()).Do(func(crds []domain.UserPasswordData) {
 			require.Equal(t, 1, len(crds))
 			crd := crds[0]
+			assert.Equal(t, "id1", crd.ID)
 			assert.Equal(t, "100", crd.Hint)
 			assert.Equal(t, "login", crd.Login)
 			assert.Equal(t, "pass", crd.Passwrod)
 		}).Times(1)
+		mockStorage.EXPECT().SetBankCards(gomock.Any()).Times(1)
+		mockStorage.EXPECT().SetChangeSeq(int64(1)).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.GetUserPasswordDataList(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("delta", func(t *testing.T) {
+
+		masterKey := "masterKey"
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(3)).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(3)).Return(&domain.SecretItemChanges{
+			Seq: 5,
+			Items: []domain.EncryptedSecretItem{
+				{
+					ID:       "id1",
+					Type:     domain.SecretItemUserPassword,
+					Content:  "content",
+					Revision: 2,
+				},
+			},
+			Deleted: []domain.DeletedSecretItem{
+				{
+					ID:   "id2",
+					Type: domain.SecretItemUserPassword,
+				},
+			},
+		}, nil).Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().DecryptShortData(masterKey, "content").Return(`{"hint":"101", "login":"login","password":"pass"}`, nil).Times(1)
+
+		mockStorage.EXPECT().ApplyFileMetadataChanges(nil, nil).Times(1)
+
+		mockStorage.EXPECT().ApplySecureNoteChanges(nil, nil).Times(1)
+
+		mockStorage.EXPECT().ApplyAuthenticatorChanges(nil, nil).Times(1)
+
+		mockStorage.EXPECT().ApplySSHKeyChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyUserPasswordDataChanges(gomock.Any(), []string{"id2"}).Do(func(changed []domain.UserPasswordData, deletedIDs []string) {
+			require.Equal(t, 1, len(changed))
+			assert.Equal(t, "id1", changed[0].ID)
+			assert.Equal(t, "101", changed[0].Hint)
+			assert.Equal(t, int64(2), changed[0].Revision)
+		}).Times(1)
+		mockStorage.EXPECT().ApplyBankCardChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().SetChangeSeq(int64(5)).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.GetUserPasswordDataList(context.Background())
 		require.NoError(t, err)
 	})
 
+	t.Run("reset", func(t *testing.T) {
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(10)).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(10)).Return(&domain.SecretItemChanges{
+			Seq:   2,
+			Reset: true,
+		}, nil).Times(1)
+
+		mockStorage.EXPECT().SetBankCards(nil).Times(1)
+		mockStorage.EXPECT().SetFileMetadatas(nil).Times(1)
+		mockStorage.EXPECT().SetSecureNotes(nil).Times(1)
+		mockStorage.EXPECT().SetAuthenticators(nil).Times(1)
+		mockStorage.EXPECT().SetSSHKeys(nil).Times(1)
+		mockStorage.EXPECT().SetUserPasswordDatas(nil).Times(1)
+		mockStorage.EXPECT().SetChangeSeq(int64(2)).Times(1)
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
+		err := da.GetUserPasswordDataList(context.Background())
+		require.NoError(t, err)
+	})
+
 	t.Run("get_user_pass_login_err", func(t *testing.T) {
 
 		masterKey := "masterKey"
@@ -62,7 +153,8 @@ func TestGetUserPasswordDataList(t *testing.T) {
 		mockServer := NewMockAppServer(ctrl)
 
 		testErr := errors.New("testErr")
-		mockServer.EXPECT().GetUserPasswordDataList(gomock.Any()).Return(nil, testErr).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(nil, testErr).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
 		err := da.GetUserPasswordDataList(context.Background())
@@ -76,19 +168,25 @@ func TestGetUserPasswordDataList(t *testing.T) {
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
-		passDataList := []domain.EncryptedUserPasswordData{
+
+		itemList := []domain.EncryptedSecretItem{
 			{
-				Hint:    "100",
+				ID:      "id1",
+				Type:    domain.SecretItemUserPassword,
 				Content: "content",
 			},
 		}
-		mockServer.EXPECT().GetUserPasswordDataList(gomock.Any()).Return(passDataList, nil).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(&domain.SecretItemChanges{
+			Seq:   1,
+			Items: itemList,
+		}, nil).Times(1)
 
 		mockHelper := NewMockDomainHelper(ctrl)
 		testErr := errors.New("testErr")
 		mockHelper.EXPECT().DecryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, cnt string) (string, error) {
 			assert.Equal(t, masterKey, key)
-			assert.Equal(t, passDataList[0].Content, cnt)
+			assert.Equal(t, itemList[0].Content, cnt)
 			return `{"hint":"100", "login":"login","password":"pass"}`, testErr
 		}).Times(1)
 
@@ -96,6 +194,7 @@ func TestGetUserPasswordDataList(t *testing.T) {
 		err := da.GetUserPasswordDataList(context.Background())
 		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 	})
+
 	t.Run("json_err", func(t *testing.T) {
 
 		masterKey := "masterKey"
@@ -104,26 +203,30 @@ func TestGetUserPasswordDataList(t *testing.T) {
 
 		mockServer := NewMockAppServer(ctrl)
 
-		passDataList := []domain.EncryptedUserPasswordData{
+		itemList := []domain.EncryptedSecretItem{
 			{
-				Hint:    "100",
+				ID:      "id1",
+				Type:    domain.SecretItemUserPassword,
 				Content: "content",
 			},
 		}
-		mockServer.EXPECT().GetUserPasswordDataList(gomock.Any()).Return(passDataList, nil).Times(1)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).Times(1)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(0)).Return(&domain.SecretItemChanges{
+			Seq:   1,
+			Items: itemList,
+		}, nil).Times(1)
 
 		mockHelper := NewMockDomainHelper(ctrl)
 		mockHelper.EXPECT().DecryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, cnt string) (string, error) {
 			assert.Equal(t, masterKey, key)
-			assert.Equal(t, passDataList[0].Content, cnt)
-			return `-`, nil
+			assert.Equal(t, itemList[0].Content, cnt)
+			return `{"hint":"100", "login":"login","password":"pass"`, nil
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.GetUserPasswordDataList(context.Background())
 		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 	})
-
 }
 
 func TestAddUserPasswordData(t *testing.T) {
@@ -150,6 +253,9 @@ func TestAddUserPasswordData(t *testing.T) {
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
 
 		encryptedCnt := "encrypted"
+		encryptedName := "encryptedName"
+		mockHelper.EXPECT().EncryptShortData(masterKey, passData.Hint).Return(encryptedName, nil).Times(1)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemUserPassword, passData.Hint).Return("lookupKey").Times(1)
 		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
 
 			assert.Equal(t, masterKey, key)
@@ -160,19 +266,22 @@ func TestAddUserPasswordData(t *testing.T) {
 		}).Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
-		mockServer.EXPECT().CreateUserPasswordData(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, dt *domain.EncryptedUserPasswordData) error {
-			require.NotNil(t, dt)
-			assert.Equal(t, passData.Hint, dt.Hint)
-			assert.Equal(t, encryptedCnt, dt.Content)
-			return nil
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			require.NotNil(t, item)
+			assert.Equal(t, domain.SecretItemUserPassword, item.Type)
+			assert.Equal(t, encryptedName, item.Name)
+			assert.Equal(t, "lookupKey", item.LookupKey)
+			assert.Equal(t, encryptedCnt, item.Content)
+			return &domain.SecretItemRevision{ID: "id1", Revision: 1}, nil
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.AddUserPasswordData(context.Background(), passData)
 		require.NoError(t, err)
+		require.Equal(t, "id1", passData.ID)
 	})
 
-	t.Run("check_card_err", func(t *testing.T) {
+	t.Run("check_data_err", func(t *testing.T) {
 
 		mockHelper := NewMockDomainHelper(ctrl)
 
@@ -181,6 +290,7 @@ func TestAddUserPasswordData(t *testing.T) {
 			Login:    "Login",
 			Passwrod: "Passwod",
 		}
+
 		testErr := errors.New("testErr")
 		mockHelper.EXPECT().CheckUserPasswordData(gomock.Any()).DoAndReturn(func(crd *domain.UserPasswordData) error {
 			assert.Same(t, passData, crd)
@@ -192,7 +302,7 @@ func TestAddUserPasswordData(t *testing.T) {
 		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 	})
 
-	t.Run("emcrypt_err", func(t *testing.T) {
+	t.Run("encrypt_err", func(t *testing.T) {
 
 		mockHelper := NewMockDomainHelper(ctrl)
 
@@ -245,30 +355,18 @@ func TestAddUserPasswordData(t *testing.T) {
 		mockStorage := NewMockAppStorage(ctrl)
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
 
-		encryptedCnt := "encrypted"
-		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
-
-			assert.Equal(t, masterKey, key)
-			cnt, err := json.Marshal(passData)
-			require.NoError(t, err)
-			assert.JSONEq(t, string(cnt), content)
-			return encryptedCnt, nil
-		}).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), gomock.Any(), gomock.Any()).Return("lookupKey").Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
 		testErr := errors.New("testErr")
-		mockServer.EXPECT().CreateUserPasswordData(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, dt *domain.EncryptedUserPasswordData) error {
-			require.NotNil(t, dt)
-			assert.Equal(t, passData.Hint, dt.Hint)
-			assert.Equal(t, encryptedCnt, dt.Content)
-			return testErr
-		}).Times(1)
+		mockServer.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(nil, testErr).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.AddUserPasswordData(context.Background(), passData)
 		require.ErrorIs(t, err, testErr)
+		require.Empty(t, passData.ID)
 	})
-
 }
 
 func TestUpdateUserPasswordData(t *testing.T) {
@@ -293,8 +391,15 @@ func TestUpdateUserPasswordData(t *testing.T) {
 		masterKey := "masterKey"
 		mockStorage := NewMockAppStorage(ctrl)
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
+		mockStorage.EXPECT().GetUserPasswordData(passData.Hint).Return(&domain.UserPasswordData{
+			ID:   "id1",
+			Hint: "Hint",
+		}, nil).Times(1)
 
 		encryptedCnt := "encrypted"
+		encryptedName := "encryptedName"
+		mockHelper.EXPECT().EncryptShortData(masterKey, passData.Hint).Return(encryptedName, nil).Times(1)
+		mockHelper.EXPECT().BlindIndex(masterKey, domain.SecretItemUserPassword, passData.Hint).Return("lookupKey").Times(1)
 		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
 
 			assert.Equal(t, masterKey, key)
@@ -305,11 +410,13 @@ func TestUpdateUserPasswordData(t *testing.T) {
 		}).Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
-		mockServer.EXPECT().UpdateUserPasswordData(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, dt *domain.EncryptedUserPasswordData) error {
-			require.NotNil(t, dt)
-			assert.Equal(t, passData.Hint, dt.Hint)
-			assert.Equal(t, encryptedCnt, dt.Content)
-			return nil
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			require.NotNil(t, item)
+			assert.Equal(t, "id1", item.ID)
+			assert.Equal(t, encryptedName, item.Name)
+			assert.Equal(t, "lookupKey", item.LookupKey)
+			assert.Equal(t, encryptedCnt, item.Content)
+			return &domain.SecretItemRevision{ID: item.ID, Revision: item.Revision + 1}, nil
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
@@ -317,7 +424,7 @@ func TestUpdateUserPasswordData(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	t.Run("check_card_err", func(t *testing.T) {
+	t.Run("check_data_err", func(t *testing.T) {
 
 		mockHelper := NewMockDomainHelper(ctrl)
 
@@ -326,6 +433,7 @@ func TestUpdateUserPasswordData(t *testing.T) {
 			Login:    "Login",
 			Passwrod: "Passwod",
 		}
+
 		testErr := errors.New("testErr")
 		mockHelper.EXPECT().CheckUserPasswordData(gomock.Any()).DoAndReturn(func(crd *domain.UserPasswordData) error {
 			assert.Same(t, passData, crd)
@@ -337,11 +445,32 @@ func TestUpdateUserPasswordData(t *testing.T) {
 		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 	})
 
+	t.Run("not_exists_err", func(t *testing.T) {
+
+		mockHelper := NewMockDomainHelper(ctrl)
+
+		passData := &domain.UserPasswordData{
+			Hint:     "Hint",
+			Login:    "Login",
+			Passwrod: "Passwod",
+		}
+
+		mockHelper.EXPECT().CheckUserPasswordData(gomock.Any()).Return(nil).Times(1)
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetUserPasswordData(passData.Hint).Return(nil, domain.ErrClientInternal).Times(1)
+
+		da := app.NewDataAccessor().AppStorage(mockStorage).DomainHelper(mockHelper)
+		err := da.UpdateUserPasswordData(context.Background(), passData)
+		require.ErrorIs(t, err, domain.ErrClientInternal)
+	})
+
 	t.Run("emcrypt_err", func(t *testing.T) {
 
 		mockHelper := NewMockDomainHelper(ctrl)
 
 		passData := &domain.UserPasswordData{
+			ID:       "id1",
 			Hint:     "Hint",
 			Login:    "Login",
 			Passwrod: "Passwod",
@@ -376,6 +505,7 @@ func TestUpdateUserPasswordData(t *testing.T) {
 		mockHelper := NewMockDomainHelper(ctrl)
 
 		passData := &domain.UserPasswordData{
+			ID:       "id1",
 			Hint:     "Hint",
 			Login:    "Login",
 			Passwrod: "Passwod",
@@ -390,30 +520,21 @@ func TestUpdateUserPasswordData(t *testing.T) {
 		mockStorage := NewMockAppStorage(ctrl)
 		mockStorage.EXPECT().GetMasterPassword().Return(masterKey).Times(1)
 
-		encryptedCnt := "encrypted"
-		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, content string) (string, error) {
-
-			assert.Equal(t, masterKey, key)
-			cnt, err := json.Marshal(passData)
-			require.NoError(t, err)
-			assert.JSONEq(t, string(cnt), content)
-			return encryptedCnt, nil
-		}).Times(1)
+		mockHelper.EXPECT().EncryptShortData(gomock.Any(), gomock.Any()).Return("encrypted", nil).Times(2)
+		mockHelper.EXPECT().BlindIndex(gomock.Any(), gomock.Any(), gomock.Any()).Return("lookupKey").Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
 		testErr := errors.New("testErr")
-		mockServer.EXPECT().UpdateUserPasswordData(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, dt *domain.EncryptedUserPasswordData) error {
-			require.NotNil(t, dt)
-			assert.Equal(t, passData.Hint, dt.Hint)
-			assert.Equal(t, encryptedCnt, dt.Content)
-			return testErr
+		mockServer.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			require.NotNil(t, item)
+			assert.Equal(t, passData.ID, item.ID)
+			return nil, testErr
 		}).Times(1)
 
 		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage).DomainHelper(mockHelper)
 		err := da.UpdateUserPasswordData(context.Background(), passData)
 		require.ErrorIs(t, err, testErr)
 	})
-
 }
 
 func TestDeleteUserPasswordData(t *testing.T) {
@@ -422,32 +543,53 @@ func TestDeleteUserPasswordData(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
 
-		hint := "yandex"
+		hint := "Hint"
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetUserPasswordData(hint).Return(&domain.UserPasswordData{
+			ID:       "id1",
+			Revision: 1,
+			Hint:     hint,
+		}, nil).Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
-		mockServer.EXPECT().DeleteUserPasswordData(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, hnt string) error {
-			assert.Equal(t, hint, hnt)
-			return nil
-		}).Times(1)
+		mockServer.EXPECT().DeleteItem(gomock.Any(), "id1", int64(1)).Return(nil).Times(1)
 
-		da := app.NewDataAccessor().AppSever(mockServer)
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
 		err := da.DeleteUserPasswordData(context.Background(), hint)
 		require.NoError(t, err)
 	})
 
+	t.Run("not_exists_err", func(t *testing.T) {
+
+		hint := "Hint"
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetUserPasswordData(hint).Return(nil, domain.ErrClientInternal).Times(1)
+
+		da := app.NewDataAccessor().AppStorage(mockStorage)
+		err := da.DeleteUserPasswordData(context.Background(), hint)
+		require.ErrorIs(t, err, domain.ErrClientInternal)
+	})
+
 	t.Run("server_err", func(t *testing.T) {
 
-		hint := "yandex"
+		hint := "Hint"
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetUserPasswordData(hint).Return(&domain.UserPasswordData{
+			ID:       "id1",
+			Revision: 1,
+			Hint:     hint,
+		}, nil).Times(1)
 
 		mockServer := NewMockAppServer(ctrl)
 		testErr := errors.New("testErr")
-		mockServer.EXPECT().DeleteUserPasswordData(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, hnt string) error {
-			assert.Equal(t, hint, hnt)
-			return testErr
-		}).Times(1)
+		mockServer.EXPECT().DeleteItem(gomock.Any(), "id1", int64(1)).Return(testErr).Times(1)
 
-		da := app.NewDataAccessor().AppSever(mockServer)
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
 		err := da.DeleteUserPasswordData(context.Background(), hint)
 		require.ErrorIs(t, err, testErr)
+
 	})
 }
@@ -0,0 +1,93 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("apply_and_reconnect", func(t *testing.T) {
+		ctx, cancelFn := context.WithCancel(context.Background())
+		defer cancelFn()
+
+		seq := int64(2)
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetChangeSeq().DoAndReturn(func() int64 { return seq }).AnyTimes()
+		mockStorage.EXPECT().SetChangeSeq(gomock.Any()).Do(func(s int64) { seq = s }).Times(1)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterKey").Times(1)
+		mockStorage.EXPECT().ApplyBankCardChanges(nil, []string{"id1"}).Times(1)
+		mockStorage.EXPECT().ApplyFileMetadataChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplySecureNoteChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyAuthenticatorChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplySSHKeyChanges(nil, nil).Times(1)
+		mockStorage.EXPECT().ApplyUserPasswordDataChanges(nil, nil).Times(1)
+
+		mockServer := NewMockAppServer(ctrl)
+		gomock.InOrder(
+			mockServer.EXPECT().Watch(gomock.Any(), int64(2), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, seq int64, onEvent func(event *domain.ItemChangeEvent)) error {
+					// already applied change
+					onEvent(&domain.ItemChangeEvent{Seq: 2, Kind: domain.ItemUpdated, Type: domain.SecretItemBankCard})
+					onEvent(&domain.ItemChangeEvent{Seq: 3, Kind: domain.ItemDeleted, ItemID: "id1", Type: domain.SecretItemBankCard})
+					return domain.ErrServerIsNotResponding
+				}).Times(1),
+			// resumed from the last applied change
+			mockServer.EXPECT().Watch(gomock.Any(), int64(3), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, seq int64, onEvent func(event *domain.ItemChangeEvent)) error {
+					cancelFn()
+					return nil
+				}).Times(1),
+		)
+		mockServer.EXPECT().GetChangesSince(gomock.Any(), int64(2)).Return(&domain.SecretItemChanges{
+			Seq: 3,
+			Deleted: []domain.DeletedSecretItem{
+				{
+					ID:   "id1",
+					Type: domain.SecretItemBankCard,
+				},
+			},
+		}, nil).Times(1)
+
+		var changed []domain.SecretItemType
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
+		da.WatchChanges(ctx, 10*time.Millisecond, func(itemType domain.SecretItemType) {
+			changed = append(changed, itemType)
+		})
+
+		require.Equal(t, []domain.SecretItemType{domain.SecretItemBankCard}, changed)
+		assert.Equal(t, int64(3), seq)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		ctx, cancelFn := context.WithCancel(context.Background())
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetChangeSeq().Return(int64(0)).AnyTimes()
+
+		mockServer := NewMockAppServer(ctrl)
+		mockServer.EXPECT().Watch(gomock.Any(), int64(0), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, seq int64, onEvent func(event *domain.ItemChangeEvent)) error {
+				return domain.ErrServerIsNotResponding
+			}).MinTimes(1)
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancelFn()
+		}()
+
+		da := app.NewDataAccessor().AppSever(mockServer).AppStorage(mockStorage)
+		da.WatchChanges(ctx, 10*time.Millisecond, func(itemType domain.SecretItemType) {
+			t.Fatal("unexpected change")
+		})
+	})
+}
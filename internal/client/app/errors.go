@@ -1,8 +1,22 @@
 package app
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
 
 var (
 	ErrUserData             = errors.New("user data incorrect")
 	ErrSrvInterationTimeout = errors.New("server not available")
 )
+
+// errorMessage returns the text shown to the user: the server message with wrong fields and retry delay
+// if the error came from the server, otherwise the error text.
+func errorMessage(err error) string {
+	var dErr *domain.DetailedError
+	if errors.As(err, &dErr) {
+		return dErr.Error()
+	}
+	return err.Error()
+}
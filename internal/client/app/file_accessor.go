@@ -18,6 +18,7 @@ type fileAccessor struct {
 	appServer  AppServer
 	appStorage AppStorage
 	helper     DomainHelper
+	keeper     *vaultKeeper
 	wg         sync.WaitGroup
 	stopCh     chan struct{}
 }
@@ -37,6 +38,11 @@ func (fl *fileAccessor) DomainHelper(helper DomainHelper) *fileAccessor {
 	return fl
 }
 
+func (fl *fileAccessor) VaultKeeper(keeper *vaultKeeper) *fileAccessor {
+	fl.keeper = keeper
+	return fl
+}
+
 func (fl *fileAccessor) Stop(ctx context.Context) {
 	close(fl.stopCh)
 
@@ -65,6 +71,12 @@ func (fl *fileAccessor) GetFileInfoList(ctx context.Context) error {
 		return err
 	} else {
 		fl.appStorage.SetFilesInfo(lst)
+		if fl.keeper != nil {
+			fl.keeper.SetFiles(lst)
+			if err := fl.keeper.Save(fl.appStorage.GetMasterPassword()); err != nil {
+				log.Warnf("%v - can't save local vault: %v", action, err.Error())
+			}
+		}
 	}
 	log.Debugf("%v complete", action)
 	return nil
@@ -19,7 +19,6 @@ func (fl *fileAccessor) UploadFile(ctx context.Context,
 
 	log := GetMainLogger()
 	action := domain.GetAction(1)
-	log.Debugf("%v start", action)
 
 	if err := fl.helper.CheckFileForRead(info); err != nil {
 		err := fmt.Errorf("%w - %v error - upload file err %v", domain.ErrClientDataIncorrect, action, err.Error())
@@ -28,6 +27,45 @@ func (fl *fileAccessor) UploadFile(ctx context.Context,
 		return
 	}
 
+	fl.upload(ctx, info, func() (domain.StreamFileReader, error) {
+		return fl.helper.CreateStreamFileReader(info)
+	}, progerssFn, cancelChan, errorChan)
+}
+
+// UploadData uploads the data kept in memory as the file with the name; the data is not written to the disk.
+func (fl *fileAccessor) UploadData(ctx context.Context,
+	name string,
+	data []byte,
+	progerssFn func(send int, all int),
+	cancelChan <-chan struct{},
+	errorChan chan<- error) {
+
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+
+	if err := domain.CheckFileName(name); err != nil {
+		err := fmt.Errorf("%w - %v error - upload data err %v", domain.ErrClientDataIncorrect, action, err.Error())
+		log.Warn(err.Error())
+		errorChan <- err
+		return
+	}
+
+	fl.upload(ctx, &domain.FileInfo{Name: name}, func() (domain.StreamFileReader, error) {
+		return fl.helper.CreateStreamBytesReader(data), nil
+	}, progerssFn, cancelChan, errorChan)
+}
+
+func (fl *fileAccessor) upload(ctx context.Context,
+	info *domain.FileInfo,
+	createReader func() (domain.StreamFileReader, error),
+	progerssFn func(send int, all int),
+	cancelChan <-chan struct{},
+	errorChan chan<- error) {
+
+	log := GetMainLogger()
+	action := domain.GetAction(2)
+	log.Debugf("%v start", action)
+
 	// test by local storage
 	if fl.appStorage.IsFileInfoExists(info.Name) {
 		err := fmt.Errorf("%w fileInfo %s already exists. change name", domain.ErrClientDataIncorrect, info.Name)
@@ -70,7 +108,7 @@ func (fl *fileAccessor) UploadFile(ctx context.Context,
 		defer GetMainLogger().Debugf("read goroutine complete")
 		defer opWg.Done()
 
-		reader, err := fl.helper.CreateStreamFileReader(info)
+		reader, err := createReader()
 		if err != nil {
 			err := fmt.Errorf("%w upload file %s err %s", domain.ErrClientInternal, info.Name, err.Error())
 			log.Warn(err.Error())
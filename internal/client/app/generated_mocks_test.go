@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/StasMerzlyakov/gophkeeper/internal/client/app (interfaces: AppServer,AppView,Pinger,DomainHelper,AppStorage)
+// Source: github.com/StasMerzlyakov/gophkeeper/internal/client/app (interfaces: AppServer,AppView,Pinger,DomainHelper,AppStorage,LocalVault)
 
 // Package app_test is a generated GoMock package.
 package app_test
@@ -7,6 +7,7 @@ package app_test
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	gomock "github.com/golang/mock/gomock"
@@ -50,20 +51,6 @@ func (mr *MockAppServerMockRecorder) CheckEMail(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEMail", reflect.TypeOf((*MockAppServer)(nil).CheckEMail), arg0, arg1)
 }
 
-// CreateBankCard mocks base method.
-func (m *MockAppServer) CreateBankCard(arg0 context.Context, arg1 *domain.EncryptedBankCard) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBankCard", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CreateBankCard indicates an expected call of CreateBankCard.
-func (mr *MockAppServerMockRecorder) CreateBankCard(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankCard", reflect.TypeOf((*MockAppServer)(nil).CreateBankCard), arg0, arg1)
-}
-
 // CreateFileReceiver mocks base method.
 func (m *MockAppServer) CreateFileReceiver(arg0 context.Context, arg1 string) (domain.StreamFileReader, error) {
 	m.ctrl.T.Helper()
@@ -94,32 +81,34 @@ func (mr *MockAppServerMockRecorder) CreateFileSender(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFileSender", reflect.TypeOf((*MockAppServer)(nil).CreateFileSender), arg0)
 }
 
-// CreateUserPasswordData mocks base method.
-func (m *MockAppServer) CreateUserPasswordData(arg0 context.Context, arg1 *domain.EncryptedUserPasswordData) error {
+// CreateItem mocks base method.
+func (m *MockAppServer) CreateItem(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserPasswordData", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateUserPasswordData indicates an expected call of CreateUserPasswordData.
-func (mr *MockAppServerMockRecorder) CreateUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockAppServerMockRecorder) CreateItem(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserPasswordData", reflect.TypeOf((*MockAppServer)(nil).CreateUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockAppServer)(nil).CreateItem), arg0, arg1)
 }
 
-// DeleteBankCard mocks base method.
-func (m *MockAppServer) DeleteBankCard(arg0 context.Context, arg1 string) error {
+// CreateSecureNote mocks base method.
+func (m *MockAppServer) CreateSecureNote(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBankCard", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateSecureNote", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteBankCard indicates an expected call of DeleteBankCard.
-func (mr *MockAppServerMockRecorder) DeleteBankCard(arg0, arg1 interface{}) *gomock.Call {
+// CreateSecureNote indicates an expected call of CreateSecureNote.
+func (mr *MockAppServerMockRecorder) CreateSecureNote(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankCard", reflect.TypeOf((*MockAppServer)(nil).DeleteBankCard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecureNote", reflect.TypeOf((*MockAppServer)(nil).CreateSecureNote), arg0, arg1)
 }
 
 // DeleteFileInfo mocks base method.
@@ -136,33 +125,47 @@ func (mr *MockAppServerMockRecorder) DeleteFileInfo(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileInfo", reflect.TypeOf((*MockAppServer)(nil).DeleteFileInfo), arg0, arg1)
 }
 
-// DeleteUserPasswordData mocks base method.
-func (m *MockAppServer) DeleteUserPasswordData(arg0 context.Context, arg1 string) error {
+// DeleteItem mocks base method.
+func (m *MockAppServer) DeleteItem(arg0 context.Context, arg1 string, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUserPasswordData", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteItem", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUserPasswordData indicates an expected call of DeleteUserPasswordData.
-func (mr *MockAppServerMockRecorder) DeleteUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockAppServerMockRecorder) DeleteItem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockAppServer)(nil).DeleteItem), arg0, arg1, arg2)
+}
+
+// DeleteSecureNote mocks base method.
+func (m *MockAppServer) DeleteSecureNote(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecureNote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecureNote indicates an expected call of DeleteSecureNote.
+func (mr *MockAppServerMockRecorder) DeleteSecureNote(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPasswordData", reflect.TypeOf((*MockAppServer)(nil).DeleteUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecureNote", reflect.TypeOf((*MockAppServer)(nil).DeleteSecureNote), arg0, arg1, arg2)
 }
 
-// GetBankCardList mocks base method.
-func (m *MockAppServer) GetBankCardList(arg0 context.Context) ([]domain.EncryptedBankCard, error) {
+// GetChangesSince mocks base method.
+func (m *MockAppServer) GetChangesSince(arg0 context.Context, arg1 int64) (*domain.SecretItemChanges, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankCardList", arg0)
-	ret0, _ := ret[0].([]domain.EncryptedBankCard)
+	ret := m.ctrl.Call(m, "GetChangesSince", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemChanges)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBankCardList indicates an expected call of GetBankCardList.
-func (mr *MockAppServerMockRecorder) GetBankCardList(arg0 interface{}) *gomock.Call {
+// GetChangesSince indicates an expected call of GetChangesSince.
+func (mr *MockAppServerMockRecorder) GetChangesSince(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankCardList", reflect.TypeOf((*MockAppServer)(nil).GetBankCardList), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangesSince", reflect.TypeOf((*MockAppServer)(nil).GetChangesSince), arg0, arg1)
 }
 
 // GetFileInfoList mocks base method.
@@ -195,19 +198,49 @@ func (mr *MockAppServerMockRecorder) GetHelloData(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelloData", reflect.TypeOf((*MockAppServer)(nil).GetHelloData), arg0)
 }
 
-// GetUserPasswordDataList mocks base method.
-func (m *MockAppServer) GetUserPasswordDataList(arg0 context.Context) ([]domain.EncryptedUserPasswordData, error) {
+// GetItem mocks base method.
+func (m *MockAppServer) GetItem(arg0 context.Context, arg1 string) (*domain.EncryptedSecretItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPasswordDataList", arg0)
-	ret0, _ := ret[0].([]domain.EncryptedUserPasswordData)
+	ret := m.ctrl.Call(m, "GetItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.EncryptedSecretItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserPasswordDataList indicates an expected call of GetUserPasswordDataList.
-func (mr *MockAppServerMockRecorder) GetUserPasswordDataList(arg0 interface{}) *gomock.Call {
+// GetItem indicates an expected call of GetItem.
+func (mr *MockAppServerMockRecorder) GetItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockAppServer)(nil).GetItem), arg0, arg1)
+}
+
+// GetRevision mocks base method.
+func (m *MockAppServer) GetRevision(arg0 context.Context, arg1 string, arg2 int64) (*domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockAppServerMockRecorder) GetRevision(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockAppServer)(nil).GetRevision), arg0, arg1, arg2)
+}
+
+// GetSessionList mocks base method.
+func (m *MockAppServer) GetSessionList(arg0 context.Context) ([]domain.SessionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionList", arg0)
+	ret0, _ := ret[0].([]domain.SessionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionList indicates an expected call of GetSessionList.
+func (mr *MockAppServerMockRecorder) GetSessionList(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordDataList", reflect.TypeOf((*MockAppServer)(nil).GetUserPasswordDataList), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionList", reflect.TypeOf((*MockAppServer)(nil).GetSessionList), arg0)
 }
 
 // InitMasterKey mocks base method.
@@ -224,6 +257,66 @@ func (mr *MockAppServerMockRecorder) InitMasterKey(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitMasterKey", reflect.TypeOf((*MockAppServer)(nil).InitMasterKey), arg0, arg1)
 }
 
+// ListItems mocks base method.
+func (m *MockAppServer) ListItems(arg0 context.Context, arg1 domain.SecretItemType) ([]domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItems", arg0, arg1)
+	ret0, _ := ret[0].([]domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockAppServerMockRecorder) ListItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockAppServer)(nil).ListItems), arg0, arg1)
+}
+
+// ListRevisions mocks base method.
+func (m *MockAppServer) ListRevisions(arg0 context.Context, arg1 string) ([]domain.SecretItemHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.SecretItemHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockAppServerMockRecorder) ListRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockAppServer)(nil).ListRevisions), arg0, arg1)
+}
+
+// ListSecureNotes mocks base method.
+func (m *MockAppServer) ListSecureNotes(arg0 context.Context) ([]domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecureNotes", arg0)
+	ret0, _ := ret[0].([]domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecureNotes indicates an expected call of ListSecureNotes.
+func (mr *MockAppServerMockRecorder) ListSecureNotes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecureNotes", reflect.TypeOf((*MockAppServer)(nil).ListSecureNotes), arg0)
+}
+
+// ListTrash mocks base method.
+func (m *MockAppServer) ListTrash(arg0 context.Context) ([]domain.TrashEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0)
+	ret0, _ := ret[0].([]domain.TrashEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockAppServerMockRecorder) ListTrash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockAppServer)(nil).ListTrash), arg0)
+}
+
 // Login mocks base method.
 func (m *MockAppServer) Login(arg0 context.Context, arg1 *domain.EMailData) error {
 	m.ctrl.T.Helper()
@@ -280,6 +373,20 @@ func (mr *MockAppServerMockRecorder) Ping(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockAppServer)(nil).Ping), arg0)
 }
 
+// PurgeTrash mocks base method.
+func (m *MockAppServer) PurgeTrash(arg0 context.Context, arg1 domain.SecretItemType, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockAppServerMockRecorder) PurgeTrash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockAppServer)(nil).PurgeTrash), arg0, arg1, arg2)
+}
+
 // Registrate mocks base method.
 func (m *MockAppServer) Registrate(arg0 context.Context, arg1 *domain.EMailData) error {
 	m.ctrl.T.Helper()
@@ -294,6 +401,35 @@ func (mr *MockAppServerMockRecorder) Registrate(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Registrate", reflect.TypeOf((*MockAppServer)(nil).Registrate), arg0, arg1)
 }
 
+// RestoreFromTrash mocks base method.
+func (m *MockAppServer) RestoreFromTrash(arg0 context.Context, arg1 domain.SecretItemType, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFromTrash", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFromTrash indicates an expected call of RestoreFromTrash.
+func (mr *MockAppServerMockRecorder) RestoreFromTrash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFromTrash", reflect.TypeOf((*MockAppServer)(nil).RestoreFromTrash), arg0, arg1, arg2)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockAppServer) RestoreRevision(arg0 context.Context, arg1 string, arg2, arg3 int64) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockAppServerMockRecorder) RestoreRevision(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockAppServer)(nil).RestoreRevision), arg0, arg1, arg2, arg3)
+}
+
 // Start mocks base method.
 func (m *MockAppServer) Start() {
 	m.ctrl.T.Helper()
@@ -318,32 +454,62 @@ func (mr *MockAppServerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockAppServer)(nil).Stop))
 }
 
-// UpdateBankCard mocks base method.
-func (m *MockAppServer) UpdateBankCard(arg0 context.Context, arg1 *domain.EncryptedBankCard) error {
+// TerminateSession mocks base method.
+func (m *MockAppServer) TerminateSession(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBankCard", arg0, arg1)
+	ret := m.ctrl.Call(m, "TerminateSession", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateBankCard indicates an expected call of UpdateBankCard.
-func (mr *MockAppServerMockRecorder) UpdateBankCard(arg0, arg1 interface{}) *gomock.Call {
+// TerminateSession indicates an expected call of TerminateSession.
+func (mr *MockAppServerMockRecorder) TerminateSession(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBankCard", reflect.TypeOf((*MockAppServer)(nil).UpdateBankCard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateSession", reflect.TypeOf((*MockAppServer)(nil).TerminateSession), arg0, arg1)
 }
 
-// UpdateUserPasswordData mocks base method.
-func (m *MockAppServer) UpdateUserPasswordData(arg0 context.Context, arg1 *domain.EncryptedUserPasswordData) error {
+// UpdateItem mocks base method.
+func (m *MockAppServer) UpdateItem(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockAppServerMockRecorder) UpdateItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockAppServer)(nil).UpdateItem), arg0, arg1)
+}
+
+// UpdateSecureNote mocks base method.
+func (m *MockAppServer) UpdateSecureNote(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecureNote", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecureNote indicates an expected call of UpdateSecureNote.
+func (mr *MockAppServerMockRecorder) UpdateSecureNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecureNote", reflect.TypeOf((*MockAppServer)(nil).UpdateSecureNote), arg0, arg1)
+}
+
+// Watch mocks base method.
+func (m *MockAppServer) Watch(arg0 context.Context, arg1 int64, arg2 func(*domain.ItemChangeEvent)) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserPasswordData", arg0, arg1)
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateUserPasswordData indicates an expected call of UpdateUserPasswordData.
-func (mr *MockAppServerMockRecorder) UpdateUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// Watch indicates an expected call of Watch.
+func (mr *MockAppServerMockRecorder) Watch(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordData", reflect.TypeOf((*MockAppServer)(nil).UpdateUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockAppServer)(nil).Watch), arg0, arg1, arg2)
 }
 
 // MockAppView is a mock of AppView interface.
@@ -393,6 +559,54 @@ func (mr *MockAppViewMockRecorder) CreateProgressBar(arg0, arg1, arg2, arg3 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProgressBar", reflect.TypeOf((*MockAppView)(nil).CreateProgressBar), arg0, arg1, arg2, arg3)
 }
 
+// RefreshItemsView mocks base method.
+func (m *MockAppView) RefreshItemsView(arg0 domain.SecretItemType) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RefreshItemsView", arg0)
+}
+
+// RefreshItemsView indicates an expected call of RefreshItemsView.
+func (mr *MockAppViewMockRecorder) RefreshItemsView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshItemsView", reflect.TypeOf((*MockAppView)(nil).RefreshItemsView), arg0)
+}
+
+// ShowAuthenticatorConflictView mocks base method.
+func (m *MockAppView) ShowAuthenticatorConflictView(arg0 *domain.Authenticator) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowAuthenticatorConflictView", arg0)
+}
+
+// ShowAuthenticatorConflictView indicates an expected call of ShowAuthenticatorConflictView.
+func (mr *MockAppViewMockRecorder) ShowAuthenticatorConflictView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowAuthenticatorConflictView", reflect.TypeOf((*MockAppView)(nil).ShowAuthenticatorConflictView), arg0)
+}
+
+// ShowAuthenticatorListView mocks base method.
+func (m *MockAppView) ShowAuthenticatorListView(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowAuthenticatorListView", arg0)
+}
+
+// ShowAuthenticatorListView indicates an expected call of ShowAuthenticatorListView.
+func (mr *MockAppViewMockRecorder) ShowAuthenticatorListView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowAuthenticatorListView", reflect.TypeOf((*MockAppView)(nil).ShowAuthenticatorListView), arg0)
+}
+
+// ShowBankCardConflictView mocks base method.
+func (m *MockAppView) ShowBankCardConflictView(arg0 *domain.BankCardView) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowBankCardConflictView", arg0)
+}
+
+// ShowBankCardConflictView indicates an expected call of ShowBankCardConflictView.
+func (mr *MockAppViewMockRecorder) ShowBankCardConflictView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowBankCardConflictView", reflect.TypeOf((*MockAppView)(nil).ShowBankCardConflictView), arg0)
+}
+
 // ShowBankCardListView mocks base method.
 func (m *MockAppView) ShowBankCardListView(arg0 []string) {
 	m.ctrl.T.Helper()
@@ -417,6 +631,30 @@ func (mr *MockAppViewMockRecorder) ShowDataAccessView() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowDataAccessView", reflect.TypeOf((*MockAppView)(nil).ShowDataAccessView))
 }
 
+// ShowDeleteConflictView mocks base method.
+func (m *MockAppView) ShowDeleteConflictView(arg0 domain.SecretItemType, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowDeleteConflictView", arg0, arg1)
+}
+
+// ShowDeleteConflictView indicates an expected call of ShowDeleteConflictView.
+func (mr *MockAppViewMockRecorder) ShowDeleteConflictView(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowDeleteConflictView", reflect.TypeOf((*MockAppView)(nil).ShowDeleteConflictView), arg0, arg1)
+}
+
+// ShowEditAuthenticatorView mocks base method.
+func (m *MockAppView) ShowEditAuthenticatorView(arg0 *domain.Authenticator) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowEditAuthenticatorView", arg0)
+}
+
+// ShowEditAuthenticatorView indicates an expected call of ShowEditAuthenticatorView.
+func (mr *MockAppViewMockRecorder) ShowEditAuthenticatorView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowEditAuthenticatorView", reflect.TypeOf((*MockAppView)(nil).ShowEditAuthenticatorView), arg0)
+}
+
 // ShowEditBankCardView mocks base method.
 func (m *MockAppView) ShowEditBankCardView(arg0 *domain.BankCard) {
 	m.ctrl.T.Helper()
@@ -429,6 +667,30 @@ func (mr *MockAppViewMockRecorder) ShowEditBankCardView(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowEditBankCardView", reflect.TypeOf((*MockAppView)(nil).ShowEditBankCardView), arg0)
 }
 
+// ShowEditSSHKeyView mocks base method.
+func (m *MockAppView) ShowEditSSHKeyView(arg0 *domain.SSHKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowEditSSHKeyView", arg0)
+}
+
+// ShowEditSSHKeyView indicates an expected call of ShowEditSSHKeyView.
+func (mr *MockAppViewMockRecorder) ShowEditSSHKeyView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowEditSSHKeyView", reflect.TypeOf((*MockAppView)(nil).ShowEditSSHKeyView), arg0)
+}
+
+// ShowEditSecureNoteView mocks base method.
+func (m *MockAppView) ShowEditSecureNoteView(arg0 *domain.SecureNote) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowEditSecureNoteView", arg0)
+}
+
+// ShowEditSecureNoteView indicates an expected call of ShowEditSecureNoteView.
+func (mr *MockAppViewMockRecorder) ShowEditSecureNoteView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowEditSecureNoteView", reflect.TypeOf((*MockAppView)(nil).ShowEditSecureNoteView), arg0)
+}
+
 // ShowEditUserPasswordDataView mocks base method.
 func (m *MockAppView) ShowEditUserPasswordDataView(arg0 *domain.UserPasswordData) {
 	m.ctrl.T.Helper()
@@ -466,15 +728,27 @@ func (mr *MockAppViewMockRecorder) ShowFileInfoListView(arg0 interface{}) *gomoc
 }
 
 // ShowFileInfoView mocks base method.
-func (m *MockAppView) ShowFileInfoView(arg0 *domain.FileInfo) {
+func (m *MockAppView) ShowFileInfoView(arg0 *domain.FileInfo, arg1 *domain.FileMetadata) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "ShowFileInfoView", arg0)
+	m.ctrl.Call(m, "ShowFileInfoView", arg0, arg1)
 }
 
 // ShowFileInfoView indicates an expected call of ShowFileInfoView.
-func (mr *MockAppViewMockRecorder) ShowFileInfoView(arg0 interface{}) *gomock.Call {
+func (mr *MockAppViewMockRecorder) ShowFileInfoView(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowFileInfoView", reflect.TypeOf((*MockAppView)(nil).ShowFileInfoView), arg0, arg1)
+}
+
+// ShowItemHistoryView mocks base method.
+func (m *MockAppView) ShowItemHistoryView(arg0 domain.SecretItemType, arg1 string, arg2 []domain.ItemRevision) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowItemHistoryView", arg0, arg1, arg2)
+}
+
+// ShowItemHistoryView indicates an expected call of ShowItemHistoryView.
+func (mr *MockAppViewMockRecorder) ShowItemHistoryView(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowFileInfoView", reflect.TypeOf((*MockAppView)(nil).ShowFileInfoView), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowItemHistoryView", reflect.TypeOf((*MockAppView)(nil).ShowItemHistoryView), arg0, arg1, arg2)
 }
 
 // ShowLogOTPView mocks base method.
@@ -525,6 +799,18 @@ func (mr *MockAppViewMockRecorder) ShowMsg(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowMsg", reflect.TypeOf((*MockAppView)(nil).ShowMsg), arg0)
 }
 
+// ShowNewAuthenticatorView mocks base method.
+func (m *MockAppView) ShowNewAuthenticatorView(arg0 *domain.Authenticator) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowNewAuthenticatorView", arg0)
+}
+
+// ShowNewAuthenticatorView indicates an expected call of ShowNewAuthenticatorView.
+func (mr *MockAppViewMockRecorder) ShowNewAuthenticatorView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowNewAuthenticatorView", reflect.TypeOf((*MockAppView)(nil).ShowNewAuthenticatorView), arg0)
+}
+
 // ShowNewBankCardView mocks base method.
 func (m *MockAppView) ShowNewBankCardView() {
 	m.ctrl.T.Helper()
@@ -537,6 +823,30 @@ func (mr *MockAppViewMockRecorder) ShowNewBankCardView() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowNewBankCardView", reflect.TypeOf((*MockAppView)(nil).ShowNewBankCardView))
 }
 
+// ShowNewSSHKeyView mocks base method.
+func (m *MockAppView) ShowNewSSHKeyView() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowNewSSHKeyView")
+}
+
+// ShowNewSSHKeyView indicates an expected call of ShowNewSSHKeyView.
+func (mr *MockAppViewMockRecorder) ShowNewSSHKeyView() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowNewSSHKeyView", reflect.TypeOf((*MockAppView)(nil).ShowNewSSHKeyView))
+}
+
+// ShowNewSecureNoteView mocks base method.
+func (m *MockAppView) ShowNewSecureNoteView() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowNewSecureNoteView")
+}
+
+// ShowNewSecureNoteView indicates an expected call of ShowNewSecureNoteView.
+func (mr *MockAppViewMockRecorder) ShowNewSecureNoteView() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowNewSecureNoteView", reflect.TypeOf((*MockAppView)(nil).ShowNewSecureNoteView))
+}
+
 // ShowNewUserPasswordDataView mocks base method.
 func (m *MockAppView) ShowNewUserPasswordDataView() {
 	m.ctrl.T.Helper()
@@ -549,6 +859,30 @@ func (mr *MockAppViewMockRecorder) ShowNewUserPasswordDataView() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowNewUserPasswordDataView", reflect.TypeOf((*MockAppView)(nil).ShowNewUserPasswordDataView))
 }
 
+// ShowOfflineMode mocks base method.
+func (m *MockAppView) ShowOfflineMode(arg0 time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowOfflineMode", arg0)
+}
+
+// ShowOfflineMode indicates an expected call of ShowOfflineMode.
+func (mr *MockAppViewMockRecorder) ShowOfflineMode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowOfflineMode", reflect.TypeOf((*MockAppView)(nil).ShowOfflineMode), arg0)
+}
+
+// ShowPendingChanges mocks base method.
+func (m *MockAppView) ShowPendingChanges(arg0 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowPendingChanges", arg0)
+}
+
+// ShowPendingChanges indicates an expected call of ShowPendingChanges.
+func (mr *MockAppViewMockRecorder) ShowPendingChanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowPendingChanges", reflect.TypeOf((*MockAppView)(nil).ShowPendingChanges), arg0)
+}
+
 // ShowRegMasterKeyView mocks base method.
 func (m *MockAppView) ShowRegMasterKeyView() {
 	m.ctrl.T.Helper()
@@ -585,78 +919,200 @@ func (mr *MockAppViewMockRecorder) ShowRegView() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowRegView", reflect.TypeOf((*MockAppView)(nil).ShowRegView))
 }
 
-// ShowUserPasswordDataListView mocks base method.
-func (m *MockAppView) ShowUserPasswordDataListView(arg0 []string) {
+// ShowSSHKeyConflictView mocks base method.
+func (m *MockAppView) ShowSSHKeyConflictView(arg0 *domain.SSHKey) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "ShowUserPasswordDataListView", arg0)
+	m.ctrl.Call(m, "ShowSSHKeyConflictView", arg0)
 }
 
-// ShowUserPasswordDataListView indicates an expected call of ShowUserPasswordDataListView.
-func (mr *MockAppViewMockRecorder) ShowUserPasswordDataListView(arg0 interface{}) *gomock.Call {
+// ShowSSHKeyConflictView indicates an expected call of ShowSSHKeyConflictView.
+func (mr *MockAppViewMockRecorder) ShowSSHKeyConflictView(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUserPasswordDataListView", reflect.TypeOf((*MockAppView)(nil).ShowUserPasswordDataListView), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSSHKeyConflictView", reflect.TypeOf((*MockAppView)(nil).ShowSSHKeyConflictView), arg0)
 }
 
-// MockPinger is a mock of Pinger interface.
-type MockPinger struct {
-	ctrl     *gomock.Controller
-	recorder *MockPingerMockRecorder
+// ShowSSHKeyListView mocks base method.
+func (m *MockAppView) ShowSSHKeyListView(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowSSHKeyListView", arg0)
 }
 
-// MockPingerMockRecorder is the mock recorder for MockPinger.
-type MockPingerMockRecorder struct {
-	mock *MockPinger
+// ShowSSHKeyListView indicates an expected call of ShowSSHKeyListView.
+func (mr *MockAppViewMockRecorder) ShowSSHKeyListView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSSHKeyListView", reflect.TypeOf((*MockAppView)(nil).ShowSSHKeyListView), arg0)
 }
 
-// NewMockPinger creates a new mock instance.
-func NewMockPinger(ctrl *gomock.Controller) *MockPinger {
-	mock := &MockPinger{ctrl: ctrl}
-	mock.recorder = &MockPingerMockRecorder{mock}
-	return mock
+// ShowSSHSignConfirmView mocks base method.
+func (m *MockAppView) ShowSSHSignConfirmView(arg0 context.Context, arg1 string, arg2 func(bool)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowSSHSignConfirmView", arg0, arg1, arg2)
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockPinger) EXPECT() *MockPingerMockRecorder {
-	return m.recorder
+// ShowSSHSignConfirmView indicates an expected call of ShowSSHSignConfirmView.
+func (mr *MockAppViewMockRecorder) ShowSSHSignConfirmView(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSSHSignConfirmView", reflect.TypeOf((*MockAppView)(nil).ShowSSHSignConfirmView), arg0, arg1, arg2)
 }
 
-// Ping mocks base method.
-func (m *MockPinger) Ping(arg0 context.Context) error {
+// ShowSecureNoteConflictView mocks base method.
+func (m *MockAppView) ShowSecureNoteConflictView(arg0 *domain.SecureNote) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ping", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	m.ctrl.Call(m, "ShowSecureNoteConflictView", arg0)
 }
 
-// Ping indicates an expected call of Ping.
-func (mr *MockPingerMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+// ShowSecureNoteConflictView indicates an expected call of ShowSecureNoteConflictView.
+func (mr *MockAppViewMockRecorder) ShowSecureNoteConflictView(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockPinger)(nil).Ping), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSecureNoteConflictView", reflect.TypeOf((*MockAppView)(nil).ShowSecureNoteConflictView), arg0)
 }
 
-// MockDomainHelper is a mock of DomainHelper interface.
-type MockDomainHelper struct {
-	ctrl     *gomock.Controller
-	recorder *MockDomainHelperMockRecorder
+// ShowSecureNoteListView mocks base method.
+func (m *MockAppView) ShowSecureNoteListView(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowSecureNoteListView", arg0)
 }
 
-// MockDomainHelperMockRecorder is the mock recorder for MockDomainHelper.
-type MockDomainHelperMockRecorder struct {
-	mock *MockDomainHelper
+// ShowSecureNoteListView indicates an expected call of ShowSecureNoteListView.
+func (mr *MockAppViewMockRecorder) ShowSecureNoteListView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSecureNoteListView", reflect.TypeOf((*MockAppView)(nil).ShowSecureNoteListView), arg0)
 }
 
-// NewMockDomainHelper creates a new mock instance.
-func NewMockDomainHelper(ctrl *gomock.Controller) *MockDomainHelper {
-	mock := &MockDomainHelper{ctrl: ctrl}
-	mock.recorder = &MockDomainHelperMockRecorder{mock}
-	return mock
+// ShowSecureNoteTooLargeView mocks base method.
+func (m *MockAppView) ShowSecureNoteTooLargeView(arg0 *domain.SecureNote) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowSecureNoteTooLargeView", arg0)
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockDomainHelper) EXPECT() *MockDomainHelperMockRecorder {
+// ShowSecureNoteTooLargeView indicates an expected call of ShowSecureNoteTooLargeView.
+func (mr *MockAppViewMockRecorder) ShowSecureNoteTooLargeView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSecureNoteTooLargeView", reflect.TypeOf((*MockAppView)(nil).ShowSecureNoteTooLargeView), arg0)
+}
+
+// ShowSessionListView mocks base method.
+func (m *MockAppView) ShowSessionListView(arg0 []domain.SessionInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowSessionListView", arg0)
+}
+
+// ShowSessionListView indicates an expected call of ShowSessionListView.
+func (mr *MockAppViewMockRecorder) ShowSessionListView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSessionListView", reflect.TypeOf((*MockAppView)(nil).ShowSessionListView), arg0)
+}
+
+// ShowTrashListView mocks base method.
+func (m *MockAppView) ShowTrashListView(arg0 []domain.TrashEntry) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowTrashListView", arg0)
+}
+
+// ShowTrashListView indicates an expected call of ShowTrashListView.
+func (mr *MockAppViewMockRecorder) ShowTrashListView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowTrashListView", reflect.TypeOf((*MockAppView)(nil).ShowTrashListView), arg0)
+}
+
+// ShowUserPasswordDataConflictView mocks base method.
+func (m *MockAppView) ShowUserPasswordDataConflictView(arg0 *domain.UserPasswordData) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowUserPasswordDataConflictView", arg0)
+}
+
+// ShowUserPasswordDataConflictView indicates an expected call of ShowUserPasswordDataConflictView.
+func (mr *MockAppViewMockRecorder) ShowUserPasswordDataConflictView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUserPasswordDataConflictView", reflect.TypeOf((*MockAppView)(nil).ShowUserPasswordDataConflictView), arg0)
+}
+
+// ShowUserPasswordDataListView mocks base method.
+func (m *MockAppView) ShowUserPasswordDataListView(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowUserPasswordDataListView", arg0)
+}
+
+// ShowUserPasswordDataListView indicates an expected call of ShowUserPasswordDataListView.
+func (mr *MockAppViewMockRecorder) ShowUserPasswordDataListView(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUserPasswordDataListView", reflect.TypeOf((*MockAppView)(nil).ShowUserPasswordDataListView), arg0)
+}
+
+// MockPinger is a mock of Pinger interface.
+type MockPinger struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingerMockRecorder
+}
+
+// MockPingerMockRecorder is the mock recorder for MockPinger.
+type MockPingerMockRecorder struct {
+	mock *MockPinger
+}
+
+// NewMockPinger creates a new mock instance.
+func NewMockPinger(ctrl *gomock.Controller) *MockPinger {
+	mock := &MockPinger{ctrl: ctrl}
+	mock.recorder = &MockPingerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPinger) EXPECT() *MockPingerMockRecorder {
 	return m.recorder
 }
 
+// Ping mocks base method.
+func (m *MockPinger) Ping(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockPingerMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockPinger)(nil).Ping), arg0)
+}
+
+// MockDomainHelper is a mock of DomainHelper interface.
+type MockDomainHelper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainHelperMockRecorder
+}
+
+// MockDomainHelperMockRecorder is the mock recorder for MockDomainHelper.
+type MockDomainHelperMockRecorder struct {
+	mock *MockDomainHelper
+}
+
+// NewMockDomainHelper creates a new mock instance.
+func NewMockDomainHelper(ctrl *gomock.Controller) *MockDomainHelper {
+	mock := &MockDomainHelper{ctrl: ctrl}
+	mock.recorder = &MockDomainHelperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainHelper) EXPECT() *MockDomainHelperMockRecorder {
+	return m.recorder
+}
+
+// BlindIndex mocks base method.
+func (m *MockDomainHelper) BlindIndex(arg0 string, arg1 domain.SecretItemType, arg2 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlindIndex", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BlindIndex indicates an expected call of BlindIndex.
+func (mr *MockDomainHelperMockRecorder) BlindIndex(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlindIndex", reflect.TypeOf((*MockDomainHelper)(nil).BlindIndex), arg0, arg1, arg2)
+}
+
 // CheckAuthPasswordComplexityLevel mocks base method.
 func (m *MockDomainHelper) CheckAuthPasswordComplexityLevel(arg0 string) bool {
 	m.ctrl.T.Helper()
@@ -671,6 +1127,20 @@ func (mr *MockDomainHelperMockRecorder) CheckAuthPasswordComplexityLevel(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAuthPasswordComplexityLevel", reflect.TypeOf((*MockDomainHelper)(nil).CheckAuthPasswordComplexityLevel), arg0)
 }
 
+// CheckAuthenticator mocks base method.
+func (m *MockDomainHelper) CheckAuthenticator(arg0 *domain.Authenticator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAuthenticator", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAuthenticator indicates an expected call of CheckAuthenticator.
+func (mr *MockDomainHelperMockRecorder) CheckAuthenticator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAuthenticator", reflect.TypeOf((*MockDomainHelper)(nil).CheckAuthenticator), arg0)
+}
+
 // CheckBankCardData mocks base method.
 func (m *MockDomainHelper) CheckBankCardData(arg0 *domain.BankCard) error {
 	m.ctrl.T.Helper()
@@ -727,6 +1197,34 @@ func (mr *MockDomainHelperMockRecorder) CheckMasterPasswordComplexityLevel(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMasterPasswordComplexityLevel", reflect.TypeOf((*MockDomainHelper)(nil).CheckMasterPasswordComplexityLevel), arg0)
 }
 
+// CheckSSHKey mocks base method.
+func (m *MockDomainHelper) CheckSSHKey(arg0 *domain.SSHKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSSHKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSSHKey indicates an expected call of CheckSSHKey.
+func (mr *MockDomainHelperMockRecorder) CheckSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSSHKey", reflect.TypeOf((*MockDomainHelper)(nil).CheckSSHKey), arg0)
+}
+
+// CheckSecureNote mocks base method.
+func (m *MockDomainHelper) CheckSecureNote(arg0 *domain.SecureNote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSecureNote", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSecureNote indicates an expected call of CheckSecureNote.
+func (mr *MockDomainHelperMockRecorder) CheckSecureNote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSecureNote", reflect.TypeOf((*MockDomainHelper)(nil).CheckSecureNote), arg0)
+}
+
 // CheckUserPasswordData mocks base method.
 func (m *MockDomainHelper) CheckUserPasswordData(arg0 *domain.UserPasswordData) error {
 	m.ctrl.T.Helper()
@@ -770,6 +1268,20 @@ func (mr *MockDomainHelperMockRecorder) CreateChunkEncrypter(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChunkEncrypter", reflect.TypeOf((*MockDomainHelper)(nil).CreateChunkEncrypter), arg0)
 }
 
+// CreateStreamBytesReader mocks base method.
+func (m *MockDomainHelper) CreateStreamBytesReader(arg0 []byte) domain.StreamFileReader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStreamBytesReader", arg0)
+	ret0, _ := ret[0].(domain.StreamFileReader)
+	return ret0
+}
+
+// CreateStreamBytesReader indicates an expected call of CreateStreamBytesReader.
+func (mr *MockDomainHelperMockRecorder) CreateStreamBytesReader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreamBytesReader", reflect.TypeOf((*MockDomainHelper)(nil).CreateStreamBytesReader), arg0)
+}
+
 // CreateStreamFileReader mocks base method.
 func (m *MockDomainHelper) CreateStreamFileReader(arg0 *domain.FileInfo) (domain.StreamFileReader, error) {
 	m.ctrl.T.Helper()
@@ -887,6 +1399,21 @@ func (mr *MockDomainHelperMockRecorder) Random32ByteString() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Random32ByteString", reflect.TypeOf((*MockDomainHelper)(nil).Random32ByteString))
 }
 
+// ReadOTPAuthQR mocks base method.
+func (m *MockDomainHelper) ReadOTPAuthQR(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOTPAuthQR", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOTPAuthQR indicates an expected call of ReadOTPAuthQR.
+func (mr *MockDomainHelperMockRecorder) ReadOTPAuthQR(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOTPAuthQR", reflect.TypeOf((*MockDomainHelper)(nil).ReadOTPAuthQR), arg0)
+}
+
 // MockAppStorage is a mock of AppStorage interface.
 type MockAppStorage struct {
 	ctrl     *gomock.Controller
@@ -910,6 +1437,20 @@ func (m *MockAppStorage) EXPECT() *MockAppStorageMockRecorder {
 	return m.recorder
 }
 
+// AddAuthenticator mocks base method.
+func (m *MockAppStorage) AddAuthenticator(arg0 *domain.Authenticator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuthenticator", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuthenticator indicates an expected call of AddAuthenticator.
+func (mr *MockAppStorageMockRecorder) AddAuthenticator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuthenticator", reflect.TypeOf((*MockAppStorage)(nil).AddAuthenticator), arg0)
+}
+
 // AddBankCard mocks base method.
 func (m *MockAppStorage) AddBankCard(arg0 *domain.BankCard) error {
 	m.ctrl.T.Helper()
@@ -938,6 +1479,34 @@ func (mr *MockAppStorageMockRecorder) AddFileInfo(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileInfo", reflect.TypeOf((*MockAppStorage)(nil).AddFileInfo), arg0)
 }
 
+// AddSSHKey mocks base method.
+func (m *MockAppStorage) AddSSHKey(arg0 *domain.SSHKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSSHKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSSHKey indicates an expected call of AddSSHKey.
+func (mr *MockAppStorageMockRecorder) AddSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSSHKey", reflect.TypeOf((*MockAppStorage)(nil).AddSSHKey), arg0)
+}
+
+// AddSecureNote mocks base method.
+func (m *MockAppStorage) AddSecureNote(arg0 *domain.SecureNote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecureNote", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecureNote indicates an expected call of AddSecureNote.
+func (mr *MockAppStorageMockRecorder) AddSecureNote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecureNote", reflect.TypeOf((*MockAppStorage)(nil).AddSecureNote), arg0)
+}
+
 // AddUserPasswordData mocks base method.
 func (m *MockAppStorage) AddUserPasswordData(arg0 *domain.UserPasswordData) error {
 	m.ctrl.T.Helper()
@@ -952,6 +1521,92 @@ func (mr *MockAppStorageMockRecorder) AddUserPasswordData(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserPasswordData", reflect.TypeOf((*MockAppStorage)(nil).AddUserPasswordData), arg0)
 }
 
+// ApplyAuthenticatorChanges mocks base method.
+func (m *MockAppStorage) ApplyAuthenticatorChanges(arg0 []domain.Authenticator, arg1 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplyAuthenticatorChanges", arg0, arg1)
+}
+
+// ApplyAuthenticatorChanges indicates an expected call of ApplyAuthenticatorChanges.
+func (mr *MockAppStorageMockRecorder) ApplyAuthenticatorChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyAuthenticatorChanges", reflect.TypeOf((*MockAppStorage)(nil).ApplyAuthenticatorChanges), arg0, arg1)
+}
+
+// ApplyBankCardChanges mocks base method.
+func (m *MockAppStorage) ApplyBankCardChanges(arg0 []domain.BankCard, arg1 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplyBankCardChanges", arg0, arg1)
+}
+
+// ApplyBankCardChanges indicates an expected call of ApplyBankCardChanges.
+func (mr *MockAppStorageMockRecorder) ApplyBankCardChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyBankCardChanges", reflect.TypeOf((*MockAppStorage)(nil).ApplyBankCardChanges), arg0, arg1)
+}
+
+// ApplyFileMetadataChanges mocks base method.
+func (m *MockAppStorage) ApplyFileMetadataChanges(arg0 []domain.FileMetadata, arg1 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplyFileMetadataChanges", arg0, arg1)
+}
+
+// ApplyFileMetadataChanges indicates an expected call of ApplyFileMetadataChanges.
+func (mr *MockAppStorageMockRecorder) ApplyFileMetadataChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyFileMetadataChanges", reflect.TypeOf((*MockAppStorage)(nil).ApplyFileMetadataChanges), arg0, arg1)
+}
+
+// ApplySSHKeyChanges mocks base method.
+func (m *MockAppStorage) ApplySSHKeyChanges(arg0 []domain.SSHKey, arg1 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplySSHKeyChanges", arg0, arg1)
+}
+
+// ApplySSHKeyChanges indicates an expected call of ApplySSHKeyChanges.
+func (mr *MockAppStorageMockRecorder) ApplySSHKeyChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplySSHKeyChanges", reflect.TypeOf((*MockAppStorage)(nil).ApplySSHKeyChanges), arg0, arg1)
+}
+
+// ApplySecureNoteChanges mocks base method.
+func (m *MockAppStorage) ApplySecureNoteChanges(arg0 []domain.SecureNote, arg1 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplySecureNoteChanges", arg0, arg1)
+}
+
+// ApplySecureNoteChanges indicates an expected call of ApplySecureNoteChanges.
+func (mr *MockAppStorageMockRecorder) ApplySecureNoteChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplySecureNoteChanges", reflect.TypeOf((*MockAppStorage)(nil).ApplySecureNoteChanges), arg0, arg1)
+}
+
+// ApplyUserPasswordDataChanges mocks base method.
+func (m *MockAppStorage) ApplyUserPasswordDataChanges(arg0 []domain.UserPasswordData, arg1 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplyUserPasswordDataChanges", arg0, arg1)
+}
+
+// ApplyUserPasswordDataChanges indicates an expected call of ApplyUserPasswordDataChanges.
+func (mr *MockAppStorageMockRecorder) ApplyUserPasswordDataChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUserPasswordDataChanges", reflect.TypeOf((*MockAppStorage)(nil).ApplyUserPasswordDataChanges), arg0, arg1)
+}
+
+// DeleteAuthenticator mocks base method.
+func (m *MockAppStorage) DeleteAuthenticator(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuthenticator", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthenticator indicates an expected call of DeleteAuthenticator.
+func (mr *MockAppStorageMockRecorder) DeleteAuthenticator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthenticator", reflect.TypeOf((*MockAppStorage)(nil).DeleteAuthenticator), arg0)
+}
+
 // DeleteBankCard mocks base method.
 func (m *MockAppStorage) DeleteBankCard(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -980,6 +1635,34 @@ func (mr *MockAppStorageMockRecorder) DeleteFileInfo(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileInfo", reflect.TypeOf((*MockAppStorage)(nil).DeleteFileInfo), arg0)
 }
 
+// DeleteSSHKey mocks base method.
+func (m *MockAppStorage) DeleteSSHKey(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSSHKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSSHKey indicates an expected call of DeleteSSHKey.
+func (mr *MockAppStorageMockRecorder) DeleteSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSHKey", reflect.TypeOf((*MockAppStorage)(nil).DeleteSSHKey), arg0)
+}
+
+// DeleteSecureNote mocks base method.
+func (m *MockAppStorage) DeleteSecureNote(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecureNote", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecureNote indicates an expected call of DeleteSecureNote.
+func (mr *MockAppStorageMockRecorder) DeleteSecureNote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecureNote", reflect.TypeOf((*MockAppStorage)(nil).DeleteSecureNote), arg0)
+}
+
 // DeleteUserPasswordData mocks base method.
 func (m *MockAppStorage) DeleteUserPasswordData(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -994,6 +1677,35 @@ func (mr *MockAppStorageMockRecorder) DeleteUserPasswordData(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPasswordData", reflect.TypeOf((*MockAppStorage)(nil).DeleteUserPasswordData), arg0)
 }
 
+// GetAuthenticator mocks base method.
+func (m *MockAppStorage) GetAuthenticator(arg0 string) (*domain.Authenticator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthenticator", arg0)
+	ret0, _ := ret[0].(*domain.Authenticator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthenticator indicates an expected call of GetAuthenticator.
+func (mr *MockAppStorageMockRecorder) GetAuthenticator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthenticator", reflect.TypeOf((*MockAppStorage)(nil).GetAuthenticator), arg0)
+}
+
+// GetAuthenticatorList mocks base method.
+func (m *MockAppStorage) GetAuthenticatorList() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthenticatorList")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetAuthenticatorList indicates an expected call of GetAuthenticatorList.
+func (mr *MockAppStorageMockRecorder) GetAuthenticatorList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthenticatorList", reflect.TypeOf((*MockAppStorage)(nil).GetAuthenticatorList))
+}
+
 // GetBankCard mocks base method.
 func (m *MockAppStorage) GetBankCard(arg0 string) (*domain.BankCard, error) {
 	m.ctrl.T.Helper()
@@ -1023,6 +1735,20 @@ func (mr *MockAppStorageMockRecorder) GetBankCardNumberList() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankCardNumberList", reflect.TypeOf((*MockAppStorage)(nil).GetBankCardNumberList))
 }
 
+// GetChangeSeq mocks base method.
+func (m *MockAppStorage) GetChangeSeq() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangeSeq")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetChangeSeq indicates an expected call of GetChangeSeq.
+func (mr *MockAppStorageMockRecorder) GetChangeSeq() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeSeq", reflect.TypeOf((*MockAppStorage)(nil).GetChangeSeq))
+}
+
 // GetFileInfo mocks base method.
 func (m *MockAppStorage) GetFileInfo(arg0 string) (*domain.FileInfo, error) {
 	m.ctrl.T.Helper()
@@ -1052,6 +1778,20 @@ func (mr *MockAppStorageMockRecorder) GetFileInfoList() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfoList", reflect.TypeOf((*MockAppStorage)(nil).GetFileInfoList))
 }
 
+// GetFileMetadata mocks base method.
+func (m *MockAppStorage) GetFileMetadata(arg0 string) *domain.FileMetadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileMetadata", arg0)
+	ret0, _ := ret[0].(*domain.FileMetadata)
+	return ret0
+}
+
+// GetFileMetadata indicates an expected call of GetFileMetadata.
+func (mr *MockAppStorageMockRecorder) GetFileMetadata(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileMetadata", reflect.TypeOf((*MockAppStorage)(nil).GetFileMetadata), arg0)
+}
+
 // GetMasterPassword mocks base method.
 func (m *MockAppStorage) GetMasterPassword() string {
 	m.ctrl.T.Helper()
@@ -1066,6 +1806,64 @@ func (mr *MockAppStorageMockRecorder) GetMasterPassword() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterPassword", reflect.TypeOf((*MockAppStorage)(nil).GetMasterPassword))
 }
 
+// GetSSHKey mocks base method.
+func (m *MockAppStorage) GetSSHKey(arg0 string) (*domain.SSHKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSHKey", arg0)
+	ret0, _ := ret[0].(*domain.SSHKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSSHKey indicates an expected call of GetSSHKey.
+func (mr *MockAppStorageMockRecorder) GetSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKey", reflect.TypeOf((*MockAppStorage)(nil).GetSSHKey), arg0)
+}
+
+// GetSSHKeyList mocks base method.
+func (m *MockAppStorage) GetSSHKeyList() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSHKeyList")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetSSHKeyList indicates an expected call of GetSSHKeyList.
+func (mr *MockAppStorageMockRecorder) GetSSHKeyList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKeyList", reflect.TypeOf((*MockAppStorage)(nil).GetSSHKeyList))
+}
+
+// GetSecureNote mocks base method.
+func (m *MockAppStorage) GetSecureNote(arg0 string) (*domain.SecureNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecureNote", arg0)
+	ret0, _ := ret[0].(*domain.SecureNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecureNote indicates an expected call of GetSecureNote.
+func (mr *MockAppStorageMockRecorder) GetSecureNote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecureNote", reflect.TypeOf((*MockAppStorage)(nil).GetSecureNote), arg0)
+}
+
+// GetSecureNoteList mocks base method.
+func (m *MockAppStorage) GetSecureNoteList() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecureNoteList")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetSecureNoteList indicates an expected call of GetSecureNoteList.
+func (mr *MockAppStorageMockRecorder) GetSecureNoteList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecureNoteList", reflect.TypeOf((*MockAppStorage)(nil).GetSecureNoteList))
+}
+
 // GetUserPasswordData mocks base method.
 func (m *MockAppStorage) GetUserPasswordData(arg0 string) (*domain.UserPasswordData, error) {
 	m.ctrl.T.Helper()
@@ -1109,6 +1907,18 @@ func (mr *MockAppStorageMockRecorder) IsFileInfoExists(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFileInfoExists", reflect.TypeOf((*MockAppStorage)(nil).IsFileInfoExists), arg0)
 }
 
+// SetAuthenticators mocks base method.
+func (m *MockAppStorage) SetAuthenticators(arg0 []domain.Authenticator) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAuthenticators", arg0)
+}
+
+// SetAuthenticators indicates an expected call of SetAuthenticators.
+func (mr *MockAppStorageMockRecorder) SetAuthenticators(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuthenticators", reflect.TypeOf((*MockAppStorage)(nil).SetAuthenticators), arg0)
+}
+
 // SetBankCards mocks base method.
 func (m *MockAppStorage) SetBankCards(arg0 []domain.BankCard) {
 	m.ctrl.T.Helper()
@@ -1121,6 +1931,42 @@ func (mr *MockAppStorageMockRecorder) SetBankCards(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBankCards", reflect.TypeOf((*MockAppStorage)(nil).SetBankCards), arg0)
 }
 
+// SetChangeSeq mocks base method.
+func (m *MockAppStorage) SetChangeSeq(arg0 int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetChangeSeq", arg0)
+}
+
+// SetChangeSeq indicates an expected call of SetChangeSeq.
+func (mr *MockAppStorageMockRecorder) SetChangeSeq(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChangeSeq", reflect.TypeOf((*MockAppStorage)(nil).SetChangeSeq), arg0)
+}
+
+// SetFileMetadata mocks base method.
+func (m *MockAppStorage) SetFileMetadata(arg0 *domain.FileMetadata) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFileMetadata", arg0)
+}
+
+// SetFileMetadata indicates an expected call of SetFileMetadata.
+func (mr *MockAppStorageMockRecorder) SetFileMetadata(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFileMetadata", reflect.TypeOf((*MockAppStorage)(nil).SetFileMetadata), arg0)
+}
+
+// SetFileMetadatas mocks base method.
+func (m *MockAppStorage) SetFileMetadatas(arg0 []domain.FileMetadata) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFileMetadatas", arg0)
+}
+
+// SetFileMetadatas indicates an expected call of SetFileMetadatas.
+func (mr *MockAppStorageMockRecorder) SetFileMetadatas(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFileMetadatas", reflect.TypeOf((*MockAppStorage)(nil).SetFileMetadatas), arg0)
+}
+
 // SetFilesInfo mocks base method.
 func (m *MockAppStorage) SetFilesInfo(arg0 []domain.FileInfo) {
 	m.ctrl.T.Helper()
@@ -1145,6 +1991,30 @@ func (mr *MockAppStorageMockRecorder) SetMasterPassword(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMasterPassword", reflect.TypeOf((*MockAppStorage)(nil).SetMasterPassword), arg0)
 }
 
+// SetSSHKeys mocks base method.
+func (m *MockAppStorage) SetSSHKeys(arg0 []domain.SSHKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSSHKeys", arg0)
+}
+
+// SetSSHKeys indicates an expected call of SetSSHKeys.
+func (mr *MockAppStorageMockRecorder) SetSSHKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSSHKeys", reflect.TypeOf((*MockAppStorage)(nil).SetSSHKeys), arg0)
+}
+
+// SetSecureNotes mocks base method.
+func (m *MockAppStorage) SetSecureNotes(arg0 []domain.SecureNote) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSecureNotes", arg0)
+}
+
+// SetSecureNotes indicates an expected call of SetSecureNotes.
+func (mr *MockAppStorageMockRecorder) SetSecureNotes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecureNotes", reflect.TypeOf((*MockAppStorage)(nil).SetSecureNotes), arg0)
+}
+
 // SetUserPasswordDatas mocks base method.
 func (m *MockAppStorage) SetUserPasswordDatas(arg0 []domain.UserPasswordData) {
 	m.ctrl.T.Helper()
@@ -1157,6 +2027,20 @@ func (mr *MockAppStorageMockRecorder) SetUserPasswordDatas(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPasswordDatas", reflect.TypeOf((*MockAppStorage)(nil).SetUserPasswordDatas), arg0)
 }
 
+// UpdateAuthenticator mocks base method.
+func (m *MockAppStorage) UpdateAuthenticator(arg0 *domain.Authenticator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAuthenticator", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAuthenticator indicates an expected call of UpdateAuthenticator.
+func (mr *MockAppStorageMockRecorder) UpdateAuthenticator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuthenticator", reflect.TypeOf((*MockAppStorage)(nil).UpdateAuthenticator), arg0)
+}
+
 // UpdateBankCard mocks base method.
 func (m *MockAppStorage) UpdateBankCard(arg0 *domain.BankCard) error {
 	m.ctrl.T.Helper()
@@ -1185,6 +2069,34 @@ func (mr *MockAppStorageMockRecorder) UpdateFileInfo(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileInfo", reflect.TypeOf((*MockAppStorage)(nil).UpdateFileInfo), arg0)
 }
 
+// UpdateSSHKey mocks base method.
+func (m *MockAppStorage) UpdateSSHKey(arg0 *domain.SSHKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSSHKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSSHKey indicates an expected call of UpdateSSHKey.
+func (mr *MockAppStorageMockRecorder) UpdateSSHKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSSHKey", reflect.TypeOf((*MockAppStorage)(nil).UpdateSSHKey), arg0)
+}
+
+// UpdateSecureNote mocks base method.
+func (m *MockAppStorage) UpdateSecureNote(arg0 *domain.SecureNote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecureNote", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecureNote indicates an expected call of UpdateSecureNote.
+func (mr *MockAppStorageMockRecorder) UpdateSecureNote(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecureNote", reflect.TypeOf((*MockAppStorage)(nil).UpdateSecureNote), arg0)
+}
+
 // UpdateUserPasswordData mocks base method.
 func (m *MockAppStorage) UpdateUserPasswordData(arg0 *domain.UserPasswordData) error {
 	m.ctrl.T.Helper()
@@ -1198,3 +2110,55 @@ func (mr *MockAppStorageMockRecorder) UpdateUserPasswordData(arg0 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordData", reflect.TypeOf((*MockAppStorage)(nil).UpdateUserPasswordData), arg0)
 }
+
+// MockLocalVault is a mock of LocalVault interface.
+type MockLocalVault struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocalVaultMockRecorder
+}
+
+// MockLocalVaultMockRecorder is the mock recorder for MockLocalVault.
+type MockLocalVaultMockRecorder struct {
+	mock *MockLocalVault
+}
+
+// NewMockLocalVault creates a new mock instance.
+func NewMockLocalVault(ctrl *gomock.Controller) *MockLocalVault {
+	mock := &MockLocalVault{ctrl: ctrl}
+	mock.recorder = &MockLocalVaultMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocalVault) EXPECT() *MockLocalVaultMockRecorder {
+	return m.recorder
+}
+
+// Load mocks base method.
+func (m *MockLocalVault) Load() (*domain.EncryptedVault, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(*domain.EncryptedVault)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockLocalVaultMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockLocalVault)(nil).Load))
+}
+
+// Save mocks base method.
+func (m *MockLocalVault) Save(arg0 *domain.EncryptedVault) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockLocalVaultMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockLocalVault)(nil).Save), arg0)
+}
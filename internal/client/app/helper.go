@@ -31,6 +31,18 @@ func (h *helper) CheckBankCardData(data *domain.BankCard) error {
 func (h *helper) CheckUserPasswordData(data *domain.UserPasswordData) error {
 	return domain.CheckUserPasswordData(data)
 }
+func (h *helper) CheckSecureNote(data *domain.SecureNote) error {
+	return domain.CheckSecureNote(data)
+}
+func (h *helper) CheckAuthenticator(data *domain.Authenticator) error {
+	return domain.CheckAuthenticator(data)
+}
+func (h *helper) ReadOTPAuthQR(path string) (string, error) {
+	return domain.ReadOTPAuthQR(path)
+}
+func (h *helper) CheckSSHKey(data *domain.SSHKey) error {
+	return domain.CheckSSHKey(data)
+}
 
 func (h *helper) CheckMasterPasswordComplexityLevel(pass string) bool {
 	return domain.CheckMasterPasswordComplexityLevel(pass)
@@ -54,6 +66,9 @@ func (h *helper) EncryptShortData(masterKey string, data string) (string, error)
 func (h *helper) DecryptShortData(masterKey string, ciphertext string) (string, error) {
 	return domain.DecryptShortData(masterKey, ciphertext)
 }
+func (h *helper) BlindIndex(masterPass string, itemType domain.SecretItemType, value string) string {
+	return domain.BlindIndex(masterPass, itemType, value)
+}
 
 func (h *helper) CheckFileForRead(info *domain.FileInfo) error {
 	return domain.CheckFileForRead(info)
@@ -67,6 +82,10 @@ func (h *helper) CreateStreamFileReader(info *domain.FileInfo) (domain.StreamFil
 	return domain.CreateStreamFileReader(info.Path)
 }
 
+func (h *helper) CreateStreamBytesReader(data []byte) domain.StreamFileReader {
+	return domain.CreateStreamBytesReader(data)
+}
+
 func (h *helper) CreateStreamFileWriter(dir string) (domain.StreamFileWriter, error) {
 	return domain.CreateStreamFileWriter(dir)
 }
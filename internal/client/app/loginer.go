@@ -26,10 +26,16 @@ func (lg *loginer) LoginStorage(storage AppStorage) *loginer {
 	return lg
 }
 
+func (lg *loginer) VaultKeeper(keeper *vaultKeeper) *loginer {
+	lg.keeper = keeper
+	return lg
+}
+
 type loginer struct {
 	logSrv  AppServer
 	helper  DomainHelper
 	storage AppStorage
+	keeper  *vaultKeeper
 }
 
 func (lg *loginer) Login(ctx context.Context, data *domain.EMailData) error {
@@ -77,5 +83,7 @@ func (lg *loginer) CheckMasterKey(ctx context.Context, masterPassword string) (e
 
 	log.Debug("checkMasterKey success")
 	lg.storage.SetMasterPassword(masterPassword)
+	lg.keeper.SetHello(helloData)
+	lg.keeper.RestorePending(masterPassword)
 	return nil, ""
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -18,11 +19,18 @@ func NewStatusWrapper(clientConf *config.ClientConf, server AppServer) *serverSt
 	}
 }
 
+// OnOnline sets the function invoked when the ping loop finds the server available after it was offline.
+func (aw *serverStatusWrapper) OnOnline(fn func()) *serverStatusWrapper {
+	aw.onOnline = fn
+	return aw
+}
+
 // serverStatusWrapper wrapp server communication object and hold connection status.
 type serverStatusWrapper struct {
 	server   AppServer
 	conf     *config.ClientConf
 	status   domain.ClientStatus
+	onOnline func()
 	stopChan chan struct{}
 	wg       sync.WaitGroup
 }
@@ -31,7 +39,7 @@ var _ AppServer = (*serverStatusWrapper)(nil)
 
 func (aw *serverStatusWrapper) Start() {
 	aw.wg.Add(1)
-	aw.stopChan = make(chan struct{}, 1)
+	aw.stopChan = make(chan struct{})
 	go func() {
 		defer aw.wg.Done()
 		_ = aw.invokeFn(context.Background(), aw.ping) // Start ping immediatly
@@ -59,6 +67,9 @@ func (aw *serverStatusWrapper) ping(ctx context.Context) error {
 		return err
 	} else {
 		log.Debug("server is online")
+		if aw.status != domain.ClientStatusOnline && aw.onOnline != nil {
+			go aw.onOnline()
+		}
 		aw.status = domain.ClientStatusOnline
 		return nil
 	}
@@ -100,7 +111,8 @@ func (aw *serverStatusWrapper) invokeFn(ctx context.Context, fn func(ctx context
 
 func (aw *serverStatusWrapper) Stop() {
 	if aw.stopChan != nil {
-		aw.stopChan <- struct{}{}
+		// closed - the signal is received by the ping loop and by the running calls
+		close(aw.stopChan)
 	}
 	aw.wg.Wait()
 	if aw.server != nil {
@@ -164,61 +176,155 @@ func (aw *serverStatusWrapper) GetHelloData(ctx context.Context) (*domain.HelloD
 	return data, retErr
 }
 
-func (aw *serverStatusWrapper) GetBankCardList(ctx context.Context) ([]domain.EncryptedBankCard, error) {
-	var data []domain.EncryptedBankCard
+func (aw *serverStatusWrapper) ListItems(ctx context.Context, itemType domain.SecretItemType) ([]domain.EncryptedSecretItem, error) {
+	var data []domain.EncryptedSecretItem
 	var err error
 	fn := func(ctx context.Context) error {
-		data, err = aw.server.GetBankCardList(ctx)
+		data, err = aw.server.ListItems(ctx, itemType)
 		return err
 	}
 	retErr := aw.invokeOnlineFn(ctx, fn)
 	return data, retErr
 }
-func (aw *serverStatusWrapper) CreateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error {
-	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
-		return aw.server.CreateBankCard(ctx, bnkCard)
-	})
+
+func (aw *serverStatusWrapper) GetItem(ctx context.Context, itemID string) (*domain.EncryptedSecretItem, error) {
+	var item *domain.EncryptedSecretItem
+	var err error
+	fn := func(ctx context.Context) error {
+		item, err = aw.server.GetItem(ctx, itemID)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return item, retErr
 }
 
-func (aw *serverStatusWrapper) UpdateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error {
-	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
-		return aw.server.UpdateBankCard(ctx, bnkCard)
-	})
+func (aw *serverStatusWrapper) CreateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	var rev *domain.SecretItemRevision
+	var err error
+	fn := func(ctx context.Context) error {
+		rev, err = aw.server.CreateItem(ctx, item)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return rev, retErr
 }
 
-func (aw *serverStatusWrapper) DeleteBankCard(ctx context.Context, number string) error {
+func (aw *serverStatusWrapper) UpdateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	var rev *domain.SecretItemRevision
+	var err error
+	fn := func(ctx context.Context) error {
+		rev, err = aw.server.UpdateItem(ctx, item)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return rev, retErr
+}
+
+func (aw *serverStatusWrapper) DeleteItem(ctx context.Context, itemID string, revision int64) error {
 	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
-		return aw.server.DeleteBankCard(ctx, number)
+		return aw.server.DeleteItem(ctx, itemID, revision)
 	})
 }
 
-func (aw *serverStatusWrapper) GetUserPasswordDataList(ctx context.Context) ([]domain.EncryptedUserPasswordData, error) {
-	var data []domain.EncryptedUserPasswordData
+func (aw *serverStatusWrapper) ListSecureNotes(ctx context.Context) ([]domain.EncryptedSecretItem, error) {
+	var data []domain.EncryptedSecretItem
 	var err error
 	fn := func(ctx context.Context) error {
-		data, err = aw.server.GetUserPasswordDataList(ctx)
+		data, err = aw.server.ListSecureNotes(ctx)
 		return err
 	}
 	retErr := aw.invokeOnlineFn(ctx, fn)
 	return data, retErr
 }
 
-func (aw *serverStatusWrapper) CreateUserPasswordData(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
-	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
-		return aw.server.CreateUserPasswordData(ctx, data)
-	})
+func (aw *serverStatusWrapper) CreateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	var rev *domain.SecretItemRevision
+	var err error
+	fn := func(ctx context.Context) error {
+		rev, err = aw.server.CreateSecureNote(ctx, note)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return rev, retErr
 }
-func (aw *serverStatusWrapper) UpdateUserPasswordData(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
-	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
-		return aw.server.UpdateUserPasswordData(ctx, data)
-	})
+
+func (aw *serverStatusWrapper) UpdateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	var rev *domain.SecretItemRevision
+	var err error
+	fn := func(ctx context.Context) error {
+		rev, err = aw.server.UpdateSecureNote(ctx, note)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return rev, retErr
 }
-func (aw *serverStatusWrapper) DeleteUserPasswordData(ctx context.Context, hint string) error {
+
+func (aw *serverStatusWrapper) DeleteSecureNote(ctx context.Context, noteID string, revision int64) error {
 	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
-		return aw.server.DeleteUserPasswordData(ctx, hint)
+		return aw.server.DeleteSecureNote(ctx, noteID, revision)
 	})
 }
 
+func (aw *serverStatusWrapper) GetChangesSince(ctx context.Context, seq int64) (*domain.SecretItemChanges, error) {
+	var changes *domain.SecretItemChanges
+	var err error
+	fn := func(ctx context.Context) error {
+		changes, err = aw.server.GetChangesSince(ctx, seq)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return changes, retErr
+}
+
+// Watch is not limited by the interaction timeout - the stream lives until ctx is done or the connection is lost.
+// The lost connection switches the status to offline; the ping loop restores it and the caller reopens the stream.
+func (aw *serverStatusWrapper) Watch(ctx context.Context, seq int64, onEvent func(event *domain.ItemChangeEvent)) error {
+	if aw.status != domain.ClientStatusOnline {
+		return fmt.Errorf("%w server is offline", domain.ErrServerIsNotResponding)
+	}
+
+	err := aw.server.Watch(ctx, seq, onEvent)
+	if errors.Is(err, domain.ErrServerIsNotResponding) {
+		aw.status = domain.ClientStatusOffline
+		log := GetMainLogger()
+		log.Warn("watch stream is broken - server is not available")
+	}
+	return err
+}
+
+func (aw *serverStatusWrapper) ListRevisions(ctx context.Context, itemID string) ([]domain.SecretItemHistoryEntry, error) {
+	var data []domain.SecretItemHistoryEntry
+	var err error
+	fn := func(ctx context.Context) error {
+		data, err = aw.server.ListRevisions(ctx, itemID)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return data, retErr
+}
+
+func (aw *serverStatusWrapper) GetRevision(ctx context.Context, itemID string, revision int64) (*domain.EncryptedSecretItem, error) {
+	var item *domain.EncryptedSecretItem
+	var err error
+	fn := func(ctx context.Context) error {
+		item, err = aw.server.GetRevision(ctx, itemID, revision)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return item, retErr
+}
+
+func (aw *serverStatusWrapper) RestoreRevision(ctx context.Context, itemID string, revision int64, expected int64) (*domain.SecretItemRevision, error) {
+	var rev *domain.SecretItemRevision
+	var err error
+	fn := func(ctx context.Context) error {
+		rev, err = aw.server.RestoreRevision(ctx, itemID, revision, expected)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return rev, retErr
+}
+
 func (aw *serverStatusWrapper) GetFileInfoList(ctx context.Context) ([]domain.FileInfo, error) {
 	var data []domain.FileInfo
 	var err error
@@ -258,3 +364,43 @@ func (aw *serverStatusWrapper) CreateFileReceiver(ctx context.Context, name stri
 	retErr := aw.invokeOnlineFn(ctx, fn)
 	return data, retErr
 }
+
+func (aw *serverStatusWrapper) GetSessionList(ctx context.Context) ([]domain.SessionInfo, error) {
+	var data []domain.SessionInfo
+	var err error
+	fn := func(ctx context.Context) error {
+		data, err = aw.server.GetSessionList(ctx)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return data, retErr
+}
+
+func (aw *serverStatusWrapper) TerminateSession(ctx context.Context, sessionID string) error {
+	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
+		return aw.server.TerminateSession(ctx, sessionID)
+	})
+}
+
+func (aw *serverStatusWrapper) ListTrash(ctx context.Context) ([]domain.TrashEntry, error) {
+	var data []domain.TrashEntry
+	var err error
+	fn := func(ctx context.Context) error {
+		data, err = aw.server.ListTrash(ctx)
+		return err
+	}
+	retErr := aw.invokeOnlineFn(ctx, fn)
+	return data, retErr
+}
+
+func (aw *serverStatusWrapper) RestoreFromTrash(ctx context.Context, itemType domain.SecretItemType, id string) error {
+	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
+		return aw.server.RestoreFromTrash(ctx, itemType, id)
+	})
+}
+
+func (aw *serverStatusWrapper) PurgeTrash(ctx context.Context, itemType domain.SecretItemType, id string) error {
+	return aw.invokeOnlineFn(ctx, func(ctx context.Context) error {
+		return aw.server.PurgeTrash(ctx, itemType, id)
+	})
+}
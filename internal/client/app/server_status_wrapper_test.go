@@ -45,6 +45,27 @@ func TestServerStatusWrapper(t *testing.T) {
 		wrapper.Stop()
 	})
 
+	t.Run("on_online", func(t *testing.T) {
+		mockServ := NewMockAppServer(ctrl)
+		mockServ.EXPECT().Ping(gomock.Any()).Return(nil).Times(1)
+		mockServ.EXPECT().Stop().Times(1)
+
+		conf := &config.ClientConf{
+			InterationTimeout: 2 * time.Second,
+		}
+		onlineCh := make(chan struct{}, 1)
+		wrapper := app.NewStatusWrapper(conf, mockServ).OnOnline(func() {
+			onlineCh <- struct{}{}
+		})
+		wrapper.Start()
+		select {
+		case <-onlineCh:
+		case <-time.After(time.Second):
+			assert.Fail(t, "online function is not invoked")
+		}
+		wrapper.Stop()
+	})
+
 	t.Run("start_long ping", func(t *testing.T) {
 		mockServ := NewMockAppServer(ctrl)
 		mockServ.EXPECT().Ping(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
@@ -65,6 +86,51 @@ func TestServerStatusWrapper(t *testing.T) {
 	})
 }
 
+func TestWrapperWatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("offline", func(t *testing.T) {
+		mockServ := NewMockAppServer(ctrl)
+		mockServ.EXPECT().Watch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		conf := &config.ClientConf{
+			InterationTimeout: 2 * time.Second,
+		}
+		wrapper := app.NewStatusWrapper(conf, mockServ)
+		err := wrapper.Watch(context.Background(), 0, func(event *domain.ItemChangeEvent) {})
+		assert.ErrorIs(t, err, domain.ErrServerIsNotResponding)
+	})
+
+	t.Run("connection_lost", func(t *testing.T) {
+		mockServ := NewMockAppServer(ctrl)
+		mockServ.EXPECT().Ping(gomock.Any()).Return(nil).Times(1)
+		mockServ.EXPECT().Watch(gomock.Any(), int64(3), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, seq int64, onEvent func(event *domain.ItemChangeEvent)) error {
+				onEvent(&domain.ItemChangeEvent{Seq: 4, Kind: domain.ItemCreated})
+				return domain.ErrServerIsNotResponding
+			}).Times(1)
+		mockServ.EXPECT().Stop().Times(1)
+
+		conf := &config.ClientConf{
+			InterationTimeout: 2 * time.Second,
+		}
+		wrapper := app.NewStatusWrapper(conf, mockServ)
+		wrapper.Start()
+		time.Sleep(1 * time.Second)
+		assert.Equal(t, domain.ClientStatusOnline, wrapper.GetStatus())
+
+		var events []domain.ItemChangeEvent
+		err := wrapper.Watch(context.Background(), 3, func(event *domain.ItemChangeEvent) {
+			events = append(events, *event)
+		})
+		assert.ErrorIs(t, err, domain.ErrServerIsNotResponding)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, domain.ClientStatusOffline, wrapper.GetStatus())
+		wrapper.Stop()
+	})
+}
+
 func TestWrapperRegistrationFn(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
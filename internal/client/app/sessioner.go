@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+func NewSessioner() *sessioner {
+	return &sessioner{}
+}
+
+func (ss *sessioner) AppServer(appServer AppServer) *sessioner {
+	ss.appServer = appServer
+	return ss
+}
+
+// sessioner lists active user sessions and terminates them.
+type sessioner struct {
+	appServer AppServer
+}
+
+func (ss *sessioner) GetSessionList(ctx context.Context) ([]domain.SessionInfo, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	sessions, err := ss.appServer.GetSessionList(ctx)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	log.Debugf("%v success", action)
+	return sessions, nil
+}
+
+func (ss *sessioner) TerminateSession(ctx context.Context, sessionID string) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := ss.appServer.TerminateSession(ctx, sessionID); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
@@ -0,0 +1,65 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessioner_GetSessionList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		sessions := []domain.SessionInfo{
+			{SessionID: "session1", DeviceName: "laptop", Current: true},
+			{SessionID: "session2", DeviceName: "desktop"},
+		}
+
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().GetSessionList(gomock.Any()).Return(sessions, nil).Times(1)
+
+		ss := app.NewSessioner().AppServer(mockSrv)
+		res, err := ss.GetSessionList(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, sessions, res)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		testErr := errors.New("testErr")
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().GetSessionList(gomock.Any()).Return(nil, testErr).Times(1)
+
+		ss := app.NewSessioner().AppServer(mockSrv)
+		_, err := ss.GetSessionList(context.Background())
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestSessioner_TerminateSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().TerminateSession(gomock.Any(), gomock.Eq("session2")).Return(nil).Times(1)
+
+		ss := app.NewSessioner().AppServer(mockSrv)
+		err := ss.TerminateSession(context.Background(), "session2")
+		require.NoError(t, err)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().TerminateSession(gomock.Any(), gomock.Any()).Return(domain.ErrClientDataIncorrect).Times(1)
+
+		ss := app.NewSessioner().AppServer(mockSrv)
+		err := ss.TerminateSession(context.Background(), "session2")
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
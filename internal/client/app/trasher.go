@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+func NewTrasher() *trasher {
+	return &trasher{}
+}
+
+func (tr *trasher) AppServer(appServer AppServer) *trasher {
+	tr.appServer = appServer
+	return tr
+}
+
+func (tr *trasher) AppStorage(appStorage AppStorage) *trasher {
+	tr.appStorage = appStorage
+	return tr
+}
+
+func (tr *trasher) DomainHelper(helper DomainHelper) *trasher {
+	tr.helper = helper
+	return tr
+}
+
+// trasher lists, restores and purges items and files deleted by the user.
+type trasher struct {
+	appServer  AppServer
+	appStorage AppStorage
+	helper     DomainHelper
+}
+
+// ListTrash returns the trash entries; names of the items are decrypted, the item id is shown
+// instead of the name that can't be decrypted.
+func (tr *trasher) ListTrash(ctx context.Context) ([]domain.TrashEntry, error) {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	entries, err := tr.appServer.ListTrash(ctx)
+	if err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return nil, err
+	}
+
+	masterPass := tr.appStorage.GetMasterPassword()
+	for i := range entries {
+		if entries[i].Type == domain.TrashFile {
+			continue
+		}
+		name, err := tr.helper.DecryptShortData(masterPass, entries[i].Name)
+		if err != nil || name == "" {
+			log.Warnf("%v can't decrypt name of item %v", action, entries[i].ID)
+			name = entries[i].ID
+		}
+		entries[i].Name = name
+	}
+
+	log.Debugf("%v success", action)
+	return entries, nil
+}
+
+func (tr *trasher) Restore(ctx context.Context, entry *domain.TrashEntry) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	if err := tr.appServer.RestoreFromTrash(ctx, entry.Type, entry.ID); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
+
+// Purge deletes the entry permanently; the whole trash is purged for nil entry.
+func (tr *trasher) Purge(ctx context.Context, entry *domain.TrashEntry) error {
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+	log.Debugf("%v start", action)
+
+	var itemType domain.SecretItemType
+	var id string
+	if entry != nil {
+		itemType, id = entry.Type, entry.ID
+	}
+
+	if err := tr.appServer.PurgeTrash(ctx, itemType, id); err != nil {
+		err := fmt.Errorf("%w - %v error", err, action)
+		log.Warn(err.Error())
+		return err
+	}
+
+	log.Debugf("%v success", action)
+	return nil
+}
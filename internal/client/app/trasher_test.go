@@ -0,0 +1,85 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrasher_ListTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().ListTrash(gomock.Any()).Return([]domain.TrashEntry{
+			{ID: "file1", Type: domain.TrashFile, Name: "file"},
+			{ID: "item1", Type: domain.SecretItemBankCard, Name: "encrypted"},
+			{ID: "item2", Type: domain.SecretItemUserPassword, Name: ""},
+		}, nil).Times(1)
+
+		mockStorage := NewMockAppStorage(ctrl)
+		mockStorage.EXPECT().GetMasterPassword().Return("masterPass").Times(1)
+
+		mockHelper := NewMockDomainHelper(ctrl)
+		mockHelper.EXPECT().DecryptShortData("masterPass", "encrypted").Return("1234", nil).Times(1)
+		mockHelper.EXPECT().DecryptShortData("masterPass", "").Return("", errors.New("testErr")).Times(1)
+
+		tr := app.NewTrasher().AppServer(mockSrv).AppStorage(mockStorage).DomainHelper(mockHelper)
+		res, err := tr.ListTrash(context.Background())
+		require.NoError(t, err)
+		require.Len(t, res, 3)
+		require.Equal(t, "file", res[0].Name)
+		require.Equal(t, "1234", res[1].Name)
+		require.Equal(t, "item2", res[2].Name)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		testErr := errors.New("testErr")
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().ListTrash(gomock.Any()).Return(nil, testErr).Times(1)
+
+		tr := app.NewTrasher().AppServer(mockSrv)
+		_, err := tr.ListTrash(context.Background())
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestTrasher_RestoreAndPurge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entry := &domain.TrashEntry{ID: "item1", Type: domain.SecretItemBankCard}
+
+	t.Run("restore", func(t *testing.T) {
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().RestoreFromTrash(gomock.Any(), domain.SecretItemBankCard, "item1").Return(domain.ErrClientDataIncorrect).Times(1)
+
+		tr := app.NewTrasher().AppServer(mockSrv)
+		err := tr.Restore(context.Background(), entry)
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().PurgeTrash(gomock.Any(), domain.SecretItemBankCard, "item1").Return(nil).Times(1)
+
+		tr := app.NewTrasher().AppServer(mockSrv)
+		err := tr.Purge(context.Background(), entry)
+		require.NoError(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		mockSrv := NewMockAppServer(ctrl)
+		mockSrv.EXPECT().PurgeTrash(gomock.Any(), domain.SecretItemType(""), "").Return(nil).Times(1)
+
+		tr := app.NewTrasher().AppServer(mockSrv)
+		err := tr.Purge(context.Background(), nil)
+		require.NoError(t, err)
+	})
+}
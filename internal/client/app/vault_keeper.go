@@ -0,0 +1,255 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+func NewVaultKeeper() *vaultKeeper {
+	return &vaultKeeper{
+		items: make(map[string]domain.EncryptedSecretItem),
+	}
+}
+
+func (vk *vaultKeeper) LocalVault(vault LocalVault) *vaultKeeper {
+	vk.vault = vault
+	return vk
+}
+
+func (vk *vaultKeeper) DomainHelper(helper DomainHelper) *vaultKeeper {
+	vk.helper = helper
+	return vk
+}
+
+// vaultKeeper collects the encrypted data downloaded by the client and saves it to the local vault;
+// the vault is unlocked with the master password when the server is not available.
+type vaultKeeper struct {
+	vault   LocalVault
+	helper  DomainHelper
+	hello   *domain.HelloData
+	seq     int64
+	items   map[string]domain.EncryptedSecretItem
+	files   []domain.FileInfo
+	pending []domain.PendingChange
+	mtx     sync.Mutex
+}
+
+// SetHello stores the data for the offline master password check; invoked after the online check.
+func (vk *vaultKeeper) SetHello(hello *domain.HelloData) {
+	if vk == nil {
+		return
+	}
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+	vk.hello = hello
+}
+
+// ApplyChanges updates the collected items; all the items are replaced if full is set.
+func (vk *vaultKeeper) ApplyChanges(changes *domain.SecretItemChanges, full bool) {
+	if vk == nil {
+		return
+	}
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+
+	if full {
+		vk.items = make(map[string]domain.EncryptedSecretItem)
+	}
+	for _, item := range changes.Deleted {
+		delete(vk.items, item.ID)
+	}
+	for _, item := range changes.Items {
+		vk.items[item.ID] = item
+	}
+	vk.seq = changes.Seq
+}
+
+func (vk *vaultKeeper) SetFiles(files []domain.FileInfo) {
+	if vk == nil {
+		return
+	}
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+	vk.files = files
+}
+
+// Save encrypts the collected data on the master password and writes it to the local vault.
+// Nothing is saved before the master password was checked online.
+func (vk *vaultKeeper) Save(masterPass string) error {
+	if vk == nil || vk.vault == nil {
+		return nil
+	}
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+
+	if vk.hello == nil {
+		return nil
+	}
+
+	data := domain.VaultData{
+		Seq:     vk.seq,
+		Files:   vk.files,
+		Pending: vk.pending,
+		SavedAt: time.Now(),
+	}
+	for _, item := range vk.items {
+		data.Items = append(data.Items, item)
+	}
+
+	res, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("%w - can't marshal vault data %v", domain.ErrClientInternal, err.Error())
+	}
+
+	encrypted, err := vk.helper.EncryptShortData(masterPass, string(res))
+	if err != nil {
+		return fmt.Errorf("%w - can't encrypt vault data %v", domain.ErrClientInternal, err.Error())
+	}
+
+	return vk.vault.Save(&domain.EncryptedVault{
+		Hello: *vk.hello,
+		Data:  encrypted,
+	})
+}
+
+// Unlock checks the master password against the saved hello data and decrypts the vault.
+// The keeper continues with the vault data, so the changes made offline are saved over it.
+// Returns:
+//
+//	nil, "", [domain.ErrDataNotExists] if the vault was never saved.
+//	nil, hint, error if the master password is wrong.
+func (vk *vaultKeeper) Unlock(masterPass string) (*domain.VaultData, string, error) {
+	vault, data, hint, err := vk.load(masterPass)
+	if err != nil {
+		return nil, hint, err
+	}
+
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+	vk.hello = &vault.Hello
+	vk.seq = data.Seq
+	vk.items = make(map[string]domain.EncryptedSecretItem)
+	for _, item := range data.Items {
+		vk.items[item.ID] = item
+	}
+	vk.files = data.Files
+	vk.pending = data.Pending
+	return data, "", nil
+}
+
+// RestorePending takes the changes journaled by the previous session; invoked after the online master password check.
+// The vault saved for another master password is ignored.
+func (vk *vaultKeeper) RestorePending(masterPass string) {
+	if vk == nil || vk.vault == nil {
+		return
+	}
+	log := GetMainLogger()
+	action := domain.GetAction(1)
+
+	_, data, _, err := vk.load(masterPass)
+	if err != nil {
+		log.Debugf("%v - vault is not restored: %v", action, err.Error())
+		return
+	}
+
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+	vk.pending = append(data.Pending, vk.pending...)
+	log.Debugf("%v - %d pending changes restored", action, len(data.Pending))
+}
+
+// AddPending journals the change made while the server was not available. Changes of the same item are merged,
+// so every item has at most one change based on the stored revision: the item created offline is identified
+// by the lookup key, an update or a delete replaces the previous change, a delete drops the item created offline.
+func (vk *vaultKeeper) AddPending(change *domain.PendingChange) {
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+
+	for i, p := range vk.pending {
+		if !samePendingItem(&p.Item, &change.Item) {
+			continue
+		}
+		switch {
+		case p.Kind == domain.ItemCreated && change.Kind == domain.ItemDeleted:
+			vk.pending = append(vk.pending[:i], vk.pending[i+1:]...)
+		case p.Kind == domain.ItemCreated:
+			vk.pending[i].Item = change.Item
+		default:
+			vk.pending[i] = *change
+		}
+		return
+	}
+	vk.pending = append(vk.pending, *change)
+}
+
+func samePendingItem(a, b *domain.EncryptedSecretItem) bool {
+	if a.ID != "" || b.ID != "" {
+		return a.ID == b.ID
+	}
+	return a.Type == b.Type && a.LookupKey == b.LookupKey
+}
+
+// Pending returns the journaled changes in the order they were made.
+func (vk *vaultKeeper) Pending() []domain.PendingChange {
+	if vk == nil {
+		return nil
+	}
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+	return append([]domain.PendingChange(nil), vk.pending...)
+}
+
+// RemovePending removes the journaled change; invoked after the change was sent or the conflict was resolved.
+// The change merged with a newer one in the meantime is kept.
+func (vk *vaultKeeper) RemovePending(change *domain.PendingChange) {
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+	for i, p := range vk.pending {
+		if p.Kind == change.Kind && p.Item == change.Item {
+			vk.pending = append(vk.pending[:i], vk.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// MarkPendingConflict marks the journaled change rejected by the server on replay.
+func (vk *vaultKeeper) MarkPendingConflict(change *domain.PendingChange) {
+	vk.mtx.Lock()
+	defer vk.mtx.Unlock()
+	for i, p := range vk.pending {
+		if p.Kind == change.Kind && p.Item == change.Item {
+			vk.pending[i].Conflict = true
+			return
+		}
+	}
+}
+
+func (vk *vaultKeeper) load(masterPass string) (*domain.EncryptedVault, *domain.VaultData, string, error) {
+	if vk == nil || vk.vault == nil {
+		return nil, nil, "", fmt.Errorf("%w - local vault is not set", domain.ErrClientInternal)
+	}
+
+	vault, err := vk.vault.Load()
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	if err := vk.helper.DecryptHello(masterPass, vault.Hello.HelloEncrypted); err != nil {
+		return nil, nil, vault.Hello.MasterPasswordHint, err
+	}
+
+	decrypted, err := vk.helper.DecryptShortData(masterPass, vault.Data)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("%w - can't decrypt vault %v", domain.ErrClientDataIncorrect, err.Error())
+	}
+
+	var data domain.VaultData
+	if err := json.Unmarshal([]byte(decrypted), &data); err != nil {
+		return nil, nil, "", fmt.Errorf("%w - can't decode vault %v", domain.ErrClientDataIncorrect, err.Error())
+	}
+	return vault, &data, "", nil
+}
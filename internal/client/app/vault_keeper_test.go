@@ -0,0 +1,152 @@
+package app_test
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/client/app"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultKeeper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterPass := "masterPass"
+	helper := app.NewHelper(rand.Read)
+
+	helloEncrypted, err := helper.EncryptHello(masterPass, "hello")
+	require.NoError(t, err)
+	hello := &domain.HelloData{
+		HelloEncrypted:     helloEncrypted,
+		MasterPasswordHint: "hint",
+	}
+
+	t.Run("not_saved_without_hello", func(t *testing.T) {
+		mockVault := NewMockLocalVault(ctrl)
+		mockVault.EXPECT().Save(gomock.Any()).Times(0)
+
+		keeper := app.NewVaultKeeper().LocalVault(mockVault).DomainHelper(helper)
+		keeper.ApplyChanges(&domain.SecretItemChanges{Seq: 1}, true)
+		require.NoError(t, keeper.Save(masterPass))
+	})
+
+	t.Run("save_unlock", func(t *testing.T) {
+		var saved *domain.EncryptedVault
+		mockVault := NewMockLocalVault(ctrl)
+		mockVault.EXPECT().Save(gomock.Any()).DoAndReturn(func(vault *domain.EncryptedVault) error {
+			saved = vault
+			return nil
+		}).Times(2)
+		mockVault.EXPECT().Load().DoAndReturn(func() (*domain.EncryptedVault, error) {
+			return saved, nil
+		}).Times(2)
+
+		keeper := app.NewVaultKeeper().LocalVault(mockVault).DomainHelper(helper)
+		keeper.SetHello(hello)
+		keeper.ApplyChanges(&domain.SecretItemChanges{
+			Seq: 2,
+			Items: []domain.EncryptedSecretItem{
+				{ID: "id1", Type: domain.SecretItemBankCard, Content: "card1"},
+				{ID: "id2", Type: domain.SecretItemUserPassword, Content: "pass2"},
+			},
+		}, true)
+		keeper.SetFiles([]domain.FileInfo{{Name: "file"}})
+		require.NoError(t, keeper.Save(masterPass))
+
+		// delta
+		keeper.ApplyChanges(&domain.SecretItemChanges{
+			Seq:     3,
+			Deleted: []domain.DeletedSecretItem{{ID: "id1", Type: domain.SecretItemBankCard}},
+		}, false)
+		require.NoError(t, keeper.Save(masterPass))
+
+		// the data is not readable without the master password
+		var plain domain.VaultData
+		assert.Error(t, json.Unmarshal([]byte(saved.Data), &plain))
+
+		data, hint, err := keeper.Unlock(masterPass)
+		require.NoError(t, err)
+		assert.Equal(t, "", hint)
+		assert.Equal(t, int64(3), data.Seq)
+		require.Equal(t, 1, len(data.Items))
+		assert.Equal(t, "id2", data.Items[0].ID)
+		assert.Equal(t, "pass2", data.Items[0].Content)
+		require.Equal(t, 1, len(data.Files))
+		assert.Equal(t, "file", data.Files[0].Name)
+		assert.False(t, data.SavedAt.IsZero())
+
+		_, hint, err = keeper.Unlock("wrongPass")
+		require.Error(t, err)
+		assert.Equal(t, "hint", hint)
+	})
+
+	t.Run("not_exists", func(t *testing.T) {
+		mockVault := NewMockLocalVault(ctrl)
+		mockVault.EXPECT().Load().Return(nil, domain.ErrDataNotExists).Times(1)
+
+		keeper := app.NewVaultKeeper().LocalVault(mockVault).DomainHelper(helper)
+		_, _, err := keeper.Unlock(masterPass)
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+}
+
+func TestUnlockOffline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterPass := "masterPass"
+	helper := app.NewHelper(rand.Read)
+
+	helloEncrypted, err := helper.EncryptHello(masterPass, "hello")
+	require.NoError(t, err)
+
+	cardContent, err := helper.EncryptShortData(masterPass, `{"number":"100", "type":"MIR"}`)
+	require.NoError(t, err)
+
+	var saved *domain.EncryptedVault
+	mockVault := NewMockLocalVault(ctrl)
+	mockVault.EXPECT().Save(gomock.Any()).DoAndReturn(func(vault *domain.EncryptedVault) error {
+		saved = vault
+		return nil
+	}).Times(1)
+	mockVault.EXPECT().Load().DoAndReturn(func() (*domain.EncryptedVault, error) {
+		return saved, nil
+	}).Times(1)
+
+	keeper := app.NewVaultKeeper().LocalVault(mockVault).DomainHelper(helper)
+	keeper.SetHello(&domain.HelloData{HelloEncrypted: helloEncrypted})
+	keeper.ApplyChanges(&domain.SecretItemChanges{
+		Seq: 5,
+		Items: []domain.EncryptedSecretItem{
+			{ID: "id1", Revision: 2, Type: domain.SecretItemBankCard, Content: cardContent},
+		},
+	}, true)
+	keeper.SetFiles([]domain.FileInfo{{Name: "file"}})
+	require.NoError(t, keeper.Save(masterPass))
+
+	mockStorage := NewMockAppStorage(ctrl)
+	mockStorage.EXPECT().SetMasterPassword(masterPass).Times(1)
+	mockStorage.EXPECT().SetBankCards(gomock.Any()).Do(func(cards []domain.BankCard) {
+		require.Equal(t, 1, len(cards))
+		assert.Equal(t, "100", cards[0].Number)
+		assert.Equal(t, "id1", cards[0].ID)
+		assert.Equal(t, int64(2), cards[0].Revision)
+	}).Times(1)
+	mockStorage.EXPECT().SetFileMetadatas(nil).Times(1)
+	mockStorage.EXPECT().SetSecureNotes(nil).Times(1)
+	mockStorage.EXPECT().SetAuthenticators(nil).Times(1)
+	mockStorage.EXPECT().SetSSHKeys(nil).Times(1)
+	mockStorage.EXPECT().SetUserPasswordDatas(gomock.Any()).Times(1)
+	mockStorage.EXPECT().SetFilesInfo([]domain.FileInfo{{Name: "file"}}).Times(1)
+	mockStorage.EXPECT().SetChangeSeq(int64(5)).Times(1)
+
+	da := app.NewDataAccessor().AppStorage(mockStorage).DomainHelper(helper).VaultKeeper(keeper)
+	savedAt, _, err := da.UnlockOffline(masterPass)
+	require.NoError(t, err)
+	assert.False(t, savedAt.IsZero())
+}
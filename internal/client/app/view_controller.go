@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -14,13 +15,17 @@ import (
 
 func NewViewController(conf *config.ClientConf) *viewController {
 	helper := NewHelper(rand.Read)
+	keeper := NewVaultKeeper().DomainHelper(helper)
 	cntr := &viewController{
 		conf:         conf,
-		loginer:      NewLoginer().LoginHelper(helper),
+		loginer:      NewLoginer().LoginHelper(helper).VaultKeeper(keeper),
 		registrator:  NewRegistrator().RegHelper(helper),
-		dataAccessor: NewDataAccessor().DomainHelper(helper),
-		fileAccessor: NewFileAccessor().DomainHelper(helper),
+		dataAccessor: NewDataAccessor().DomainHelper(helper).VaultKeeper(keeper),
+		fileAccessor: NewFileAccessor().DomainHelper(helper).VaultKeeper(keeper),
+		sessioner:    NewSessioner(),
+		trasher:      NewTrasher().DomainHelper(helper),
 		helper:       helper,
+		keeper:       keeper,
 	}
 	return cntr
 }
@@ -36,6 +41,8 @@ func (ac *viewController) Stop(stopCtx context.Context) {
 	if ac.server == nil {
 		panic("appController is not initialized - server is nil")
 	}
+	ac.stopWatch()
+
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -62,6 +69,13 @@ func (ac *viewController) SetAppStorage(storage AppStorage) *viewController {
 	ac.dataAccessor.AppStorage(storage)
 	ac.fileAccessor.AppStorage(storage)
 	ac.loginer.LoginStorage(storage)
+	ac.trasher.AppStorage(storage)
+	return ac
+}
+
+// SetLocalVault sets the storage of the encrypted data for offline access; the data is not cached if not set.
+func (ac *viewController) SetLocalVault(vault LocalVault) *viewController {
+	ac.keeper.LocalVault(vault)
 	return ac
 }
 
@@ -71,6 +85,8 @@ func (ac *viewController) SetServer(server AppServer) *viewController {
 	ac.registrator.RegServer(server)
 	ac.dataAccessor.AppSever(server)
 	ac.fileAccessor.AppServer(server)
+	ac.sessioner.AppServer(server)
+	ac.trasher.AppServer(server)
 	return ac
 }
 
@@ -83,14 +99,21 @@ type viewController struct {
 	registrator  *registrator
 	dataAccessor *dataAccessor
 	fileAccessor *fileAccessor
+	sessioner    *sessioner
+	trasher      *trasher
 	storage      AppStorage
+	keeper       *vaultKeeper
+	offline      atomic.Bool
+	watchCancel  context.CancelFunc
+	watchWg      sync.WaitGroup
+	watchMx      sync.Mutex
 }
 
 func (ac *viewController) invokeFn(fn func(ctx context.Context) error, successFn func()) {
 	go func() {
 		ctx := context.Background()
 		if err := fn(ctx); err != nil {
-			ac.appView.ShowMsg(err.Error())
+			ac.appView.ShowMsg(errorMessage(err))
 		} else {
 			if successFn != nil {
 				successFn()
@@ -99,6 +122,15 @@ func (ac *viewController) invokeFn(fn func(ctx context.Context) error, successFn
 	}()
 }
 
+// invokeOnlineFn invokes fn if the data is available for changes; in offline mode the data is read-only.
+func (ac *viewController) invokeOnlineFn(fn func(ctx context.Context) error, successFn func()) {
+	if ac.offline.Load() {
+		ac.appView.ShowMsg(errorMessage(domain.ErrClientOfflineReadOnly))
+		return
+	}
+	ac.invokeFn(fn, successFn)
+}
+
 func (ac *viewController) LoginEMail(data *domain.EMailData) {
 	ac.invokeFn(
 		func(ctx context.Context) error {
@@ -123,13 +155,150 @@ func (ac *viewController) LoginCheckMasterKey(masterKeyPassword string) {
 			err, hint := ac.loginer.CheckMasterKey(ctx, masterKeyPassword)
 			if err != nil {
 				ac.appView.ShowMasterKeyView(hint)
+				return err
+			}
+			// not fatal - items without lookup key are migrated on the next unlock
+			_ = ac.dataAccessor.MigrateItems(ctx)
+			return nil
+		},
+		func() {
+			ac.startWatch()
+			ac.appView.ShowDataAccessView()
+			ac.ReplayPendingChanges()
+		})
+}
+
+// OfflineUnlock opens the data saved by the previous online session when the server is not available.
+// The data is read-only until the application is restarted.
+func (ac *viewController) OfflineUnlock(masterKeyPassword string) {
+	var savedAt time.Time
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			var err error
+			var hint string
+			savedAt, hint, err = ac.dataAccessor.UnlockOffline(masterKeyPassword)
+			if err != nil && hint != "" {
+				return fmt.Errorf("%w (hint: %s)", err, hint)
 			}
 			return err
 		},
 		func() {
+			ac.offline.Store(true)
+			ac.appView.ShowOfflineMode(savedAt)
+			ac.appView.ShowPendingChanges(ac.dataAccessor.PendingCount())
 			ac.appView.ShowDataAccessView()
 		})
 }
+
+// ReplayPendingChanges sends the changes made while the server was not available; invoked when the server
+// is available again and after login. The first conflicting change is shown to the user, the replay is continued
+// after the conflict is resolved.
+func (ac *viewController) ReplayPendingChanges() {
+	if ac.offline.Load() || ac.storage.GetMasterPassword() == "" {
+		return // not logged in - replayed after login
+	}
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			conflict, err := ac.dataAccessor.ReplayPending(ctx)
+			ac.appView.ShowPendingChanges(ac.dataAccessor.PendingCount())
+			if err != nil {
+				return err
+			}
+			if conflict != nil {
+				ac.showPendingConflict(conflict)
+			}
+			return nil
+		}, nil)
+}
+
+func (ac *viewController) showPendingConflict(conflict *domain.PendingConflict) {
+	switch {
+	case conflict.Kind == domain.ItemDeleted && conflict.BankCard != nil:
+		ac.appView.ShowDeleteConflictView(domain.SecretItemBankCard, conflict.BankCard.Number)
+	case conflict.Kind == domain.ItemDeleted && conflict.UserPasswordData != nil:
+		ac.appView.ShowDeleteConflictView(domain.SecretItemUserPassword, conflict.UserPasswordData.Hint)
+	case conflict.Kind == domain.ItemDeleted && conflict.SecureNote != nil:
+		ac.appView.ShowDeleteConflictView(domain.SecretItemSecureNote, conflict.SecureNote.Title)
+	case conflict.Kind == domain.ItemDeleted && conflict.Authenticator != nil:
+		ac.appView.ShowDeleteConflictView(domain.SecretItemAuthenticator, conflict.Authenticator.Name)
+	case conflict.Kind == domain.ItemDeleted && conflict.SSHKey != nil:
+		ac.appView.ShowDeleteConflictView(domain.SecretItemSSHKey, conflict.SSHKey.Name)
+	case conflict.BankCard != nil:
+		ac.appView.ShowBankCardConflictView(conflict.BankCard.ToView())
+	case conflict.UserPasswordData != nil:
+		ac.appView.ShowUserPasswordDataConflictView(conflict.UserPasswordData)
+	case conflict.SecureNote != nil:
+		ac.appView.ShowSecureNoteConflictView(conflict.SecureNote)
+	case conflict.Authenticator != nil:
+		ac.appView.ShowAuthenticatorConflictView(conflict.Authenticator)
+	case conflict.SSHKey != nil:
+		ac.appView.ShowSSHKeyConflictView(conflict.SSHKey)
+	}
+}
+
+// ResolveDeleteConflict invoked by tui view after the user chose whether to delete the item changed
+// by another client after it was deleted offline.
+func (ac *viewController) ResolveDeleteConflict(itemType domain.SecretItemType, name string, remove bool) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			if remove {
+				if err := ac.deleteItem(ctx, itemType, name); err != nil {
+					return err
+				}
+			}
+			ac.dataAccessor.ResolvePendingConflict()
+			return nil
+		}, func() {
+			ac.appView.RefreshItemsView(itemType)
+			ac.ReplayPendingChanges()
+		})
+}
+
+func (ac *viewController) deleteItem(ctx context.Context, itemType domain.SecretItemType, name string) error {
+	switch itemType {
+	case domain.SecretItemBankCard:
+		return ac.dataAccessor.DeleteBankCard(ctx, name)
+	case domain.SecretItemUserPassword:
+		return ac.dataAccessor.DeleteUserPasswordData(ctx, name)
+	case domain.SecretItemSecureNote:
+		return ac.dataAccessor.DeleteSecureNote(ctx, name)
+	case domain.SecretItemAuthenticator:
+		return ac.dataAccessor.DeleteAuthenticator(ctx, name)
+	case domain.SecretItemSSHKey:
+		return ac.dataAccessor.DeleteSSHKey(ctx, name)
+	default:
+		return fmt.Errorf("%w - unknown item type %v", domain.ErrClientDataIncorrect, itemType)
+	}
+}
+
+// startWatch starts listening to the changes made by other clients of the user.
+func (ac *viewController) startWatch() {
+	ac.watchMx.Lock()
+	defer ac.watchMx.Unlock()
+	if ac.watchCancel != nil {
+		return
+	}
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	ac.watchCancel = cancelFn
+	ac.watchWg.Add(1)
+	go func() {
+		defer ac.watchWg.Done()
+		ac.dataAccessor.WatchChanges(ctx, 2*ac.conf.InterationTimeout, func(itemType domain.SecretItemType) {
+			ac.appView.RefreshItemsView(itemType)
+		})
+	}()
+}
+
+func (ac *viewController) stopWatch() {
+	ac.watchMx.Lock()
+	defer ac.watchMx.Unlock()
+	if ac.watchCancel != nil {
+		ac.watchCancel()
+		ac.watchWg.Wait()
+		ac.watchCancel = nil
+	}
+}
 func (ac *viewController) RegEMail(data *domain.EMailData) {
 	ac.invokeFn(
 		func(ctx context.Context) error {
@@ -158,14 +327,18 @@ func (ac *viewController) RegInitMasterKey(mKey *domain.UnencryptedMasterKeyData
 
 func (ac *viewController) GetBankCardList() {
 	ac.invokeFn(func(ctx context.Context) error {
+		if ac.offline.Load() {
+			return nil // show cache
+		}
 		if err := ac.dataAccessor.GetBankCardList(ctx); err != nil {
-			ac.appView.ShowMsg(err.Error())
+			ac.appView.ShowMsg(errorMessage(err))
 			// do not return error - show cache
 		}
 		return nil
 	}, func() {
 		nmbrs := ac.storage.GetBankCardNumberList()
 		ac.appView.ShowBankCardListView(nmbrs)
+		ac.appView.ShowPendingChanges(ac.dataAccessor.PendingCount())
 	})
 }
 
@@ -187,6 +360,7 @@ func (ac *viewController) AddBankCard(bankCardView *domain.BankCardView) {
 }
 
 func (ac *viewController) UpdateBankCard(bankCardView *domain.BankCardView) {
+	var conflict bool
 	ac.invokeFn(
 		func(ctx context.Context) error {
 			bankCard, err := bankCardView.ToBankCard()
@@ -195,12 +369,39 @@ func (ac *viewController) UpdateBankCard(bankCardView *domain.BankCardView) {
 			}
 
 			if err := ac.dataAccessor.UpdateBankCard(ctx, bankCard); err != nil {
+				if errors.Is(err, domain.ErrDataConflict) {
+					conflict = true
+					ac.appView.ShowBankCardConflictView(bankCardView)
+					return nil
+				}
+				return err
+			}
+			return nil
+		},
+		func() {
+			if !conflict {
+				ac.GetBankCardList()
+			}
+		})
+}
+
+// ResolveBankCardConflict invoked by tui view after the user chose how to resolve the update conflict.
+func (ac *viewController) ResolveBankCardConflict(bankCardView *domain.BankCardView, resolution domain.ConflictResolution) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			bankCard, err := bankCardView.ToBankCard()
+			if err != nil {
+				return err
+			}
+			if err := ac.dataAccessor.ResolveBankCardConflict(ctx, bankCard, resolution); err != nil {
 				return err
 			}
+			ac.dataAccessor.ResolvePendingConflict()
 			return nil
 		},
 		func() {
 			ac.GetBankCardList()
+			ac.ReplayPendingChanges() // the conflict may be found on replay
 		})
 }
 
@@ -243,17 +444,27 @@ func (ac *viewController) GetFileInfo(name string) {
 			if data, err := ac.storage.GetFileInfo(name); err != nil {
 				return err
 			} else {
-				ac.appView.ShowFileInfoView(data)
+				ac.appView.ShowFileInfoView(data, ac.storage.GetFileMetadata(name))
 			}
 			return nil
 		}, nil)
 }
 
-func (ac *viewController) DeleteFile(name string) {
+// SaveFileMetadata stores the custom fields and notes of the file.
+func (ac *viewController) SaveFileMetadata(meta *domain.FileMetadata) {
 	ac.invokeFn(
+		func(ctx context.Context) error {
+			return ac.dataAccessor.SaveFileMetadata(ctx, meta)
+		}, func() {
+			ac.GetFileInfo(meta.Name)
+		})
+}
+
+func (ac *viewController) DeleteFile(name string) {
+	ac.invokeOnlineFn(
 		func(ctx context.Context) error {
 			if err := ac.fileAccessor.DeleteFile(ctx, name); err != nil {
-				ac.appView.ShowMsg(err.Error()) //
+				ac.appView.ShowMsg(errorMessage(err)) //
 			}
 			return nil
 		}, func() {
@@ -261,6 +472,110 @@ func (ac *viewController) DeleteFile(name string) {
 		})
 }
 
+func (ac *viewController) GetSessionList() {
+	ac.invokeOnlineFn(func(ctx context.Context) error {
+		sessions, err := ac.sessioner.GetSessionList(ctx)
+		if err != nil {
+			return err
+		}
+		ac.appView.ShowSessionListView(sessions)
+		return nil
+	}, nil)
+}
+
+func (ac *viewController) TerminateSession(sessionID string) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			return ac.sessioner.TerminateSession(ctx, sessionID)
+		}, func() {
+			ac.GetSessionList()
+		})
+}
+
+// GetBankCardHistory invoked by tui view
+func (ac *viewController) GetBankCardHistory(number string) {
+	ac.invokeOnlineFn(func(ctx context.Context) error {
+		revisions, err := ac.dataAccessor.GetBankCardHistory(ctx, number)
+		if err != nil {
+			return err
+		}
+		ac.appView.ShowItemHistoryView(domain.SecretItemBankCard, number, revisions)
+		return nil
+	}, nil)
+}
+
+// GetUserPasswordDataHistory invoked by tui view
+func (ac *viewController) GetUserPasswordDataHistory(hint string) {
+	ac.invokeOnlineFn(func(ctx context.Context) error {
+		revisions, err := ac.dataAccessor.GetUserPasswordDataHistory(ctx, hint)
+		if err != nil {
+			return err
+		}
+		ac.appView.ShowItemHistoryView(domain.SecretItemUserPassword, hint, revisions)
+		return nil
+	}, nil)
+}
+
+// RestoreItemRevision rolls the item back to the revision and shows the restored item.
+func (ac *viewController) RestoreItemRevision(rev domain.ItemRevision) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			return ac.dataAccessor.RestoreItemRevision(ctx, &rev)
+		}, func() {
+			switch rev.Type {
+			case domain.SecretItemBankCard:
+				ac.GetBankCard(rev.Name)
+			case domain.SecretItemUserPassword:
+				ac.GetUserPasswordData(rev.Name)
+			case domain.SecretItemSecureNote:
+				ac.GetSecureNote(rev.Name)
+			case domain.SecretItemAuthenticator:
+				ac.GetAuthenticator(rev.Name)
+			case domain.SecretItemSSHKey:
+				ac.GetSSHKey(rev.Name)
+			}
+		})
+}
+
+func (ac *viewController) GetTrashList() {
+	ac.invokeOnlineFn(func(ctx context.Context) error {
+		entries, err := ac.trasher.ListTrash(ctx)
+		if err != nil {
+			return err
+		}
+		ac.appView.ShowTrashListView(entries)
+		return nil
+	}, nil)
+}
+
+// RestoreTrashEntry moves the deleted item or file back; the restored item is received with the next changes.
+func (ac *viewController) RestoreTrashEntry(entry domain.TrashEntry) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			return ac.trasher.Restore(ctx, &entry)
+		}, func() {
+			ac.GetTrashList()
+		})
+}
+
+func (ac *viewController) PurgeTrashEntry(entry domain.TrashEntry) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			return ac.trasher.Purge(ctx, &entry)
+		}, func() {
+			ac.GetTrashList()
+		})
+}
+
+func (ac *viewController) EmptyTrash() {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			return ac.trasher.Purge(ctx, nil)
+		}, func() {
+			ac.GetTrashList()
+		})
+}
+
 // GetUserPasswordData invoked by tui view
 func (ac *viewController) GetUserPasswordData(hint string) {
 	ac.invokeFn(
@@ -285,8 +600,11 @@ func (ac *viewController) NewUserPasswordData() {
 
 func (ac *viewController) GetFilesInfoList() {
 	ac.invokeFn(func(ctx context.Context) error {
+		if ac.offline.Load() {
+			return nil // show cache
+		}
 		if err := ac.fileAccessor.GetFileInfoList(ctx); err != nil {
-			ac.appView.ShowMsg(err.Error())
+			ac.appView.ShowMsg(errorMessage(err))
 			// do not return error - show cache
 		}
 		return nil
@@ -298,14 +616,18 @@ func (ac *viewController) GetFilesInfoList() {
 
 func (ac *viewController) GetUserPasswordDataList() {
 	ac.invokeFn(func(ctx context.Context) error {
+		if ac.offline.Load() {
+			return nil // show cache
+		}
 		if err := ac.dataAccessor.GetUserPasswordDataList(ctx); err != nil {
-			ac.appView.ShowMsg(err.Error())
+			ac.appView.ShowMsg(errorMessage(err))
 			// do not return error - show cache
 		}
 		return nil
 	}, func() {
 		nmbrs := ac.storage.GetUserPasswordDataList()
 		ac.appView.ShowUserPasswordDataListView(nmbrs) // show always
+		ac.appView.ShowPendingChanges(ac.dataAccessor.PendingCount())
 	})
 }
 
@@ -321,14 +643,37 @@ func (ac *viewController) AddUserPasswordData(data *domain.UserPasswordData) {
 		})
 }
 func (ac *viewController) UpdatePasswordData(data *domain.UserPasswordData) {
+	var conflict bool
 	ac.invokeFn(
 		func(ctx context.Context) error {
 			if err := ac.dataAccessor.UpdateUserPasswordData(ctx, data); err != nil {
+				if errors.Is(err, domain.ErrDataConflict) {
+					conflict = true
+					ac.appView.ShowUserPasswordDataConflictView(data)
+					return nil
+				}
+				return err
+			}
+			return nil
+		}, func() {
+			if !conflict {
+				ac.GetUserPasswordDataList()
+			}
+		})
+}
+
+// ResolveUserPasswordDataConflict invoked by tui view after the user chose how to resolve the update conflict.
+func (ac *viewController) ResolveUserPasswordDataConflict(data *domain.UserPasswordData, resolution domain.ConflictResolution) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			if err := ac.dataAccessor.ResolveUserPasswordDataConflict(ctx, data, resolution); err != nil {
 				return err
 			}
+			ac.dataAccessor.ResolvePendingConflict()
 			return nil
 		}, func() {
 			ac.GetUserPasswordDataList()
+			ac.ReplayPendingChanges() // the conflict may be found on replay
 		})
 }
 func (ac *viewController) DeleteUpdatePasswordData(hint string) {
@@ -343,7 +688,397 @@ func (ac *viewController) DeleteUpdatePasswordData(hint string) {
 		})
 }
 
+func (ac *viewController) GetSecureNoteList() {
+	ac.invokeFn(func(ctx context.Context) error {
+		if ac.offline.Load() {
+			return nil // show cache
+		}
+		if err := ac.dataAccessor.GetSecureNoteList(ctx); err != nil {
+			ac.appView.ShowMsg(errorMessage(err))
+			// do not return error - show cache
+		}
+		return nil
+	}, func() {
+		titles := ac.storage.GetSecureNoteList()
+		ac.appView.ShowSecureNoteListView(titles) // show always
+		ac.appView.ShowPendingChanges(ac.dataAccessor.PendingCount())
+	})
+}
+
+// GetSecureNote invoked by tui view
+func (ac *viewController) GetSecureNote(title string) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			if note, err := ac.storage.GetSecureNote(title); err != nil {
+				return err
+			} else {
+				ac.appView.ShowEditSecureNoteView(note)
+			}
+			return nil
+		}, nil)
+}
+
+func (ac *viewController) NewSecureNote() {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return nil
+		}, func() {
+			ac.appView.ShowNewSecureNoteView()
+		})
+}
+
+// AddSecureNote stores the note; the user is offered to store the oversized note as a file.
+func (ac *viewController) AddSecureNote(note *domain.SecureNote) {
+	var tooLarge bool
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			if err := ac.dataAccessor.AddSecureNote(ctx, note); err != nil {
+				if errors.Is(err, domain.ErrClientNoteTooLarge) {
+					tooLarge = true
+					ac.appView.ShowSecureNoteTooLargeView(note)
+					return nil
+				}
+				return err
+			}
+			return nil
+		}, func() {
+			if !tooLarge {
+				ac.GetSecureNoteList()
+			}
+		})
+}
+
+// UpdateSecureNote stores the note changes; the user is offered to store the oversized note as a file.
+func (ac *viewController) UpdateSecureNote(note *domain.SecureNote) {
+	var shown bool
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			if err := ac.dataAccessor.UpdateSecureNote(ctx, note); err != nil {
+				switch {
+				case errors.Is(err, domain.ErrDataConflict):
+					shown = true
+					ac.appView.ShowSecureNoteConflictView(note)
+					return nil
+				case errors.Is(err, domain.ErrClientNoteTooLarge):
+					shown = true
+					ac.appView.ShowSecureNoteTooLargeView(note)
+					return nil
+				}
+				return err
+			}
+			return nil
+		}, func() {
+			if !shown {
+				ac.GetSecureNoteList()
+			}
+		})
+}
+
+// ResolveSecureNoteConflict invoked by tui view after the user chose how to resolve the update conflict.
+func (ac *viewController) ResolveSecureNoteConflict(note *domain.SecureNote, resolution domain.ConflictResolution) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			if err := ac.dataAccessor.ResolveSecureNoteConflict(ctx, note, resolution); err != nil {
+				return err
+			}
+			ac.dataAccessor.ResolvePendingConflict()
+			return nil
+		}, func() {
+			ac.GetSecureNoteList()
+			ac.ReplayPendingChanges() // the conflict may be found on replay
+		})
+}
+
+func (ac *viewController) DeleteSecureNote(title string) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return ac.dataAccessor.DeleteSecureNote(ctx, title)
+		}, func() {
+			ac.GetSecureNoteList()
+		})
+}
+
+// GetSecureNoteHistory invoked by tui view
+func (ac *viewController) GetSecureNoteHistory(title string) {
+	ac.invokeOnlineFn(func(ctx context.Context) error {
+		revisions, err := ac.dataAccessor.GetSecureNoteHistory(ctx, title)
+		if err != nil {
+			return err
+		}
+		ac.appView.ShowItemHistoryView(domain.SecretItemSecureNote, title, revisions)
+		return nil
+	}, nil)
+}
+
+func (ac *viewController) GetAuthenticatorList() {
+	ac.invokeFn(func(ctx context.Context) error {
+		if ac.offline.Load() {
+			return nil // show cache
+		}
+		if err := ac.dataAccessor.GetAuthenticatorList(ctx); err != nil {
+			ac.appView.ShowMsg(errorMessage(err))
+			// do not return error - show cache
+		}
+		return nil
+	}, func() {
+		names := ac.storage.GetAuthenticatorList()
+		ac.appView.ShowAuthenticatorListView(names) // show always
+		ac.appView.ShowPendingChanges(ac.dataAccessor.PendingCount())
+	})
+}
+
+// GetAuthenticator invoked by tui view
+func (ac *viewController) GetAuthenticator(name string) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			if auth, err := ac.storage.GetAuthenticator(name); err != nil {
+				return err
+			} else {
+				ac.appView.ShowEditAuthenticatorView(auth)
+			}
+			return nil
+		}, nil)
+}
+
+func (ac *viewController) NewAuthenticator() {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return nil
+		}, func() {
+			ac.appView.ShowNewAuthenticatorView(nil)
+		})
+}
+
+// ReadAuthenticatorQR invoked by tui view; the new authenticator form is shown again with the uri read from the QR code.
+func (ac *viewController) ReadAuthenticatorQR(auth *domain.Authenticator, path string) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return ac.dataAccessor.ReadAuthenticatorQR(auth, path)
+		}, func() {
+			ac.appView.ShowNewAuthenticatorView(auth)
+		})
+}
+
+func (ac *viewController) AddAuthenticator(auth *domain.Authenticator) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return ac.dataAccessor.AddAuthenticator(ctx, auth)
+		}, func() {
+			ac.GetAuthenticatorList()
+		})
+}
+
+func (ac *viewController) UpdateAuthenticator(auth *domain.Authenticator) {
+	var conflict bool
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			if err := ac.dataAccessor.UpdateAuthenticator(ctx, auth); err != nil {
+				if errors.Is(err, domain.ErrDataConflict) {
+					conflict = true
+					ac.appView.ShowAuthenticatorConflictView(auth)
+					return nil
+				}
+				return err
+			}
+			return nil
+		}, func() {
+			if !conflict {
+				ac.GetAuthenticatorList()
+			}
+		})
+}
+
+// ResolveAuthenticatorConflict invoked by tui view after the user chose how to resolve the update conflict.
+func (ac *viewController) ResolveAuthenticatorConflict(auth *domain.Authenticator, resolution domain.ConflictResolution) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			if err := ac.dataAccessor.ResolveAuthenticatorConflict(ctx, auth, resolution); err != nil {
+				return err
+			}
+			ac.dataAccessor.ResolvePendingConflict()
+			return nil
+		}, func() {
+			ac.GetAuthenticatorList()
+			ac.ReplayPendingChanges() // the conflict may be found on replay
+		})
+}
+
+func (ac *viewController) DeleteAuthenticator(name string) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return ac.dataAccessor.DeleteAuthenticator(ctx, name)
+		}, func() {
+			ac.GetAuthenticatorList()
+		})
+}
+
+// GetAuthenticatorHistory invoked by tui view
+func (ac *viewController) GetAuthenticatorHistory(name string) {
+	ac.invokeOnlineFn(func(ctx context.Context) error {
+		revisions, err := ac.dataAccessor.GetAuthenticatorHistory(ctx, name)
+		if err != nil {
+			return err
+		}
+		ac.appView.ShowItemHistoryView(domain.SecretItemAuthenticator, name, revisions)
+		return nil
+	}, nil)
+}
+
+func (ac *viewController) GetSSHKeyList() {
+	ac.invokeFn(func(ctx context.Context) error {
+		if ac.offline.Load() {
+			return nil // show cache
+		}
+		if err := ac.dataAccessor.GetSSHKeyList(ctx); err != nil {
+			ac.appView.ShowMsg(errorMessage(err))
+			// do not return error - show cache
+		}
+		return nil
+	}, func() {
+		names := ac.storage.GetSSHKeyList()
+		ac.appView.ShowSSHKeyListView(names) // show always
+		ac.appView.ShowPendingChanges(ac.dataAccessor.PendingCount())
+	})
+}
+
+// GetSSHKey invoked by tui view
+func (ac *viewController) GetSSHKey(name string) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			if key, err := ac.storage.GetSSHKey(name); err != nil {
+				return err
+			} else {
+				ac.appView.ShowEditSSHKeyView(key)
+			}
+			return nil
+		}, nil)
+}
+
+func (ac *viewController) NewSSHKey() {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return nil
+		}, func() {
+			ac.appView.ShowNewSSHKeyView()
+		})
+}
+
+func (ac *viewController) AddSSHKey(key *domain.SSHKey) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return ac.dataAccessor.AddSSHKey(ctx, key)
+		}, func() {
+			ac.GetSSHKeyList()
+		})
+}
+
+func (ac *viewController) UpdateSSHKey(key *domain.SSHKey) {
+	var conflict bool
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			if err := ac.dataAccessor.UpdateSSHKey(ctx, key); err != nil {
+				if errors.Is(err, domain.ErrDataConflict) {
+					conflict = true
+					ac.appView.ShowSSHKeyConflictView(key)
+					return nil
+				}
+				return err
+			}
+			return nil
+		}, func() {
+			if !conflict {
+				ac.GetSSHKeyList()
+			}
+		})
+}
+
+// ResolveSSHKeyConflict invoked by tui view after the user chose how to resolve the update conflict.
+func (ac *viewController) ResolveSSHKeyConflict(key *domain.SSHKey, resolution domain.ConflictResolution) {
+	ac.invokeOnlineFn(
+		func(ctx context.Context) error {
+			if err := ac.dataAccessor.ResolveSSHKeyConflict(ctx, key, resolution); err != nil {
+				return err
+			}
+			ac.dataAccessor.ResolvePendingConflict()
+			return nil
+		}, func() {
+			ac.GetSSHKeyList()
+			ac.ReplayPendingChanges() // the conflict may be found on replay
+		})
+}
+
+func (ac *viewController) DeleteSSHKey(name string) {
+	ac.invokeFn(
+		func(ctx context.Context) error {
+			return ac.dataAccessor.DeleteSSHKey(ctx, name)
+		}, func() {
+			ac.GetSSHKeyList()
+		})
+}
+
+// GetSSHKeyHistory invoked by tui view
+func (ac *viewController) GetSSHKeyHistory(name string) {
+	ac.invokeOnlineFn(func(ctx context.Context) error {
+		revisions, err := ac.dataAccessor.GetSSHKeyHistory(ctx, name)
+		if err != nil {
+			return err
+		}
+		ac.appView.ShowItemHistoryView(domain.SecretItemSSHKey, name, revisions)
+		return nil
+	}, nil)
+}
+
+// SSHKeys invoked by the ssh-agent; no keys are returned while the vault is locked.
+func (ac *viewController) SSHKeys(ctx context.Context) []domain.SSHKey {
+	return ac.dataAccessor.SSHKeys(ctx)
+}
+
+// SecretItem invoked by the command line run; only the requested item is decrypted.
+func (ac *viewController) SecretItem(ctx context.Context, itemType domain.SecretItemType, name string) (any, error) {
+	return ac.dataAccessor.SecretItem(ctx, itemType, name)
+}
+
+// ConfirmSSHSign invoked by the ssh-agent for the key requiring confirmation; the signing is refused
+// if the user does not answer until ctx is done.
+func (ac *viewController) ConfirmSSHSign(ctx context.Context, key *domain.SSHKey) bool {
+	answer := make(chan bool, 1)
+	ac.appView.ShowSSHSignConfirmView(ctx, key.Name, func(allowed bool) {
+		answer <- allowed
+	})
+
+	select {
+	case allowed := <-answer:
+		return allowed
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// StoreSecureNoteAsFile uploads the note text as the file named by the note title; the text is encrypted
+// in memory and is not written to the disk. The custom fields of the note become the file metadata.
+func (ac *viewController) StoreSecureNoteAsFile(note *domain.SecureNote) {
+	name := domain.SecureNoteFileName(note.Title)
+	ac.upload(name, func(ctx context.Context, progerssFn func(send int, all int), cancelChan <-chan struct{}, errorChan chan<- error) {
+		ac.fileAccessor.UploadData(ctx, name, []byte(note.Text), progerssFn, cancelChan, errorChan)
+	}, func() {
+		if len(note.Fields) == 0 {
+			ac.GetFilesInfoList()
+			return
+		}
+		ac.invokeFn(
+			func(ctx context.Context) error {
+				return ac.dataAccessor.SaveFileMetadata(ctx, &domain.FileMetadata{Name: name, Fields: note.Fields})
+			}, func() {
+				ac.GetFilesInfoList()
+			})
+	})
+}
+
 func (ac *viewController) SaveFile(info *domain.FileInfo) {
+	if ac.offline.Load() {
+		ac.appView.ShowMsg(errorMessage(domain.ErrClientOfflineReadOnly))
+		return
+	}
 	log.Debug("SaveFile start")
 	cancelChan := make(chan struct{}, 1)
 	errorChan := make(chan error, 1)
@@ -402,7 +1137,7 @@ func (ac *viewController) SaveFile(info *domain.FileInfo) {
 		log.Debug("Load complete")
 		select {
 		case err := <-errorChan:
-			ac.appView.ShowMsg(err.Error())
+			ac.appView.ShowMsg(errorMessage(err))
 		default:
 			ac.GetFilesInfoList()
 		}
@@ -411,6 +1146,21 @@ func (ac *viewController) SaveFile(info *domain.FileInfo) {
 }
 
 func (ac *viewController) UploadFile(info *domain.FileInfo) {
+	ac.upload(info.Name, func(ctx context.Context, progerssFn func(send int, all int), cancelChan <-chan struct{}, errorChan chan<- error) {
+		ac.fileAccessor.UploadFile(ctx, info, progerssFn, cancelChan, errorChan)
+	}, func() {
+		ac.GetFilesInfoList()
+	})
+}
+
+// upload runs the upload operation with the progress bar; successFn is invoked if the file is uploaded.
+func (ac *viewController) upload(name string,
+	uploadFn func(ctx context.Context, progerssFn func(send int, all int), cancelChan <-chan struct{}, errorChan chan<- error),
+	successFn func()) {
+	if ac.offline.Load() {
+		ac.appView.ShowMsg(errorMessage(domain.ErrClientOfflineReadOnly))
+		return
+	}
 	log.Debug("Upload start")
 	cancelChan := make(chan struct{}, 1)
 	errorChan := make(chan error, 1)
@@ -451,7 +1201,7 @@ func (ac *viewController) UploadFile(info *domain.FileInfo) {
 					if percentage > 100 {
 						percentage = 100
 					}
-					ac.appView.CreateProgressBar(fmt.Sprintf("Uploading %s", info.Name), percentage, progressText, cancelFnHandler)
+					ac.appView.CreateProgressBar(fmt.Sprintf("Uploading %s", name), percentage, progressText, cancelFnHandler)
 				}
 			}
 		}()
@@ -465,13 +1215,13 @@ func (ac *viewController) UploadFile(info *domain.FileInfo) {
 
 		ctx := context.Background()
 
-		ac.fileAccessor.UploadFile(ctx, info, progerssFn, cancelChan, errorChan)
+		uploadFn(ctx, progerssFn, cancelChan, errorChan)
 		log.Debug("Upload complete")
 		select {
 		case err := <-errorChan:
-			ac.appView.ShowMsg(err.Error())
+			ac.appView.ShowMsg(errorMessage(err))
 		default:
-			ac.GetFilesInfoList()
+			successFn()
 		}
 	}()
 
@@ -1,6 +1,7 @@
 package app_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -89,4 +90,71 @@ func TestController(t *testing.T) {
 		appController.Stop(stpCtx)
 		defer stopFn()
 	})
+	t.Run("login_err_details", func(t *testing.T) {
+		mockSrv := NewMockAppServer(ctrl)
+
+		conf := &config.ClientConf{
+			InterationTimeout: 1 * time.Second,
+		}
+
+		mockSrv.EXPECT().Ping(gomock.Any()).Return(nil).AnyTimes()
+		mockSrv.EXPECT().Stop().Times(1)
+		mockSrv.EXPECT().Start().Times(1)
+
+		mockSrv.EXPECT().Login(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, dt *domain.EMailData) error {
+			dErr := domain.NewDetailedError(domain.ErrAuthTooManyAttempts, "locked").WithRetryAfter(time.Minute)
+			return fmt.Errorf("%w: login err ", dErr)
+		})
+
+		msgCh := make(chan string, 1)
+		mockView := NewMockAppView(ctrl)
+		mockView.EXPECT().ShowMsg(gomock.Any()).Do(func(msg string) {
+			msgCh <- msg
+		}).Times(1)
+
+		appController := app.NewViewController(conf).SetServer(mockSrv).SetInfoView(mockView)
+
+		appController.Start()
+
+		appController.LoginEMail(&domain.EMailData{
+			EMail:    "email",
+			Password: "pass",
+		})
+
+		select {
+		case msg := <-msgCh:
+			assert.Equal(t, "locked, retry after 1m0s", msg)
+		case <-time.After(3 * time.Second):
+			t.Fatal("message is not shown")
+		}
+
+		stpCtx, stopFn := context.WithTimeout(context.Background(), time.Second)
+		appController.Stop(stpCtx)
+		defer stopFn()
+	})
+
+	t.Run("confirm_ssh_sign", func(t *testing.T) {
+		conf := &config.ClientConf{
+			InterationTimeout: 1 * time.Second,
+		}
+		mockView := NewMockAppView(ctrl)
+		appController := app.NewViewController(conf).SetInfoView(mockView)
+		key := &domain.SSHKey{Name: "work", Confirm: true}
+
+		mockView.EXPECT().ShowSSHSignConfirmView(gomock.Any(), "work", gomock.Any()).Do(func(ctx context.Context, name string, answer func(allowed bool)) {
+			go answer(true)
+		}).Times(1)
+		assert.True(t, appController.ConfirmSSHSign(context.Background(), key))
+
+		mockView.EXPECT().ShowSSHSignConfirmView(gomock.Any(), "work", gomock.Any()).Do(func(ctx context.Context, name string, answer func(allowed bool)) {
+			go answer(false)
+		}).Times(1)
+		assert.False(t, appController.ConfirmSSHSign(context.Background(), key))
+
+		// no answer
+		mockView.EXPECT().ShowSSHSignConfirmView(gomock.Any(), "work", gomock.Any()).Times(1)
+		ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancelFn()
+		assert.False(t, appController.ConfirmSSHSign(ctx, key))
+	})
 }
@@ -14,13 +14,21 @@ const (
 	ClientDefaultCACert            = "../../keys/ca-cert.pem"
 	ClientDefaultLogFile           = "./client.log"
 	ClientDefaultInterationTimeout = 3 * time.Second
+	ClientDefaultVaultFile         = "./vault.json"
+	ClientDefaultSessionFile       = "./session.json"
 )
 
 type ClientConf struct {
 	ServerAddress     string        `env:"SERVER_ADDRESS" json:"serverAddress,omitempty"`
 	InterationTimeout time.Duration `env:"INTERACTION_TIMEOUT" json:"interactionTimeout,omitempty"`
 	CACert            string        `env:"CA_CERT" json:"caCert"`
+	TLSCert           string        `env:"TLS_CERT" json:"tlsCert,omitempty"` // client certificate for mutual TLS, optional
+	TLSKey            string        `env:"TLS_KEY" json:"tlsKey,omitempty"`   // client certificate private key
 	LogFile           string        `env:"LOG_FILE" json:"logFile"`
+	DeviceName        string        `env:"DEVICE_NAME" json:"deviceName,omitempty"`          // is shown in the session list, host name by default
+	VaultFile         string        `env:"VAULT_FILE" json:"vaultFile,omitempty"`            // encrypted data copy for offline access
+	SessionFile       string        `env:"SESSION_FILE" json:"sessionFile,omitempty"`        // server session of the command line mode
+	SSHAgentSocket    string        `env:"SSH_AGENT_SOCKET" json:"sshAgentSocket,omitempty"` // ssh-agent unix socket, the agent is disabled if empty
 }
 
 func defaultClientConf() *ClientConf {
@@ -29,6 +37,8 @@ func defaultClientConf() *ClientConf {
 		CACert:            ClientDefaultCACert,
 		InterationTimeout: ClientDefaultInterationTimeout,
 		LogFile:           ClientDefaultLogFile,
+		VaultFile:         ClientDefaultVaultFile,
+		SessionFile:       ClientDefaultSessionFile,
 	}
 }
 
@@ -22,6 +22,8 @@ func TestClientConf(t *testing.T) {
 
 		assert.Equal(t, config.ClientDefaultServerAddres, conf.ServerAddress)
 		assert.Equal(t, config.ClientDefaultCACert, conf.CACert)
+		assert.Equal(t, config.ClientDefaultVaultFile, conf.VaultFile)
+		assert.Equal(t, config.ClientDefaultSessionFile, conf.SessionFile)
 	})
 
 	t.Run("env values", func(t *testing.T) {
@@ -33,6 +35,18 @@ func TestClientConf(t *testing.T) {
 		err = os.Setenv("CA_CERT", "ca.cert")
 		require.NoError(t, err)
 
+		err = os.Setenv("DEVICE_NAME", "laptop")
+		require.NoError(t, err)
+
+		err = os.Setenv("TLS_CERT", "client-cert.pem")
+		require.NoError(t, err)
+
+		err = os.Setenv("TLS_KEY", "client-key.pem")
+		require.NoError(t, err)
+
+		err = os.Setenv("VAULT_FILE", "/tmp/vault.json")
+		require.NoError(t, err)
+
 		flagSet := flag.NewFlagSet(t.Name(), errorHandling)
 
 		conf, err := config.LoadClientConf(flagSet)
@@ -40,6 +54,10 @@ func TestClientConf(t *testing.T) {
 
 		assert.Equal(t, "http://test", conf.ServerAddress)
 		assert.Equal(t, "ca.cert", conf.CACert)
+		assert.Equal(t, "laptop", conf.DeviceName)
+		assert.Equal(t, "client-cert.pem", conf.TLSCert)
+		assert.Equal(t, "client-key.pem", conf.TLSKey)
+		assert.Equal(t, "/tmp/vault.json", conf.VaultFile)
 	})
 
 	t.Run("config file", func(t *testing.T) {
@@ -13,8 +13,12 @@ const (
 	ServerDefaultPort             = ":3200"
 	ServerDefaultTLSKey           = "../../keys/server-key.pem"
 	ServerDefaultTLSCert          = "../../keys/server-cert.pem"
+	ServerDefaultTLSClientAuth    = "none"
+	ServerDefaultTLSClientCA      = ""
 	ServerDefaultTokenExp         = 3 * time.Hour
-	ServerDefaultTokenSecret      = "secret"
+	ServerDefaultJWTKeysDir       = "../../keys/jwt"
+	ServerDefaultJWTKeyAlg        = "EdDSA"
+	ServerDefaultJWTKeysReload    = time.Minute
 	ServerDefaultAuthStageTimeout = 5 * time.Minute
 	ServerDefaultServerSecret     = "ServerKey!12>Au{mL736}"
 	ServerDefaultDomainName       = "localhost"
@@ -28,14 +32,31 @@ const (
 	ServerDefaultSMTPUsername     = ""
 	ServerDefaultSMTPPassword     = ""
 	ServerDefaultFStoragePath     = "/tmp"
+	ServerDefaultPassHashAlg      = "argon2id"
+	ServerDefaultAuthMaxFailures  = 5
+	ServerDefaultAuthMaxPeerFails = 20
+	ServerDefaultAuthLockoutBase  = 30 * time.Second
+	ServerDefaultAuthLockoutMax   = 15 * time.Minute
+	ServerDefaultAuthMaxOTPTries  = 3
+	ServerDefaultOTPSkewPast      = 1
+	ServerDefaultOTPSkewFuture    = 1
+	ServerDefaultRefreshTokenExp  = 7 * 24 * time.Hour
+	ServerDefaultTrashRetention   = 30 * 24 * time.Hour
+	ServerDefaultTrashPurge       = time.Hour
+	ServerDefaultHistoryMaxCount  = 10
+	ServerDefaultHistoryMaxAge    = 90 * 24 * time.Hour
 )
 
 type ServerConf struct {
 	Port             string        `env:"PORT" json:"port,omitempty"`
 	TLSKey           string        `env:"TLS_KEY" json:"tlsKey,omitempty"`
 	TLSCert          string        `env:"TLS_CERT" json:"tlsCert,omitempty"`
+	TLSClientAuth    string        `env:"TLS_CLIENT_AUTH" json:"tlsClientAuth,omitempty"` // none, optional or require
+	TLSClientCA      string        `env:"TLS_CLIENT_CA" json:"tlsClientCA,omitempty"`     // CA bundle to verify client certificates
 	TokenExp         time.Duration `env:"JWT_EXP" json:"jwtExp,omitempty"`
-	TokenSecret      string        `env:"JWT_SECRET" json:"jwtSecret,omitempty"`
+	JWTKeysDir       string        `env:"JWT_KEYS_DIR" json:"jwtKeysDir,omitempty"`       // directory with jwt signing keys
+	JWTKeyAlg        string        `env:"JWT_KEY_ALG" json:"jwtKeyAlg,omitempty"`         // EdDSA or ES256, is used for new keys on rotation
+	JWTKeysReload    time.Duration `env:"JWT_KEYS_RELOAD" json:"jwtKeysReload,omitempty"` // key directory reload interval, 0 - disabled
 	AuthStageTimeout time.Duration `env:"AUTH_STAGE_TIMEOUT" json:"authTimeout,omitempty"`
 	ServerSecret     string        `env:"SERVER_SECRET" json:"serverSecret,omitempty"`
 	DomainName       string        `env:"DOMAIN_NAME" json:"domainName,omitempty"`
@@ -49,6 +70,19 @@ type ServerConf struct {
 	MaxConnLifetime  time.Duration `env:"DATABASE_MAX_CONN_LIFE_TIME" json:"dbMaxConnLifeTime,omitempty"`
 	MaxConnIdleTime  time.Duration `env:"DATABASE_MAX_CONN_IDLE_TIME" json:"dbMaxConnIdleTime,omitempty"`
 	FStoragePath     string        `env:"FS_STORAGE_PATH" json:"fsStoragePath,omitempty"`
+	PassHashAlg      string        `env:"PASS_HASH_ALG" json:"passHashAlg,omitempty"`                  // argon2id, bcrypt or scrypt
+	AuthMaxFailures  int           `env:"AUTH_MAX_FAILURES" json:"authMaxFailures,omitempty"`          // failed attempts per account before lockout, 0 - disabled
+	AuthMaxPeerFails int           `env:"AUTH_MAX_PEER_FAILURES" json:"authMaxPeerFailures,omitempty"` // failed attempts per client ip before lockout, 0 - disabled
+	AuthLockoutBase  time.Duration `env:"AUTH_LOCKOUT_BASE" json:"authLockoutBase,omitempty"`          // first lockout duration, doubled on every next failure
+	AuthLockoutMax   time.Duration `env:"AUTH_LOCKOUT_MAX" json:"authLockoutMax,omitempty"`            // max lockout duration; counters are reset after it without failures
+	AuthMaxOTPTries  int           `env:"AUTH_MAX_OTP_TRIES" json:"authMaxOTPTries,omitempty"`         // OTP attempts per login session, 0 - unlimited
+	OTPSkewPast      uint          `env:"OTP_SKEW_PAST" json:"otpSkewPast,omitempty"`                  // accepted TOTP steps before the current one
+	OTPSkewFuture    uint          `env:"OTP_SKEW_FUTURE" json:"otpSkewFuture,omitempty"`              // accepted TOTP steps after the current one
+	RefreshTokenExp  time.Duration `env:"REFRESH_TOKEN_EXP" json:"refreshTokenExp,omitempty"`          // refresh token lifetime
+	TrashRetention   time.Duration `env:"TRASH_RETENTION" json:"trashRetention,omitempty"`             // how long deleted items and files are kept in the trash
+	TrashPurge       time.Duration `env:"TRASH_PURGE_INTERVAL" json:"trashPurgeInterval,omitempty"`    // expired trash purge interval, 0 - disabled
+	HistoryMaxCount  int           `env:"ITEM_HISTORY_MAX_COUNT" json:"itemHistoryMaxCount,omitempty"` // previous revisions kept per item, 0 - unlimited
+	HistoryMaxAge    time.Duration `env:"ITEM_HISTORY_MAX_AGE" json:"itemHistoryMaxAge,omitempty"`     // how long previous revisions are kept, 0 - unlimited
 }
 
 func defaultServConf() *ServerConf {
@@ -56,8 +90,12 @@ func defaultServConf() *ServerConf {
 		Port:             ServerDefaultPort,
 		TLSKey:           ServerDefaultTLSKey,
 		TLSCert:          ServerDefaultTLSCert,
+		TLSClientAuth:    ServerDefaultTLSClientAuth,
+		TLSClientCA:      ServerDefaultTLSClientCA,
 		TokenExp:         ServerDefaultTokenExp,
-		TokenSecret:      ServerDefaultTokenSecret,
+		JWTKeysDir:       ServerDefaultJWTKeysDir,
+		JWTKeyAlg:        ServerDefaultJWTKeyAlg,
+		JWTKeysReload:    ServerDefaultJWTKeysReload,
 		AuthStageTimeout: ServerDefaultAuthStageTimeout,
 		ServerSecret:     ServerDefaultServerSecret,
 		DomainName:       ServerDefaultDomainName,
@@ -71,6 +109,19 @@ func defaultServConf() *ServerConf {
 		SMTPPassword:     ServerDefaultSMTPPassword,
 		SMTPUsername:     ServerDefaultSMTPUsername,
 		FStoragePath:     ServerDefaultFStoragePath,
+		PassHashAlg:      ServerDefaultPassHashAlg,
+		AuthMaxFailures:  ServerDefaultAuthMaxFailures,
+		AuthMaxPeerFails: ServerDefaultAuthMaxPeerFails,
+		AuthLockoutBase:  ServerDefaultAuthLockoutBase,
+		AuthLockoutMax:   ServerDefaultAuthLockoutMax,
+		AuthMaxOTPTries:  ServerDefaultAuthMaxOTPTries,
+		OTPSkewPast:      ServerDefaultOTPSkewPast,
+		OTPSkewFuture:    ServerDefaultOTPSkewFuture,
+		RefreshTokenExp:  ServerDefaultRefreshTokenExp,
+		TrashRetention:   ServerDefaultTrashRetention,
+		TrashPurge:       ServerDefaultTrashPurge,
+		HistoryMaxCount:  ServerDefaultHistoryMaxCount,
+		HistoryMaxAge:    ServerDefaultHistoryMaxAge,
 	}
 }
 
@@ -125,6 +176,13 @@ func (sCnf *ServerConf) UnmarshalJSON(data []byte) (err error) {
 		AuthStageTimeout string `json:"authTimeout,omitempty"`
 		MaxConnLifetime  string `json:"dbMaxConnLifeTime,omitempty"`
 		MaxConnIdleTime  string `json:"dbMaxConnIdleTime,omitempty"`
+		AuthLockoutBase  string `json:"authLockoutBase,omitempty"`
+		AuthLockoutMax   string `json:"authLockoutMax,omitempty"`
+		RefreshTokenExp  string `json:"refreshTokenExp,omitempty"`
+		JWTKeysReload    string `json:"jwtKeysReload,omitempty"`
+		TrashRetention   string `json:"trashRetention,omitempty"`
+		TrashPurge       string `json:"trashPurgeInterval,omitempty"`
+		HistoryMaxAge    string `json:"itemHistoryMaxAge,omitempty"`
 	}{
 
 		ServerConfAlias: (*ServerConfAlias)(sCnf),
@@ -166,5 +224,61 @@ func (sCnf *ServerConf) UnmarshalJSON(data []byte) (err error) {
 		sCnf.MaxConnIdleTime = tm
 	}
 
+	if aliasValue.AuthLockoutBase != "" {
+		tm, err := time.ParseDuration(aliasValue.AuthLockoutBase)
+		if err != nil {
+			return err
+		}
+		sCnf.AuthLockoutBase = tm
+	}
+
+	if aliasValue.AuthLockoutMax != "" {
+		tm, err := time.ParseDuration(aliasValue.AuthLockoutMax)
+		if err != nil {
+			return err
+		}
+		sCnf.AuthLockoutMax = tm
+	}
+
+	if aliasValue.RefreshTokenExp != "" {
+		tm, err := time.ParseDuration(aliasValue.RefreshTokenExp)
+		if err != nil {
+			return err
+		}
+		sCnf.RefreshTokenExp = tm
+	}
+
+	if aliasValue.JWTKeysReload != "" {
+		tm, err := time.ParseDuration(aliasValue.JWTKeysReload)
+		if err != nil {
+			return err
+		}
+		sCnf.JWTKeysReload = tm
+	}
+
+	if aliasValue.TrashRetention != "" {
+		tm, err := time.ParseDuration(aliasValue.TrashRetention)
+		if err != nil {
+			return err
+		}
+		sCnf.TrashRetention = tm
+	}
+
+	if aliasValue.TrashPurge != "" {
+		tm, err := time.ParseDuration(aliasValue.TrashPurge)
+		if err != nil {
+			return err
+		}
+		sCnf.TrashPurge = tm
+	}
+
+	if aliasValue.HistoryMaxAge != "" {
+		tm, err := time.ParseDuration(aliasValue.HistoryMaxAge)
+		if err != nil {
+			return err
+		}
+		sCnf.HistoryMaxAge = tm
+	}
+
 	return
 }
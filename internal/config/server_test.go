@@ -27,9 +27,13 @@ func TestLoadServConf(t *testing.T) {
 		assert.Equal(t, config.ServerDefaultPort, conf.Port)
 		assert.Equal(t, config.ServerDefaultTLSCert, conf.TLSCert)
 		assert.Equal(t, config.ServerDefaultTLSKey, conf.TLSKey)
+		assert.Equal(t, config.ServerDefaultTLSClientAuth, conf.TLSClientAuth)
+		assert.Equal(t, config.ServerDefaultTLSClientCA, conf.TLSClientCA)
 
 		assert.Equal(t, config.ServerDefaultTokenExp, conf.TokenExp)
-		assert.Equal(t, config.ServerDefaultTokenSecret, conf.TokenSecret)
+		assert.Equal(t, config.ServerDefaultJWTKeysDir, conf.JWTKeysDir)
+		assert.Equal(t, config.ServerDefaultJWTKeyAlg, conf.JWTKeyAlg)
+		assert.Equal(t, config.ServerDefaultJWTKeysReload, conf.JWTKeysReload)
 		assert.Equal(t, config.ServerDefaultAuthStageTimeout, conf.AuthStageTimeout)
 
 		assert.Equal(t, config.ServerDefaultServerSecret, conf.ServerSecret)
@@ -47,6 +51,19 @@ func TestLoadServConf(t *testing.T) {
 		assert.Equal(t, config.ServerDefaultMaxConnLifetime, conf.MaxConnLifetime)
 		assert.Equal(t, config.ServerDefaultMaxConnIdleTime, conf.MaxConnIdleTime)
 		assert.Equal(t, config.ServerDefaultFStoragePath, conf.FStoragePath)
+		assert.Equal(t, config.ServerDefaultPassHashAlg, conf.PassHashAlg)
+		assert.Equal(t, config.ServerDefaultAuthMaxFailures, conf.AuthMaxFailures)
+		assert.Equal(t, config.ServerDefaultAuthMaxPeerFails, conf.AuthMaxPeerFails)
+		assert.Equal(t, config.ServerDefaultAuthLockoutBase, conf.AuthLockoutBase)
+		assert.Equal(t, config.ServerDefaultAuthLockoutMax, conf.AuthLockoutMax)
+		assert.Equal(t, config.ServerDefaultAuthMaxOTPTries, conf.AuthMaxOTPTries)
+		assert.Equal(t, uint(config.ServerDefaultOTPSkewPast), conf.OTPSkewPast)
+		assert.Equal(t, uint(config.ServerDefaultOTPSkewFuture), conf.OTPSkewFuture)
+		assert.Equal(t, config.ServerDefaultRefreshTokenExp, conf.RefreshTokenExp)
+		assert.Equal(t, config.ServerDefaultTrashRetention, conf.TrashRetention)
+		assert.Equal(t, config.ServerDefaultTrashPurge, conf.TrashPurge)
+		assert.Equal(t, config.ServerDefaultHistoryMaxCount, conf.HistoryMaxCount)
+		assert.Equal(t, config.ServerDefaultHistoryMaxAge, conf.HistoryMaxAge)
 	})
 
 	t.Run("env values durations", func(t *testing.T) {
@@ -74,10 +91,22 @@ func TestLoadServConf(t *testing.T) {
 		err = os.Setenv("TLS_CERT", "test.cert")
 		require.NoError(t, err)
 
+		err = os.Setenv("TLS_CLIENT_AUTH", "require")
+		require.NoError(t, err)
+
+		err = os.Setenv("TLS_CLIENT_CA", "client-ca.pem")
+		require.NoError(t, err)
+
 		err = os.Setenv("JWT_EXP", "1h")
 		require.NoError(t, err)
 
-		err = os.Setenv("JWT_SECRET", "pass")
+		err = os.Setenv("JWT_KEYS_DIR", "./jwt")
+		require.NoError(t, err)
+
+		err = os.Setenv("JWT_KEY_ALG", "ES256")
+		require.NoError(t, err)
+
+		err = os.Setenv("JWT_KEYS_RELOAD", "30s")
 		require.NoError(t, err)
 
 		err = os.Setenv("AUTH_STAGE_TIMEOUT", "4m")
@@ -119,6 +148,45 @@ func TestLoadServConf(t *testing.T) {
 		err = os.Setenv("FS_STORAGE_PATH", "./")
 		require.NoError(t, err)
 
+		err = os.Setenv("PASS_HASH_ALG", "bcrypt")
+		require.NoError(t, err)
+
+		err = os.Setenv("AUTH_MAX_FAILURES", "7")
+		require.NoError(t, err)
+
+		err = os.Setenv("AUTH_MAX_PEER_FAILURES", "30")
+		require.NoError(t, err)
+
+		err = os.Setenv("AUTH_LOCKOUT_BASE", "10s")
+		require.NoError(t, err)
+
+		err = os.Setenv("AUTH_LOCKOUT_MAX", "1h")
+		require.NoError(t, err)
+
+		err = os.Setenv("AUTH_MAX_OTP_TRIES", "2")
+		require.NoError(t, err)
+
+		err = os.Setenv("OTP_SKEW_PAST", "2")
+		require.NoError(t, err)
+
+		err = os.Setenv("OTP_SKEW_FUTURE", "0")
+		require.NoError(t, err)
+
+		err = os.Setenv("REFRESH_TOKEN_EXP", "24h")
+		require.NoError(t, err)
+
+		err = os.Setenv("TRASH_RETENTION", "72h")
+		require.NoError(t, err)
+
+		err = os.Setenv("TRASH_PURGE_INTERVAL", "10m")
+		require.NoError(t, err)
+
+		err = os.Setenv("ITEM_HISTORY_MAX_COUNT", "5")
+		require.NoError(t, err)
+
+		err = os.Setenv("ITEM_HISTORY_MAX_AGE", "240h")
+		require.NoError(t, err)
+
 		flagSet := flag.NewFlagSet(t.Name(), errorHandling)
 		conf, err := config.LoadServConf(flagSet)
 
@@ -127,9 +195,13 @@ func TestLoadServConf(t *testing.T) {
 		assert.Equal(t, ":9191", conf.Port)
 		assert.Equal(t, "test.cert", conf.TLSCert)
 		assert.Equal(t, "test.key", conf.TLSKey)
+		assert.Equal(t, "require", conf.TLSClientAuth)
+		assert.Equal(t, "client-ca.pem", conf.TLSClientCA)
 
 		assert.Equal(t, 1*time.Hour, conf.TokenExp)
-		assert.Equal(t, "pass", conf.TokenSecret)
+		assert.Equal(t, "./jwt", conf.JWTKeysDir)
+		assert.Equal(t, "ES256", conf.JWTKeyAlg)
+		assert.Equal(t, 30*time.Second, conf.JWTKeysReload)
 		assert.Equal(t, 4*time.Minute, conf.AuthStageTimeout)
 		assert.Equal(t, "key", conf.ServerSecret)
 		assert.Equal(t, "example.com", conf.DomainName)
@@ -146,6 +218,19 @@ func TestLoadServConf(t *testing.T) {
 		assert.Equal(t, 1*time.Minute, conf.MaxConnLifetime)
 		assert.Equal(t, 2*time.Minute, conf.MaxConnIdleTime)
 		assert.Equal(t, "./", conf.FStoragePath)
+		assert.Equal(t, "bcrypt", conf.PassHashAlg)
+		assert.Equal(t, 7, conf.AuthMaxFailures)
+		assert.Equal(t, 30, conf.AuthMaxPeerFails)
+		assert.Equal(t, 10*time.Second, conf.AuthLockoutBase)
+		assert.Equal(t, 1*time.Hour, conf.AuthLockoutMax)
+		assert.Equal(t, 2, conf.AuthMaxOTPTries)
+		assert.Equal(t, uint(2), conf.OTPSkewPast)
+		assert.Equal(t, uint(0), conf.OTPSkewFuture)
+		assert.Equal(t, 24*time.Hour, conf.RefreshTokenExp)
+		assert.Equal(t, 72*time.Hour, conf.TrashRetention)
+		assert.Equal(t, 10*time.Minute, conf.TrashPurge)
+		assert.Equal(t, 5, conf.HistoryMaxCount)
+		assert.Equal(t, 240*time.Hour, conf.HistoryMaxAge)
 	})
 
 	t.Run("env rewrite", func(t *testing.T) {
@@ -168,6 +253,7 @@ func TestLoadServConf(t *testing.T) {
 
 		assert.Equal(t, ":9191", conf.Port)
 		assert.Equal(t, 45*time.Second, conf.AuthStageTimeout)
+		assert.Equal(t, 20*time.Minute, conf.AuthLockoutMax)
 	})
 
 	t.Run("err", func(t *testing.T) {
@@ -35,3 +35,9 @@ type ChunkDecrypter interface {
 	WriteChunk(chunk []byte) ([]byte, error)
 	Finish() error
 }
+
+// JWTKeyProvider returns the key new jwt tokens are signed with and keys tokens are verified by
+type JWTKeyProvider interface {
+	SigningKey() *JWTSigningKey
+	VerificationKey(keyID string) (*JWTVerificationKey, bool)
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+)
+
+// CertFingerprint returns hex encoded sha256 of the DER certificate.
+func CertFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// PEMCertFingerprint returns fingerprint of the first certificate in PEM data.
+func PEMCertFingerprint(pemData []byte) (string, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", fmt.Errorf("%w: no PEM certificate found", ErrClientDataIncorrect)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("%w: can't parse certificate - %s", ErrClientDataIncorrect, err.Error())
+	}
+	return CertFingerprint(cert), nil
+}
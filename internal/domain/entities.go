@@ -69,6 +69,7 @@ type RegistrationData struct {
 	PasswordSalt    string
 	EncryptedOTPKey string
 	State           RegistrationState
+	OTPStep         int64 // the accepted TOTP time-step, stored when the user is created
 }
 
 // FullRegistrationData stuct contains all registration data for user creation in StateFullStorage
@@ -115,6 +116,13 @@ type LoginData struct {
 	PasswordHash    string
 	PasswordSalt    string
 	EncryptedOTPKey string
+	CertFingerprint string // account is bound to the client certificate if set
+}
+
+// AuthAttempts struct contains failed authentification attempts counter
+type AuthAttempts struct {
+	Count       int
+	LastFailure time.Time
 }
 
 // HashData struct contains user password information for saving
@@ -125,7 +133,44 @@ type HashData struct {
 
 type JWTToken string
 
+// RefreshToken is opaque token used to get new JWTToken
+type RefreshToken string
+
+// AuthTokens struct is returned on successful authentification
+type AuthTokens struct {
+	Token        JWTToken
+	RefreshToken RefreshToken
+}
+
+// RefreshTokenData struct contains refresh token information for saving
+type RefreshTokenData struct {
+	Hash      string
+	UserID    UserID
+	FamilyID  string // all tokens issued by rotation of one login have the same family; equals to session id
+	ExpiresAt time.Time
+}
+
+// ClientInfo struct is sent by client in grpc metadata
+type ClientInfo struct {
+	DeviceName    string
+	ClientVersion string
+}
+
+// SessionInfo struct describes an active user session, i.e. a device the user logged in from
+type SessionInfo struct {
+	SessionID     string
+	UserID        UserID
+	DeviceName    string
+	ClientVersion string
+	PeerIP        string
+	CreatedAt     time.Time
+	LastSeenAt    time.Time
+	Current       bool // the session of the request
+}
+
+// Claims struct is jwt token payload; RegisteredClaims.ID is used as jti for token revocation
 type Claims struct {
 	jwt.RegisteredClaims
-	UserID UserID
+	UserID    UserID
+	SessionID string
 }
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrorDomain is sent in errdetails.ErrorInfo.Domain.
+const ErrorDomain = "gophkeeper"
+
+// ErrorReason is a machine-readable error reason; is sent to the client in errdetails.ErrorInfo.Reason.
+type ErrorReason string
+
+const (
+	ReasonInternal            ErrorReason = "INTERNAL"
+	ReasonNotAuthorized       ErrorReason = "NOT_AUTHORIZED"
+	ReasonDuplicateKey        ErrorReason = "DUPLICATE_KEY"
+	ReasonDataNotExists       ErrorReason = "DATA_NOT_EXISTS"
+	ReasonDataConflict        ErrorReason = "DATA_CONFLICT"
+	ReasonAuthDataIncorrect   ErrorReason = "AUTH_DATA_INCORRECT"
+	ReasonTooManyAttempts     ErrorReason = "TOO_MANY_ATTEMPTS"
+	ReasonClientDataIncorrect ErrorReason = "CLIENT_DATA_INCORRECT"
+	ReasonValidationFailed    ErrorReason = "VALIDATION_FAILED"
+	ReasonDeprecated          ErrorReason = "DEPRECATED_OPERATION"
+)
+
+// errorReasons binds reasons to the base domain errors; the base error text is a user-safe default message.
+var errorReasons = []struct {
+	reason ErrorReason
+	err    error
+}{
+	{ReasonNotAuthorized, ErrNotAuthorized},
+	{ReasonDuplicateKey, ErrDublicateKeyViolation},
+	{ReasonDataNotExists, ErrDataNotExists},
+	{ReasonDataConflict, ErrDataConflict},
+	{ReasonAuthDataIncorrect, ErrAuthDataIncorrect},
+	{ReasonTooManyAttempts, ErrAuthTooManyAttempts},
+	{ReasonClientDataIncorrect, ErrClientDataIncorrect},
+	{ReasonValidationFailed, ErrClientDataIncorrect},
+	{ReasonDeprecated, ErrDeprecatedOperation},
+	{ReasonInternal, ErrServerInternal},
+}
+
+// FieldViolation describes a wrong request field; is sent in errdetails.BadRequest.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// DetailedError is a domain error with a reason and a message that can be shown to the user.
+// errors.Is works with the base domain error.
+type DetailedError struct {
+	Reason     ErrorReason
+	Message    string
+	Fields     []FieldViolation
+	RetryAfter time.Duration
+	base       error
+}
+
+// NewDetailedError creates an error for the base domain error; the reason is taken from the base error.
+func NewDetailedError(base error, message string) *DetailedError {
+	return &DetailedError{
+		Reason:  ErrorReasonOf(base),
+		Message: message,
+		base:    base,
+	}
+}
+
+// NewDetailedErrorByReason restores an error received from the server.
+func NewDetailedErrorByReason(reason ErrorReason, message string) *DetailedError {
+	return &DetailedError{
+		Reason:  reason,
+		Message: message,
+		base:    ErrorByReason(reason),
+	}
+}
+
+// WithFields sets wrong request fields.
+func (e *DetailedError) WithFields(fields ...FieldViolation) *DetailedError {
+	e.Fields = append(e.Fields, fields...)
+	return e
+}
+
+// WithRetryAfter sets the delay after which the request can be repeated.
+func (e *DetailedError) WithRetryAfter(retryAfter time.Duration) *DetailedError {
+	e.RetryAfter = retryAfter
+	return e
+}
+
+func (e *DetailedError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(e.Message)
+
+	for i, f := range e.Fields {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(fmt.Sprintf("%s - %s", f.Field, f.Description))
+	}
+
+	if e.RetryAfter > 0 {
+		sb.WriteString(fmt.Sprintf(", retry after %s", e.RetryAfter.Round(time.Second)))
+	}
+	return sb.String()
+}
+
+func (e *DetailedError) Unwrap() error {
+	return e.base
+}
+
+// ErrorReasonOf returns reason of the domain error; unknown errors are internal.
+func ErrorReasonOf(err error) ErrorReason {
+	var dErr *DetailedError
+	if errors.As(err, &dErr) {
+		return dErr.Reason
+	}
+
+	for _, r := range errorReasons {
+		if errors.Is(err, r.err) {
+			return r.reason
+		}
+	}
+	return ReasonInternal
+}
+
+// ErrorByReason returns base domain error for the reason.
+func ErrorByReason(reason ErrorReason) error {
+	for _, r := range errorReasons {
+		if r.reason == reason {
+			return r.err
+		}
+	}
+	return ErrServerInternal
+}
+
+// UserErrorMessage returns error message which is safe to send to the client: the message of [DetailedError]
+// or the text of the base domain error. Wrapped details are not included.
+func UserErrorMessage(err error) string {
+	var dErr *DetailedError
+	if errors.As(err, &dErr) && dErr.Message != "" && dErr.Reason != ReasonInternal {
+		return dErr.Message
+	}
+	return ErrorByReason(ErrorReasonOf(err)).Error()
+}
@@ -0,0 +1,58 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorReasonOf(t *testing.T) {
+	assert.Equal(t, domain.ReasonNotAuthorized, domain.ErrorReasonOf(fmt.Errorf("%w err", domain.ErrNotAuthorized)))
+	assert.Equal(t, domain.ReasonDuplicateKey, domain.ErrorReasonOf(fmt.Errorf("%w err", domain.ErrDublicateKeyViolation)))
+	assert.Equal(t, domain.ReasonDataNotExists, domain.ErrorReasonOf(fmt.Errorf("%w err", domain.ErrDataNotExists)))
+	assert.Equal(t, domain.ReasonDataConflict, domain.ErrorReasonOf(fmt.Errorf("%w err", domain.ErrDataConflict)))
+	assert.Equal(t, domain.ReasonAuthDataIncorrect, domain.ErrorReasonOf(fmt.Errorf("%w err", domain.ErrAuthDataIncorrect)))
+	assert.Equal(t, domain.ReasonTooManyAttempts, domain.ErrorReasonOf(fmt.Errorf("%w err", domain.ErrAuthTooManyAttempts)))
+	assert.Equal(t, domain.ReasonClientDataIncorrect, domain.ErrorReasonOf(fmt.Errorf("%w err", domain.ErrClientDataIncorrect)))
+	assert.Equal(t, domain.ReasonInternal, domain.ErrorReasonOf(fmt.Errorf("%w err", domain.ErrServerInternal)))
+	assert.Equal(t, domain.ReasonInternal, domain.ErrorReasonOf(errors.New("new")))
+
+	dErr := domain.NewDetailedErrorByReason(domain.ReasonValidationFailed, "validation failed")
+	assert.Equal(t, domain.ReasonValidationFailed, domain.ErrorReasonOf(fmt.Errorf("%w err", dErr)))
+}
+
+func TestErrorByReason(t *testing.T) {
+	assert.ErrorIs(t, domain.ErrorByReason(domain.ReasonValidationFailed), domain.ErrClientDataIncorrect)
+	assert.ErrorIs(t, domain.ErrorByReason(domain.ReasonTooManyAttempts), domain.ErrAuthTooManyAttempts)
+	assert.ErrorIs(t, domain.ErrorByReason("UNKNOWN"), domain.ErrServerInternal)
+}
+
+func TestDetailedError(t *testing.T) {
+	dErr := domain.NewDetailedError(domain.ErrClientDataIncorrect, "wrong request").
+		WithFields(
+			domain.FieldViolation{Field: "Number", Description: "wrong format"},
+			domain.FieldViolation{Field: "Content", Description: "is empty"},
+		).
+		WithRetryAfter(90 * time.Second)
+
+	err := fmt.Errorf("%w - details", dErr)
+	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	assert.Equal(t, domain.ReasonClientDataIncorrect, dErr.Reason)
+	assert.Equal(t, "wrong request: Number - wrong format; Content - is empty, retry after 1m30s", dErr.Error())
+}
+
+func TestUserErrorMessage(t *testing.T) {
+	assert.Equal(t, domain.ErrServerInternal.Error(), domain.UserErrorMessage(errors.New("connection to 10.0.0.1 refused")))
+	assert.Equal(t, domain.ErrDataNotExists.Error(), domain.UserErrorMessage(fmt.Errorf("%w - user 10", domain.ErrDataNotExists)))
+
+	dErr := domain.NewDetailedError(domain.ErrAuthTooManyAttempts, "locked")
+	assert.Equal(t, "locked", domain.UserErrorMessage(fmt.Errorf("%w - account:test@email", dErr)))
+
+	internalErr := domain.NewDetailedError(domain.ErrServerInternal, "db is down")
+	assert.Equal(t, domain.ErrServerInternal.Error(), domain.UserErrorMessage(internalErr))
+}
@@ -7,7 +7,10 @@ var (
 	ErrNotAuthorized         = errors.New("user is not authorized")
 	ErrDublicateKeyViolation = errors.New("dublicate key violation error")
 	ErrDataNotExists         = errors.New("data not exists error")
+	ErrDataConflict          = errors.New("data was changed by another client")
 	ErrAuthDataIncorrect     = errors.New("wrong auth data")
+	ErrAuthTooManyAttempts   = errors.New("too many auth attempts")
+	ErrDeprecatedOperation   = errors.New("operation is not supported anymore, update the client")
 
 	ErrClientDataIncorrect = errors.New("client data incorrect")
 	ErrClientInternal      = errors.New("client internal error")
@@ -15,6 +18,10 @@ var (
 	ErrClientDataIsNotRestored = errors.New("data is not restored")
 	ErrServerIsNotResponding   = errors.New("server is not responding")
 
+	ErrClientOfflineReadOnly = errors.New("offline mode - operation is not available")
+
+	ErrClientNoteTooLarge = errors.New("note is too large, store it as a file")
+
 	ErrClientInteruptoin = errors.New("user interuption")
 	ErrClientAppStopped  = errors.New("application stopped")
 )
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type JWTKeyAlgorithm string
+
+const (
+	JWTKeyAlgorithmEdDSA JWTKeyAlgorithm = "EdDSA" // Ed25519
+	JWTKeyAlgorithmES256 JWTKeyAlgorithm = "ES256" // ECDSA P-256
+)
+
+// JWTSigningKey is the private key new tokens are signed with.
+type JWTSigningKey struct {
+	KeyID      string
+	Algorithm  JWTKeyAlgorithm
+	PrivateKey crypto.Signer
+}
+
+// JWTVerificationKey is the public key of an active or retired signing key.
+type JWTVerificationKey struct {
+	KeyID     string
+	Algorithm JWTKeyAlgorithm
+	PublicKey crypto.PublicKey
+}
+
+// VerificationKey returns public part of the signing key.
+func (sk *JWTSigningKey) VerificationKey() *JWTVerificationKey {
+	return &JWTVerificationKey{
+		KeyID:     sk.KeyID,
+		Algorithm: sk.Algorithm,
+		PublicKey: sk.PrivateKey.Public(),
+	}
+}
+
+// CheckJWTKeyAlgorithm returns error if the algorithm is not supported.
+func CheckJWTKeyAlgorithm(alg JWTKeyAlgorithm) error {
+	switch alg {
+	case JWTKeyAlgorithmEdDSA, JWTKeyAlgorithmES256:
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported jwt key algorithm %s", ErrServerInternal, alg)
+	}
+}
+
+// GenerateJWTSigningKey creates new signing key with random key id.
+func GenerateJWTSigningKey(alg JWTKeyAlgorithm) (*JWTSigningKey, error) {
+	var privateKey crypto.Signer
+	var err error
+
+	switch alg {
+	case JWTKeyAlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	case JWTKeyAlgorithmES256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, CheckJWTKeyAlgorithm(alg)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: can't generate jwt key - %s", ErrServerInternal, err.Error())
+	}
+
+	return &JWTSigningKey{
+		KeyID:      uuid.NewString(),
+		Algorithm:  alg,
+		PrivateKey: privateKey,
+	}, nil
+}
+
+// JWTKeyAlgorithmOf detects the signing algorithm by the public key type.
+func JWTKeyAlgorithmOf(publicKey crypto.PublicKey) (JWTKeyAlgorithm, error) {
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		return JWTKeyAlgorithmEdDSA, nil
+	case *ecdsa.PublicKey:
+		if key.Curve == elliptic.P256() {
+			return JWTKeyAlgorithmES256, nil
+		}
+		return "", fmt.Errorf("%w: unsupported ecdsa curve %s", ErrServerInternal, key.Curve.Params().Name)
+	default:
+		return "", fmt.Errorf("%w: unsupported jwt key type %T", ErrServerInternal, publicKey)
+	}
+}
+
+// NewJWTKeySet creates key set; the signing key is also used for verification.
+func NewJWTKeySet(signingKey *JWTSigningKey, retiredKeys ...*JWTVerificationKey) *JWTKeySet {
+	ks := &JWTKeySet{
+		signingKey:       signingKey,
+		verificationKeys: make(map[string]*JWTVerificationKey, len(retiredKeys)+1),
+	}
+	for _, key := range retiredKeys {
+		ks.verificationKeys[key.KeyID] = key
+	}
+	ks.verificationKeys[signingKey.KeyID] = signingKey.VerificationKey()
+	return ks
+}
+
+var _ JWTKeyProvider = (*JWTKeySet)(nil)
+
+// JWTKeySet is immutable set of active signing key and retired verification keys.
+type JWTKeySet struct {
+	signingKey       *JWTSigningKey
+	verificationKeys map[string]*JWTVerificationKey
+}
+
+func (ks *JWTKeySet) SigningKey() *JWTSigningKey {
+	return ks.signingKey
+}
+
+func (ks *JWTKeySet) VerificationKey(keyID string) (*JWTVerificationKey, bool) {
+	key, ok := ks.verificationKeys[keyID]
+	return key, ok
+}
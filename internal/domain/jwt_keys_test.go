@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateJWTSigningKey(t *testing.T) {
+	for _, alg := range []domain.JWTKeyAlgorithm{domain.JWTKeyAlgorithmEdDSA, domain.JWTKeyAlgorithmES256} {
+		t.Run(string(alg), func(t *testing.T) {
+			key, err := domain.GenerateJWTSigningKey(alg)
+			require.NoError(t, err)
+			assert.NotEmpty(t, key.KeyID)
+			assert.Equal(t, alg, key.Algorithm)
+
+			detected, err := domain.JWTKeyAlgorithmOf(key.PrivateKey.Public())
+			require.NoError(t, err)
+			assert.Equal(t, alg, detected)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := domain.GenerateJWTSigningKey("HS256")
+		require.ErrorIs(t, err, domain.ErrServerInternal)
+	})
+}
+
+func TestJWTKeyAlgorithmOf(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	_, err = domain.JWTKeyAlgorithmOf(key.Public())
+	require.ErrorIs(t, err, domain.ErrServerInternal)
+
+	_, err = domain.JWTKeyAlgorithmOf([]byte("secret"))
+	require.ErrorIs(t, err, domain.ErrServerInternal)
+}
+
+func TestJWTKeySet(t *testing.T) {
+	active, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmEdDSA)
+	require.NoError(t, err)
+	retired, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmES256)
+	require.NoError(t, err)
+
+	keys := domain.NewJWTKeySet(active, retired.VerificationKey())
+	assert.Equal(t, active, keys.SigningKey())
+
+	key, ok := keys.VerificationKey(active.KeyID)
+	require.True(t, ok)
+	assert.Equal(t, domain.JWTKeyAlgorithmEdDSA, key.Algorithm)
+
+	key, ok = keys.VerificationKey(retired.KeyID)
+	require.True(t, ok)
+	assert.Equal(t, domain.JWTKeyAlgorithmES256, key.Algorithm)
+
+	_, ok = keys.VerificationKey("unknown")
+	assert.False(t, ok)
+}
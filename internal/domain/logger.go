@@ -43,6 +43,14 @@ type ContextKey string
 
 const UserIDKey = ContextKey("UserID")
 
+const PeerIPKey = ContextKey("PeerIP")
+
+const PeerCertFingerprintKey = ContextKey("PeerCertFingerprint")
+
+const ClaimsKey = ContextKey("Claims")
+
+const ClientInfoKey = ContextKey("ClientInfo")
+
 const LoggerKey = ContextKey("Logger")
 const LoggerKeyRequestID = "requestID"
 
@@ -64,6 +72,68 @@ func GetUserID(ctx context.Context) (UserID, error) {
 	return UserID(-1), fmt.Errorf("%w: can't extract userID", ErrNotAuthorized)
 }
 
+// EnrichWithClaims stores jwt token claims in context; is used by logout
+func EnrichWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, ClaimsKey, claims)
+}
+
+// GetClaims returns jwt token claims
+func GetClaims(ctx context.Context) (*Claims, error) {
+	if v := ctx.Value(ClaimsKey); v != nil {
+		claims, ok := v.(*Claims)
+		if !ok {
+			return nil, fmt.Errorf("%w: unexpected claims type", ErrServerInternal)
+		}
+		return claims, nil
+	}
+	return nil, fmt.Errorf("%w: can't extract claims", ErrNotAuthorized)
+}
+
+// EnrichWithPeerIP stores client ip address in context
+func EnrichWithPeerIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, PeerIPKey, ip)
+}
+
+// GetPeerIP returns client ip address or empty string if address is unknown
+func GetPeerIP(ctx context.Context) string {
+	if v := ctx.Value(PeerIPKey); v != nil {
+		if ip, ok := v.(string); ok {
+			return ip
+		}
+	}
+	return ""
+}
+
+// EnrichWithPeerCertFingerprint stores fingerprint of the verified client certificate in context
+func EnrichWithPeerCertFingerprint(ctx context.Context, fingerprint string) context.Context {
+	return context.WithValue(ctx, PeerCertFingerprintKey, fingerprint)
+}
+
+// GetPeerCertFingerprint returns client certificate fingerprint or empty string if the client has no certificate
+func GetPeerCertFingerprint(ctx context.Context) string {
+	if v := ctx.Value(PeerCertFingerprintKey); v != nil {
+		if fingerprint, ok := v.(string); ok {
+			return fingerprint
+		}
+	}
+	return ""
+}
+
+// EnrichWithClientInfo stores client device name and version in context; is used on session creation
+func EnrichWithClientInfo(ctx context.Context, info *ClientInfo) context.Context {
+	return context.WithValue(ctx, ClientInfoKey, info)
+}
+
+// GetClientInfo returns client info or empty struct if the client didn't send it
+func GetClientInfo(ctx context.Context) *ClientInfo {
+	if v := ctx.Value(ClientInfoKey); v != nil {
+		if info, ok := v.(*ClientInfo); ok {
+			return info
+		}
+	}
+	return &ClientInfo{}
+}
+
 func EnrichWithRequestIDLogger(ctx context.Context, requestID uuid.UUID, logger Logger) context.Context {
 	requestIDLogger := &requestIDLogger{
 		internalLogger: logger,
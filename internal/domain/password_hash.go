@@ -0,0 +1,309 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// PassHashAlgorithm is an account password hashing algorithm.
+type PassHashAlgorithm string
+
+const (
+	PassHashArgon2id PassHashAlgorithm = "argon2id"
+	PassHashBcrypt   PassHashAlgorithm = "bcrypt"
+	PassHashScrypt   PassHashAlgorithm = "scrypt"
+)
+
+// Recommended parameters
+// https://cheatsheetseries.owasp.org/cheatsheets/Password_Storage_Cheat_Sheet.html
+const (
+	Argon2Memory  = 19 * KiB // in KiB
+	Argon2Time    = 2
+	Argon2Threads = 1
+	BcryptCost    = 10
+	ScryptLogN    = 15
+	ScryptR       = 8
+	ScryptP       = 1
+	PassHashLen   = 32
+)
+
+// PassHashParams contains account password hashing algorithm and its parameters.
+type PassHashParams struct {
+	Algorithm     PassHashAlgorithm
+	Argon2Memory  uint32
+	Argon2Time    uint32
+	Argon2Threads uint8
+	BcryptCost    int
+	ScryptLogN    uint8
+	ScryptR       int
+	ScryptP       int
+}
+
+// NewPassHashParams returns recommended parameters for the algorithm. Empty algorithm means argon2id.
+func NewPassHashParams(alg PassHashAlgorithm) (*PassHashParams, error) {
+	switch alg {
+	case "", PassHashArgon2id:
+		return &PassHashParams{
+			Algorithm:     PassHashArgon2id,
+			Argon2Memory:  Argon2Memory,
+			Argon2Time:    Argon2Time,
+			Argon2Threads: Argon2Threads,
+		}, nil
+	case PassHashBcrypt:
+		return &PassHashParams{
+			Algorithm:  PassHashBcrypt,
+			BcryptCost: BcryptCost,
+		}, nil
+	case PassHashScrypt:
+		return &PassHashParams{
+			Algorithm:  PassHashScrypt,
+			ScryptLogN: ScryptLogN,
+			ScryptR:    ScryptR,
+			ScryptP:    ScryptP,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w - unknown password hash algorithm %s", ErrServerInternal, alg)
+	}
+}
+
+// HashPassword hashes account password with argon2id.
+func HashPassword(pass string, saltFn SaltFn) (*HashData, error) {
+	params, _ := NewPassHashParams(PassHashArgon2id)
+	return HashPasswordWithParams(pass, params, saltFn)
+}
+
+// HashPasswordWithParams hashes account password.
+//
+// The result hash is a self-describing PHC string (bcrypt uses own modular crypt format),
+// so HashData.Salt is empty - salt is stored inside the hash.
+func HashPasswordWithParams(pass string, params *PassHashParams, saltFn SaltFn) (*HashData, error) {
+	salt := make([]byte, SaltSize)
+	if _, err := saltFn(salt); err != nil {
+		return nil, fmt.Errorf("%w - generate salt error", ErrServerInternal)
+	}
+
+	var hash string
+	switch params.Algorithm {
+	case PassHashArgon2id:
+		key := argon2.IDKey([]byte(pass), salt, params.Argon2Time, params.Argon2Memory, params.Argon2Threads, PassHashLen)
+		hash = fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", PassHashArgon2id, argon2.Version,
+			params.Argon2Memory, params.Argon2Time, params.Argon2Threads,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+	case PassHashBcrypt:
+		// bcrypt generates salt itself
+		b, err := bcrypt.GenerateFromPassword([]byte(pass), params.BcryptCost)
+		if err != nil {
+			return nil, fmt.Errorf("%w - bcrypt error %s", ErrServerInternal, err.Error())
+		}
+		hash = string(b)
+	case PassHashScrypt:
+		key, err := scrypt.Key([]byte(pass), salt, 1<<params.ScryptLogN, params.ScryptR, params.ScryptP, PassHashLen)
+		if err != nil {
+			return nil, fmt.Errorf("%w - scrypt error %s", ErrServerInternal, err.Error())
+		}
+		hash = fmt.Sprintf("$%s$ln=%d,r=%d,p=%d$%s$%s", PassHashScrypt,
+			params.ScryptLogN, params.ScryptR, params.ScryptP,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+	default:
+		return nil, fmt.Errorf("%w - unknown password hash algorithm %s", ErrServerInternal, params.Algorithm)
+	}
+
+	return &HashData{
+		Hash: hash,
+	}, nil
+}
+
+// ValidateAccountPass checks account password.
+//
+// Supports PHC hashes and legacy base64 sha256(salt + pass) hashes.
+func ValidateAccountPass(pass string, hash string, saltB64 string) (bool, error) {
+	if !isPHCHash(hash) {
+		return validateLegacyAccountPass(pass, hash, saltB64)
+	}
+
+	phc, err := parsePHCHash(hash)
+	if err != nil {
+		return false, err
+	}
+
+	var ok bool
+	switch phc.params.Algorithm {
+	case PassHashBcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(pass))
+		if err != nil && !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, fmt.Errorf("%w - bcrypt error %s", ErrServerInternal, err.Error())
+		}
+		ok = err == nil
+	case PassHashArgon2id:
+		key := argon2.IDKey([]byte(pass), phc.salt, phc.params.Argon2Time, phc.params.Argon2Memory,
+			phc.params.Argon2Threads, uint32(len(phc.key)))
+		ok = subtle.ConstantTimeCompare(key, phc.key) == 1
+	case PassHashScrypt:
+		key, err := scrypt.Key([]byte(pass), phc.salt, 1<<phc.params.ScryptLogN, phc.params.ScryptR, phc.params.ScryptP, len(phc.key))
+		if err != nil {
+			return false, fmt.Errorf("%w - scrypt error %s", ErrServerInternal, err.Error())
+		}
+		ok = subtle.ConstantTimeCompare(key, phc.key) == 1
+	}
+
+	if !ok {
+		return false, fmt.Errorf("%w - wrong login or password", ErrAuthDataIncorrect)
+	}
+	return true, nil
+}
+
+// PassHashNeedsRehash returns true if hash is legacy, is made by other algorithm or with weaker parameters.
+func PassHashNeedsRehash(hash string, params *PassHashParams) bool {
+	if !isPHCHash(hash) {
+		return true
+	}
+
+	phc, err := parsePHCHash(hash)
+	if err != nil {
+		return true
+	}
+
+	if phc.params.Algorithm != params.Algorithm {
+		return true
+	}
+
+	switch params.Algorithm {
+	case PassHashArgon2id:
+		return phc.params.Argon2Memory < params.Argon2Memory ||
+			phc.params.Argon2Time < params.Argon2Time ||
+			phc.params.Argon2Threads < params.Argon2Threads ||
+			len(phc.key) < PassHashLen
+	case PassHashBcrypt:
+		return phc.params.BcryptCost < params.BcryptCost
+	case PassHashScrypt:
+		return phc.params.ScryptLogN < params.ScryptLogN ||
+			phc.params.ScryptR < params.ScryptR ||
+			phc.params.ScryptP < params.ScryptP ||
+			len(phc.key) < PassHashLen
+	}
+	return true
+}
+
+func validateLegacyAccountPass(pass string, hashB64 string, saltB64 string) (bool, error) {
+	salt, err := base64.StdEncoding.DecodeString(saltB64)
+	if err != nil {
+		return false, fmt.Errorf("%w - wrong salt", ErrClientDataIncorrect)
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(hashB64)
+	if err != nil {
+		return false, fmt.Errorf("%w - wrong hash", ErrClientDataIncorrect)
+	}
+
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(pass))
+	if subtle.ConstantTimeCompare(h.Sum(nil), hash) != 1 {
+		return false, fmt.Errorf("%w - wrong login or password", ErrAuthDataIncorrect)
+	}
+	return true, nil
+}
+
+type phcHash struct {
+	params PassHashParams
+	salt   []byte
+	key    []byte
+}
+
+func isPHCHash(hash string) bool {
+	return strings.HasPrefix(hash, "$")
+}
+
+func parsePHCHash(hash string) (*phcHash, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) < 3 {
+		return nil, fmt.Errorf("%w - wrong hash format", ErrServerInternal)
+	}
+
+	var err error
+	res := &phcHash{}
+
+	switch parts[1] {
+	case "2a", "2b", "2y":
+		res.params.Algorithm = PassHashBcrypt
+		if res.params.BcryptCost, err = bcrypt.Cost([]byte(hash)); err != nil {
+			return nil, fmt.Errorf("%w - wrong bcrypt hash %s", ErrServerInternal, err.Error())
+		}
+		return res, nil
+
+	case string(PassHashArgon2id):
+		// $argon2id$v=19$m=..,t=..,p=..$salt$hash
+		if len(parts) != 6 {
+			return nil, fmt.Errorf("%w - wrong argon2id hash format", ErrServerInternal)
+		}
+		if parts[2] != fmt.Sprintf("v=%d", argon2.Version) {
+			return nil, fmt.Errorf("%w - unsupported argon2id version %s", ErrServerInternal, parts[2])
+		}
+		values, err := parsePHCParams(parts[3], "m", "t", "p")
+		if err != nil {
+			return nil, err
+		}
+		res.params.Algorithm = PassHashArgon2id
+		res.params.Argon2Memory = uint32(values[0])
+		res.params.Argon2Time = uint32(values[1])
+		res.params.Argon2Threads = uint8(values[2])
+		parts = parts[4:]
+
+	case string(PassHashScrypt):
+		// $scrypt$ln=..,r=..,p=..$salt$hash
+		if len(parts) != 5 {
+			return nil, fmt.Errorf("%w - wrong scrypt hash format", ErrServerInternal)
+		}
+		values, err := parsePHCParams(parts[2], "ln", "r", "p")
+		if err != nil {
+			return nil, err
+		}
+		res.params.Algorithm = PassHashScrypt
+		res.params.ScryptLogN = uint8(values[0])
+		res.params.ScryptR = int(values[1])
+		res.params.ScryptP = int(values[2])
+		parts = parts[3:]
+
+	default:
+		return nil, fmt.Errorf("%w - unknown hash algorithm %s", ErrServerInternal, parts[1])
+	}
+
+	if res.salt, err = base64.RawStdEncoding.DecodeString(parts[0]); err != nil {
+		return nil, fmt.Errorf("%w - wrong hash salt", ErrServerInternal)
+	}
+	if res.key, err = base64.RawStdEncoding.DecodeString(parts[1]); err != nil || len(res.key) == 0 {
+		return nil, fmt.Errorf("%w - wrong hash value", ErrServerInternal)
+	}
+	return res, nil
+}
+
+// parsePHCParams parses "k1=v1,k2=v2,..." string; values are returned in the names order.
+func parsePHCParams(str string, names ...string) ([]uint64, error) {
+	kvs := strings.Split(str, ",")
+	if len(kvs) != len(names) {
+		return nil, fmt.Errorf("%w - wrong hash params %s", ErrServerInternal, str)
+	}
+
+	result := make([]uint64, len(names))
+	for i, kv := range kvs {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k != names[i] {
+			return nil, fmt.Errorf("%w - wrong hash params %s", ErrServerInternal, str)
+		}
+		val, err := strconv.ParseUint(v, 10, 32)
+		if err != nil || val == 0 {
+			return nil, fmt.Errorf("%w - wrong hash param %s", ErrServerInternal, kv)
+		}
+		result[i] = val
+	}
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package domain_test
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashPasswordWithParams(t *testing.T) {
+	testData := []struct {
+		name   string
+		alg    domain.PassHashAlgorithm
+		prefix string
+	}{
+		{"argon2id", domain.PassHashArgon2id, "$argon2id$"},
+		{"bcrypt", domain.PassHashBcrypt, "$2a$10$"},
+		{"scrypt", domain.PassHashScrypt, "$scrypt$ln=15,r=8,p=1$"},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			params, err := domain.NewPassHashParams(test.alg)
+			require.NoError(t, err)
+
+			pass := "12345678"
+			hash, err := domain.HashPasswordWithParams(pass, params, rand.Read)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(hash.Hash, test.prefix))
+
+			ok, err := domain.ValidateAccountPass(pass, hash.Hash, hash.Salt)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			ok, err = domain.ValidateAccountPass("123456789", hash.Hash, hash.Salt)
+			require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
+			assert.False(t, ok)
+
+			assert.False(t, domain.PassHashNeedsRehash(hash.Hash, params))
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := domain.NewPassHashParams("md5")
+		require.ErrorIs(t, err, domain.ErrServerInternal)
+	})
+
+	t.Run("wrong_hash", func(t *testing.T) {
+		ok, err := domain.ValidateAccountPass("12345678", "$argon2id$v=19$m=19456,t=2$AAECAwQFBgcICQoLDA0ODw$AA", "")
+		require.ErrorIs(t, err, domain.ErrServerInternal)
+		assert.False(t, ok)
+	})
+}
+
+func TestPassHashNeedsRehash(t *testing.T) {
+	argon2Params, err := domain.NewPassHashParams(domain.PassHashArgon2id)
+	require.NoError(t, err)
+
+	weakParams := *argon2Params
+	weakParams.Argon2Memory = 8 * domain.KiB
+	weak, err := domain.HashPasswordWithParams("12345678", &weakParams, rand.Read)
+	require.NoError(t, err)
+
+	bcryptParams, err := domain.NewPassHashParams(domain.PassHashBcrypt)
+	require.NoError(t, err)
+	bcryptHash, err := domain.HashPasswordWithParams("12345678", bcryptParams, rand.Read)
+	require.NoError(t, err)
+
+	assert.True(t, domain.PassHashNeedsRehash("In+BZhwpWKZH/S1QtMWcAOONZcrO9jVDaMDoJqgOfWM=", argon2Params), "legacy")
+	assert.True(t, domain.PassHashNeedsRehash(weak.Hash, argon2Params), "weak params")
+	assert.True(t, domain.PassHashNeedsRehash(bcryptHash.Hash, argon2Params), "other algorithm")
+	assert.False(t, domain.PassHashNeedsRehash(weak.Hash, &weakParams), "same params")
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "io"
+
+// NewStreamBytesReader reads the data kept in memory by chunks; used to upload the data that must not be
+// written to the disk unencrypted.
+func NewStreamBytesReader(data []byte, chunkSize int) *streamBytesReader {
+	return &streamBytesReader{
+		data:      data,
+		chunkSize: chunkSize,
+	}
+}
+
+var _ StreamFileReader = (*streamBytesReader)(nil)
+
+type streamBytesReader struct {
+	data      []byte
+	offset    int
+	chunkSize int
+}
+
+func (sb *streamBytesReader) FileSize() int64 {
+	return int64(len(sb.data))
+}
+
+func (sb *streamBytesReader) Next() ([]byte, error) {
+	if sb.offset >= len(sb.data) {
+		return nil, io.EOF
+	}
+	end := min(sb.offset+sb.chunkSize, len(sb.data))
+	chunk := sb.data[sb.offset:end]
+	sb.offset = end
+	return chunk, nil
+}
+
+func (sb *streamBytesReader) Close() {
+}
@@ -0,0 +1,39 @@
+package domain_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamBytesReader(t *testing.T) {
+	chunkSize := 512
+
+	bufSize := chunkSize*2 + chunkSize/2
+	buf := make([]byte, bufSize)
+
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+
+	reader := domain.NewStreamBytesReader(buf, chunkSize)
+	require.Equal(t, int64(bufSize), reader.FileSize())
+
+	var resultBuf bytes.Buffer
+	for i := 0; i < 3; i++ {
+		chunk, err := reader.Next()
+		require.NoError(t, err)
+		require.NotEmpty(t, chunk)
+		resultBuf.Write(chunk)
+	}
+
+	require.Equal(t, buf, resultBuf.Bytes())
+	chunk, err := reader.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, 0, len(chunk))
+
+	reader.Close()
+}
@@ -7,7 +7,9 @@ import (
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"image/png"
 	"io"
@@ -20,14 +22,19 @@ import (
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain/creditcard"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/liyue201/goqr"
 	"github.com/pquerna/otp"
 	"github.com/pquerna/otp/totp"
 	pasVld "github.com/wagslane/go-password-validator"
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/ssh"
 )
 
 const (
 	AuthorizationMetadataTokenName = "authorization"
+	DeviceNameMetadataName         = "device-name"
+	ClientVersionMetadataName      = "client-version"
 )
 
 const (
@@ -35,12 +42,18 @@ const (
 	TOTPDigits    = otp.DigitsSix
 	TOTPAlgorithm = otp.AlgorithmSHA512
 	SaltSize      = 16
+
+	RefreshTokenSize = 32
 )
 
 const (
 	Pbkdf2SaltLen    = 32
 	EncryptAESKeyLen = 2 * aes.BlockSize
 	Pbkdf2Iter       = 100002
+
+	// BlindIndexSalt separates the blind index key from the encryption keys derived from the master password
+	BlindIndexSalt   = "gophkeeper-blind-index"
+	BlindIndexKeyLen = 32
 )
 
 const (
@@ -127,29 +140,19 @@ func DecryptShortData(masterKey string, ciphertext string) (string, error) {
 	return decryptData(masterKey, ciphertext)
 }
 
-type SaltFn func(b []byte) (n int, err error)
-
-// HashPassword has user password
-func HashPassword(pass string, saltFn SaltFn) (*HashData, error) {
-	salt := make([]byte, SaltSize)
-	if _, err := saltFn(salt); err != nil {
-		return nil, fmt.Errorf("%w - salt generation error %s", ErrServerInternal, err.Error())
-	}
-
-	saltB64 := base64.StdEncoding.EncodeToString(salt)
-
-	h := sha256.New()
-	h.Write(salt)
-	h.Write([]byte(pass))
-	hex := h.Sum(nil)
-	hexB64 := base64.StdEncoding.EncodeToString(hex)
-
-	return &HashData{
-		Hash: hexB64,
-		Salt: saltB64,
-	}, nil
+// BlindIndex returns keyed blind index of the item key (card number, hint): HMAC-SHA256 under the key derived
+// from the master password. The server can compare indexes for equality but can't restore the value.
+func BlindIndex(masterPass string, itemType SecretItemType, value string) string {
+	key := pbkdf2.Key([]byte(masterPass), []byte(BlindIndexSalt), Pbkdf2Iter, BlindIndexKeyLen, sha256.New)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(itemType))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
 }
 
+type SaltFn func(b []byte) (n int, err error)
+
 // Minimal server secret key complexity level
 // https://github.com/wagslane/go-password-validator
 const minSecretKeyPassEntropyBits = 120
@@ -164,30 +167,6 @@ func CheckServerSecretKeyComplexityLevel(pass string) error {
 	return nil
 }
 
-// ValidateAccountPass check server secret key complexity level
-func ValidateAccountPass(pass string, hashB64 string, saltB64 string) (bool, error) {
-	hash, err := base64.StdEncoding.DecodeString(hashB64)
-	if err != nil {
-		return false, fmt.Errorf("%w - hash decoding error %s", ErrClientDataIncorrect, err.Error())
-	}
-
-	salt, err := base64.StdEncoding.DecodeString(saltB64)
-	if err != nil {
-		return false, fmt.Errorf("%w - salt decoding error %s", ErrClientDataIncorrect, err.Error())
-	}
-
-	h := sha256.New()
-	h.Write(salt)
-	h.Write([]byte(pass))
-	hex := h.Sum(nil)
-
-	if !bytes.Equal(hash, hex) {
-		return false, fmt.Errorf("%w - authentification failed", ErrAuthDataIncorrect)
-	}
-
-	return true, nil
-}
-
 // EncryptHello is used in registration process
 func EncryptHello(masterPass string, hello string) (string, error) {
 	return encryptData(masterPass, hello)
@@ -327,9 +306,26 @@ func GenerateQR(issuer string, userEmail string) (string, []byte, error) {
 
 // ValidateOTPCode check otpPassCode
 func ValidateOTPCode(keyURL string, otpPassCode string) (bool, error) {
+	_, valid, err := ValidateOTPCodeStep(keyURL, otpPassCode, OTPSkew{}, time.Now())
+	return valid, err
+}
+
+// OTPSkew contains number of TOTP time-steps accepted before and after the current one.
+type OTPSkew struct {
+	Past   uint
+	Future uint
+}
+
+// ValidateOTPCodeStep check otpPassCode within skew window and returns accepted time-step.
+// The step is used to reject code reuse.
+func ValidateOTPCodeStep(keyURL string, otpPassCode string, skew OTPSkew, now time.Time) (int64, bool, error) {
 	key, err := otp.NewKeyFromURL(keyURL)
 	if err != nil {
-		return false, fmt.Errorf("%w ValidatePassCode restore key err - %s", err, err.Error())
+		return 0, false, fmt.Errorf("%w ValidatePassCode restore key err - %s", err, err.Error())
+	}
+
+	if len(otpPassCode) != TOTPDigits.Length() {
+		return 0, false, fmt.Errorf("%w - wrong otp code length", ErrAuthDataIncorrect)
 	}
 
 	validOpts := totp.ValidateOpts{
@@ -338,20 +334,40 @@ func ValidateOTPCode(keyURL string, otpPassCode string) (bool, error) {
 		Algorithm: TOTPAlgorithm,
 	}
 
-	valid, err := totp.ValidateCustom(otpPassCode, key.Secret(), time.Now(), validOpts)
-	return valid, err
+	current := now.Unix() / TOTPPeriod
+	for step := current - int64(skew.Past); step <= current+int64(skew.Future); step++ {
+		code, err := totp.GenerateCodeCustom(key.Secret(), time.Unix(step*TOTPPeriod, 0), validOpts)
+		if err != nil {
+			return 0, false, fmt.Errorf("%w ValidatePassCode generate code err - %s", ErrServerInternal, err.Error())
+		}
+		if subtle.ConstantTimeCompare([]byte(code), []byte(otpPassCode)) == 1 {
+			return step, true, nil
+		}
+	}
+
+	return 0, false, nil
 }
 
-// CreateJWTToken
-func CreateJWTToken(tokenSecret []byte, tokenExp time.Duration, userID UserID) (JWTToken, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+// CreateJWTToken creates token signed with the active key; key id is stored in the "kid" header.
+func CreateJWTToken(keys JWTKeyProvider, tokenExp time.Duration, userID UserID, sessionID string) (JWTToken, error) {
+	signingKey := keys.SigningKey()
+	if signingKey == nil {
+		return "", fmt.Errorf("%w: jwt signing key is not set", ErrServerInternal)
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(string(signingKey.Algorithm)), Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenExp)),
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(tokenExp)),
 		},
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
 	})
+	token.Header["kid"] = signingKey.KeyID
 
-	tokenString, err := token.SignedString(tokenSecret)
+	tokenString, err := token.SignedString(signingKey.PrivateKey)
 	if err != nil {
 		return "", fmt.Errorf("%w: can't sign token %v", ErrServerInternal, err.Error())
 	}
@@ -360,23 +376,83 @@ func CreateJWTToken(tokenSecret []byte, tokenExp time.Duration, userID UserID) (
 }
 
 // ParseJWTToken
-func ParseJWTToken(tokenSecret []byte, token JWTToken) (UserID, error) {
+func ParseJWTToken(keys JWTKeyProvider, token JWTToken) (UserID, error) {
+	claims, err := ParseJWTClaims(keys, token)
+	if err != nil {
+		return -1, err
+	}
+
+	return claims.UserID, nil
+}
+
+// ParseJWTClaims check token by the key from "kid" header and returns its claims
+func ParseJWTClaims(keys JWTKeyProvider, token JWTToken) (*Claims, error) {
 	claims := &Claims{}
 
-	_, err := jwt.ParseWithClaims(string(token), claims, func(t *jwt.Token) (interface{}, error) {
-		return tokenSecret, nil
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{
+		string(JWTKeyAlgorithmEdDSA),
+		string(JWTKeyAlgorithmES256),
+	}))
+
+	_, err := parser.ParseWithClaims(string(token), claims, func(t *jwt.Token) (interface{}, error) {
+		keyID, ok := t.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("token has no key id")
+		}
+		key, ok := keys.VerificationKey(keyID)
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %s", keyID)
+		}
+		if t.Method.Alg() != string(key.Algorithm) {
+			return nil, fmt.Errorf("unexpected signing method %s for key %s", t.Method.Alg(), keyID)
+		}
+		return key.PublicKey, nil
 	})
 
 	if err != nil {
-		return -1, fmt.Errorf("%w: authorization failed - %s", ErrAuthDataIncorrect, err.Error())
+		return nil, fmt.Errorf("%w: authorization failed - %s", ErrAuthDataIncorrect, err.Error())
 	}
 
-	return claims.UserID, nil
+	return claims, nil
+}
+
+// GetJWTExpiresAt returns token expiration time without signature check; is used on client side.
+func GetJWTExpiresAt(token JWTToken) (time.Time, error) {
+	claims := &Claims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(string(token), claims); err != nil {
+		return time.Time{}, fmt.Errorf("%w: can't parse token - %s", ErrClientDataIncorrect, err.Error())
+	}
+
+	if claims.ExpiresAt == nil {
+		return time.Time{}, fmt.Errorf("%w: token has no expiration time", ErrClientDataIncorrect)
+	}
+	return claims.ExpiresAt.Time, nil
+}
+
+// NewRefreshToken generates random opaque refresh token
+func NewRefreshToken() (RefreshToken, error) {
+	b := make([]byte, RefreshTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("%w: can't generate refresh token - %s", ErrServerInternal, err.Error())
+	}
+	return RefreshToken(base64.RawURLEncoding.EncodeToString(b)), nil
+}
+
+// HashRefreshToken returns refresh token hash for saving. Token has enough entropy, so salt is not used.
+func HashRefreshToken(token RefreshToken) string {
+	h := sha256.Sum256([]byte(token))
+	return base64.StdEncoding.EncodeToString(h[:])
 }
 
 func CheckBankCardData(data *BankCard) error {
 
-	card := (*creditcard.Card)(data)
+	card := &creditcard.Card{
+		Type:        data.Type,
+		Number:      data.Number,
+		ExpiryMonth: data.ExpiryMonth,
+		ExpiryYear:  data.ExpiryYear,
+		CVV:         data.CVV,
+	}
 
 	if len(card.Number) != 16 { // The github.com/retgits/creditcard library has a bug if card.Number length too short.
 		return fmt.Errorf("%w wrong card number length", ErrClientDataIncorrect)
@@ -386,7 +462,8 @@ func CheckBankCardData(data *BankCard) error {
 		errStr := strings.Join(validation.Errors, ", ")
 		return fmt.Errorf("%w bank data validation err %s", ErrClientDataIncorrect, errStr)
 	}
-	return nil
+	data.Type = card.Type
+	return CheckCustomFields(data.Fields)
 }
 
 func CheckUserPasswordData(data *UserPasswordData) error {
@@ -402,16 +479,192 @@ func CheckUserPasswordData(data *UserPasswordData) error {
 		return fmt.Errorf("%w password is not set", ErrClientDataIncorrect)
 	}
 
+	return CheckCustomFields(data.Fields)
+}
+
+// MaxSecureNoteSize is the max size of the secure note text in bytes; the bigger text is stored as a file.
+// The encrypted JSON content of the note must fit into 64KiB accepted by the server.
+const MaxSecureNoteSize = 16 * 1024
+
+// SecureNoteFileName returns the name of the file the note text is stored in when it is too large.
+func SecureNoteFileName(title string) string {
+	return strings.NewReplacer("/", "_", "\\", "_").Replace(strings.TrimSpace(title)) + ".txt"
+}
+
+func CheckSecureNote(data *SecureNote) error {
+	if strings.TrimSpace(data.Title) == "" {
+		return fmt.Errorf("%w title is not set", ErrClientDataIncorrect)
+	}
+
+	if len(data.Text) == 0 {
+		return fmt.Errorf("%w text is not set", ErrClientDataIncorrect)
+	}
+
+	if len(data.Text) > MaxSecureNoteSize {
+		return fmt.Errorf("%w %w - %d bytes, max %d", ErrClientDataIncorrect, ErrClientNoteTooLarge, len(data.Text), MaxSecureNoteSize)
+	}
+
+	return CheckCustomFields(data.Fields)
+}
+
+// OTPAuthScheme is the scheme of the uri used to share TOTP seeds, usually encoded in a QR code.
+const OTPAuthScheme = "otpauth://"
+
+func CheckAuthenticator(data *Authenticator) error {
+	if strings.TrimSpace(data.Name) == "" {
+		return fmt.Errorf("%w name is not set", ErrClientDataIncorrect)
+	}
+
+	if _, _, err := AuthenticatorCode(data.URI, time.Now()); err != nil {
+		return err
+	}
 	return nil
 }
 
-func CheckFileForRead(info *FileInfo) error {
-	if len(info.Name) < 5 {
-		return fmt.Errorf("%w Name is too short", ErrClientDataIncorrect)
+// AuthenticatorCode generates the TOTP code of the otpauth uri at the moment; the time left until the code
+// is changed is returned too. The period, the digits and the algorithm are taken from the uri.
+func AuthenticatorCode(uri string, now time.Time) (string, time.Duration, error) {
+	uri = strings.TrimSpace(uri)
+	if !strings.HasPrefix(uri, OTPAuthScheme) {
+		return "", 0, fmt.Errorf("%w uri must start with %s", ErrClientDataIncorrect, OTPAuthScheme)
 	}
 
-	if strings.HasPrefix(TempFileNamePrefix, info.Name) {
-		return fmt.Errorf("%w wrong Name - it is start with %s", ErrClientDataIncorrect, TempFileNamePrefix)
+	key, err := otp.NewKeyFromURL(uri)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w wrong otpauth uri - %s", ErrClientDataIncorrect, err.Error())
+	}
+
+	if key.Type() != "totp" {
+		return "", 0, fmt.Errorf("%w only totp keys are supported, got %s", ErrClientDataIncorrect, key.Type())
+	}
+
+	if key.Secret() == "" {
+		return "", 0, fmt.Errorf("%w otpauth uri secret is not set", ErrClientDataIncorrect)
+	}
+
+	period := int64(key.Period())
+	if period <= 0 {
+		return "", 0, fmt.Errorf("%w wrong otpauth uri period", ErrClientDataIncorrect)
+	}
+
+	code, err := totp.GenerateCodeCustom(key.Secret(), now, totp.ValidateOpts{
+		Period:    uint(period),
+		Digits:    key.Digits(),
+		Algorithm: key.Algorithm(),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("%w wrong otpauth uri secret - %s", ErrClientDataIncorrect, err.Error())
+	}
+
+	next := time.Unix((now.Unix()/period+1)*period, 0)
+	return code, next.Sub(now), nil
+}
+
+// ReadOTPAuthQR reads the otpauth uri from the QR code in the PNG file.
+func ReadOTPAuthQR(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("%w file by path %s is not acceptable", ErrClientDataIncorrect, path)
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		return "", fmt.Errorf("%w file %s is not a PNG image - %s", ErrClientDataIncorrect, path, err.Error())
+	}
+
+	qrCodes, err := goqr.Recognize(img)
+	if err != nil {
+		return "", fmt.Errorf("%w QR code is not recognized in %s - %s", ErrClientDataIncorrect, path, err.Error())
+	}
+
+	for _, qrCode := range qrCodes {
+		if payload := string(qrCode.Payload); strings.HasPrefix(payload, OTPAuthScheme) {
+			return payload, nil
+		}
+	}
+	return "", fmt.Errorf("%w QR code in %s does not contain otpauth uri", ErrClientDataIncorrect, path)
+}
+
+func CheckSSHKey(data *SSHKey) error {
+	if strings.TrimSpace(data.Name) == "" {
+		return fmt.Errorf("%w name is not set", ErrClientDataIncorrect)
+	}
+
+	signer, err := SSHKeySigner(data)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(data.PublicKey) == "" {
+		data.PublicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+		return nil
+	}
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(data.PublicKey))
+	if err != nil {
+		return fmt.Errorf("%w wrong public key - %s", ErrClientDataIncorrect, err.Error())
+	}
+	if !bytes.Equal(pub.Marshal(), signer.PublicKey().Marshal()) {
+		return fmt.Errorf("%w public key does not match the private key", ErrClientDataIncorrect)
+	}
+	return nil
+}
+
+// SSHKeySigner parses the private key, decrypting it with the passphrase if one is set.
+func SSHKeySigner(data *SSHKey) (ssh.Signer, error) {
+	if strings.TrimSpace(data.PrivateKey) == "" {
+		return nil, fmt.Errorf("%w private key is not set", ErrClientDataIncorrect)
+	}
+
+	var raw any
+	var err error
+	if data.Passphrase != "" {
+		raw, err = ssh.ParseRawPrivateKeyWithPassphrase([]byte(data.PrivateKey), []byte(data.Passphrase))
+	} else {
+		raw, err = ssh.ParseRawPrivateKey([]byte(data.PrivateKey))
+	}
+	if err != nil {
+		var missingErr *ssh.PassphraseMissingError
+		if errors.As(err, &missingErr) {
+			return nil, fmt.Errorf("%w private key is protected, passphrase is not set", ErrClientDataIncorrect)
+		}
+		return nil, fmt.Errorf("%w wrong private key - %s", ErrClientDataIncorrect, err.Error())
+	}
+
+	signer, err := ssh.NewSignerFromKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w unsupported private key - %s", ErrClientDataIncorrect, err.Error())
+	}
+	return signer, nil
+}
+
+// MaxCustomFields is the max number of the custom fields of the item.
+const MaxCustomFields = 32
+
+// CheckCustomFields checks the custom fields have not empty unique names.
+func CheckCustomFields(fields []CustomField) error {
+	if len(fields) > MaxCustomFields {
+		return fmt.Errorf("%w too many custom fields, max %d", ErrClientDataIncorrect, MaxCustomFields)
+	}
+
+	names := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		name := strings.TrimSpace(field.Name)
+		if name == "" {
+			return fmt.Errorf("%w custom field name is not set", ErrClientDataIncorrect)
+		}
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("%w custom field %s is duplicated", ErrClientDataIncorrect, name)
+		}
+		names[name] = struct{}{}
+	}
+	return nil
+}
+
+func CheckFileForRead(info *FileInfo) error {
+	if err := CheckFileName(info.Name); err != nil {
+		return err
 	}
 
 	f, err := os.Open(info.Path)
@@ -424,6 +677,18 @@ func CheckFileForRead(info *FileInfo) error {
 	return nil
 }
 
+// CheckFileName checks the name of the uploaded file.
+func CheckFileName(name string) error {
+	if len(name) < 5 {
+		return fmt.Errorf("%w Name is too short", ErrClientDataIncorrect)
+	}
+
+	if strings.HasPrefix(TempFileNamePrefix, name) {
+		return fmt.Errorf("%w wrong Name - it is start with %s", ErrClientDataIncorrect, TempFileNamePrefix)
+	}
+	return nil
+}
+
 func CheckFileForWrite(info *FileInfo) error {
 	path := info.Path
 	if info.Path == "" {
@@ -461,6 +726,10 @@ func CreateStreamFileReader(name string) (StreamFileReader, error) {
 	return NewStreamFileReader(name, FileChunkSize)
 }
 
+func CreateStreamBytesReader(data []byte) StreamFileReader {
+	return NewStreamBytesReader(data, FileChunkSize)
+}
+
 func CreateStreamFileWriter(dir string) (StreamFileWriter, error) {
 	return NewStreamFileWriter(dir)
 }
@@ -2,17 +2,25 @@ package domain_test
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/pquerna/otp"
 	"github.com/pquerna/otp/totp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestGetAction(t *testing.T) {
@@ -179,14 +187,20 @@ func TestPasswordOperation(t *testing.T) {
 
 		require.NotNil(t, hash)
 
-		assert.Equal(t, "In+BZhwpWKZH/S1QtMWcAOONZcrO9jVDaMDoJqgOfWM=", hash.Hash)
-		assert.Equal(t, "AAECAwQFBgcICQoLDA0ODw==", hash.Salt)
+		assert.True(t, strings.HasPrefix(hash.Hash, "$argon2id$v=19$m=19456,t=2,p=1$AAECAwQFBgcICQoLDA0ODw$"))
+		assert.Empty(t, hash.Salt)
 
 		ok, err := domain.ValidateAccountPass(pass, hash.Hash, hash.Salt)
 		require.NoError(t, err)
 		assert.True(t, ok)
 	})
 
+	t.Run("legacy_ok", func(t *testing.T) {
+		ok, err := domain.ValidateAccountPass("12345678", "In+BZhwpWKZH/S1QtMWcAOONZcrO9jVDaMDoJqgOfWM=", "AAECAwQFBgcICQoLDA0ODw==")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
 	t.Run("errSaltFn", func(t *testing.T) {
 		saltFn := testErrSaltFn
 		pass := "12345678"
@@ -313,6 +327,51 @@ func TestValidate(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("skew", func(t *testing.T) {
+		keyURL, _, err := domain.GenerateQR("issuer", "accountName")
+		require.NoError(t, err)
+
+		key, err := otp.NewKeyFromURL(keyURL)
+		require.NoError(t, err)
+
+		validOpts := totp.ValidateOpts{
+			Period:    domain.TOTPPeriod,
+			Digits:    domain.TOTPDigits,
+			Algorithm: domain.TOTPAlgorithm,
+		}
+
+		now := time.Now()
+		current := now.Unix() / domain.TOTPPeriod
+
+		prevCode, err := totp.GenerateCodeCustom(key.Secret(), now.Add(-domain.TOTPPeriod*time.Second), validOpts)
+		require.NoError(t, err)
+
+		_, ok, err := domain.ValidateOTPCodeStep(keyURL, prevCode, domain.OTPSkew{}, now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		step, ok, err := domain.ValidateOTPCodeStep(keyURL, prevCode, domain.OTPSkew{Past: 1}, now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, current-1, step)
+
+		nextCode, err := totp.GenerateCodeCustom(key.Secret(), now.Add(domain.TOTPPeriod*time.Second), validOpts)
+		require.NoError(t, err)
+
+		_, ok, err = domain.ValidateOTPCodeStep(keyURL, nextCode, domain.OTPSkew{Past: 1}, now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		step, ok, err = domain.ValidateOTPCodeStep(keyURL, nextCode, domain.OTPSkew{Future: 1}, now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, current+1, step)
+
+		_, ok, err = domain.ValidateOTPCodeStep(keyURL, "123", domain.OTPSkew{}, now)
+		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
+		assert.False(t, ok)
+	})
+
 	t.Run("err2", func(t *testing.T) {
 		issuer := "issuer"
 		accountName := ""
@@ -361,52 +420,121 @@ func TestHelloWorld(t *testing.T) {
 }
 
 func TestJWT(t *testing.T) {
-	t.Run("ok", func(t *testing.T) {
-		tokenSecret := "tokenSecret"
-		tokenDuration := 2 * time.Second
-		userID := domain.UserID(1)
-
-		jwtTok, err := domain.CreateJWTToken([]byte(tokenSecret), tokenDuration, userID)
-		require.NoError(t, err)
-		require.NotEmpty(t, jwtTok)
+	for _, alg := range []domain.JWTKeyAlgorithm{domain.JWTKeyAlgorithmEdDSA, domain.JWTKeyAlgorithmES256} {
+		t.Run(fmt.Sprintf("ok_%s", alg), func(t *testing.T) {
+			signingKey, err := domain.GenerateJWTSigningKey(alg)
+			require.NoError(t, err)
+			keys := domain.NewJWTKeySet(signingKey)
+
+			tokenDuration := 2 * time.Second
+			userID := domain.UserID(1)
+
+			jwtTok, err := domain.CreateJWTToken(keys, tokenDuration, userID, "sessionID")
+			require.NoError(t, err)
+			require.NotEmpty(t, jwtTok)
+
+			uID, err := domain.ParseJWTToken(keys, jwtTok)
+			require.NoError(t, err)
+			require.Equal(t, userID, uID)
+
+			claims, err := domain.ParseJWTClaims(keys, jwtTok)
+			require.NoError(t, err)
+			require.NotEmpty(t, claims.ID)
+			require.Equal(t, "sessionID", claims.SessionID)
+
+			expAt, err := domain.GetJWTExpiresAt(jwtTok)
+			require.NoError(t, err)
+			require.True(t, expAt.Equal(claims.ExpiresAt.Time))
+		})
+	}
 
-		uID, err := domain.ParseJWTToken([]byte(tokenSecret), jwtTok)
+	t.Run("timeout", func(t *testing.T) {
+		signingKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmEdDSA)
 		require.NoError(t, err)
-		require.Equal(t, userID, uID)
-	})
+		keys := domain.NewJWTKeySet(signingKey)
 
-	t.Run("timeout", func(t *testing.T) {
-		tokenSecret := "tokenSecret"
 		tokenDuration := 1 * time.Second
 		userID := domain.UserID(1)
 
-		jwtTok, err := domain.CreateJWTToken([]byte(tokenSecret), tokenDuration, userID)
+		jwtTok, err := domain.CreateJWTToken(keys, tokenDuration, userID, "sessionID")
 		require.NoError(t, err)
 		require.NotEmpty(t, jwtTok)
 
 		time.Sleep(2 * time.Second)
 
-		_, err = domain.ParseJWTToken([]byte(tokenSecret), jwtTok)
+		_, err = domain.ParseJWTToken(keys, jwtTok)
 		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
 	})
 
-	t.Run("wrong_secret", func(t *testing.T) {
-		tokenSecret := "tokenSecret"
-		tokenDuration := 1 * time.Second
-		userID := domain.UserID(1)
+	t.Run("unknown_key", func(t *testing.T) {
+		signingKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmEdDSA)
+		require.NoError(t, err)
 
-		jwtTok, err := domain.CreateJWTToken([]byte(tokenSecret), tokenDuration, userID)
+		jwtTok, err := domain.CreateJWTToken(domain.NewJWTKeySet(signingKey), time.Minute, 1, "sessionID")
 		require.NoError(t, err)
-		require.NotEmpty(t, jwtTok)
 
-		time.Sleep(2 * time.Second)
+		otherKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmEdDSA)
+		require.NoError(t, err)
+
+		_, err = domain.ParseJWTToken(domain.NewJWTKeySet(otherKey), jwtTok)
+		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
+	})
+
+	t.Run("retired_key", func(t *testing.T) {
+		oldKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmEdDSA)
+		require.NoError(t, err)
+
+		jwtTok, err := domain.CreateJWTToken(domain.NewJWTKeySet(oldKey), time.Minute, 1, "sessionID")
+		require.NoError(t, err)
+
+		newKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmES256)
+		require.NoError(t, err)
+		keys := domain.NewJWTKeySet(newKey, oldKey.VerificationKey())
+
+		uID, err := domain.ParseJWTToken(keys, jwtTok)
+		require.NoError(t, err)
+		require.Equal(t, domain.UserID(1), uID)
+
+		newTok, err := domain.CreateJWTToken(keys, time.Minute, 1, "sessionID")
+		require.NoError(t, err)
+
+		// old key set doesn't know the new key
+		_, err = domain.ParseJWTToken(domain.NewJWTKeySet(oldKey), newTok)
+		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
+	})
+
+	t.Run("wrong_signing_method", func(t *testing.T) {
+		signingKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmEdDSA)
+		require.NoError(t, err)
+		keys := domain.NewJWTKeySet(signingKey)
 
-		tokenSecret = tokenSecret + "nonce"
-		_, err = domain.ParseJWTToken([]byte(tokenSecret), jwtTok)
+		// HS256 token signed with a secret and the valid key id is rejected
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, domain.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			UserID: 1,
+		})
+		token.Header["kid"] = signingKey.KeyID
+		jwtTok, err := token.SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		_, err = domain.ParseJWTToken(keys, domain.JWTToken(jwtTok))
 		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
 	})
 }
 
+func TestRefreshToken(t *testing.T) {
+	token1, err := domain.NewRefreshToken()
+	require.NoError(t, err)
+	token2, err := domain.NewRefreshToken()
+	require.NoError(t, err)
+
+	require.NotEqual(t, token1, token2)
+	require.Equal(t, domain.HashRefreshToken(token1), domain.HashRefreshToken(token1))
+	require.NotEqual(t, domain.HashRefreshToken(token1), domain.HashRefreshToken(token2))
+}
+
 func TestEncryptionText(t *testing.T) {
 	passphrase := domain.Random32ByteString()
 
@@ -427,6 +555,22 @@ func TestEncryptionText(t *testing.T) {
 	require.False(t, bytes.Equal([]byte(encrypted), []byte(encrypted2)))
 }
 
+func TestBlindIndex(t *testing.T) {
+	masterPass := "masterPass"
+	number := "4000000000000002"
+
+	idx := domain.BlindIndex(masterPass, domain.SecretItemBankCard, number)
+	require.NotEmpty(t, idx)
+	require.NotContains(t, idx, number)
+
+	// deterministic
+	require.Equal(t, idx, domain.BlindIndex(masterPass, domain.SecretItemBankCard, number))
+
+	require.NotEqual(t, idx, domain.BlindIndex(masterPass, domain.SecretItemUserPassword, number))
+	require.NotEqual(t, idx, domain.BlindIndex(masterPass, domain.SecretItemBankCard, "4000000000000010"))
+	require.NotEqual(t, idx, domain.BlindIndex("anotherPass", domain.SecretItemBankCard, number))
+}
+
 func TestCheckBankCardData(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
@@ -499,6 +643,200 @@ func TestCheckLoginPassData(t *testing.T) {
 	})
 }
 
+func TestCheckCustomFields(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		assert.NoError(t, domain.CheckCustomFields(nil))
+		assert.NoError(t, domain.CheckCustomFields([]domain.CustomField{
+			{Name: "pin", Value: "1234", Hidden: true},
+			{Name: "bank", Value: ""},
+		}))
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		assert.ErrorIs(t, domain.CheckCustomFields([]domain.CustomField{
+			{Name: " ", Value: "1234"},
+		}), domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("duplicated name", func(t *testing.T) {
+		assert.ErrorIs(t, domain.CheckCustomFields([]domain.CustomField{
+			{Name: "pin", Value: "1234"},
+			{Name: "pin ", Value: "4321"},
+		}), domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("wrong fields in login pass data", func(t *testing.T) {
+		lData := &domain.UserPasswordData{
+			Hint:     "ya.ru",
+			Login:    "login",
+			Passwrod: "pass",
+			Fields:   []domain.CustomField{{Name: ""}},
+		}
+		assert.ErrorIs(t, domain.CheckUserPasswordData(lData), domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestCheckSecureNote(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		assert.NoError(t, domain.CheckSecureNote(&domain.SecureNote{
+			Title: "recovery",
+			Text:  "word1 word2\nword3",
+		}))
+	})
+
+	t.Run("empty title", func(t *testing.T) {
+		assert.ErrorIs(t, domain.CheckSecureNote(&domain.SecureNote{
+			Title: " ",
+			Text:  "text",
+		}), domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("empty text", func(t *testing.T) {
+		assert.ErrorIs(t, domain.CheckSecureNote(&domain.SecureNote{
+			Title: "title",
+		}), domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		err := domain.CheckSecureNote(&domain.SecureNote{
+			Title: "title",
+			Text:  strings.Repeat("a", domain.MaxSecureNoteSize+1),
+		})
+		assert.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+		assert.ErrorIs(t, err, domain.ErrClientNoteTooLarge)
+	})
+
+	t.Run("file name", func(t *testing.T) {
+		assert.Equal(t, "server_notes.txt", domain.SecureNoteFileName(" server/notes "))
+	})
+}
+
+func TestAuthenticatorCode(t *testing.T) {
+	// RFC 6238 test vector
+	uri := "otpauth://totp/Example:alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&digits=8&issuer=Example"
+
+	t.Run("ok", func(t *testing.T) {
+		code, left, err := domain.AuthenticatorCode(uri, time.Unix(59, 0))
+		require.NoError(t, err)
+		assert.Equal(t, "94287082", code)
+		assert.Equal(t, time.Second, left)
+	})
+
+	t.Run("next period", func(t *testing.T) {
+		code, left, err := domain.AuthenticatorCode(uri, time.Unix(1111111109, 0))
+		require.NoError(t, err)
+		assert.Equal(t, "07081804", code)
+		assert.Equal(t, 1*time.Second, left)
+	})
+
+	t.Run("wrong scheme", func(t *testing.T) {
+		_, _, err := domain.AuthenticatorCode("https://example.com", time.Now())
+		assert.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("hotp", func(t *testing.T) {
+		_, _, err := domain.AuthenticatorCode("otpauth://hotp/Example:alice?secret=GEZDGNBVGY3TQOJQ&counter=1", time.Now())
+		assert.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("no secret", func(t *testing.T) {
+		_, _, err := domain.AuthenticatorCode("otpauth://totp/Example:alice", time.Now())
+		assert.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		assert.NoError(t, domain.CheckAuthenticator(&domain.Authenticator{Name: "example", URI: uri}))
+		assert.ErrorIs(t, domain.CheckAuthenticator(&domain.Authenticator{URI: uri}), domain.ErrClientDataIncorrect)
+		assert.ErrorIs(t, domain.CheckAuthenticator(&domain.Authenticator{Name: "example", URI: "otpauth://totp/x?secret=1"}),
+			domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestReadOTPAuthQR(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		keyURL, qr, err := domain.GenerateQR("Example", "alice@example.com")
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "qr.png")
+		require.NoError(t, os.WriteFile(path, qr, 0600))
+
+		uri, err := domain.ReadOTPAuthQR(path)
+		require.NoError(t, err)
+		assert.Equal(t, keyURL, uri)
+	})
+
+	t.Run("not png", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "qr.png")
+		require.NoError(t, os.WriteFile(path, []byte("text"), 0600))
+
+		_, err := domain.ReadOTPAuthQR(path)
+		assert.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		_, err := domain.ReadOTPAuthQR(filepath.Join(t.TempDir(), "qr.png"))
+		assert.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestCheckSSHKey(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	authorized := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub)))
+
+	block, err := ssh.MarshalPrivateKey(priv, "test key")
+	require.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(block))
+
+	block, err = ssh.MarshalPrivateKeyWithPassphrase(priv, "test key", []byte("secret"))
+	require.NoError(t, err)
+	protectedKey := string(pem.EncodeToMemory(block))
+
+	t.Run("public key is filled", func(t *testing.T) {
+		key := &domain.SSHKey{Name: "work", PrivateKey: privateKey}
+		require.NoError(t, domain.CheckSSHKey(key))
+		assert.Equal(t, authorized, key.PublicKey)
+	})
+
+	t.Run("passphrase", func(t *testing.T) {
+		key := &domain.SSHKey{Name: "work", PrivateKey: protectedKey, Passphrase: "secret", PublicKey: authorized}
+		require.NoError(t, domain.CheckSSHKey(key))
+
+		key.Passphrase = ""
+		assert.ErrorIs(t, domain.CheckSSHKey(key), domain.ErrClientDataIncorrect)
+
+		key.Passphrase = "wrong"
+		assert.ErrorIs(t, domain.CheckSSHKey(key), domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("public key does not match", func(t *testing.T) {
+		otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		otherSSHPub, err := ssh.NewPublicKey(otherPub)
+		require.NoError(t, err)
+
+		key := &domain.SSHKey{Name: "work", PrivateKey: privateKey, PublicKey: string(ssh.MarshalAuthorizedKey(otherSSHPub))}
+		assert.ErrorIs(t, domain.CheckSSHKey(key), domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("wrong data", func(t *testing.T) {
+		assert.ErrorIs(t, domain.CheckSSHKey(&domain.SSHKey{PrivateKey: privateKey}), domain.ErrClientDataIncorrect)
+		assert.ErrorIs(t, domain.CheckSSHKey(&domain.SSHKey{Name: "work"}), domain.ErrClientDataIncorrect)
+		assert.ErrorIs(t, domain.CheckSSHKey(&domain.SSHKey{Name: "work", PrivateKey: "key"}), domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("signer", func(t *testing.T) {
+		signer, err := domain.SSHKeySigner(&domain.SSHKey{PrivateKey: privateKey})
+		require.NoError(t, err)
+
+		sig, err := signer.Sign(rand.Reader, []byte("data"))
+		require.NoError(t, err)
+		require.NoError(t, sshPub.Verify([]byte("data"), sig))
+	})
+}
+
 func TestCheckFileForRead(t *testing.T) {
 
 	t.Run("ok", func(t *testing.T) {
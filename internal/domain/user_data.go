@@ -3,26 +3,150 @@ package domain
 import (
 	"fmt"
 	"strconv"
+	"time"
 )
 
-// EncryptedBankCard used on server side
-type EncryptedBankCard struct {
-	Number  string
-	Content string
+// SecretItemType is a type of the user secret item.
+type SecretItemType string
+
+const (
+	SecretItemBankCard     SecretItemType = "bank_card"
+	SecretItemUserPassword SecretItemType = "user_password"
+	// SecretItemFileMetadata keeps the custom fields and the notes of the uploaded file; the file content is stored separately.
+	SecretItemFileMetadata SecretItemType = "file_metadata"
+	SecretItemSecureNote   SecretItemType = "secure_note"
+	// SecretItemAuthenticator keeps the TOTP seed of the third-party service.
+	SecretItemAuthenticator SecretItemType = "authenticator"
+	// SecretItemSSHKey keeps the ssh private key served by the client ssh-agent.
+	SecretItemSSHKey SecretItemType = "ssh_key"
+)
+
+// EncryptedSecretItem used on server side; ID is generated by the server, Name and Content are encrypted by the client.
+// LookupKey is a blind index of the item key (card number, hint), see [BlindIndex]; the server compares it only for equality.
+// Revision is increased on every update; update and delete are applied only to the expected revision.
+type EncryptedSecretItem struct {
+	ID        string
+	Type      SecretItemType
+	Name      string
+	Content   string
+	LookupKey string
+	Revision  int64
+	UpdatedAt time.Time
+}
+
+// SecretItemRevision is the stored state of the item returned after create, update and delete.
+// ChangeSeq is the user change sequence number assigned to the change.
+type SecretItemRevision struct {
+	ID        string
+	Type      SecretItemType
+	Revision  int64
+	ChangeSeq int64
+}
+
+// SecretItemHistoryEntry is a previous revision of the item kept on update: UpdatedAt is the time the revision
+// was stored, ReplacedAt - the time it was replaced by the next one. The content is loaded by the revision number.
+type SecretItemHistoryEntry struct {
+	ItemID     string
+	Revision   int64
+	UpdatedAt  time.Time
+	ReplacedAt time.Time
+}
+
+// ItemRevision is the decrypted previous revision of the item shown in the item history;
+// Name is the card number, the hint, the title or the item name the history is shown for.
+type ItemRevision struct {
+	ItemID     string
+	Type       SecretItemType
+	Name       string
+	Revision   int64
+	UpdatedAt  time.Time
+	ReplacedAt time.Time
+	// only the data of the item type is set
+	BankCard         *BankCard
+	UserPasswordData *UserPasswordData
+	SecureNote       *SecureNote
+	Authenticator    *Authenticator
+	SSHKey           *SSHKey
+}
+
+// DeletedSecretItem is a tombstone of the deleted item.
+type DeletedSecretItem struct {
+	ID   string
+	Type SecretItemType
+}
+
+// TrashFile is the type of the trash entry holding a deleted file.
+const TrashFile SecretItemType = "file"
+
+// TrashEntry is an item or a file moved to the trash. ID is the item id or the trash id of the file;
+// Name of the item is encrypted by the client, Name of the file is the file name.
+// The entry is purged after ExpiresAt.
+type TrashEntry struct {
+	ID        string
+	Type      SecretItemType
+	Name      string
+	DeletedAt time.Time
+	ExpiresAt time.Time
+}
+
+// SecretItemChanges are the changes of the user items after the requested sequence number.
+// Seq is the last change sequence number of the user; the client requests the next changes since it.
+// Reset is set if the requested sequence number is unknown to the server - the client must drop
+// all stored items, Items contains all user items in this case.
+type SecretItemChanges struct {
+	Seq     int64
+	Reset   bool
+	Items   []EncryptedSecretItem
+	Deleted []DeletedSecretItem
+}
+
+// ItemChangeKind is the kind of the item change pushed to the watching clients.
+type ItemChangeKind string
+
+const (
+	ItemCreated ItemChangeKind = "created"
+	ItemUpdated ItemChangeKind = "updated"
+	ItemDeleted ItemChangeKind = "deleted"
+	// ItemsChanged is sent on watch start if the client missed changes; ItemID and Type are empty.
+	ItemsChanged ItemChangeKind = "changed"
+)
+
+// ItemChangeEvent notifies the clients of the user about the committed item change with sequence number Seq.
+type ItemChangeEvent struct {
+	Seq    int64
+	Kind   ItemChangeKind
+	ItemID string
+	Type   SecretItemType
 }
 
+// ConflictResolution is chosen by the user when the item was changed by another client.
+type ConflictResolution int
+
+const (
+	// ConflictReload drops local changes and shows the stored item.
+	ConflictReload ConflictResolution = iota
+	// ConflictOverwrite stores local changes over the stored item.
+	ConflictOverwrite
+	// ConflictKeepBoth stores local changes as a new item.
+	ConflictKeepBoth
+)
+
 // BankCardView used in view -> controller communication
 type BankCardView struct {
 	Number      string
 	ExpiryMonth string
 	ExpiryYear  string
 	CVV         string
+	Fields      []CustomField
+	Notes       string
 }
 
 func (bcv BankCardView) ToBankCard() (*BankCard, error) {
 	bankCard := &BankCard{
 		Number: bcv.Number,
 		CVV:    bcv.CVV,
+		Fields: bcv.Fields,
+		Notes:  bcv.Notes,
 	}
 
 	expMonth, err := strconv.Atoi(bcv.ExpiryMonth)
@@ -44,8 +168,24 @@ func (bcv BankCardView) ToBankCard() (*BankCard, error) {
 	return bankCard, nil
 }
 
+// ToView converts the card to the view format.
+func (bc BankCard) ToView() *BankCardView {
+	return &BankCardView{
+		Number:      bc.Number,
+		ExpiryMonth: fmt.Sprintf("%02v", bc.ExpiryMonth),
+		ExpiryYear:  fmt.Sprintf("%v", bc.ExpiryYear),
+		CVV:         bc.CVV,
+		Fields:      bc.Fields,
+		Notes:       bc.Notes,
+	}
+}
+
 // BankCard bank card data
 type BankCard struct {
+	// ID is the secret item id; is not a part of the encrypted content
+	ID string `json:"-"`
+	// Revision is the secret item revision the data is based on
+	Revision int64 `json:"-"`
 	// Type is an optional string with one of the supported card types
 	Type string `json:"type,omitempty"`
 	// Number is the credit card number
@@ -56,20 +196,131 @@ type BankCard struct {
 	ExpiryYear int `json:"exporityYear,omitempty"`
 	// CVV is the credit card CVV code
 	CVV string `json:"cvv,omitempty"`
-}
-
-// EncryptedUserPasswordData used on server side
-type EncryptedUserPasswordData struct {
-	Hint    string
-	Content string
+	// Fields are the custom fields defined by the user
+	Fields []CustomField `json:"fields,omitempty"`
+	// Notes is a free-form text
+	Notes string `json:"notes,omitempty"`
 }
 
 // UserPasswordData user login/password data
 type UserPasswordData struct {
+	// ID is the secret item id; is not a part of the encrypted content
+	ID string `json:"-"`
+	// Revision is the secret item revision the data is based on
+	Revision int64 `json:"-"`
 	// SiteURL or other hint
 	Hint string `json:"hint,omitempty"`
 	// Login is user login
 	Login string `json:"login,omitempty"`
 	// Password is user password
 	Passwrod string `json:"password,omitempty"`
+	// Fields are the custom fields defined by the user
+	Fields []CustomField `json:"fields,omitempty"`
+	// Notes is a free-form text
+	Notes string `json:"notes,omitempty"`
+}
+
+// FileMetadata is the user metadata of the uploaded file; it is stored encrypted as a separate secret item
+// bound to the file by the name.
+type FileMetadata struct {
+	// ID is the secret item id; is not a part of the encrypted content
+	ID string `json:"-"`
+	// Revision is the secret item revision the data is based on
+	Revision int64 `json:"-"`
+	// Name is the file name
+	Name string `json:"name,omitempty"`
+	// Fields are the custom fields defined by the user
+	Fields []CustomField `json:"fields,omitempty"`
+	// Notes is a free-form text
+	Notes string `json:"notes,omitempty"`
+}
+
+// SecureNote is a multi-line text secret: recovery phrase, license key, server notes.
+type SecureNote struct {
+	// ID is the secret item id; is not a part of the encrypted content
+	ID string `json:"-"`
+	// Revision is the secret item revision the data is based on
+	Revision int64 `json:"-"`
+	// Title is the unique note title
+	Title string `json:"title,omitempty"`
+	// Text is the note text, at most MaxSecureNoteSize bytes
+	Text string `json:"text,omitempty"`
+	// Fields are the custom fields defined by the user
+	Fields []CustomField `json:"fields,omitempty"`
+}
+
+// Authenticator keeps the otpauth uri of the third-party service; the codes are generated on the client.
+type Authenticator struct {
+	// ID is the secret item id; is not a part of the encrypted content
+	ID string `json:"-"`
+	// Revision is the secret item revision the data is based on
+	Revision int64 `json:"-"`
+	// Name is the unique authenticator name shown in the list
+	Name string `json:"name,omitempty"`
+	// URI is the otpauth://totp/ uri with the seed
+	URI string `json:"uri,omitempty"`
+	// Notes is the free-form text, for example recovery codes location
+	Notes string `json:"notes,omitempty"`
+}
+
+// SSHKey is the ssh key pair served by the client ssh-agent while the vault is unlocked.
+type SSHKey struct {
+	// ID is the secret item id; is not a part of the encrypted content
+	ID string `json:"-"`
+	// Revision is the secret item revision the data is based on
+	Revision int64 `json:"-"`
+	// Name is the unique key name shown in the list
+	Name string `json:"name,omitempty"`
+	// PrivateKey is the PEM encoded private key, may be protected by the passphrase
+	PrivateKey string `json:"privateKey,omitempty"`
+	// PublicKey is the public key in the authorized_keys format; is filled from the private key if not set
+	PublicKey string `json:"publicKey,omitempty"`
+	// Comment is returned by the agent with the public key
+	Comment string `json:"comment,omitempty"`
+	// Passphrase decrypts the private key, optional
+	Passphrase string `json:"passphrase,omitempty"`
+	// Confirm requires the user confirmation for every signing request
+	Confirm bool `json:"confirm,omitempty"`
+}
+
+// CustomField is a user-defined key/value field of the item; the value of the hidden field is masked in the views.
+type CustomField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value,omitempty"`
+	Hidden bool   `json:"hidden,omitempty"`
+}
+
+// EncryptedVault is the local cache of the client data for offline access. Hello checks the master password
+// without the server; Data is [VaultData] JSON encrypted on the master password.
+type EncryptedVault struct {
+	Hello HelloData `json:"hello"`
+	Data  string    `json:"data"`
+}
+
+// VaultData is the snapshot of the data downloaded by the client; items are stored as received from the server.
+type VaultData struct {
+	Seq     int64                 `json:"seq"`
+	Items   []EncryptedSecretItem `json:"items"`
+	Files   []FileInfo            `json:"files"`
+	Pending []PendingChange       `json:"pending,omitempty"`
+	SavedAt time.Time             `json:"savedAt"`
+}
+
+// PendingChange is the item change made while the server was not available; Item is ready to be sent on replay.
+// The items created offline have no ID. Conflict is set if the change was rejected by the server on replay:
+// the change is not sent again and is kept until the user resolves the conflict.
+type PendingChange struct {
+	Kind     ItemChangeKind      `json:"kind"`
+	Item     EncryptedSecretItem `json:"item"`
+	Conflict bool                `json:"conflict,omitempty"`
+}
+
+// PendingConflict is the journaled change rejected by the server on replay; one of the data fields is set.
+type PendingConflict struct {
+	Kind             ItemChangeKind
+	BankCard         *BankCard
+	UserPasswordData *UserPasswordData
+	SecureNote       *SecureNote
+	Authenticator    *Authenticator
+	SSHKey           *SSHKey
 }
@@ -0,0 +1,7859 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: gophkeeper.proto
+
+package proto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _gophkeeper_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on RegistrationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegistrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegistrationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegistrationRequestMultiError, or nil if none found.
+func (m *RegistrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegistrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RegistrationRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return RegistrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RegistrationRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RegistrationRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RegistrationRequestMultiError is an error wrapping multiple validation
+// errors returned by RegistrationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegistrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegistrationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegistrationRequestMultiError) AllErrors() []error { return m }
+
+// RegistrationRequestValidationError is the validation error returned by
+// RegistrationRequest.Validate if the designated constraints aren't met.
+type RegistrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistrationRequestValidationError) ErrorName() string {
+	return "RegistrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegistrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistrationRequestValidationError{}
+
+// Validate checks the field values on RegistrationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegistrationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegistrationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegistrationResponseMultiError, or nil if none found.
+func (m *RegistrationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegistrationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return RegistrationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegistrationResponseMultiError is an error wrapping multiple validation
+// errors returned by RegistrationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RegistrationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegistrationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegistrationResponseMultiError) AllErrors() []error { return m }
+
+// RegistrationResponseValidationError is the validation error returned by
+// RegistrationResponse.Validate if the designated constraints aren't met.
+type RegistrationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistrationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistrationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistrationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistrationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistrationResponseValidationError) ErrorName() string {
+	return "RegistrationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegistrationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistrationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistrationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistrationResponseValidationError{}
+
+// Validate checks the field values on PassOTPRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PassOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PassOTPRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PassOTPRequestMultiError,
+// or nil if none found.
+func (m *PassOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PassOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return PassOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PassOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by PassOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PassOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PassOTPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PassOTPRequestMultiError) AllErrors() []error { return m }
+
+// PassOTPRequestValidationError is the validation error returned by
+// PassOTPRequest.Validate if the designated constraints aren't met.
+type PassOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PassOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PassOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PassOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PassOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PassOTPRequestValidationError) ErrorName() string { return "PassOTPRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PassOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPassOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PassOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PassOTPRequestValidationError{}
+
+// Validate checks the field values on PassOTPResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PassOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PassOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PassOTPResponseMultiError, or nil if none found.
+func (m *PassOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PassOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return PassOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PassOTPResponseMultiError is an error wrapping multiple validation errors
+// returned by PassOTPResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PassOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PassOTPResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PassOTPResponseMultiError) AllErrors() []error { return m }
+
+// PassOTPResponseValidationError is the validation error returned by
+// PassOTPResponse.Validate if the designated constraints aren't met.
+type PassOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PassOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PassOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PassOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PassOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PassOTPResponseValidationError) ErrorName() string { return "PassOTPResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PassOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPassOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PassOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PassOTPResponseValidationError{}
+
+// Validate checks the field values on MasterKeyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MasterKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MasterKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MasterKeyRequestMultiError, or nil if none found.
+func (m *MasterKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MasterKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	// no validation rules for MasterPasswordHint
+
+	// no validation rules for HelloEncrypted
+
+	if len(errors) > 0 {
+		return MasterKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MasterKeyRequestMultiError is an error wrapping multiple validation errors
+// returned by MasterKeyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MasterKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MasterKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MasterKeyRequestMultiError) AllErrors() []error { return m }
+
+// MasterKeyRequestValidationError is the validation error returned by
+// MasterKeyRequest.Validate if the designated constraints aren't met.
+type MasterKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MasterKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MasterKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MasterKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MasterKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MasterKeyRequestValidationError) ErrorName() string { return "MasterKeyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MasterKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMasterKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MasterKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MasterKeyRequestValidationError{}
+
+// Validate checks the field values on CheckEMailRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckEMailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckEMailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckEMailRequestMultiError, or nil if none found.
+func (m *CheckEMailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckEMailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = CheckEMailRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckEMailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CheckEMailRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CheckEMailRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CheckEMailRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckEMailRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckEMailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckEMailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckEMailRequestMultiError) AllErrors() []error { return m }
+
+// CheckEMailRequestValidationError is the validation error returned by
+// CheckEMailRequest.Validate if the designated constraints aren't met.
+type CheckEMailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckEMailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckEMailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckEMailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckEMailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckEMailRequestValidationError) ErrorName() string {
+	return "CheckEMailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckEMailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckEMailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckEMailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckEMailRequestValidationError{}
+
+// Validate checks the field values on CheckEMailResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckEMailResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckEMailResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckEMailResponseMultiError, or nil if none found.
+func (m *CheckEMailResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckEMailResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return CheckEMailResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckEMailResponseMultiError is an error wrapping multiple validation errors
+// returned by CheckEMailResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CheckEMailResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckEMailResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckEMailResponseMultiError) AllErrors() []error { return m }
+
+// CheckEMailResponseValidationError is the validation error returned by
+// CheckEMailResponse.Validate if the designated constraints aren't met.
+type CheckEMailResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckEMailResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckEMailResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckEMailResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckEMailResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckEMailResponseValidationError) ErrorName() string {
+	return "CheckEMailResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckEMailResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckEMailResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckEMailResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckEMailResponseValidationError{}
+
+// Validate checks the field values on LoginRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginRequestMultiError, or
+// nil if none found.
+func (m *LoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = LoginRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return LoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *LoginRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *LoginRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// LoginRequestMultiError is an error wrapping multiple validation errors
+// returned by LoginRequest.ValidateAll() if the designated constraints aren't met.
+type LoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginRequestMultiError) AllErrors() []error { return m }
+
+// LoginRequestValidationError is the validation error returned by
+// LoginRequest.Validate if the designated constraints aren't met.
+type LoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginRequestValidationError) ErrorName() string { return "LoginRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginRequestValidationError{}
+
+// Validate checks the field values on LoginResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginResponseMultiError, or
+// nil if none found.
+func (m *LoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return LoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginResponseMultiError is an error wrapping multiple validation errors
+// returned by LoginResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginResponseMultiError) AllErrors() []error { return m }
+
+// LoginResponseValidationError is the validation error returned by
+// LoginResponse.Validate if the designated constraints aren't met.
+type LoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginResponseValidationError) ErrorName() string { return "LoginResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginResponseValidationError{}
+
+// Validate checks the field values on AuthResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthResponseMultiError, or
+// nil if none found.
+func (m *AuthResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return AuthResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthResponse.ValidateAll() if the designated constraints aren't met.
+type AuthResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthResponseMultiError) AllErrors() []error { return m }
+
+// AuthResponseValidationError is the validation error returned by
+// AuthResponse.Validate if the designated constraints aren't met.
+type AuthResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthResponseValidationError) ErrorName() string { return "AuthResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthResponseValidationError{}
+
+// Validate checks the field values on RefreshRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RefreshRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefreshRequestMultiError,
+// or nil if none found.
+func (m *RefreshRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return RefreshRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshRequestMultiError is an error wrapping multiple validation errors
+// returned by RefreshRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RefreshRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshRequestMultiError) AllErrors() []error { return m }
+
+// RefreshRequestValidationError is the validation error returned by
+// RefreshRequest.Validate if the designated constraints aren't met.
+type RefreshRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshRequestValidationError) ErrorName() string { return "RefreshRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefreshRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshRequestValidationError{}
+
+// Validate checks the field values on LogoutRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutRequestMultiError, or
+// nil if none found.
+func (m *LogoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return LogoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by LogoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LogoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutRequestMultiError) AllErrors() []error { return m }
+
+// LogoutRequestValidationError is the validation error returned by
+// LogoutRequest.Validate if the designated constraints aren't met.
+type LogoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutRequestValidationError{}
+
+// Validate checks the field values on HelloResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HelloResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HelloResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HelloResponseMultiError, or
+// nil if none found.
+func (m *HelloResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HelloResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HelloEncrypted
+
+	// no validation rules for MasterPasswordHint
+
+	if len(errors) > 0 {
+		return HelloResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// HelloResponseMultiError is an error wrapping multiple validation errors
+// returned by HelloResponse.ValidateAll() if the designated constraints
+// aren't met.
+type HelloResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HelloResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HelloResponseMultiError) AllErrors() []error { return m }
+
+// HelloResponseValidationError is the validation error returned by
+// HelloResponse.Validate if the designated constraints aren't met.
+type HelloResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HelloResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HelloResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HelloResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HelloResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HelloResponseValidationError) ErrorName() string { return "HelloResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HelloResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHelloResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HelloResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HelloResponseValidationError{}
+
+// Validate checks the field values on BankCard with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BankCard) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BankCard with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BankCardMultiError, or nil
+// if none found.
+func (m *BankCard) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BankCard) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return BankCardMultiError(errors)
+	}
+
+	return nil
+}
+
+// BankCardMultiError is an error wrapping multiple validation errors returned
+// by BankCard.ValidateAll() if the designated constraints aren't met.
+type BankCardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BankCardMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BankCardMultiError) AllErrors() []error { return m }
+
+// BankCardValidationError is the validation error returned by
+// BankCard.Validate if the designated constraints aren't met.
+type BankCardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BankCardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BankCardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BankCardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BankCardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BankCardValidationError) ErrorName() string { return "BankCardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BankCardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBankCard.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BankCardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BankCardValidationError{}
+
+// Validate checks the field values on BankCardListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BankCardListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BankCardListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BankCardListResponseMultiError, or nil if none found.
+func (m *BankCardListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BankCardListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BankCardListResponseValidationError{
+						field:  fmt.Sprintf("Cards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BankCardListResponseValidationError{
+						field:  fmt.Sprintf("Cards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BankCardListResponseValidationError{
+					field:  fmt.Sprintf("Cards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BankCardListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BankCardListResponseMultiError is an error wrapping multiple validation
+// errors returned by BankCardListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BankCardListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BankCardListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BankCardListResponseMultiError) AllErrors() []error { return m }
+
+// BankCardListResponseValidationError is the validation error returned by
+// BankCardListResponse.Validate if the designated constraints aren't met.
+type BankCardListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BankCardListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BankCardListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BankCardListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BankCardListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BankCardListResponseValidationError) ErrorName() string {
+	return "BankCardListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BankCardListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBankCardListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BankCardListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BankCardListResponseValidationError{}
+
+// Validate checks the field values on CreateBankCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBankCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBankCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBankCardRequestMultiError, or nil if none found.
+func (m *CreateBankCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBankCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CreateBankCardRequest_Number_Pattern.MatchString(m.GetNumber()) {
+		err := CreateBankCardRequestValidationError{
+			field:  "Number",
+			reason: "value does not match regex pattern \"^[0-9]{12,19}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 65536 {
+		err := CreateBankCardRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateBankCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBankCardRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBankCardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBankCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBankCardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBankCardRequestMultiError) AllErrors() []error { return m }
+
+// CreateBankCardRequestValidationError is the validation error returned by
+// CreateBankCardRequest.Validate if the designated constraints aren't met.
+type CreateBankCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBankCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBankCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBankCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBankCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBankCardRequestValidationError) ErrorName() string {
+	return "CreateBankCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBankCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBankCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBankCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBankCardRequestValidationError{}
+
+var _CreateBankCardRequest_Number_Pattern = regexp.MustCompile("^[0-9]{12,19}$")
+
+// Validate checks the field values on DeleteBankCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBankCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBankCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBankCardRequestMultiError, or nil if none found.
+func (m *DeleteBankCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBankCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DeleteBankCardRequest_Number_Pattern.MatchString(m.GetNumber()) {
+		err := DeleteBankCardRequestValidationError{
+			field:  "Number",
+			reason: "value does not match regex pattern \"^[0-9]{12,19}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteBankCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBankCardRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBankCardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBankCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBankCardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBankCardRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBankCardRequestValidationError is the validation error returned by
+// DeleteBankCardRequest.Validate if the designated constraints aren't met.
+type DeleteBankCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBankCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBankCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBankCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBankCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBankCardRequestValidationError) ErrorName() string {
+	return "DeleteBankCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBankCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBankCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBankCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBankCardRequestValidationError{}
+
+var _DeleteBankCardRequest_Number_Pattern = regexp.MustCompile("^[0-9]{12,19}$")
+
+// Validate checks the field values on UpdateBankCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBankCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBankCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBankCardRequestMultiError, or nil if none found.
+func (m *UpdateBankCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBankCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_UpdateBankCardRequest_Number_Pattern.MatchString(m.GetNumber()) {
+		err := UpdateBankCardRequestValidationError{
+			field:  "Number",
+			reason: "value does not match regex pattern \"^[0-9]{12,19}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 65536 {
+		err := UpdateBankCardRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateBankCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBankCardRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBankCardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBankCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBankCardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBankCardRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBankCardRequestValidationError is the validation error returned by
+// UpdateBankCardRequest.Validate if the designated constraints aren't met.
+type UpdateBankCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBankCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBankCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBankCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBankCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBankCardRequestValidationError) ErrorName() string {
+	return "UpdateBankCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBankCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBankCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBankCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBankCardRequestValidationError{}
+
+var _UpdateBankCardRequest_Number_Pattern = regexp.MustCompile("^[0-9]{12,19}$")
+
+// Validate checks the field values on UserPasswordData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserPasswordData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPasswordData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPasswordDataMultiError, or nil if none found.
+func (m *UserPasswordData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPasswordData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hint
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return UserPasswordDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPasswordDataMultiError is an error wrapping multiple validation errors
+// returned by UserPasswordData.ValidateAll() if the designated constraints
+// aren't met.
+type UserPasswordDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPasswordDataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPasswordDataMultiError) AllErrors() []error { return m }
+
+// UserPasswordDataValidationError is the validation error returned by
+// UserPasswordData.Validate if the designated constraints aren't met.
+type UserPasswordDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPasswordDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPasswordDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPasswordDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPasswordDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPasswordDataValidationError) ErrorName() string { return "UserPasswordDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPasswordDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPasswordData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPasswordDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPasswordDataValidationError{}
+
+// Validate checks the field values on UserPasswordDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserPasswordDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPasswordDataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPasswordDataResponseMultiError, or nil if none found.
+func (m *UserPasswordDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPasswordDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDatas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserPasswordDataResponseValidationError{
+						field:  fmt.Sprintf("Datas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserPasswordDataResponseValidationError{
+						field:  fmt.Sprintf("Datas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserPasswordDataResponseValidationError{
+					field:  fmt.Sprintf("Datas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserPasswordDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPasswordDataResponseMultiError is an error wrapping multiple validation
+// errors returned by UserPasswordDataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserPasswordDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPasswordDataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPasswordDataResponseMultiError) AllErrors() []error { return m }
+
+// UserPasswordDataResponseValidationError is the validation error returned by
+// UserPasswordDataResponse.Validate if the designated constraints aren't met.
+type UserPasswordDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPasswordDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPasswordDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPasswordDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPasswordDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPasswordDataResponseValidationError) ErrorName() string {
+	return "UserPasswordDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserPasswordDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPasswordDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPasswordDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPasswordDataResponseValidationError{}
+
+// Validate checks the field values on CreateUserPasswordDataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUserPasswordDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserPasswordDataRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateUserPasswordDataRequestMultiError, or nil if none found.
+func (m *CreateUserPasswordDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserPasswordDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetHint()); l < 1 || l > 256 {
+		err := CreateUserPasswordDataRequestValidationError{
+			field:  "Hint",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 65536 {
+		err := CreateUserPasswordDataRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateUserPasswordDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserPasswordDataRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateUserPasswordDataRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateUserPasswordDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserPasswordDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserPasswordDataRequestMultiError) AllErrors() []error { return m }
+
+// CreateUserPasswordDataRequestValidationError is the validation error
+// returned by CreateUserPasswordDataRequest.Validate if the designated
+// constraints aren't met.
+type CreateUserPasswordDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserPasswordDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserPasswordDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserPasswordDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserPasswordDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserPasswordDataRequestValidationError) ErrorName() string {
+	return "CreateUserPasswordDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUserPasswordDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserPasswordDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserPasswordDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserPasswordDataRequestValidationError{}
+
+// Validate checks the field values on DeleteUserPasswordDataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUserPasswordDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUserPasswordDataRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteUserPasswordDataRequestMultiError, or nil if none found.
+func (m *DeleteUserPasswordDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUserPasswordDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetHint()); l < 1 || l > 256 {
+		err := DeleteUserPasswordDataRequestValidationError{
+			field:  "Hint",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteUserPasswordDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUserPasswordDataRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteUserPasswordDataRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteUserPasswordDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUserPasswordDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUserPasswordDataRequestMultiError) AllErrors() []error { return m }
+
+// DeleteUserPasswordDataRequestValidationError is the validation error
+// returned by DeleteUserPasswordDataRequest.Validate if the designated
+// constraints aren't met.
+type DeleteUserPasswordDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUserPasswordDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUserPasswordDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUserPasswordDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUserPasswordDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUserPasswordDataRequestValidationError) ErrorName() string {
+	return "DeleteUserPasswordDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUserPasswordDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUserPasswordDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUserPasswordDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUserPasswordDataRequestValidationError{}
+
+// Validate checks the field values on UpdateUserPasswordDataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserPasswordDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserPasswordDataRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateUserPasswordDataRequestMultiError, or nil if none found.
+func (m *UpdateUserPasswordDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserPasswordDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetHint()); l < 1 || l > 256 {
+		err := UpdateUserPasswordDataRequestValidationError{
+			field:  "Hint",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 65536 {
+		err := UpdateUserPasswordDataRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserPasswordDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserPasswordDataRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPasswordDataRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateUserPasswordDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserPasswordDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserPasswordDataRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserPasswordDataRequestValidationError is the validation error
+// returned by UpdateUserPasswordDataRequest.Validate if the designated
+// constraints aren't met.
+type UpdateUserPasswordDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserPasswordDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserPasswordDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserPasswordDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserPasswordDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserPasswordDataRequestValidationError) ErrorName() string {
+	return "UpdateUserPasswordDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserPasswordDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserPasswordDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserPasswordDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserPasswordDataRequestValidationError{}
+
+// Validate checks the field values on SecretItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretItemMultiError, or
+// nil if none found.
+func (m *SecretItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretItemValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretItemValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretItemValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LookupKey
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return SecretItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretItemMultiError is an error wrapping multiple validation errors
+// returned by SecretItem.ValidateAll() if the designated constraints aren't met.
+type SecretItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretItemMultiError) AllErrors() []error { return m }
+
+// SecretItemValidationError is the validation error returned by
+// SecretItem.Validate if the designated constraints aren't met.
+type SecretItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretItemValidationError) ErrorName() string { return "SecretItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretItemValidationError{}
+
+// Validate checks the field values on ListItemsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListItemsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListItemsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListItemsRequestMultiError, or nil if none found.
+func (m *ListItemsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListItemsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := SecretItemType_name[int32(m.GetType())]; !ok {
+		err := ListItemsRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListItemsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListItemsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListItemsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListItemsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListItemsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListItemsRequestMultiError) AllErrors() []error { return m }
+
+// ListItemsRequestValidationError is the validation error returned by
+// ListItemsRequest.Validate if the designated constraints aren't met.
+type ListItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListItemsRequestValidationError) ErrorName() string { return "ListItemsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListItemsRequestValidationError{}
+
+// Validate checks the field values on ListItemsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListItemsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListItemsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListItemsResponseMultiError, or nil if none found.
+func (m *ListItemsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListItemsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListItemsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListItemsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListItemsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListItemsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListItemsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListItemsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListItemsResponseMultiError) AllErrors() []error { return m }
+
+// ListItemsResponseValidationError is the validation error returned by
+// ListItemsResponse.Validate if the designated constraints aren't met.
+type ListItemsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListItemsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListItemsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListItemsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListItemsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListItemsResponseValidationError) ErrorName() string {
+	return "ListItemsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListItemsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListItemsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListItemsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListItemsResponseValidationError{}
+
+// Validate checks the field values on GetItemRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetItemRequestMultiError,
+// or nil if none found.
+func (m *GetItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetItemRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetItemRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetItemRequestMultiError is an error wrapping multiple validation errors
+// returned by GetItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetItemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetItemRequestMultiError) AllErrors() []error { return m }
+
+// GetItemRequestValidationError is the validation error returned by
+// GetItemRequest.Validate if the designated constraints aren't met.
+type GetItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetItemRequestValidationError) ErrorName() string { return "GetItemRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetItemRequestValidationError{}
+
+// Validate checks the field values on CreateItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateItemRequestMultiError, or nil if none found.
+func (m *CreateItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _CreateItemRequest_Type_NotInLookup[m.GetType()]; ok {
+		err := CreateItemRequestValidationError{
+			field:  "Type",
+			reason: "value must not be in list [SECRET_ITEM_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := SecretItemType_name[int32(m.GetType())]; !ok {
+		err := CreateItemRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := CreateItemRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 65536 {
+		err := CreateItemRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLookupKey()) > 128 {
+		err := CreateItemRequestValidationError{
+			field:  "LookupKey",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateItemRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateItemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateItemRequestMultiError) AllErrors() []error { return m }
+
+// CreateItemRequestValidationError is the validation error returned by
+// CreateItemRequest.Validate if the designated constraints aren't met.
+type CreateItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateItemRequestValidationError) ErrorName() string {
+	return "CreateItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateItemRequestValidationError{}
+
+var _CreateItemRequest_Type_NotInLookup = map[SecretItemType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateItemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateItemResponseMultiError, or nil if none found.
+func (m *CreateItemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateItemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return CreateItemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateItemResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateItemResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateItemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateItemResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateItemResponseMultiError) AllErrors() []error { return m }
+
+// CreateItemResponseValidationError is the validation error returned by
+// CreateItemResponse.Validate if the designated constraints aren't met.
+type CreateItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateItemResponseValidationError) ErrorName() string {
+	return "CreateItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateItemResponseValidationError{}
+
+// Validate checks the field values on UpdateItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateItemRequestMultiError, or nil if none found.
+func (m *UpdateItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateItemRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := UpdateItemRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 65536 {
+		err := UpdateItemRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLookupKey()) > 128 {
+		err := UpdateItemRequestValidationError{
+			field:  "LookupKey",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := UpdateItemRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateItemRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateItemRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateItemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateItemRequestMultiError) AllErrors() []error { return m }
+
+// UpdateItemRequestValidationError is the validation error returned by
+// UpdateItemRequest.Validate if the designated constraints aren't met.
+type UpdateItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateItemRequestValidationError) ErrorName() string {
+	return "UpdateItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateItemRequestValidationError{}
+
+// Validate checks the field values on UpdateItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateItemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateItemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateItemResponseMultiError, or nil if none found.
+func (m *UpdateItemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateItemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return UpdateItemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateItemResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateItemResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateItemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateItemResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateItemResponseMultiError) AllErrors() []error { return m }
+
+// UpdateItemResponseValidationError is the validation error returned by
+// UpdateItemResponse.Validate if the designated constraints aren't met.
+type UpdateItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateItemResponseValidationError) ErrorName() string {
+	return "UpdateItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateItemResponseValidationError{}
+
+// Validate checks the field values on DeleteItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteItemRequestMultiError, or nil if none found.
+func (m *DeleteItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteItemRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := DeleteItemRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteItemRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteItemRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteItemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteItemRequestMultiError) AllErrors() []error { return m }
+
+// DeleteItemRequestValidationError is the validation error returned by
+// DeleteItemRequest.Validate if the designated constraints aren't met.
+type DeleteItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteItemRequestValidationError) ErrorName() string {
+	return "DeleteItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteItemRequestValidationError{}
+
+// Validate checks the field values on GetChangesSinceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChangesSinceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChangesSinceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChangesSinceRequestMultiError, or nil if none found.
+func (m *GetChangesSinceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChangesSinceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSeq() < 0 {
+		err := GetChangesSinceRequestValidationError{
+			field:  "Seq",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetChangesSinceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChangesSinceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetChangesSinceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetChangesSinceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChangesSinceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChangesSinceRequestMultiError) AllErrors() []error { return m }
+
+// GetChangesSinceRequestValidationError is the validation error returned by
+// GetChangesSinceRequest.Validate if the designated constraints aren't met.
+type GetChangesSinceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChangesSinceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChangesSinceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChangesSinceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChangesSinceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChangesSinceRequestValidationError) ErrorName() string {
+	return "GetChangesSinceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChangesSinceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChangesSinceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChangesSinceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChangesSinceRequestValidationError{}
+
+// Validate checks the field values on DeletedItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeletedItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletedItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeletedItemMultiError, or
+// nil if none found.
+func (m *DeletedItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletedItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return DeletedItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletedItemMultiError is an error wrapping multiple validation errors
+// returned by DeletedItem.ValidateAll() if the designated constraints aren't met.
+type DeletedItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletedItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletedItemMultiError) AllErrors() []error { return m }
+
+// DeletedItemValidationError is the validation error returned by
+// DeletedItem.Validate if the designated constraints aren't met.
+type DeletedItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletedItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletedItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletedItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletedItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletedItemValidationError) ErrorName() string { return "DeletedItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeletedItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletedItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletedItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletedItemValidationError{}
+
+// Validate checks the field values on GetChangesSinceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChangesSinceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChangesSinceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChangesSinceResponseMultiError, or nil if none found.
+func (m *GetChangesSinceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChangesSinceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	// no validation rules for Reset_
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetChangesSinceResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetChangesSinceResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetChangesSinceResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDeleted() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetChangesSinceResponseValidationError{
+						field:  fmt.Sprintf("Deleted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetChangesSinceResponseValidationError{
+						field:  fmt.Sprintf("Deleted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetChangesSinceResponseValidationError{
+					field:  fmt.Sprintf("Deleted[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetChangesSinceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChangesSinceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetChangesSinceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetChangesSinceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChangesSinceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChangesSinceResponseMultiError) AllErrors() []error { return m }
+
+// GetChangesSinceResponseValidationError is the validation error returned by
+// GetChangesSinceResponse.Validate if the designated constraints aren't met.
+type GetChangesSinceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChangesSinceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChangesSinceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChangesSinceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChangesSinceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChangesSinceResponseValidationError) ErrorName() string {
+	return "GetChangesSinceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChangesSinceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChangesSinceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChangesSinceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChangesSinceResponseValidationError{}
+
+// Validate checks the field values on WatchRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchRequestMultiError, or
+// nil if none found.
+func (m *WatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSeq() < 0 {
+		err := WatchRequestValidationError{
+			field:  "Seq",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchRequest.ValidateAll() if the designated constraints aren't met.
+type WatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRequestMultiError) AllErrors() []error { return m }
+
+// WatchRequestValidationError is the validation error returned by
+// WatchRequest.Validate if the designated constraints aren't met.
+type WatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRequestValidationError) ErrorName() string { return "WatchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRequestValidationError{}
+
+// Validate checks the field values on ItemChangeEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ItemChangeEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ItemChangeEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ItemChangeEventMultiError, or nil if none found.
+func (m *ItemChangeEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ItemChangeEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	// no validation rules for Kind
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return ItemChangeEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItemChangeEventMultiError is an error wrapping multiple validation errors
+// returned by ItemChangeEvent.ValidateAll() if the designated constraints
+// aren't met.
+type ItemChangeEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItemChangeEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItemChangeEventMultiError) AllErrors() []error { return m }
+
+// ItemChangeEventValidationError is the validation error returned by
+// ItemChangeEvent.Validate if the designated constraints aren't met.
+type ItemChangeEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItemChangeEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItemChangeEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItemChangeEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItemChangeEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItemChangeEventValidationError) ErrorName() string { return "ItemChangeEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ItemChangeEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItemChangeEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItemChangeEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItemChangeEventValidationError{}
+
+// Validate checks the field values on ListRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsRequestMultiError, or nil if none found.
+func (m *ListRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ListRevisionsRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListRevisionsRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListRevisionsRequestValidationError is the validation error returned by
+// ListRevisionsRequest.Validate if the designated constraints aren't met.
+type ListRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRevisionsRequestValidationError) ErrorName() string {
+	return "ListRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRevisionsRequestValidationError{}
+
+// Validate checks the field values on ItemRevisionInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ItemRevisionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ItemRevisionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ItemRevisionInfoMultiError, or nil if none found.
+func (m *ItemRevisionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ItemRevisionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revision
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ItemRevisionInfoValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ItemRevisionInfoValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ItemRevisionInfoValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReplacedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ItemRevisionInfoValidationError{
+					field:  "ReplacedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ItemRevisionInfoValidationError{
+					field:  "ReplacedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplacedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ItemRevisionInfoValidationError{
+				field:  "ReplacedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ItemRevisionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItemRevisionInfoMultiError is an error wrapping multiple validation errors
+// returned by ItemRevisionInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ItemRevisionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItemRevisionInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItemRevisionInfoMultiError) AllErrors() []error { return m }
+
+// ItemRevisionInfoValidationError is the validation error returned by
+// ItemRevisionInfo.Validate if the designated constraints aren't met.
+type ItemRevisionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItemRevisionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItemRevisionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItemRevisionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItemRevisionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItemRevisionInfoValidationError) ErrorName() string { return "ItemRevisionInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ItemRevisionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItemRevisionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItemRevisionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItemRevisionInfoValidationError{}
+
+// Validate checks the field values on ListRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsResponseMultiError, or nil if none found.
+func (m *ListRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRevisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRevisionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListRevisionsResponseValidationError is the validation error returned by
+// ListRevisionsResponse.Validate if the designated constraints aren't met.
+type ListRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRevisionsResponseValidationError) ErrorName() string {
+	return "ListRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRevisionsResponseValidationError{}
+
+// Validate checks the field values on GetRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevisionRequestMultiError, or nil if none found.
+func (m *GetRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetRevisionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := GetRevisionRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRevisionRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRevisionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetRevisionRequestValidationError is the validation error returned by
+// GetRevisionRequest.Validate if the designated constraints aren't met.
+type GetRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevisionRequestValidationError) ErrorName() string {
+	return "GetRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevisionRequestValidationError{}
+
+// Validate checks the field values on RestoreRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRevisionRequestMultiError, or nil if none found.
+func (m *RestoreRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreRevisionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := RestoreRevisionRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCurrentRevision() <= 0 {
+		err := RestoreRevisionRequestValidationError{
+			field:  "CurrentRevision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreRevisionRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreRevisionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRevisionRequestMultiError) AllErrors() []error { return m }
+
+// RestoreRevisionRequestValidationError is the validation error returned by
+// RestoreRevisionRequest.Validate if the designated constraints aren't met.
+type RestoreRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRevisionRequestValidationError) ErrorName() string {
+	return "RestoreRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRevisionRequestValidationError{}
+
+// Validate checks the field values on FileInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileInfoMultiError, or nil
+// if none found.
+func (m *FileInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return FileInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileInfoMultiError is an error wrapping multiple validation errors returned
+// by FileInfo.ValidateAll() if the designated constraints aren't met.
+type FileInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileInfoMultiError) AllErrors() []error { return m }
+
+// FileInfoValidationError is the validation error returned by
+// FileInfo.Validate if the designated constraints aren't met.
+type FileInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileInfoValidationError) ErrorName() string { return "FileInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileInfoValidationError{}
+
+// Validate checks the field values on SecureNote with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecureNote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecureNote with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecureNoteMultiError, or
+// nil if none found.
+func (m *SecureNote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecureNote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecureNoteValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecureNoteValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecureNoteValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LookupKey
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return SecureNoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecureNoteMultiError is an error wrapping multiple validation errors
+// returned by SecureNote.ValidateAll() if the designated constraints aren't met.
+type SecureNoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecureNoteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecureNoteMultiError) AllErrors() []error { return m }
+
+// SecureNoteValidationError is the validation error returned by
+// SecureNote.Validate if the designated constraints aren't met.
+type SecureNoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecureNoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecureNoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecureNoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecureNoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecureNoteValidationError) ErrorName() string { return "SecureNoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecureNoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecureNote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecureNoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecureNoteValidationError{}
+
+// Validate checks the field values on ListSecureNotesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecureNotesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecureNotesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecureNotesResponseMultiError, or nil if none found.
+func (m *ListSecureNotesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecureNotesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSecureNotesResponseValidationError{
+						field:  fmt.Sprintf("Notes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSecureNotesResponseValidationError{
+						field:  fmt.Sprintf("Notes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSecureNotesResponseValidationError{
+					field:  fmt.Sprintf("Notes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSecureNotesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecureNotesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSecureNotesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSecureNotesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecureNotesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecureNotesResponseMultiError) AllErrors() []error { return m }
+
+// ListSecureNotesResponseValidationError is the validation error returned by
+// ListSecureNotesResponse.Validate if the designated constraints aren't met.
+type ListSecureNotesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecureNotesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecureNotesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecureNotesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecureNotesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecureNotesResponseValidationError) ErrorName() string {
+	return "ListSecureNotesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecureNotesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecureNotesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecureNotesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecureNotesResponseValidationError{}
+
+// Validate checks the field values on GetSecureNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSecureNoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSecureNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSecureNoteRequestMultiError, or nil if none found.
+func (m *GetSecureNoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSecureNoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetSecureNoteRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSecureNoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetSecureNoteRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetSecureNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSecureNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSecureNoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSecureNoteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSecureNoteRequestMultiError) AllErrors() []error { return m }
+
+// GetSecureNoteRequestValidationError is the validation error returned by
+// GetSecureNoteRequest.Validate if the designated constraints aren't met.
+type GetSecureNoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSecureNoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSecureNoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSecureNoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSecureNoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSecureNoteRequestValidationError) ErrorName() string {
+	return "GetSecureNoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSecureNoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSecureNoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSecureNoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSecureNoteRequestValidationError{}
+
+// Validate checks the field values on CreateSecureNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSecureNoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSecureNoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSecureNoteRequestMultiError, or nil if none found.
+func (m *CreateSecureNoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSecureNoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 1024 {
+		err := CreateSecureNoteRequestValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 65536 {
+		err := CreateSecureNoteRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetLookupKey()); l < 1 || l > 128 {
+		err := CreateSecureNoteRequestValidationError{
+			field:  "LookupKey",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSecureNoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSecureNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSecureNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSecureNoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSecureNoteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSecureNoteRequestMultiError) AllErrors() []error { return m }
+
+// CreateSecureNoteRequestValidationError is the validation error returned by
+// CreateSecureNoteRequest.Validate if the designated constraints aren't met.
+type CreateSecureNoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSecureNoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSecureNoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSecureNoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSecureNoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSecureNoteRequestValidationError) ErrorName() string {
+	return "CreateSecureNoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSecureNoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSecureNoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSecureNoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSecureNoteRequestValidationError{}
+
+// Validate checks the field values on UpdateSecureNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecureNoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecureNoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecureNoteRequestMultiError, or nil if none found.
+func (m *UpdateSecureNoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecureNoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateSecureNoteRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 1024 {
+		err := UpdateSecureNoteRequestValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 65536 {
+		err := UpdateSecureNoteRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 65536 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetLookupKey()); l < 1 || l > 128 {
+		err := UpdateSecureNoteRequestValidationError{
+			field:  "LookupKey",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := UpdateSecureNoteRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateSecureNoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateSecureNoteRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateSecureNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecureNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSecureNoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecureNoteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecureNoteRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSecureNoteRequestValidationError is the validation error returned by
+// UpdateSecureNoteRequest.Validate if the designated constraints aren't met.
+type UpdateSecureNoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecureNoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecureNoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecureNoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecureNoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecureNoteRequestValidationError) ErrorName() string {
+	return "UpdateSecureNoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecureNoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecureNoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecureNoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecureNoteRequestValidationError{}
+
+// Validate checks the field values on DeleteSecureNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSecureNoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSecureNoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSecureNoteRequestMultiError, or nil if none found.
+func (m *DeleteSecureNoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSecureNoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteSecureNoteRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := DeleteSecureNoteRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSecureNoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteSecureNoteRequest) _validateUuid(uuid string) error {
+	if matched := _gophkeeper_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteSecureNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSecureNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSecureNoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSecureNoteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSecureNoteRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSecureNoteRequestValidationError is the validation error returned by
+// DeleteSecureNoteRequest.Validate if the designated constraints aren't met.
+type DeleteSecureNoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSecureNoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSecureNoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSecureNoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSecureNoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSecureNoteRequestValidationError) ErrorName() string {
+	return "DeleteSecureNoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSecureNoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSecureNoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSecureNoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSecureNoteRequestValidationError{}
+
+// Validate checks the field values on GetFileInfoListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFileInfoListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFileInfoListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileInfoListResponseMultiError, or nil if none found.
+func (m *GetFileInfoListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFileInfoListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFileInfo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFileInfoListResponseValidationError{
+						field:  fmt.Sprintf("FileInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFileInfoListResponseValidationError{
+						field:  fmt.Sprintf("FileInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFileInfoListResponseValidationError{
+					field:  fmt.Sprintf("FileInfo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFileInfoListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileInfoListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFileInfoListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFileInfoListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileInfoListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileInfoListResponseMultiError) AllErrors() []error { return m }
+
+// GetFileInfoListResponseValidationError is the validation error returned by
+// GetFileInfoListResponse.Validate if the designated constraints aren't met.
+type GetFileInfoListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileInfoListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileInfoListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileInfoListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileInfoListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileInfoListResponseValidationError) ErrorName() string {
+	return "GetFileInfoListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFileInfoListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileInfoListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileInfoListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileInfoListResponseValidationError{}
+
+// Validate checks the field values on UploadFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UploadFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFileRequestMultiError, or nil if none found.
+func (m *UploadFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _UploadFileRequest_Name_NotInLookup[m.GetName()]; ok {
+		err := UploadFileRequestValidationError{
+			field:  "Name",
+			reason: "value must not be in list [. ..]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 255 {
+		err := UploadFileRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UploadFileRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := UploadFileRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SizeInBytes
+
+	// no validation rules for Data
+
+	// no validation rules for Cancel
+
+	if len(errors) > 0 {
+		return UploadFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFileRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFileRequestMultiError) AllErrors() []error { return m }
+
+// UploadFileRequestValidationError is the validation error returned by
+// UploadFileRequest.Validate if the designated constraints aren't met.
+type UploadFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFileRequestValidationError) ErrorName() string {
+	return "UploadFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFileRequestValidationError{}
+
+var _UploadFileRequest_Name_NotInLookup = map[string]struct{}{
+	".":  {},
+	"..": {},
+}
+
+var _UploadFileRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\]*$")
+
+// Validate checks the field values on LoadFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LoadFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoadFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LoadFileRequestMultiError, or nil if none found.
+func (m *LoadFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoadFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _LoadFileRequest_Name_NotInLookup[m.GetName()]; ok {
+		err := LoadFileRequestValidationError{
+			field:  "Name",
+			reason: "value must not be in list [. ..]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := LoadFileRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_LoadFileRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := LoadFileRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LoadFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoadFileRequestMultiError is an error wrapping multiple validation errors
+// returned by LoadFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LoadFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoadFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoadFileRequestMultiError) AllErrors() []error { return m }
+
+// LoadFileRequestValidationError is the validation error returned by
+// LoadFileRequest.Validate if the designated constraints aren't met.
+type LoadFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoadFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoadFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoadFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoadFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoadFileRequestValidationError) ErrorName() string { return "LoadFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoadFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoadFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoadFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoadFileRequestValidationError{}
+
+var _LoadFileRequest_Name_NotInLookup = map[string]struct{}{
+	".":  {},
+	"..": {},
+}
+
+var _LoadFileRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\]+$")
+
+// Validate checks the field values on LoadFileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LoadFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoadFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LoadFileResponseMultiError, or nil if none found.
+func (m *LoadFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoadFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SizeInBytes
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return LoadFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoadFileResponseMultiError is an error wrapping multiple validation errors
+// returned by LoadFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LoadFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoadFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoadFileResponseMultiError) AllErrors() []error { return m }
+
+// LoadFileResponseValidationError is the validation error returned by
+// LoadFileResponse.Validate if the designated constraints aren't met.
+type LoadFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoadFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoadFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoadFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoadFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoadFileResponseValidationError) ErrorName() string { return "LoadFileResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoadFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoadFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoadFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoadFileResponseValidationError{}
+
+// Validate checks the field values on DeleteFileInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFileInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFileInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFileInfoRequestMultiError, or nil if none found.
+func (m *DeleteFileInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFileInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _DeleteFileInfoRequest_Name_NotInLookup[m.GetName()]; ok {
+		err := DeleteFileInfoRequestValidationError{
+			field:  "Name",
+			reason: "value must not be in list [. ..]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := DeleteFileInfoRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteFileInfoRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := DeleteFileInfoRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteFileInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFileInfoRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFileInfoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFileInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFileInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFileInfoRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFileInfoRequestValidationError is the validation error returned by
+// DeleteFileInfoRequest.Validate if the designated constraints aren't met.
+type DeleteFileInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFileInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFileInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFileInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFileInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFileInfoRequestValidationError) ErrorName() string {
+	return "DeleteFileInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFileInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFileInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFileInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFileInfoRequestValidationError{}
+
+var _DeleteFileInfoRequest_Name_NotInLookup = map[string]struct{}{
+	".":  {},
+	"..": {},
+}
+
+var _DeleteFileInfoRequest_Name_Pattern = regexp.MustCompile("^[^/\\\\]+$")
+
+// Validate checks the field values on TrashEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrashEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrashEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrashEntryMultiError, or
+// nil if none found.
+func (m *TrashEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrashEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for File
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrashEntryValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrashEntryValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrashEntryValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrashEntryValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrashEntryValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrashEntryValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TrashEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrashEntryMultiError is an error wrapping multiple validation errors
+// returned by TrashEntry.ValidateAll() if the designated constraints aren't met.
+type TrashEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrashEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrashEntryMultiError) AllErrors() []error { return m }
+
+// TrashEntryValidationError is the validation error returned by
+// TrashEntry.Validate if the designated constraints aren't met.
+type TrashEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrashEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrashEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrashEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrashEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrashEntryValidationError) ErrorName() string { return "TrashEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrashEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrashEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrashEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrashEntryValidationError{}
+
+// Validate checks the field values on ListTrashResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTrashResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrashResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrashResponseMultiError, or nil if none found.
+func (m *ListTrashResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrashResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrashResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrashResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrashResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTrashResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrashResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTrashResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTrashResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrashResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrashResponseMultiError) AllErrors() []error { return m }
+
+// ListTrashResponseValidationError is the validation error returned by
+// ListTrashResponse.Validate if the designated constraints aren't met.
+type ListTrashResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrashResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrashResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrashResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrashResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrashResponseValidationError) ErrorName() string {
+	return "ListTrashResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrashResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrashResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrashResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrashResponseValidationError{}
+
+// Validate checks the field values on RestoreRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RestoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RestoreRequestMultiError,
+// or nil if none found.
+func (m *RestoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _RestoreRequest_Id_NotInLookup[m.GetId()]; ok {
+		err := RestoreRequestValidationError{
+			field:  "Id",
+			reason: "value must not be in list [. ..]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 300 {
+		err := RestoreRequestValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 300 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RestoreRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := RestoreRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for File
+
+	if len(errors) > 0 {
+		return RestoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRequestMultiError) AllErrors() []error { return m }
+
+// RestoreRequestValidationError is the validation error returned by
+// RestoreRequest.Validate if the designated constraints aren't met.
+type RestoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRequestValidationError) ErrorName() string { return "RestoreRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RestoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRequestValidationError{}
+
+var _RestoreRequest_Id_NotInLookup = map[string]struct{}{
+	".":  {},
+	"..": {},
+}
+
+var _RestoreRequest_Id_Pattern = regexp.MustCompile("^[^/\\\\]+$")
+
+// Validate checks the field values on PurgeTrashRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTrashRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTrashRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTrashRequestMultiError, or nil if none found.
+func (m *PurgeTrashRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTrashRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _PurgeTrashRequest_Id_NotInLookup[m.GetId()]; ok {
+		err := PurgeTrashRequestValidationError{
+			field:  "Id",
+			reason: "value must not be in list [. ..]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) > 300 {
+		err := PurgeTrashRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 300 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PurgeTrashRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PurgeTrashRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[^/\\\\\\\\]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for File
+
+	if len(errors) > 0 {
+		return PurgeTrashRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTrashRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeTrashRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeTrashRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTrashRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTrashRequestMultiError) AllErrors() []error { return m }
+
+// PurgeTrashRequestValidationError is the validation error returned by
+// PurgeTrashRequest.Validate if the designated constraints aren't met.
+type PurgeTrashRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTrashRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTrashRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTrashRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTrashRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTrashRequestValidationError) ErrorName() string {
+	return "PurgeTrashRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeTrashRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTrashRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTrashRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTrashRequestValidationError{}
+
+var _PurgeTrashRequest_Id_NotInLookup = map[string]struct{}{
+	".":  {},
+	"..": {},
+}
+
+var _PurgeTrashRequest_Id_Pattern = regexp.MustCompile("^[^/\\\\]*$")
+
+// Validate checks the field values on SessionInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SessionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SessionInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionInfoMultiError, or
+// nil if none found.
+func (m *SessionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SessionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	// no validation rules for DeviceName
+
+	// no validation rules for ClientVersion
+
+	// no validation rules for PeerIp
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionInfoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionInfoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSeenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionInfoValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionInfoValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionInfoValidationError{
+				field:  "LastSeenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Current
+
+	if len(errors) > 0 {
+		return SessionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionInfoMultiError is an error wrapping multiple validation errors
+// returned by SessionInfo.ValidateAll() if the designated constraints aren't met.
+type SessionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionInfoMultiError) AllErrors() []error { return m }
+
+// SessionInfoValidationError is the validation error returned by
+// SessionInfo.Validate if the designated constraints aren't met.
+type SessionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionInfoValidationError) ErrorName() string { return "SessionInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionInfoValidationError{}
+
+// Validate checks the field values on SessionListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SessionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SessionListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SessionListResponseMultiError, or nil if none found.
+func (m *SessionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SessionListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SessionListResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SessionListResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SessionListResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SessionListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionListResponseMultiError is an error wrapping multiple validation
+// errors returned by SessionListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SessionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionListResponseMultiError) AllErrors() []error { return m }
+
+// SessionListResponseValidationError is the validation error returned by
+// SessionListResponse.Validate if the designated constraints aren't met.
+type SessionListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionListResponseValidationError) ErrorName() string {
+	return "SessionListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SessionListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessionListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionListResponseValidationError{}
+
+// Validate checks the field values on TerminateSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TerminateSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TerminateSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TerminateSessionRequestMultiError, or nil if none found.
+func (m *TerminateSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TerminateSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionId()) < 1 {
+		err := TerminateSessionRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TerminateSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TerminateSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by TerminateSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TerminateSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TerminateSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TerminateSessionRequestMultiError) AllErrors() []error { return m }
+
+// TerminateSessionRequestValidationError is the validation error returned by
+// TerminateSessionRequest.Validate if the designated constraints aren't met.
+type TerminateSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TerminateSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TerminateSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TerminateSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TerminateSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TerminateSessionRequestValidationError) ErrorName() string {
+	return "TerminateSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TerminateSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerminateSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TerminateSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TerminateSessionRequestValidationError{}
@@ -227,6 +227,8 @@ var RegistrationService_ServiceDesc = grpc.ServiceDesc{
 const (
 	AuthService_Login_FullMethodName   = "/proto.AuthService/Login"
 	AuthService_PassOTP_FullMethodName = "/proto.AuthService/PassOTP"
+	AuthService_Refresh_FullMethodName = "/proto.AuthService/Refresh"
+	AuthService_Logout_FullMethodName  = "/proto.AuthService/Logout"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -235,6 +237,8 @@ const (
 type AuthServiceClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	PassOTP(ctx context.Context, in *PassOTPRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type authServiceClient struct {
@@ -265,12 +269,34 @@ func (c *authServiceClient) PassOTP(ctx context.Context, in *PassOTPRequest, opt
 	return out, nil
 }
 
+func (c *authServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, AuthService_Refresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility
 type AuthServiceServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	PassOTP(context.Context, *PassOTPRequest) (*AuthResponse, error)
+	Refresh(context.Context, *RefreshRequest) (*AuthResponse, error)
+	Logout(context.Context, *LogoutRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -284,6 +310,12 @@ func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*Lo
 func (UnimplementedAuthServiceServer) PassOTP(context.Context, *PassOTPRequest) (*AuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PassOTP not implemented")
 }
+func (UnimplementedAuthServiceServer) Refresh(context.Context, *RefreshRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -333,6 +365,42 @@ func _AuthService_PassOTP_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -348,6 +416,14 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PassOTP",
 			Handler:    _AuthService_PassOTP_Handler,
 		},
+		{
+			MethodName: "Refresh",
+			Handler:    _AuthService_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gophkeeper.proto",
@@ -446,6 +522,21 @@ var Pinger_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	DataAccessor_Hello_FullMethodName                   = "/proto.DataAccessor/Hello"
+	DataAccessor_ListItems_FullMethodName               = "/proto.DataAccessor/ListItems"
+	DataAccessor_GetItem_FullMethodName                 = "/proto.DataAccessor/GetItem"
+	DataAccessor_CreateItem_FullMethodName              = "/proto.DataAccessor/CreateItem"
+	DataAccessor_UpdateItem_FullMethodName              = "/proto.DataAccessor/UpdateItem"
+	DataAccessor_DeleteItem_FullMethodName              = "/proto.DataAccessor/DeleteItem"
+	DataAccessor_GetChangesSince_FullMethodName         = "/proto.DataAccessor/GetChangesSince"
+	DataAccessor_Watch_FullMethodName                   = "/proto.DataAccessor/Watch"
+	DataAccessor_ListRevisions_FullMethodName           = "/proto.DataAccessor/ListRevisions"
+	DataAccessor_GetRevision_FullMethodName             = "/proto.DataAccessor/GetRevision"
+	DataAccessor_RestoreRevision_FullMethodName         = "/proto.DataAccessor/RestoreRevision"
+	DataAccessor_ListSecureNotes_FullMethodName         = "/proto.DataAccessor/ListSecureNotes"
+	DataAccessor_GetSecureNote_FullMethodName           = "/proto.DataAccessor/GetSecureNote"
+	DataAccessor_CreateSecureNote_FullMethodName        = "/proto.DataAccessor/CreateSecureNote"
+	DataAccessor_UpdateSecureNote_FullMethodName        = "/proto.DataAccessor/UpdateSecureNote"
+	DataAccessor_DeleteSecureNote_FullMethodName        = "/proto.DataAccessor/DeleteSecureNote"
 	DataAccessor_GetBankCardList_FullMethodName         = "/proto.DataAccessor/GetBankCardList"
 	DataAccessor_CreateBankCard_FullMethodName          = "/proto.DataAccessor/CreateBankCard"
 	DataAccessor_DeleteBankCard_FullMethodName          = "/proto.DataAccessor/DeleteBankCard"
@@ -461,13 +552,39 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DataAccessorClient interface {
 	Hello(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HelloResponse, error)
+	ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (*ListItemsResponse, error)
+	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*SecretItem, error)
+	CreateItem(ctx context.Context, in *CreateItemRequest, opts ...grpc.CallOption) (*CreateItemResponse, error)
+	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
+	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (DataAccessor_WatchClient, error)
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*SecretItem, error)
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
+	ListSecureNotes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListSecureNotesResponse, error)
+	GetSecureNote(ctx context.Context, in *GetSecureNoteRequest, opts ...grpc.CallOption) (*SecureNote, error)
+	CreateSecureNote(ctx context.Context, in *CreateSecureNoteRequest, opts ...grpc.CallOption) (*CreateItemResponse, error)
+	UpdateSecureNote(ctx context.Context, in *UpdateSecureNoteRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
+	DeleteSecureNote(ctx context.Context, in *DeleteSecureNoteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Deprecated: Do not use.
+	// bank card and login/password operations of the old clients, kept for the deprecation period;
+	// the lists are served from the items without the card number and the hint. The changes send them
+	// in clear text, the server can't compute their blind indexes, so the changes fail with FAILED_PRECONDITION
 	GetBankCardList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BankCardListResponse, error)
+	// Deprecated: Do not use.
 	CreateBankCard(ctx context.Context, in *CreateBankCardRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	DeleteBankCard(ctx context.Context, in *DeleteBankCardRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	UpdateBankCard(ctx context.Context, in *UpdateBankCardRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	GetUserPasswordDataList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UserPasswordDataResponse, error)
+	// Deprecated: Do not use.
 	CreateUserPasswordData(ctx context.Context, in *CreateUserPasswordDataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	DeleteUserPasswordData(ctx context.Context, in *DeleteUserPasswordDataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	UpdateUserPasswordData(ctx context.Context, in *UpdateUserPasswordDataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
@@ -489,6 +606,180 @@ func (c *dataAccessorClient) Hello(ctx context.Context, in *empty.Empty, opts ..
 	return out, nil
 }
 
+func (c *dataAccessorClient) ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (*ListItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItemsResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_ListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*SecretItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecretItem)
+	err := c.cc.Invoke(ctx, DataAccessor_GetItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) CreateItem(ctx context.Context, in *CreateItemRequest, opts ...grpc.CallOption) (*CreateItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateItemResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_CreateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateItemResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_UpdateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, DataAccessor_DeleteItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChangesSinceResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_GetChangesSince_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (DataAccessor_WatchClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataAccessor_ServiceDesc.Streams[0], DataAccessor_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dataAccessorWatchClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DataAccessor_WatchClient interface {
+	Recv() (*ItemChangeEvent, error)
+	grpc.ClientStream
+}
+
+type dataAccessorWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataAccessorWatchClient) Recv() (*ItemChangeEvent, error) {
+	m := new(ItemChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dataAccessorClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_ListRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*SecretItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecretItem)
+	err := c.cc.Invoke(ctx, DataAccessor_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateItemResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_RestoreRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) ListSecureNotes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListSecureNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecureNotesResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_ListSecureNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) GetSecureNote(ctx context.Context, in *GetSecureNoteRequest, opts ...grpc.CallOption) (*SecureNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecureNote)
+	err := c.cc.Invoke(ctx, DataAccessor_GetSecureNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) CreateSecureNote(ctx context.Context, in *CreateSecureNoteRequest, opts ...grpc.CallOption) (*CreateItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateItemResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_CreateSecureNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) UpdateSecureNote(ctx context.Context, in *UpdateSecureNoteRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateItemResponse)
+	err := c.cc.Invoke(ctx, DataAccessor_UpdateSecureNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAccessorClient) DeleteSecureNote(ctx context.Context, in *DeleteSecureNoteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, DataAccessor_DeleteSecureNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
 func (c *dataAccessorClient) GetBankCardList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BankCardListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BankCardListResponse)
@@ -499,6 +790,7 @@ func (c *dataAccessorClient) GetBankCardList(ctx context.Context, in *empty.Empt
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *dataAccessorClient) CreateBankCard(ctx context.Context, in *CreateBankCardRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(empty.Empty)
@@ -509,6 +801,7 @@ func (c *dataAccessorClient) CreateBankCard(ctx context.Context, in *CreateBankC
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *dataAccessorClient) DeleteBankCard(ctx context.Context, in *DeleteBankCardRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(empty.Empty)
@@ -519,6 +812,7 @@ func (c *dataAccessorClient) DeleteBankCard(ctx context.Context, in *DeleteBankC
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *dataAccessorClient) UpdateBankCard(ctx context.Context, in *UpdateBankCardRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(empty.Empty)
@@ -529,6 +823,7 @@ func (c *dataAccessorClient) UpdateBankCard(ctx context.Context, in *UpdateBankC
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *dataAccessorClient) GetUserPasswordDataList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UserPasswordDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserPasswordDataResponse)
@@ -539,6 +834,7 @@ func (c *dataAccessorClient) GetUserPasswordDataList(ctx context.Context, in *em
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *dataAccessorClient) CreateUserPasswordData(ctx context.Context, in *CreateUserPasswordDataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(empty.Empty)
@@ -549,6 +845,7 @@ func (c *dataAccessorClient) CreateUserPasswordData(ctx context.Context, in *Cre
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *dataAccessorClient) DeleteUserPasswordData(ctx context.Context, in *DeleteUserPasswordDataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(empty.Empty)
@@ -559,6 +856,7 @@ func (c *dataAccessorClient) DeleteUserPasswordData(ctx context.Context, in *Del
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *dataAccessorClient) UpdateUserPasswordData(ctx context.Context, in *UpdateUserPasswordDataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(empty.Empty)
@@ -574,13 +872,39 @@ func (c *dataAccessorClient) UpdateUserPasswordData(ctx context.Context, in *Upd
 // for forward compatibility
 type DataAccessorServer interface {
 	Hello(context.Context, *empty.Empty) (*HelloResponse, error)
+	ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error)
+	GetItem(context.Context, *GetItemRequest) (*SecretItem, error)
+	CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error)
+	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
+	DeleteItem(context.Context, *DeleteItemRequest) (*empty.Empty, error)
+	GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error)
+	Watch(*WatchRequest, DataAccessor_WatchServer) error
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*SecretItem, error)
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*UpdateItemResponse, error)
+	ListSecureNotes(context.Context, *empty.Empty) (*ListSecureNotesResponse, error)
+	GetSecureNote(context.Context, *GetSecureNoteRequest) (*SecureNote, error)
+	CreateSecureNote(context.Context, *CreateSecureNoteRequest) (*CreateItemResponse, error)
+	UpdateSecureNote(context.Context, *UpdateSecureNoteRequest) (*UpdateItemResponse, error)
+	DeleteSecureNote(context.Context, *DeleteSecureNoteRequest) (*empty.Empty, error)
+	// Deprecated: Do not use.
+	// bank card and login/password operations of the old clients, kept for the deprecation period;
+	// the lists are served from the items without the card number and the hint. The changes send them
+	// in clear text, the server can't compute their blind indexes, so the changes fail with FAILED_PRECONDITION
 	GetBankCardList(context.Context, *empty.Empty) (*BankCardListResponse, error)
+	// Deprecated: Do not use.
 	CreateBankCard(context.Context, *CreateBankCardRequest) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	DeleteBankCard(context.Context, *DeleteBankCardRequest) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	UpdateBankCard(context.Context, *UpdateBankCardRequest) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	GetUserPasswordDataList(context.Context, *empty.Empty) (*UserPasswordDataResponse, error)
+	// Deprecated: Do not use.
 	CreateUserPasswordData(context.Context, *CreateUserPasswordDataRequest) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	DeleteUserPasswordData(context.Context, *DeleteUserPasswordDataRequest) (*empty.Empty, error)
+	// Deprecated: Do not use.
 	UpdateUserPasswordData(context.Context, *UpdateUserPasswordDataRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedDataAccessorServer()
 }
@@ -592,6 +916,51 @@ type UnimplementedDataAccessorServer struct {
 func (UnimplementedDataAccessorServer) Hello(context.Context, *empty.Empty) (*HelloResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Hello not implemented")
 }
+func (UnimplementedDataAccessorServer) ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedDataAccessorServer) GetItem(context.Context, *GetItemRequest) (*SecretItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedDataAccessorServer) CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
+}
+func (UnimplementedDataAccessorServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedDataAccessorServer) DeleteItem(context.Context, *DeleteItemRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+}
+func (UnimplementedDataAccessorServer) GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangesSince not implemented")
+}
+func (UnimplementedDataAccessorServer) Watch(*WatchRequest, DataAccessor_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedDataAccessorServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedDataAccessorServer) GetRevision(context.Context, *GetRevisionRequest) (*SecretItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedDataAccessorServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedDataAccessorServer) ListSecureNotes(context.Context, *empty.Empty) (*ListSecureNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecureNotes not implemented")
+}
+func (UnimplementedDataAccessorServer) GetSecureNote(context.Context, *GetSecureNoteRequest) (*SecureNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecureNote not implemented")
+}
+func (UnimplementedDataAccessorServer) CreateSecureNote(context.Context, *CreateSecureNoteRequest) (*CreateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecureNote not implemented")
+}
+func (UnimplementedDataAccessorServer) UpdateSecureNote(context.Context, *UpdateSecureNoteRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecureNote not implemented")
+}
+func (UnimplementedDataAccessorServer) DeleteSecureNote(context.Context, *DeleteSecureNoteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecureNote not implemented")
+}
 func (UnimplementedDataAccessorServer) GetBankCardList(context.Context, *empty.Empty) (*BankCardListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBankCardList not implemented")
 }
@@ -647,164 +1016,493 @@ func _DataAccessor_Hello_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataAccessor_GetBankCardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _DataAccessor_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataAccessorServer).GetBankCardList(ctx, in)
+		return srv.(DataAccessorServer).ListItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataAccessor_GetBankCardList_FullMethodName,
+		FullMethod: DataAccessor_ListItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataAccessorServer).GetBankCardList(ctx, req.(*empty.Empty))
+		return srv.(DataAccessorServer).ListItems(ctx, req.(*ListItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataAccessor_CreateBankCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBankCardRequest)
+func _DataAccessor_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataAccessorServer).CreateBankCard(ctx, in)
+		return srv.(DataAccessorServer).GetItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataAccessor_CreateBankCard_FullMethodName,
+		FullMethod: DataAccessor_GetItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataAccessorServer).CreateBankCard(ctx, req.(*CreateBankCardRequest))
+		return srv.(DataAccessorServer).GetItem(ctx, req.(*GetItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataAccessor_DeleteBankCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBankCardRequest)
+func _DataAccessor_CreateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataAccessorServer).DeleteBankCard(ctx, in)
+		return srv.(DataAccessorServer).CreateItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataAccessor_DeleteBankCard_FullMethodName,
+		FullMethod: DataAccessor_CreateItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataAccessorServer).DeleteBankCard(ctx, req.(*DeleteBankCardRequest))
+		return srv.(DataAccessorServer).CreateItem(ctx, req.(*CreateItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataAccessor_UpdateBankCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBankCardRequest)
+func _DataAccessor_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataAccessorServer).UpdateBankCard(ctx, in)
+		return srv.(DataAccessorServer).UpdateItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataAccessor_UpdateBankCard_FullMethodName,
+		FullMethod: DataAccessor_UpdateItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataAccessorServer).UpdateBankCard(ctx, req.(*UpdateBankCardRequest))
+		return srv.(DataAccessorServer).UpdateItem(ctx, req.(*UpdateItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataAccessor_GetUserPasswordDataList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _DataAccessor_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataAccessorServer).GetUserPasswordDataList(ctx, in)
+		return srv.(DataAccessorServer).DeleteItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataAccessor_GetUserPasswordDataList_FullMethodName,
+		FullMethod: DataAccessor_DeleteItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataAccessorServer).GetUserPasswordDataList(ctx, req.(*empty.Empty))
+		return srv.(DataAccessorServer).DeleteItem(ctx, req.(*DeleteItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataAccessor_CreateUserPasswordData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserPasswordDataRequest)
+func _DataAccessor_GetChangesSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangesSinceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataAccessorServer).CreateUserPasswordData(ctx, in)
+		return srv.(DataAccessorServer).GetChangesSince(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataAccessor_CreateUserPasswordData_FullMethodName,
+		FullMethod: DataAccessor_GetChangesSince_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataAccessorServer).CreateUserPasswordData(ctx, req.(*CreateUserPasswordDataRequest))
+		return srv.(DataAccessorServer).GetChangesSince(ctx, req.(*GetChangesSinceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataAccessor_DeleteUserPasswordData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserPasswordDataRequest)
+func _DataAccessor_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataAccessorServer).Watch(m, &dataAccessorWatchServer{ServerStream: stream})
+}
+
+type DataAccessor_WatchServer interface {
+	Send(*ItemChangeEvent) error
+	grpc.ServerStream
+}
+
+type dataAccessorWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataAccessorWatchServer) Send(m *ItemChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DataAccessor_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataAccessorServer).DeleteUserPasswordData(ctx, in)
+		return srv.(DataAccessorServer).ListRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataAccessor_DeleteUserPasswordData_FullMethodName,
+		FullMethod: DataAccessor_ListRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataAccessorServer).DeleteUserPasswordData(ctx, req.(*DeleteUserPasswordDataRequest))
+		return srv.(DataAccessorServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataAccessor_UpdateUserPasswordData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserPasswordDataRequest)
+func _DataAccessor_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataAccessorServer).UpdateUserPasswordData(ctx, in)
+		return srv.(DataAccessorServer).GetRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataAccessor_UpdateUserPasswordData_FullMethodName,
+		FullMethod: DataAccessor_GetRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataAccessorServer).UpdateUserPasswordData(ctx, req.(*UpdateUserPasswordDataRequest))
+		return srv.(DataAccessorServer).GetRevision(ctx, req.(*GetRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// DataAccessor_ServiceDesc is the grpc.ServiceDesc for DataAccessor service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var DataAccessor_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.DataAccessor",
-	HandlerType: (*DataAccessorServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Hello",
-			Handler:    _DataAccessor_Hello_Handler,
-		},
-		{
-			MethodName: "GetBankCardList",
-			Handler:    _DataAccessor_GetBankCardList_Handler,
+func _DataAccessor_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_RestoreRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_ListSecureNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).ListSecureNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_ListSecureNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).ListSecureNotes(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_GetSecureNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecureNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).GetSecureNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_GetSecureNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).GetSecureNote(ctx, req.(*GetSecureNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_CreateSecureNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecureNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).CreateSecureNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_CreateSecureNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).CreateSecureNote(ctx, req.(*CreateSecureNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_UpdateSecureNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecureNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).UpdateSecureNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_UpdateSecureNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).UpdateSecureNote(ctx, req.(*UpdateSecureNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_DeleteSecureNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecureNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).DeleteSecureNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_DeleteSecureNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).DeleteSecureNote(ctx, req.(*DeleteSecureNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_GetBankCardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).GetBankCardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_GetBankCardList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).GetBankCardList(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_CreateBankCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBankCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).CreateBankCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_CreateBankCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).CreateBankCard(ctx, req.(*CreateBankCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_DeleteBankCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).DeleteBankCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_DeleteBankCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).DeleteBankCard(ctx, req.(*DeleteBankCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_UpdateBankCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).UpdateBankCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_UpdateBankCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).UpdateBankCard(ctx, req.(*UpdateBankCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_GetUserPasswordDataList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).GetUserPasswordDataList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_GetUserPasswordDataList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).GetUserPasswordDataList(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_CreateUserPasswordData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserPasswordDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).CreateUserPasswordData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_CreateUserPasswordData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).CreateUserPasswordData(ctx, req.(*CreateUserPasswordDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_DeleteUserPasswordData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserPasswordDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).DeleteUserPasswordData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_DeleteUserPasswordData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).DeleteUserPasswordData(ctx, req.(*DeleteUserPasswordDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAccessor_UpdateUserPasswordData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPasswordDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAccessorServer).UpdateUserPasswordData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataAccessor_UpdateUserPasswordData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAccessorServer).UpdateUserPasswordData(ctx, req.(*UpdateUserPasswordDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataAccessor_ServiceDesc is the grpc.ServiceDesc for DataAccessor service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataAccessor_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.DataAccessor",
+	HandlerType: (*DataAccessorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Hello",
+			Handler:    _DataAccessor_Hello_Handler,
+		},
+		{
+			MethodName: "ListItems",
+			Handler:    _DataAccessor_ListItems_Handler,
+		},
+		{
+			MethodName: "GetItem",
+			Handler:    _DataAccessor_GetItem_Handler,
+		},
+		{
+			MethodName: "CreateItem",
+			Handler:    _DataAccessor_CreateItem_Handler,
+		},
+		{
+			MethodName: "UpdateItem",
+			Handler:    _DataAccessor_UpdateItem_Handler,
+		},
+		{
+			MethodName: "DeleteItem",
+			Handler:    _DataAccessor_DeleteItem_Handler,
+		},
+		{
+			MethodName: "GetChangesSince",
+			Handler:    _DataAccessor_GetChangesSince_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _DataAccessor_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _DataAccessor_GetRevision_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _DataAccessor_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "ListSecureNotes",
+			Handler:    _DataAccessor_ListSecureNotes_Handler,
+		},
+		{
+			MethodName: "GetSecureNote",
+			Handler:    _DataAccessor_GetSecureNote_Handler,
+		},
+		{
+			MethodName: "CreateSecureNote",
+			Handler:    _DataAccessor_CreateSecureNote_Handler,
+		},
+		{
+			MethodName: "UpdateSecureNote",
+			Handler:    _DataAccessor_UpdateSecureNote_Handler,
+		},
+		{
+			MethodName: "DeleteSecureNote",
+			Handler:    _DataAccessor_DeleteSecureNote_Handler,
+		},
+		{
+			MethodName: "GetBankCardList",
+			Handler:    _DataAccessor_GetBankCardList_Handler,
 		},
 		{
 			MethodName: "CreateBankCard",
@@ -835,7 +1533,13 @@ var DataAccessor_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _DataAccessor_UpdateUserPasswordData_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _DataAccessor_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "gophkeeper.proto",
 }
 
@@ -1105,3 +1809,299 @@ var FileAccessor_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "gophkeeper.proto",
 }
+
+const (
+	TrashService_ListTrash_FullMethodName  = "/proto.TrashService/ListTrash"
+	TrashService_Restore_FullMethodName    = "/proto.TrashService/Restore"
+	TrashService_PurgeTrash_FullMethodName = "/proto.TrashService/PurgeTrash"
+)
+
+// TrashServiceClient is the client API for TrashService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TrashServiceClient interface {
+	ListTrash(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type trashServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrashServiceClient(cc grpc.ClientConnInterface) TrashServiceClient {
+	return &trashServiceClient{cc}
+}
+
+func (c *trashServiceClient) ListTrash(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, TrashService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, TrashService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, TrashService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrashServiceServer is the server API for TrashService service.
+// All implementations must embed UnimplementedTrashServiceServer
+// for forward compatibility
+type TrashServiceServer interface {
+	ListTrash(context.Context, *empty.Empty) (*ListTrashResponse, error)
+	Restore(context.Context, *RestoreRequest) (*empty.Empty, error)
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*empty.Empty, error)
+	mustEmbedUnimplementedTrashServiceServer()
+}
+
+// UnimplementedTrashServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTrashServiceServer struct {
+}
+
+func (UnimplementedTrashServiceServer) ListTrash(context.Context, *empty.Empty) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedTrashServiceServer) Restore(context.Context, *RestoreRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedTrashServiceServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedTrashServiceServer) mustEmbedUnimplementedTrashServiceServer() {}
+
+// UnsafeTrashServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrashServiceServer will
+// result in compilation errors.
+type UnsafeTrashServiceServer interface {
+	mustEmbedUnimplementedTrashServiceServer()
+}
+
+func RegisterTrashServiceServer(s grpc.ServiceRegistrar, srv TrashServiceServer) {
+	s.RegisterService(&TrashService_ServiceDesc, srv)
+}
+
+func _TrashService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrashService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServiceServer).ListTrash(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrashService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrashService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServiceServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrashService_ServiceDesc is the grpc.ServiceDesc for TrashService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrashService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.TrashService",
+	HandlerType: (*TrashServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrash",
+			Handler:    _TrashService_ListTrash_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _TrashService_Restore_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _TrashService_PurgeTrash_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gophkeeper.proto",
+}
+
+const (
+	SessionService_GetSessionList_FullMethodName   = "/proto.SessionService/GetSessionList"
+	SessionService_TerminateSession_FullMethodName = "/proto.SessionService/TerminateSession"
+)
+
+// SessionServiceClient is the client API for SessionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SessionServiceClient interface {
+	GetSessionList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SessionListResponse, error)
+	TerminateSession(ctx context.Context, in *TerminateSessionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type sessionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSessionServiceClient(cc grpc.ClientConnInterface) SessionServiceClient {
+	return &sessionServiceClient{cc}
+}
+
+func (c *sessionServiceClient) GetSessionList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SessionListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionListResponse)
+	err := c.cc.Invoke(ctx, SessionService_GetSessionList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) TerminateSession(ctx context.Context, in *TerminateSessionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, SessionService_TerminateSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionServiceServer is the server API for SessionService service.
+// All implementations must embed UnimplementedSessionServiceServer
+// for forward compatibility
+type SessionServiceServer interface {
+	GetSessionList(context.Context, *empty.Empty) (*SessionListResponse, error)
+	TerminateSession(context.Context, *TerminateSessionRequest) (*empty.Empty, error)
+	mustEmbedUnimplementedSessionServiceServer()
+}
+
+// UnimplementedSessionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSessionServiceServer struct {
+}
+
+func (UnimplementedSessionServiceServer) GetSessionList(context.Context, *empty.Empty) (*SessionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionList not implemented")
+}
+func (UnimplementedSessionServiceServer) TerminateSession(context.Context, *TerminateSessionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateSession not implemented")
+}
+func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
+
+// UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionServiceServer will
+// result in compilation errors.
+type UnsafeSessionServiceServer interface {
+	mustEmbedUnimplementedSessionServiceServer()
+}
+
+func RegisterSessionServiceServer(s grpc.ServiceRegistrar, srv SessionServiceServer) {
+	s.RegisterService(&SessionService_ServiceDesc, srv)
+}
+
+func _SessionService_GetSessionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetSessionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_GetSessionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetSessionList(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_TerminateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).TerminateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_TerminateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).TerminateSession(ctx, req.(*TerminateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SessionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.SessionService",
+	HandlerType: (*SessionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSessionList",
+			Handler:    _SessionService_GetSessionList_Handler,
+		},
+		{
+			MethodName: "TerminateSession",
+			Handler:    _SessionService_TerminateSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gophkeeper.proto",
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
@@ -51,14 +54,162 @@ func (fs *fileStorage) GetFileInfoList(ctx context.Context, bucket string) ([]do
 	return result, nil
 }
 
-func (fs *fileStorage) DeleteFileInfo(ctx context.Context, bucket string, name string) error {
+// MoveToTrash moves the file to the trash directory of the bucket; the trash id keeps the deletion time.
+func (fs *fileStorage) MoveToTrash(ctx context.Context, bucket string, name string) error {
 	filePath := filepath.Join(fs.path, bucket, name)
-	if err := os.Remove(filePath); err != nil {
+	if _, err := os.Stat(filePath); err != nil {
 		return fmt.Errorf("%w delete err %s", domain.ErrClientDataIncorrect, err.Error())
 	}
+
+	trashPath := filepath.Join(fs.path, trashDir, bucket)
+	if err := os.MkdirAll(trashPath, os.ModePerm); err != nil {
+		return fmt.Errorf("%w delete err %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := os.Rename(filePath, filepath.Join(trashPath, trashID(name, time.Now()))); err != nil {
+		return fmt.Errorf("%w delete err %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+// ListTrash returns files of the bucket moved to the trash, the last deleted first.
+func (fs *fileStorage) ListTrash(ctx context.Context, bucket string) ([]domain.TrashEntry, error) {
+	entries, err := os.ReadDir(filepath.Join(fs.path, trashDir, bucket))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var result []domain.TrashEntry
+	for _, e := range entries {
+		name, deletedAt, ok := parseTrashID(e.Name())
+		if !ok {
+			continue
+		}
+		result = append(result, domain.TrashEntry{
+			ID:        e.Name(),
+			Type:      domain.TrashFile,
+			Name:      name,
+			DeletedAt: deletedAt,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].DeletedAt.After(result[j].DeletedAt)
+	})
+	return result, nil
+}
+
+// RestoreFromTrash moves the file back to the bucket; domain.ErrClientDataIncorrect is returned
+// if the bucket already contains the file with the same name.
+func (fs *fileStorage) RestoreFromTrash(ctx context.Context, bucket string, id string) error {
+	name, _, ok := parseTrashID(id)
+	if !ok {
+		return fmt.Errorf("%w - wrong trash id %s", domain.ErrDataNotExists, id)
+	}
+
+	trashPath := filepath.Join(fs.path, trashDir, bucket, id)
+	if _, err := os.Stat(trashPath); err != nil {
+		return fmt.Errorf("%w - file %s is not in the trash", domain.ErrDataNotExists, name)
+	}
+
+	dirPath := filepath.Join(fs.path, bucket)
+	filePath := filepath.Join(dirPath, name)
+	if _, err := os.Stat(filePath); err == nil {
+		return fmt.Errorf("%w - file %s already exists", domain.ErrClientDataIncorrect, name)
+	}
+
+	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
+		return fmt.Errorf("%w restore err %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := os.Rename(trashPath, filePath); err != nil {
+		return fmt.Errorf("%w restore err %s", domain.ErrServerInternal, err.Error())
+	}
 	return nil
 }
 
+// DeleteFromTrash deletes the file from the trash of the bucket permanently; the whole trash of the bucket
+// is cleared for the empty id.
+func (fs *fileStorage) DeleteFromTrash(ctx context.Context, bucket string, id string) error {
+	if id == "" {
+		if err := os.RemoveAll(filepath.Join(fs.path, trashDir, bucket)); err != nil {
+			return fmt.Errorf("%w purge err %s", domain.ErrServerInternal, err.Error())
+		}
+		return nil
+	}
+
+	if _, _, ok := parseTrashID(id); !ok {
+		return fmt.Errorf("%w - wrong trash id %s", domain.ErrDataNotExists, id)
+	}
+
+	if err := os.Remove(filepath.Join(fs.path, trashDir, bucket, id)); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%w - %s is not in the trash", domain.ErrDataNotExists, id)
+		}
+		return fmt.Errorf("%w purge err %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+// DeleteExpiredTrash deletes files of all buckets moved to the trash before the time;
+// returns the number of deleted files.
+func (fs *fileStorage) DeleteExpiredTrash(ctx context.Context, before time.Time) (int, error) {
+	trashPath := filepath.Join(fs.path, trashDir)
+	buckets, err := os.ReadDir(trashPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var deleted int
+	for _, bucket := range buckets {
+		if !bucket.IsDir() {
+			continue
+		}
+		entries, err := os.ReadDir(filepath.Join(trashPath, bucket.Name()))
+		if err != nil {
+			return deleted, err
+		}
+		for _, e := range entries {
+			if _, deletedAt, ok := parseTrashID(e.Name()); ok && deletedAt.Before(before) {
+				if err := os.Remove(filepath.Join(trashPath, bucket.Name(), e.Name())); err != nil {
+					return deleted, err
+				}
+				deleted++
+			}
+		}
+	}
+	return deleted, nil
+}
+
+// trashDir is the directory of the trash buckets; bucket names are uuids, so it doesn't clash with them.
+const trashDir = ".trash"
+
+// trashID is the name of the file in the trash: the deletion time in nanoseconds and the file name.
+func trashID(name string, deletedAt time.Time) string {
+	return strconv.FormatInt(deletedAt.UnixNano(), 10) + "_" + name
+}
+
+func parseTrashID(id string) (string, time.Time, bool) {
+	if filepath.Base(id) != id {
+		return "", time.Time{}, false
+	}
+	tm, name, ok := strings.Cut(id, "_")
+	if !ok || name == "" || name == "." || name == ".." {
+		return "", time.Time{}, false
+	}
+	nano, err := strconv.ParseInt(tm, 10, 64)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return name, time.Unix(0, nano), true
+}
+
 func (fs *fileStorage) CreateStreamFileWriter(ctx context.Context, bucket string) (domain.StreamFileWriter, error) {
 	dirPath := filepath.Join(fs.path, bucket)
 	return domain.NewStreamFileWriter(dirPath)
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
@@ -66,13 +67,110 @@ func TestFileStorage(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 1, len(lst))
 
-		err = fs.DeleteFileInfo(ctx, bucket, fileName)
+		err = fs.MoveToTrash(ctx, bucket, fileName)
 		require.NoError(t, err)
 
-		err = fs.DeleteFileInfo(ctx, bucket+"?", fileName)
+		err = fs.MoveToTrash(ctx, bucket+"?", fileName)
 		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 	})
 
+	t.Run("test_trash", func(t *testing.T) {
+		tempDir := os.TempDir()
+
+		storagePath := filepath.Join(tempDir, "temp-storage")
+		defer func() {
+			err := os.RemoveAll(storagePath)
+			require.NoError(t, err)
+		}()
+
+		fs := fs.NewFileStorage(&config.ServerConf{
+			FStoragePath: storagePath,
+		})
+
+		ctx := context.Background()
+		bucket := uuid.New().String()
+
+		bucketPath := filepath.Join(storagePath, bucket)
+		err := os.MkdirAll(bucketPath, os.ModePerm)
+		require.NoError(t, err)
+
+		for _, name := range []string{"file1", "file_2"} {
+			err = os.WriteFile(filepath.Join(bucketPath, name), []byte(name), 0600)
+			require.NoError(t, err)
+		}
+
+		trash, err := fs.ListTrash(ctx, bucket)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(trash))
+
+		err = fs.MoveToTrash(ctx, bucket, "file1")
+		require.NoError(t, err)
+
+		err = fs.MoveToTrash(ctx, bucket, "file_2")
+		require.NoError(t, err)
+
+		lst, err := fs.GetFileInfoList(ctx, bucket)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(lst))
+
+		trash, err = fs.ListTrash(ctx, bucket)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(trash))
+		require.Equal(t, "file_2", trash[0].Name)
+		require.Equal(t, domain.TrashFile, trash[0].Type)
+		require.Equal(t, "file1", trash[1].Name)
+
+		// the file with the same name is uploaded again
+		err = os.WriteFile(filepath.Join(bucketPath, "file1"), []byte("new"), 0600)
+		require.NoError(t, err)
+
+		err = fs.RestoreFromTrash(ctx, bucket, trash[1].ID)
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+
+		err = os.Remove(filepath.Join(bucketPath, "file1"))
+		require.NoError(t, err)
+
+		err = fs.RestoreFromTrash(ctx, bucket, trash[1].ID)
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(bucketPath, "file1"))
+		require.NoError(t, err)
+		require.Equal(t, "file1", string(data))
+
+		err = fs.RestoreFromTrash(ctx, bucket, trash[1].ID)
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+		err = fs.RestoreFromTrash(ctx, bucket, "../"+trash[0].ID)
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+		cnt, err := fs.DeleteExpiredTrash(ctx, trash[0].DeletedAt)
+		require.NoError(t, err)
+		require.Equal(t, 0, cnt)
+
+		cnt, err = fs.DeleteExpiredTrash(ctx, time.Now().Add(time.Second))
+		require.NoError(t, err)
+		require.Equal(t, 1, cnt)
+
+		err = fs.DeleteFromTrash(ctx, bucket, trash[0].ID)
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+		err = fs.MoveToTrash(ctx, bucket, "file1")
+		require.NoError(t, err)
+
+		trash, err = fs.ListTrash(ctx, bucket)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(trash))
+
+		err = fs.DeleteFromTrash(ctx, bucket, trash[0].ID)
+		require.NoError(t, err)
+
+		err = fs.MoveToTrash(ctx, bucket, "file1")
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+
+		err = fs.DeleteFromTrash(ctx, bucket, "")
+		require.NoError(t, err)
+	})
+
 	t.Run("test_steram_reader", func(t *testing.T) {
 
 		tempDir := os.TempDir()
@@ -2,10 +2,16 @@ package handler_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	"os"
 	"path/filepath"
@@ -18,8 +24,10 @@ import (
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/handler"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 const TestDataDirectory = "../../../../../testdata/"
@@ -51,6 +59,12 @@ func TestPingNoTls(t *testing.T) {
 	resp, err := pinger.Ping(ctx, nil)
 	require.NoError(t, err)
 	require.NotNil(t, resp)
+
+	// the streams are authenticated without TLS too
+	stream, err := proto.NewDataAccessorClient(client).Watch(ctx, &proto.WatchRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
 	srv.Stop()
 }
 
@@ -92,6 +106,138 @@ func TestPingWithTls(t *testing.T) {
 	srv.Stop()
 }
 
+func TestPingWithMTLS(t *testing.T) {
+	port, err := getFreePort()
+	require.NoError(t, err)
+
+	pki := newTestPKI(t)
+
+	srvConf := &config.ServerConf{
+		Port:          fmt.Sprintf(":%d", port),
+		TLSKey:        pki.serverKey,
+		TLSCert:       pki.serverCert,
+		TLSClientAuth: "require",
+		TLSClientCA:   pki.caCert,
+	}
+
+	srv := handler.NewGRPCHandler(srvConf)
+
+	ctx, stopFn := context.WithCancel(context.Background())
+	defer stopFn()
+
+	srv.Start(ctx)
+	defer srv.Stop()
+
+	time.Sleep(2 * time.Second)
+
+	t.Run("with_client_cert", func(t *testing.T) {
+		clientCert, err := tls.LoadX509KeyPair(pki.clientCert, pki.clientKey)
+		require.NoError(t, err)
+
+		cred := credentials.NewTLS(&tls.Config{
+			RootCAs:      pki.caPool,
+			Certificates: []tls.Certificate{clientCert},
+		})
+
+		client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port), grpc.WithTransportCredentials(cred))
+		require.NoError(t, err)
+		defer client.Close()
+
+		resp, err := proto.NewPingerClient(client).Ping(ctx, nil)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+	})
+
+	t.Run("without_client_cert", func(t *testing.T) {
+		cred := credentials.NewTLS(&tls.Config{
+			RootCAs: pki.caPool,
+		})
+
+		client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port), grpc.WithTransportCredentials(cred))
+		require.NoError(t, err)
+		defer client.Close()
+
+		_, err = proto.NewPingerClient(client).Ping(ctx, nil)
+		require.Error(t, err)
+	})
+}
+
+type testPKI struct {
+	caPool     *x509.CertPool
+	caCert     string
+	serverCert string
+	serverKey  string
+	clientCert string
+	clientKey  string
+}
+
+// newTestPKI generates CA, server and client certificates in temporary directory.
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	pki := &testPKI{
+		caPool: x509.NewCertPool(),
+		caCert: filepath.Join(dir, "ca-cert.pem"),
+	}
+	pki.caPool.AddCert(caCert)
+	writeTestPEM(t, pki.caCert, "CERTIFICATE", caDER)
+
+	issue := func(serial int64, name string, extKeyUsage x509.ExtKeyUsage) (string, string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+
+		keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+
+		certFile := filepath.Join(dir, name+"-cert.pem")
+		keyFile := filepath.Join(dir, name+"-key.pem")
+		writeTestPEM(t, certFile, "CERTIFICATE", der)
+		writeTestPEM(t, keyFile, "PRIVATE KEY", keyDER)
+		return certFile, keyFile
+	}
+
+	pki.serverCert, pki.serverKey = issue(2, "server", x509.ExtKeyUsageServerAuth)
+	pki.clientCert, pki.clientKey = issue(3, "client", x509.ExtKeyUsageClientAuth)
+	return pki
+}
+
+func writeTestPEM(t *testing.T, fileName string, blockType string, der []byte) {
+	t.Helper()
+	err := os.WriteFile(fileName, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	require.NoError(t, err)
+}
+
 func getFreePort() (int, error) {
 	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
 	if err != nil {
@@ -6,7 +6,7 @@ import (
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 )
 
-//go:generate mockgen -destination "./generated_mocks_test.go" -package ${GOPACKAGE}_test . Registrator,DataAccessor,AuthService,FileAccessor
+//go:generate mockgen -destination "./generated_mocks_test.go" -package ${GOPACKAGE}_test . Registrator,DataAccessor,AuthService,FileAccessor,SessionAccessor,TrashAccessor
 
 type Registrator interface {
 	GetEMailStatus(ctx context.Context, email string) (domain.EMailStatus, error)
@@ -18,20 +18,30 @@ type Registrator interface {
 type DataAccessor interface {
 	GetHelloData(ctx context.Context) (*domain.HelloData, error)
 
-	GetBankCardList(ctx context.Context) ([]domain.EncryptedBankCard, error)
-	CreateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error
-	UpdateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error
-	DeleteBankCard(ctx context.Context, number string) error
-
-	GetUserPasswordDataList(ctx context.Context) ([]domain.EncryptedUserPasswordData, error)
-	CreateUserPasswordData(ctx context.Context, bnkCard *domain.EncryptedUserPasswordData) error
-	UpdateUserPasswordData(ctx context.Context, bnkCard *domain.EncryptedUserPasswordData) error
-	DeleteUserPasswordData(ctx context.Context, hint string) error
+	ListItems(ctx context.Context, itemType domain.SecretItemType) ([]domain.EncryptedSecretItem, error)
+	GetItem(ctx context.Context, itemID string) (*domain.EncryptedSecretItem, error)
+	CreateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	UpdateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	DeleteItem(ctx context.Context, itemID string, revision int64) error
+	GetChangesSince(ctx context.Context, seq int64) (*domain.SecretItemChanges, error)
+	Watch(ctx context.Context, seq int64, send func(event *domain.ItemChangeEvent) error) error
+
+	ListRevisions(ctx context.Context, itemID string) ([]domain.SecretItemHistoryEntry, error)
+	GetRevision(ctx context.Context, itemID string, revision int64) (*domain.EncryptedSecretItem, error)
+	RestoreRevision(ctx context.Context, itemID string, revision int64, expected int64) (*domain.SecretItemRevision, error)
+
+	ListSecureNotes(ctx context.Context) ([]domain.EncryptedSecretItem, error)
+	GetSecureNote(ctx context.Context, noteID string) (*domain.EncryptedSecretItem, error)
+	CreateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	UpdateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	DeleteSecureNote(ctx context.Context, noteID string, revision int64) error
 }
 
 type AuthService interface {
 	Login(ctx context.Context, data *domain.EMailData) (domain.SessionID, error)
-	CheckOTP(ctx context.Context, currentID domain.SessionID, otpPass string) (domain.JWTToken, error)
+	CheckOTP(ctx context.Context, currentID domain.SessionID, otpPass string) (*domain.AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken domain.RefreshToken) (*domain.AuthTokens, error)
+	Logout(ctx context.Context, refreshToken domain.RefreshToken) error
 }
 
 type FileAccessor interface {
@@ -40,3 +50,14 @@ type FileAccessor interface {
 	CreateStreamFileWriter(ctx context.Context) (domain.StreamFileWriter, error)
 	CreateStreamFileReader(ctx context.Context, info *domain.FileInfo) (domain.StreamFileReader, error)
 }
+
+type SessionAccessor interface {
+	GetSessionList(ctx context.Context) ([]domain.SessionInfo, error)
+	TerminateSession(ctx context.Context, sessionID string) error
+}
+
+type TrashAccessor interface {
+	ListTrash(ctx context.Context) ([]domain.TrashEntry, error)
+	Restore(ctx context.Context, itemType domain.SecretItemType, id string) error
+	PurgeTrash(ctx context.Context, itemType domain.SecretItemType, id string) error
+}
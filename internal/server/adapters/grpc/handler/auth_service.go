@@ -6,6 +6,7 @@ import (
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+	"github.com/golang/protobuf/ptypes/empty"
 )
 
 func NewAuthService(aService AuthService) *authService {
@@ -40,14 +41,38 @@ func (aS *authService) Login(ctx context.Context, req *proto.LoginRequest) (*pro
 
 func (aS *authService) PassOTP(ctx context.Context, req *proto.PassOTPRequest) (*proto.AuthResponse, error) {
 	action := domain.GetAction(1)
-	jwtToken, err := aS.authService.CheckOTP(ctx, domain.SessionID(req.SessionId), req.Password)
+	tokens, err := aS.authService.CheckOTP(ctx, domain.SessionID(req.SessionId), req.Password)
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
 
 	resp := &proto.AuthResponse{
-		Token: string(jwtToken),
+		Token:        string(tokens.Token),
+		RefreshToken: string(tokens.RefreshToken),
 	}
 
 	return resp, nil
 }
+
+func (aS *authService) Refresh(ctx context.Context, req *proto.RefreshRequest) (*proto.AuthResponse, error) {
+	action := domain.GetAction(1)
+	tokens, err := aS.authService.Refresh(ctx, domain.RefreshToken(req.RefreshToken))
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := &proto.AuthResponse{
+		Token:        string(tokens.Token),
+		RefreshToken: string(tokens.RefreshToken),
+	}
+
+	return resp, nil
+}
+
+func (aS *authService) Logout(ctx context.Context, req *proto.LogoutRequest) (*empty.Empty, error) {
+	action := domain.GetAction(1)
+	if err := aS.authService.Logout(ctx, domain.RefreshToken(req.RefreshToken)); err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &empty.Empty{}, nil
+}
@@ -69,10 +69,10 @@ func TestAuthPassOTP(t *testing.T) {
 		mockService := NewMockAuthService(ctrl)
 
 		mockService.EXPECT().CheckOTP(gomock.Any(), gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, currentID domain.SessionID, otpPass string) (domain.JWTToken, error) {
+			DoAndReturn(func(ctx context.Context, currentID domain.SessionID, otpPass string) (*domain.AuthTokens, error) {
 				require.Equal(t, domain.SessionID("currentID"), currentID)
 				require.Equal(t, "otpPass", otpPass)
-				return domain.JWTToken("token"), nil
+				return &domain.AuthTokens{Token: "token", RefreshToken: "refreshToken"}, nil
 			}).Times(1)
 
 		aService := handler.NewAuthService(mockService)
@@ -84,6 +84,7 @@ func TestAuthPassOTP(t *testing.T) {
 
 		require.Nil(t, err)
 		require.Equal(t, "token", res.Token)
+		require.Equal(t, "refreshToken", res.RefreshToken)
 	})
 
 	t.Run("err", func(t *testing.T) {
@@ -92,10 +93,10 @@ func TestAuthPassOTP(t *testing.T) {
 		testErr := errors.New("testErr")
 
 		mockService.EXPECT().CheckOTP(gomock.Any(), gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, currentID domain.SessionID, otpPass string) (domain.JWTToken, error) {
+			DoAndReturn(func(ctx context.Context, currentID domain.SessionID, otpPass string) (*domain.AuthTokens, error) {
 				require.Equal(t, domain.SessionID("currentID"), currentID)
 				require.Equal(t, "otpPass", otpPass)
-				return domain.JWTToken(""), testErr
+				return nil, testErr
 			}).Times(1)
 
 		aService := handler.NewAuthService(mockService)
@@ -108,3 +109,75 @@ func TestAuthPassOTP(t *testing.T) {
 		require.ErrorIs(t, err, testErr)
 	})
 }
+
+func TestAuthRefresh(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockService := NewMockAuthService(ctrl)
+
+		mockService.EXPECT().Refresh(gomock.Any(), gomock.Eq(domain.RefreshToken("refreshToken"))).Times(1).
+			Return(&domain.AuthTokens{Token: "token", RefreshToken: "newRefreshToken"}, nil)
+
+		aService := handler.NewAuthService(mockService)
+
+		res, err := aService.Refresh(context.Background(), &proto.RefreshRequest{
+			RefreshToken: "refreshToken",
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, "token", res.Token)
+		require.Equal(t, "newRefreshToken", res.RefreshToken)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockService := NewMockAuthService(ctrl)
+
+		mockService.EXPECT().Refresh(gomock.Any(), gomock.Any()).Times(1).Return(nil, domain.ErrNotAuthorized)
+
+		aService := handler.NewAuthService(mockService)
+
+		_, err := aService.Refresh(context.Background(), &proto.RefreshRequest{
+			RefreshToken: "refreshToken",
+		})
+
+		require.ErrorIs(t, err, domain.ErrNotAuthorized)
+	})
+}
+
+func TestAuthLogout(t *testing.T) {
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockService := NewMockAuthService(ctrl)
+
+		mockService.EXPECT().Logout(gomock.Any(), gomock.Eq(domain.RefreshToken("refreshToken"))).Times(1).Return(nil)
+
+		aService := handler.NewAuthService(mockService)
+
+		_, err := aService.Logout(context.Background(), &proto.LogoutRequest{
+			RefreshToken: "refreshToken",
+		})
+
+		require.Nil(t, err)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockService := NewMockAuthService(ctrl)
+
+		testErr := errors.New("testErr")
+		mockService.EXPECT().Logout(gomock.Any(), gomock.Any()).Times(1).Return(testErr)
+
+		aService := handler.NewAuthService(mockService)
+
+		_, err := aService.Logout(context.Background(), &proto.LogoutRequest{
+			RefreshToken: "refreshToken",
+		})
+
+		require.ErrorIs(t, err, testErr)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func NewDataAccessor(accessor DataAccessor) *dataAccessor {
@@ -36,114 +37,220 @@ func (da *dataAccessor) Hello(ctx context.Context, emtpy *empty.Empty) (*proto.H
 	return resp, nil
 }
 
-func (da *dataAccessor) GetBankCardList(ctx context.Context, emtpy *empty.Empty) (*proto.BankCardListResponse, error) {
+func (da *dataAccessor) ListItems(ctx context.Context, req *proto.ListItemsRequest) (*proto.ListItemsResponse, error) {
 	action := domain.GetAction(1)
 
-	list, err := da.accessor.GetBankCardList(ctx)
+	list, err := da.accessor.ListItems(ctx, secretItemTypeFromProto(req.Type))
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
 
-	resp := &proto.BankCardListResponse{}
-	for _, card := range list {
-		resp.Cards = append(resp.Cards, &proto.BankCard{
-			Number:  card.Number,
-			Content: card.Content,
-		})
+	resp := &proto.ListItemsResponse{}
+	for _, item := range list {
+		resp.Items = append(resp.Items, secretItemToProto(&item))
 	}
 	return resp, nil
 }
 
-func (da *dataAccessor) CreateBankCard(ctx context.Context, req *proto.CreateBankCardRequest) (*empty.Empty, error) {
+func (da *dataAccessor) GetItem(ctx context.Context, req *proto.GetItemRequest) (*proto.SecretItem, error) {
 	action := domain.GetAction(1)
 
-	encrBanckCard := &domain.EncryptedBankCard{
-		Number:  req.Number,
-		Content: req.Content,
-	}
-	err := da.accessor.CreateBankCard(ctx, encrBanckCard)
+	item, err := da.accessor.GetItem(ctx, req.Id)
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
-	return nil, nil
+	return secretItemToProto(item), nil
 }
 
-func (da *dataAccessor) DeleteBankCard(ctx context.Context, req *proto.DeleteBankCardRequest) (*empty.Empty, error) {
+func (da *dataAccessor) CreateItem(ctx context.Context, req *proto.CreateItemRequest) (*proto.CreateItemResponse, error) {
 	action := domain.GetAction(1)
-	err := da.accessor.DeleteBankCard(ctx, req.Number)
 
+	rev, err := da.accessor.CreateItem(ctx, &domain.EncryptedSecretItem{
+		Type:      secretItemTypeFromProto(req.Type),
+		Name:      req.Name,
+		Content:   req.Content,
+		LookupKey: req.LookupKey,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
-	return nil, nil
+	return &proto.CreateItemResponse{
+		Id:       rev.ID,
+		Revision: rev.Revision,
+	}, nil
 }
 
-func (da *dataAccessor) UpdateBankCard(ctx context.Context, req *proto.UpdateBankCardRequest) (*empty.Empty, error) {
+func (da *dataAccessor) UpdateItem(ctx context.Context, req *proto.UpdateItemRequest) (*proto.UpdateItemResponse, error) {
 	action := domain.GetAction(1)
-	err := da.accessor.UpdateBankCard(ctx, &domain.EncryptedBankCard{
-		Number:  req.Number,
-		Content: req.Content,
-	})
 
+	rev, err := da.accessor.UpdateItem(ctx, &domain.EncryptedSecretItem{
+		ID:        req.Id,
+		Name:      req.Name,
+		Content:   req.Content,
+		LookupKey: req.LookupKey,
+		Revision:  req.Revision,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
+	return &proto.UpdateItemResponse{
+		Revision: rev.Revision,
+	}, nil
+}
+
+func (da *dataAccessor) DeleteItem(ctx context.Context, req *proto.DeleteItemRequest) (*empty.Empty, error) {
+	action := domain.GetAction(1)
 
-	return nil, nil
+	if err := da.accessor.DeleteItem(ctx, req.Id, req.Revision); err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &empty.Empty{}, nil
 }
-func (da *dataAccessor) GetUserPasswordDataList(ctx context.Context, emtpy *empty.Empty) (*proto.UserPasswordDataResponse, error) {
+
+func (da *dataAccessor) GetChangesSince(ctx context.Context, req *proto.GetChangesSinceRequest) (*proto.GetChangesSinceResponse, error) {
 	action := domain.GetAction(1)
 
-	list, err := da.accessor.GetUserPasswordDataList(ctx)
+	changes, err := da.accessor.GetChangesSince(ctx, req.Seq)
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
 
-	resp := &proto.UserPasswordDataResponse{}
-	for _, dt := range list {
-		resp.Datas = append(resp.Datas, &proto.UserPasswordData{
-			Hint:    dt.Hint,
-			Content: dt.Content,
+	resp := &proto.GetChangesSinceResponse{
+		Seq:    changes.Seq,
+		Reset_: changes.Reset,
+	}
+	for _, item := range changes.Items {
+		resp.Items = append(resp.Items, secretItemToProto(&item))
+	}
+	for _, item := range changes.Deleted {
+		resp.Deleted = append(resp.Deleted, &proto.DeletedItem{
+			Id:   item.ID,
+			Type: secretItemTypeToProto(item.Type),
 		})
 	}
 	return resp, nil
 }
 
-func (da *dataAccessor) CreateUserPasswordData(ctx context.Context, req *proto.CreateUserPasswordDataRequest) (*empty.Empty, error) {
+func (da *dataAccessor) Watch(req *proto.WatchRequest, clientStream proto.DataAccessor_WatchServer) error {
 	action := domain.GetAction(1)
 
-	usedData := &domain.EncryptedUserPasswordData{
-		Hint:    req.Hint,
-		Content: req.Content,
+	ctx := clientStream.Context()
+	err := da.accessor.Watch(ctx, req.Seq, func(event *domain.ItemChangeEvent) error {
+		return clientStream.Send(&proto.ItemChangeEvent{
+			Seq:  event.Seq,
+			Kind: itemChangeKindToProto(event.Kind),
+			Id:   event.ItemID,
+			Type: secretItemTypeToProto(event.Type),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("%v err - %w", action, err)
 	}
-	err := da.accessor.CreateUserPasswordData(ctx, usedData)
+	return nil
+}
+
+func (da *dataAccessor) ListRevisions(ctx context.Context, req *proto.ListRevisionsRequest) (*proto.ListRevisionsResponse, error) {
+	action := domain.GetAction(1)
+
+	revisions, err := da.accessor.ListRevisions(ctx, req.Id)
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
-	return nil, nil
+
+	resp := &proto.ListRevisionsResponse{}
+	for _, rev := range revisions {
+		resp.Revisions = append(resp.Revisions, &proto.ItemRevisionInfo{
+			Revision:   rev.Revision,
+			UpdatedAt:  timestamppb.New(rev.UpdatedAt),
+			ReplacedAt: timestamppb.New(rev.ReplacedAt),
+		})
+	}
+	return resp, nil
 }
 
-func (da *dataAccessor) DeleteUserPasswordData(ctx context.Context, req *proto.DeleteUserPasswordDataRequest) (*empty.Empty, error) {
+func (da *dataAccessor) GetRevision(ctx context.Context, req *proto.GetRevisionRequest) (*proto.SecretItem, error) {
 	action := domain.GetAction(1)
-	err := da.accessor.DeleteUserPasswordData(ctx, req.Hint)
 
+	item, err := da.accessor.GetRevision(ctx, req.Id, req.Revision)
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
-	return nil, nil
+	return secretItemToProto(item), nil
 }
 
-func (da *dataAccessor) UpdateUserPasswordData(ctx context.Context, req *proto.UpdateUserPasswordDataRequest) (*empty.Empty, error) {
-
+func (da *dataAccessor) RestoreRevision(ctx context.Context, req *proto.RestoreRevisionRequest) (*proto.UpdateItemResponse, error) {
 	action := domain.GetAction(1)
-	err := da.accessor.UpdateUserPasswordData(ctx, &domain.EncryptedUserPasswordData{
-		Hint:    req.Hint,
-		Content: req.Content,
-	})
 
+	rev, err := da.accessor.RestoreRevision(ctx, req.Id, req.Revision, req.CurrentRevision)
 	if err != nil {
 		return nil, fmt.Errorf("%v err - %w", action, err)
 	}
+	return &proto.UpdateItemResponse{
+		Revision: rev.Revision,
+	}, nil
+}
+
+func itemChangeKindToProto(kind domain.ItemChangeKind) proto.ItemChangeKind {
+	switch kind {
+	case domain.ItemCreated:
+		return proto.ItemChangeKind_ITEM_CHANGE_KIND_CREATED
+	case domain.ItemUpdated:
+		return proto.ItemChangeKind_ITEM_CHANGE_KIND_UPDATED
+	case domain.ItemDeleted:
+		return proto.ItemChangeKind_ITEM_CHANGE_KIND_DELETED
+	case domain.ItemsChanged:
+		return proto.ItemChangeKind_ITEM_CHANGE_KIND_CHANGED
+	default:
+		return proto.ItemChangeKind_ITEM_CHANGE_KIND_UNSPECIFIED
+	}
+}
+
+func secretItemToProto(item *domain.EncryptedSecretItem) *proto.SecretItem {
+	return &proto.SecretItem{
+		Id:        item.ID,
+		Type:      secretItemTypeToProto(item.Type),
+		Name:      item.Name,
+		Content:   item.Content,
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+		LookupKey: item.LookupKey,
+		Revision:  item.Revision,
+	}
+}
 
-	return nil, nil
+func secretItemTypeFromProto(itemType proto.SecretItemType) domain.SecretItemType {
+	switch itemType {
+	case proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD:
+		return domain.SecretItemBankCard
+	case proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD:
+		return domain.SecretItemUserPassword
+	case proto.SecretItemType_SECRET_ITEM_TYPE_FILE_METADATA:
+		return domain.SecretItemFileMetadata
+	case proto.SecretItemType_SECRET_ITEM_TYPE_SECURE_NOTE:
+		return domain.SecretItemSecureNote
+	case proto.SecretItemType_SECRET_ITEM_TYPE_AUTHENTICATOR:
+		return domain.SecretItemAuthenticator
+	case proto.SecretItemType_SECRET_ITEM_TYPE_SSH_KEY:
+		return domain.SecretItemSSHKey
+	default:
+		return ""
+	}
+}
+
+func secretItemTypeToProto(itemType domain.SecretItemType) proto.SecretItemType {
+	switch itemType {
+	case domain.SecretItemBankCard:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD
+	case domain.SecretItemUserPassword:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD
+	case domain.SecretItemFileMetadata:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_FILE_METADATA
+	case domain.SecretItemSecureNote:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_SECURE_NOTE
+	case domain.SecretItemAuthenticator:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_AUTHENTICATOR
+	case domain.SecretItemSSHKey:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_SSH_KEY
+	default:
+		return proto.SecretItemType_SECRET_ITEM_TYPE_UNSPECIFIED
+	}
 }
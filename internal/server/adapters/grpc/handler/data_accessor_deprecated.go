@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+// Deprecated bank card and login/password operations of the old clients, kept for the deprecation period.
+// The lists are served from the secret items: the old client decrypts the content, the card number and the hint
+// are not known to the server anymore and are left empty. The changes send the card number and the hint
+// in clear text, and the server can't compute their blind indexes, so the changes are refused;
+// the request validation is kept, so wrong requests still get InvalidArgument.
+
+func (da *dataAccessor) GetBankCardList(ctx context.Context, _ *empty.Empty) (*proto.BankCardListResponse, error) {
+	action := domain.GetAction(1)
+
+	list, err := da.accessor.ListItems(ctx, domain.SecretItemBankCard)
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := &proto.BankCardListResponse{}
+	for _, item := range list {
+		resp.Cards = append(resp.Cards, &proto.BankCard{
+			Content: item.Content,
+		})
+	}
+	return resp, nil
+}
+
+func (da *dataAccessor) CreateBankCard(ctx context.Context, _ *proto.CreateBankCardRequest) (*empty.Empty, error) {
+	return nil, deprecatedOperationErr()
+}
+
+func (da *dataAccessor) DeleteBankCard(ctx context.Context, _ *proto.DeleteBankCardRequest) (*empty.Empty, error) {
+	return nil, deprecatedOperationErr()
+}
+
+func (da *dataAccessor) UpdateBankCard(ctx context.Context, _ *proto.UpdateBankCardRequest) (*empty.Empty, error) {
+	return nil, deprecatedOperationErr()
+}
+
+func (da *dataAccessor) GetUserPasswordDataList(ctx context.Context, _ *empty.Empty) (*proto.UserPasswordDataResponse, error) {
+	action := domain.GetAction(1)
+
+	list, err := da.accessor.ListItems(ctx, domain.SecretItemUserPassword)
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := &proto.UserPasswordDataResponse{}
+	for _, item := range list {
+		resp.Datas = append(resp.Datas, &proto.UserPasswordData{
+			Content: item.Content,
+		})
+	}
+	return resp, nil
+}
+
+func (da *dataAccessor) CreateUserPasswordData(ctx context.Context, _ *proto.CreateUserPasswordDataRequest) (*empty.Empty, error) {
+	return nil, deprecatedOperationErr()
+}
+
+func (da *dataAccessor) DeleteUserPasswordData(ctx context.Context, _ *proto.DeleteUserPasswordDataRequest) (*empty.Empty, error) {
+	return nil, deprecatedOperationErr()
+}
+
+func (da *dataAccessor) UpdateUserPasswordData(ctx context.Context, _ *proto.UpdateUserPasswordDataRequest) (*empty.Empty, error) {
+	return nil, deprecatedOperationErr()
+}
+
+func deprecatedOperationErr() error {
+	action := domain.GetAction(2)
+	return fmt.Errorf("%v err - %w", action,
+		domain.NewDetailedError(domain.ErrDeprecatedOperation, "bank card and password changes are replaced by the item operations, update the client"))
+}
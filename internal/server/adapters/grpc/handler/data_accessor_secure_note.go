@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (da *dataAccessor) ListSecureNotes(ctx context.Context, _ *empty.Empty) (*proto.ListSecureNotesResponse, error) {
+	action := domain.GetAction(1)
+
+	list, err := da.accessor.ListSecureNotes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := &proto.ListSecureNotesResponse{}
+	for _, note := range list {
+		resp.Notes = append(resp.Notes, secureNoteToProto(&note))
+	}
+	return resp, nil
+}
+
+func (da *dataAccessor) GetSecureNote(ctx context.Context, req *proto.GetSecureNoteRequest) (*proto.SecureNote, error) {
+	action := domain.GetAction(1)
+
+	note, err := da.accessor.GetSecureNote(ctx, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return secureNoteToProto(note), nil
+}
+
+func (da *dataAccessor) CreateSecureNote(ctx context.Context, req *proto.CreateSecureNoteRequest) (*proto.CreateItemResponse, error) {
+	action := domain.GetAction(1)
+
+	rev, err := da.accessor.CreateSecureNote(ctx, &domain.EncryptedSecretItem{
+		Type:      domain.SecretItemSecureNote,
+		Name:      req.Title,
+		Content:   req.Content,
+		LookupKey: req.LookupKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &proto.CreateItemResponse{
+		Id:       rev.ID,
+		Revision: rev.Revision,
+	}, nil
+}
+
+func (da *dataAccessor) UpdateSecureNote(ctx context.Context, req *proto.UpdateSecureNoteRequest) (*proto.UpdateItemResponse, error) {
+	action := domain.GetAction(1)
+
+	rev, err := da.accessor.UpdateSecureNote(ctx, &domain.EncryptedSecretItem{
+		ID:        req.Id,
+		Type:      domain.SecretItemSecureNote,
+		Name:      req.Title,
+		Content:   req.Content,
+		LookupKey: req.LookupKey,
+		Revision:  req.Revision,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &proto.UpdateItemResponse{
+		Revision: rev.Revision,
+	}, nil
+}
+
+func (da *dataAccessor) DeleteSecureNote(ctx context.Context, req *proto.DeleteSecureNoteRequest) (*empty.Empty, error) {
+	action := domain.GetAction(1)
+
+	if err := da.accessor.DeleteSecureNote(ctx, req.Id, req.Revision); err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func secureNoteToProto(note *domain.EncryptedSecretItem) *proto.SecureNote {
+	return &proto.SecureNote{
+		Id:        note.ID,
+		Title:     note.Name,
+		Content:   note.Content,
+		UpdatedAt: timestamppb.New(note.UpdatedAt),
+		LookupKey: note.LookupKey,
+		Revision:  note.Revision,
+	}
+}
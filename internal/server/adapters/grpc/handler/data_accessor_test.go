@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
@@ -11,6 +12,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 func TestHello(t *testing.T) {
@@ -55,188 +57,463 @@ func TestHello(t *testing.T) {
 
 }
 
-func TestBankCardOps(t *testing.T) {
+func TestSecretItemOps(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	t.Run("get_list_ok", func(t *testing.T) {
+
+	updatedAt := time.Now().UTC()
+
+	t.Run("list_items_ok", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
-		mockService.EXPECT().GetBankCardList(gomock.All()).DoAndReturn(func(ctx context.Context) ([]domain.EncryptedBankCard, error) {
-			return []domain.EncryptedBankCard{
-				{
-					Number:  "number1",
-					Content: "content1",
-				},
-				{
-					Number:  "number2",
-					Content: "content2",
-				},
-			}, nil
-		}).Times(1)
+		mockService.EXPECT().ListItems(gomock.Any(), domain.SecretItemUserPassword).Return([]domain.EncryptedSecretItem{
+			{
+				ID:        "id1",
+				Type:      domain.SecretItemUserPassword,
+				Name:      "name1",
+				LookupKey: "key1",
+				Content:   "content1",
+				Revision:  3,
+				UpdatedAt: updatedAt,
+			},
+		}, nil).Times(1)
 
 		aService := handler.NewDataAccessor(mockService)
-		resp, err := aService.GetBankCardList(context.Background(), nil)
+		resp, err := aService.ListItems(context.Background(), &proto.ListItemsRequest{
+			Type: proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD,
+		})
 		require.NoError(t, err)
-		require.Equal(t, 2, len(resp.Cards))
+		require.Equal(t, 1, len(resp.Items))
+		assert.Equal(t, "id1", resp.Items[0].Id)
+		assert.Equal(t, proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD, resp.Items[0].Type)
+		assert.Equal(t, "name1", resp.Items[0].Name)
+		assert.Equal(t, "key1", resp.Items[0].LookupKey)
+		assert.Equal(t, int64(3), resp.Items[0].Revision)
+		assert.Equal(t, "content1", resp.Items[0].Content)
+		assert.True(t, updatedAt.Equal(resp.Items[0].UpdatedAt.AsTime()))
+	})
 
-		assert.Equal(t, "number1", resp.Cards[0].Number)
-		assert.Equal(t, "content1", resp.Cards[0].Content)
+	t.Run("list_all_items_ok", func(t *testing.T) {
+		mockService := NewMockDataAccessor(ctrl)
+		mockService.EXPECT().ListItems(gomock.Any(), domain.SecretItemType("")).Return(nil, nil).Times(1)
 
-		assert.Equal(t, "number2", resp.Cards[1].Number)
-		assert.Equal(t, "content2", resp.Cards[1].Content)
+		aService := handler.NewDataAccessor(mockService)
+		resp, err := aService.ListItems(context.Background(), &proto.ListItemsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 0, len(resp.Items))
 	})
 
-	t.Run("get_list_err", func(t *testing.T) {
+	t.Run("get_item_err", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
-		testErr := errors.New("testErr")
-		mockService.EXPECT().GetBankCardList(gomock.All()).DoAndReturn(func(ctx context.Context) ([]domain.EncryptedBankCard, error) {
-			return nil, testErr
-		}).Times(1)
+		mockService.EXPECT().GetItem(gomock.Any(), "id1").Return(nil, domain.ErrDataNotExists).Times(1)
 
 		aService := handler.NewDataAccessor(mockService)
-		resp, err := aService.GetBankCardList(context.Background(), nil)
-		require.ErrorIs(t, err, testErr)
-		require.Nil(t, resp)
+		_, err := aService.GetItem(context.Background(), &proto.GetItemRequest{
+			Id: "id1",
+		})
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
 	})
 
-	t.Run("create_bank_card_ok", func(t *testing.T) {
+	t.Run("create_item_ok", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
-		mockService.EXPECT().CreateBankCard(gomock.All(), gomock.All()).DoAndReturn(func(ctx context.Context, data *domain.EncryptedBankCard) error {
-			require.Equal(t, "number1", data.Number)
-			require.Equal(t, "content1", data.Content)
-			return nil
+		mockService.EXPECT().CreateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			require.Equal(t, domain.SecretItemBankCard, item.Type)
+			require.Equal(t, "name1", item.Name)
+			require.Equal(t, "key1", item.LookupKey)
+			require.Equal(t, "content1", item.Content)
+			return &domain.SecretItemRevision{ID: "id1", Revision: 1}, nil
 		}).Times(1)
 
 		aService := handler.NewDataAccessor(mockService)
-		_, err := aService.CreateBankCard(context.Background(), &proto.CreateBankCardRequest{
-			Number:  "number1",
-			Content: "content1",
+		resp, err := aService.CreateItem(context.Background(), &proto.CreateItemRequest{
+			Type:      proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD,
+			Name:      "name1",
+			LookupKey: "key1",
+			Content:   "content1",
 		})
-
 		require.NoError(t, err)
+		require.Equal(t, "id1", resp.Id)
+		require.Equal(t, int64(1), resp.Revision)
 	})
 
-	t.Run("delete_bank_card_ok", func(t *testing.T) {
+	t.Run("update_item_ok", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
-		mockService.EXPECT().DeleteBankCard(gomock.All(), gomock.All()).DoAndReturn(func(ctx context.Context, number string) error {
-			require.Equal(t, "number1", number)
-			return nil
+		mockService.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+			require.Equal(t, "id1", item.ID)
+			require.Equal(t, "name1", item.Name)
+			require.Equal(t, "key1", item.LookupKey)
+			require.Equal(t, "content1", item.Content)
+			require.Equal(t, int64(1), item.Revision)
+			return &domain.SecretItemRevision{ID: "id1", Revision: 2}, nil
 		}).Times(1)
 
 		aService := handler.NewDataAccessor(mockService)
-		_, err := aService.DeleteBankCard(context.Background(), &proto.DeleteBankCardRequest{
-			Number: "number1",
+		resp, err := aService.UpdateItem(context.Background(), &proto.UpdateItemRequest{
+			Id:        "id1",
+			Name:      "name1",
+			LookupKey: "key1",
+			Content:   "content1",
+			Revision:  1,
 		})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), resp.Revision)
+	})
+
+	t.Run("update_item_conflict", func(t *testing.T) {
+		mockService := NewMockDataAccessor(ctrl)
+		mockService.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).Return(nil, domain.ErrDataConflict).Times(1)
+
+		aService := handler.NewDataAccessor(mockService)
+		_, err := aService.UpdateItem(context.Background(), &proto.UpdateItemRequest{
+			Id:       "id1",
+			Name:     "name1",
+			Content:  "content1",
+			Revision: 1,
+		})
+		require.ErrorIs(t, err, domain.ErrDataConflict)
+	})
+
+	t.Run("delete_item_err", func(t *testing.T) {
+		mockService := NewMockDataAccessor(ctrl)
+		testErr := errors.New("testErr")
+		mockService.EXPECT().DeleteItem(gomock.Any(), "id1", int64(2)).Return(testErr).Times(1)
+
+		aService := handler.NewDataAccessor(mockService)
+		_, err := aService.DeleteItem(context.Background(), &proto.DeleteItemRequest{
+			Id:       "id1",
+			Revision: 2,
+		})
+		require.ErrorIs(t, err, testErr)
+	})
 
+	t.Run("get_changes_since", func(t *testing.T) {
+		mockService := NewMockDataAccessor(ctrl)
+		mockService.EXPECT().GetChangesSince(gomock.Any(), int64(3)).Return(&domain.SecretItemChanges{
+			Seq: 5,
+			Items: []domain.EncryptedSecretItem{
+				{
+					ID:       "id1",
+					Type:     domain.SecretItemBankCard,
+					Revision: 2,
+				},
+			},
+			Deleted: []domain.DeletedSecretItem{
+				{
+					ID:   "id2",
+					Type: domain.SecretItemUserPassword,
+				},
+			},
+		}, nil).Times(1)
+
+		aService := handler.NewDataAccessor(mockService)
+		resp, err := aService.GetChangesSince(context.Background(), &proto.GetChangesSinceRequest{Seq: 3})
 		require.NoError(t, err)
+		require.Equal(t, int64(5), resp.Seq)
+		require.False(t, resp.Reset_)
+		require.Equal(t, 1, len(resp.Items))
+		assert.Equal(t, "id1", resp.Items[0].Id)
+		assert.Equal(t, proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD, resp.Items[0].Type)
+		require.Equal(t, 1, len(resp.Deleted))
+		assert.Equal(t, "id2", resp.Deleted[0].Id)
+		assert.Equal(t, proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD, resp.Deleted[0].Type)
 	})
+}
 
-	t.Run("update_bank_card_ok", func(t *testing.T) {
+type watchServ struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*proto.ItemChangeEvent
+}
+
+func (wSrv *watchServ) Context() context.Context {
+	return wSrv.ctx
+}
+
+func (wSrv *watchServ) Send(event *proto.ItemChangeEvent) error {
+	wSrv.events = append(wSrv.events, event)
+	return nil
+}
+
+var _ proto.DataAccessor_WatchServer = (*watchServ)(nil)
+
+func TestDataAccessor_Watch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
-		mockService.EXPECT().UpdateBankCard(gomock.All(), gomock.All()).DoAndReturn(func(ctx context.Context, data *domain.EncryptedBankCard) error {
-			require.Equal(t, "number1", data.Number)
-			require.Equal(t, "content1", data.Content)
-			return nil
-		}).Times(1)
+		mockService.EXPECT().Watch(gomock.Any(), int64(2), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, seq int64, send func(event *domain.ItemChangeEvent) error) error {
+				if err := send(&domain.ItemChangeEvent{Seq: 3, Kind: domain.ItemsChanged}); err != nil {
+					return err
+				}
+				return send(&domain.ItemChangeEvent{
+					Seq:    4,
+					Kind:   domain.ItemDeleted,
+					ItemID: "id1",
+					Type:   domain.SecretItemUserPassword,
+				})
+			}).Times(1)
 
+		srv := &watchServ{ctx: context.Background()}
 		aService := handler.NewDataAccessor(mockService)
-		_, err := aService.UpdateBankCard(context.Background(), &proto.UpdateBankCardRequest{
-			Number:  "number1",
-			Content: "content1",
+		err := aService.Watch(&proto.WatchRequest{Seq: 2}, srv)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, len(srv.events))
+		assert.Equal(t, int64(3), srv.events[0].Seq)
+		assert.Equal(t, proto.ItemChangeKind_ITEM_CHANGE_KIND_CHANGED, srv.events[0].Kind)
+		assert.Equal(t, int64(4), srv.events[1].Seq)
+		assert.Equal(t, proto.ItemChangeKind_ITEM_CHANGE_KIND_DELETED, srv.events[1].Kind)
+		assert.Equal(t, "id1", srv.events[1].Id)
+		assert.Equal(t, proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD, srv.events[1].Type)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockService := NewMockDataAccessor(ctrl)
+		mockService.EXPECT().Watch(gomock.Any(), int64(0), gomock.Any()).Return(domain.ErrServerInternal).Times(1)
+
+		aService := handler.NewDataAccessor(mockService)
+		err := aService.Watch(&proto.WatchRequest{}, &watchServ{ctx: context.Background()})
+		require.ErrorIs(t, err, domain.ErrServerInternal)
+	})
+}
+
+func TestItemRevisionOps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("list_revisions", func(t *testing.T) {
+		updatedAt := time.Now().UTC().Add(-time.Hour)
+		replacedAt := time.Now().UTC()
+
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().ListRevisions(gomock.Any(), "id1").Times(1).Return([]domain.SecretItemHistoryEntry{
+			{ItemID: "id1", Revision: 2, UpdatedAt: updatedAt, ReplacedAt: replacedAt},
+			{ItemID: "id1", Revision: 1},
+		}, nil)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		resp, err := da.ListRevisions(context.Background(), &proto.ListRevisionsRequest{Id: "id1"})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Revisions))
+		assert.Equal(t, int64(2), resp.Revisions[0].Revision)
+		assert.True(t, updatedAt.Equal(resp.Revisions[0].UpdatedAt.AsTime()))
+		assert.True(t, replacedAt.Equal(resp.Revisions[0].ReplacedAt.AsTime()))
+	})
+
+	t.Run("list_revisions_err", func(t *testing.T) {
+		testErr := errors.New("testErr")
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().ListRevisions(gomock.Any(), "id1").Times(1).Return(nil, testErr)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		_, err := da.ListRevisions(context.Background(), &proto.ListRevisionsRequest{Id: "id1"})
+		require.ErrorIs(t, err, testErr)
+	})
+
+	t.Run("get_revision", func(t *testing.T) {
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().GetRevision(gomock.Any(), "id1", int64(1)).Times(1).Return(&domain.EncryptedSecretItem{
+			ID:       "id1",
+			Type:     domain.SecretItemUserPassword,
+			Name:     "Name1",
+			Content:  "Content1",
+			Revision: 1,
+		}, nil)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		item, err := da.GetRevision(context.Background(), &proto.GetRevisionRequest{Id: "id1", Revision: 1})
+		require.NoError(t, err)
+		assert.Equal(t, proto.SecretItemType_SECRET_ITEM_TYPE_USER_PASSWORD, item.Type)
+		assert.Equal(t, "Content1", item.Content)
+		assert.Equal(t, int64(1), item.Revision)
+	})
+
+	t.Run("restore_revision", func(t *testing.T) {
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().RestoreRevision(gomock.Any(), "id1", int64(1), int64(3)).Times(1).
+			Return(&domain.SecretItemRevision{ID: "id1", Revision: 4}, nil)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		resp, err := da.RestoreRevision(context.Background(), &proto.RestoreRevisionRequest{Id: "id1", Revision: 1, CurrentRevision: 3})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), resp.Revision)
+	})
+
+	t.Run("restore_revision_conflict", func(t *testing.T) {
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().RestoreRevision(gomock.Any(), "id1", int64(1), int64(2)).Times(1).Return(nil, domain.ErrDataConflict)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		_, err := da.RestoreRevision(context.Background(), &proto.RestoreRevisionRequest{Id: "id1", Revision: 1, CurrentRevision: 2})
+		require.ErrorIs(t, err, domain.ErrDataConflict)
+	})
+}
+
+func TestSecureNoteOps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("list", func(t *testing.T) {
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().ListSecureNotes(gomock.Any()).Times(1).Return([]domain.EncryptedSecretItem{
+			{ID: "id1", Type: domain.SecretItemSecureNote, Name: "Title1", Content: "Content1", LookupKey: "Key1", Revision: 2},
+		}, nil)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		resp, err := da.ListSecureNotes(context.Background(), nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Notes))
+		assert.Equal(t, "Title1", resp.Notes[0].Title)
+		assert.Equal(t, "Key1", resp.Notes[0].LookupKey)
+		assert.Equal(t, int64(2), resp.Notes[0].Revision)
+	})
+
+	t.Run("get_err", func(t *testing.T) {
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().GetSecureNote(gomock.Any(), "id1").Times(1).Return(nil, domain.ErrDataNotExists)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		_, err := da.GetSecureNote(context.Background(), &proto.GetSecureNoteRequest{Id: "id1"})
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().CreateSecureNote(gomock.Any(), &domain.EncryptedSecretItem{
+			Type:      domain.SecretItemSecureNote,
+			Name:      "Title1",
+			Content:   "Content1",
+			LookupKey: "Key1",
+		}).Times(1).Return(&domain.SecretItemRevision{ID: "id1", Revision: 1}, nil)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		resp, err := da.CreateSecureNote(context.Background(), &proto.CreateSecureNoteRequest{
+			Title:     "Title1",
+			Content:   "Content1",
+			LookupKey: "Key1",
 		})
+		require.NoError(t, err)
+		assert.Equal(t, "id1", resp.Id)
+		assert.Equal(t, int64(1), resp.Revision)
+	})
 
+	t.Run("update_conflict", func(t *testing.T) {
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().UpdateSecureNote(gomock.Any(), gomock.Any()).Times(1).Return(nil, domain.ErrDataConflict)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		_, err := da.UpdateSecureNote(context.Background(), &proto.UpdateSecureNoteRequest{
+			Id:        "id1",
+			Title:     "Title1",
+			Content:   "Content1",
+			LookupKey: "Key1",
+			Revision:  1,
+		})
+		require.ErrorIs(t, err, domain.ErrDataConflict)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		mockAccessor := NewMockDataAccessor(ctrl)
+		mockAccessor.EXPECT().DeleteSecureNote(gomock.Any(), "id1", int64(2)).Times(1).Return(nil)
+
+		da := handler.NewDataAccessor(mockAccessor)
+		_, err := da.DeleteSecureNote(context.Background(), &proto.DeleteSecureNoteRequest{Id: "id1", Revision: 2})
 		require.NoError(t, err)
 	})
 }
 
-func TestUserPasswordDataOps(t *testing.T) {
+func TestBankCardOps(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
+
 	t.Run("get_list_ok", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
-		mockService.EXPECT().GetUserPasswordDataList(gomock.All()).DoAndReturn(func(ctx context.Context) ([]domain.EncryptedUserPasswordData, error) {
-			return []domain.EncryptedUserPasswordData{
-				{
-					Hint:    "hint1",
-					Content: "content1",
-				},
-				{
-					Hint:    "hint2",
-					Content: "content2",
-				},
-			}, nil
-		}).Times(1)
+		mockService.EXPECT().ListItems(gomock.Any(), domain.SecretItemBankCard).Return([]domain.EncryptedSecretItem{
+			{ID: "id1", Type: domain.SecretItemBankCard, Name: "name1", Content: "content1"},
+			{ID: "id2", Type: domain.SecretItemBankCard, Name: "name2", Content: "content2"},
+		}, nil).Times(1)
 
 		aService := handler.NewDataAccessor(mockService)
-		resp, err := aService.GetUserPasswordDataList(context.Background(), nil)
+		resp, err := aService.GetBankCardList(context.Background(), nil)
 		require.NoError(t, err)
-		require.Equal(t, 2, len(resp.Datas))
-
-		assert.Equal(t, "hint1", resp.Datas[0].Hint)
-		assert.Equal(t, "content1", resp.Datas[0].Content)
+		require.Equal(t, 2, len(resp.Cards))
 
-		assert.Equal(t, "hint2", resp.Datas[1].Hint)
-		assert.Equal(t, "content2", resp.Datas[1].Content)
+		assert.Empty(t, resp.Cards[0].Number)
+		assert.Equal(t, "content1", resp.Cards[0].Content)
+		assert.Equal(t, "content2", resp.Cards[1].Content)
 	})
 
 	t.Run("get_list_err", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
 		testErr := errors.New("testErr")
-		mockService.EXPECT().GetUserPasswordDataList(gomock.All()).DoAndReturn(func(ctx context.Context) ([]domain.EncryptedUserPasswordData, error) {
-			return nil, testErr
-		}).Times(1)
+		mockService.EXPECT().ListItems(gomock.Any(), domain.SecretItemBankCard).Return(nil, testErr).Times(1)
 
 		aService := handler.NewDataAccessor(mockService)
-		resp, err := aService.GetUserPasswordDataList(context.Background(), nil)
+		resp, err := aService.GetBankCardList(context.Background(), nil)
 		require.ErrorIs(t, err, testErr)
 		require.Nil(t, resp)
 	})
 
-	t.Run("create_user_data_ok", func(t *testing.T) {
-		mockService := NewMockDataAccessor(ctrl)
-		mockService.EXPECT().CreateUserPasswordData(gomock.All(), gomock.All()).DoAndReturn(func(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
-			require.Equal(t, "hint1", data.Hint)
-			require.Equal(t, "content1", data.Content)
-			return nil
-		}).Times(1)
+	t.Run("changes_deprecated", func(t *testing.T) {
+		// the service is not invoked
+		aService := handler.NewDataAccessor(NewMockDataAccessor(ctrl))
+		ctx := context.Background()
 
-		aService := handler.NewDataAccessor(mockService)
-		_, err := aService.CreateUserPasswordData(context.Background(), &proto.CreateUserPasswordDataRequest{
-			Hint:    "hint1",
-			Content: "content1",
-		})
+		_, err := aService.CreateBankCard(ctx, &proto.CreateBankCardRequest{Number: "4000000000000002", Content: "content"})
+		require.ErrorIs(t, err, domain.ErrDeprecatedOperation)
 
-		require.NoError(t, err)
+		_, err = aService.UpdateBankCard(ctx, &proto.UpdateBankCardRequest{Number: "4000000000000002", Content: "content"})
+		require.ErrorIs(t, err, domain.ErrDeprecatedOperation)
+
+		_, err = aService.DeleteBankCard(ctx, &proto.DeleteBankCardRequest{Number: "4000000000000002"})
+		require.ErrorIs(t, err, domain.ErrDeprecatedOperation)
 	})
+}
 
-	t.Run("delete_user_data_ok", func(t *testing.T) {
+func TestUserPasswordDataOps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("get_list_ok", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
-		mockService.EXPECT().DeleteUserPasswordData(gomock.All(), gomock.All()).DoAndReturn(func(ctx context.Context, hint string) error {
-			require.Equal(t, "hint1", hint)
-			return nil
-		}).Times(1)
+		mockService.EXPECT().ListItems(gomock.Any(), domain.SecretItemUserPassword).Return([]domain.EncryptedSecretItem{
+			{ID: "id1", Type: domain.SecretItemUserPassword, Name: "name1", Content: "content1"},
+			{ID: "id2", Type: domain.SecretItemUserPassword, Name: "name2", Content: "content2"},
+		}, nil).Times(1)
 
 		aService := handler.NewDataAccessor(mockService)
-		_, err := aService.DeleteUserPasswordData(context.Background(), &proto.DeleteUserPasswordDataRequest{
-			Hint: "hint1",
-		})
-
+		resp, err := aService.GetUserPasswordDataList(context.Background(), nil)
 		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Datas))
+
+		assert.Empty(t, resp.Datas[0].Hint)
+		assert.Equal(t, "content1", resp.Datas[0].Content)
+		assert.Equal(t, "content2", resp.Datas[1].Content)
 	})
 
-	t.Run("update_user_data_ok", func(t *testing.T) {
+	t.Run("get_list_err", func(t *testing.T) {
 		mockService := NewMockDataAccessor(ctrl)
-		mockService.EXPECT().UpdateUserPasswordData(gomock.All(), gomock.All()).DoAndReturn(func(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
-			require.Equal(t, "hint1", data.Hint)
-			require.Equal(t, "content1", data.Content)
-			return nil
-		}).Times(1)
+		testErr := errors.New("testErr")
+		mockService.EXPECT().ListItems(gomock.Any(), domain.SecretItemUserPassword).Return(nil, testErr).Times(1)
 
 		aService := handler.NewDataAccessor(mockService)
-		_, err := aService.UpdateUserPasswordData(context.Background(), &proto.UpdateUserPasswordDataRequest{
-			Hint:    "hint1",
-			Content: "content1",
-		})
+		resp, err := aService.GetUserPasswordDataList(context.Background(), nil)
+		require.ErrorIs(t, err, testErr)
+		require.Nil(t, resp)
+	})
 
-		require.NoError(t, err)
+	t.Run("changes_deprecated", func(t *testing.T) {
+		aService := handler.NewDataAccessor(NewMockDataAccessor(ctrl))
+		ctx := context.Background()
+
+		_, err := aService.CreateUserPasswordData(ctx, &proto.CreateUserPasswordDataRequest{Hint: "hint", Content: "content"})
+		require.ErrorIs(t, err, domain.ErrDeprecatedOperation)
+
+		_, err = aService.UpdateUserPasswordData(ctx, &proto.UpdateUserPasswordDataRequest{Hint: "hint", Content: "content"})
+		require.ErrorIs(t, err, domain.ErrDeprecatedOperation)
+
+		_, err = aService.DeleteUserPasswordData(ctx, &proto.DeleteUserPasswordDataRequest{Hint: "hint"})
+		require.ErrorIs(t, err, domain.ErrDeprecatedOperation)
 	})
 }
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/handler (interfaces: Registrator,DataAccessor,AuthService,FileAccessor)
+// Source: github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/handler (interfaces: Registrator,DataAccessor,AuthService,FileAccessor,SessionAccessor,TrashAccessor)
 
 // Package handler_test is a generated GoMock package.
 package handler_test
@@ -117,75 +117,77 @@ func (m *MockDataAccessor) EXPECT() *MockDataAccessorMockRecorder {
 	return m.recorder
 }
 
-// CreateBankCard mocks base method.
-func (m *MockDataAccessor) CreateBankCard(arg0 context.Context, arg1 *domain.EncryptedBankCard) error {
+// CreateItem mocks base method.
+func (m *MockDataAccessor) CreateItem(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBankCard", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateBankCard indicates an expected call of CreateBankCard.
-func (mr *MockDataAccessorMockRecorder) CreateBankCard(arg0, arg1 interface{}) *gomock.Call {
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockDataAccessorMockRecorder) CreateItem(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankCard", reflect.TypeOf((*MockDataAccessor)(nil).CreateBankCard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockDataAccessor)(nil).CreateItem), arg0, arg1)
 }
 
-// CreateUserPasswordData mocks base method.
-func (m *MockDataAccessor) CreateUserPasswordData(arg0 context.Context, arg1 *domain.EncryptedUserPasswordData) error {
+// CreateSecureNote mocks base method.
+func (m *MockDataAccessor) CreateSecureNote(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserPasswordData", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateSecureNote", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateUserPasswordData indicates an expected call of CreateUserPasswordData.
-func (mr *MockDataAccessorMockRecorder) CreateUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// CreateSecureNote indicates an expected call of CreateSecureNote.
+func (mr *MockDataAccessorMockRecorder) CreateSecureNote(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserPasswordData", reflect.TypeOf((*MockDataAccessor)(nil).CreateUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecureNote", reflect.TypeOf((*MockDataAccessor)(nil).CreateSecureNote), arg0, arg1)
 }
 
-// DeleteBankCard mocks base method.
-func (m *MockDataAccessor) DeleteBankCard(arg0 context.Context, arg1 string) error {
+// DeleteItem mocks base method.
+func (m *MockDataAccessor) DeleteItem(arg0 context.Context, arg1 string, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBankCard", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteItem", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteBankCard indicates an expected call of DeleteBankCard.
-func (mr *MockDataAccessorMockRecorder) DeleteBankCard(arg0, arg1 interface{}) *gomock.Call {
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockDataAccessorMockRecorder) DeleteItem(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankCard", reflect.TypeOf((*MockDataAccessor)(nil).DeleteBankCard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockDataAccessor)(nil).DeleteItem), arg0, arg1, arg2)
 }
 
-// DeleteUserPasswordData mocks base method.
-func (m *MockDataAccessor) DeleteUserPasswordData(arg0 context.Context, arg1 string) error {
+// DeleteSecureNote mocks base method.
+func (m *MockDataAccessor) DeleteSecureNote(arg0 context.Context, arg1 string, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUserPasswordData", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteSecureNote", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUserPasswordData indicates an expected call of DeleteUserPasswordData.
-func (mr *MockDataAccessorMockRecorder) DeleteUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// DeleteSecureNote indicates an expected call of DeleteSecureNote.
+func (mr *MockDataAccessorMockRecorder) DeleteSecureNote(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPasswordData", reflect.TypeOf((*MockDataAccessor)(nil).DeleteUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecureNote", reflect.TypeOf((*MockDataAccessor)(nil).DeleteSecureNote), arg0, arg1, arg2)
 }
 
-// GetBankCardList mocks base method.
-func (m *MockDataAccessor) GetBankCardList(arg0 context.Context) ([]domain.EncryptedBankCard, error) {
+// GetChangesSince mocks base method.
+func (m *MockDataAccessor) GetChangesSince(arg0 context.Context, arg1 int64) (*domain.SecretItemChanges, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankCardList", arg0)
-	ret0, _ := ret[0].([]domain.EncryptedBankCard)
+	ret := m.ctrl.Call(m, "GetChangesSince", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemChanges)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBankCardList indicates an expected call of GetBankCardList.
-func (mr *MockDataAccessorMockRecorder) GetBankCardList(arg0 interface{}) *gomock.Call {
+// GetChangesSince indicates an expected call of GetChangesSince.
+func (mr *MockDataAccessorMockRecorder) GetChangesSince(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankCardList", reflect.TypeOf((*MockDataAccessor)(nil).GetBankCardList), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangesSince", reflect.TypeOf((*MockDataAccessor)(nil).GetChangesSince), arg0, arg1)
 }
 
 // GetHelloData mocks base method.
@@ -203,47 +205,153 @@ func (mr *MockDataAccessorMockRecorder) GetHelloData(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelloData", reflect.TypeOf((*MockDataAccessor)(nil).GetHelloData), arg0)
 }
 
-// GetUserPasswordDataList mocks base method.
-func (m *MockDataAccessor) GetUserPasswordDataList(arg0 context.Context) ([]domain.EncryptedUserPasswordData, error) {
+// GetItem mocks base method.
+func (m *MockDataAccessor) GetItem(arg0 context.Context, arg1 string) (*domain.EncryptedSecretItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPasswordDataList", arg0)
-	ret0, _ := ret[0].([]domain.EncryptedUserPasswordData)
+	ret := m.ctrl.Call(m, "GetItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.EncryptedSecretItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserPasswordDataList indicates an expected call of GetUserPasswordDataList.
-func (mr *MockDataAccessorMockRecorder) GetUserPasswordDataList(arg0 interface{}) *gomock.Call {
+// GetItem indicates an expected call of GetItem.
+func (mr *MockDataAccessorMockRecorder) GetItem(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordDataList", reflect.TypeOf((*MockDataAccessor)(nil).GetUserPasswordDataList), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockDataAccessor)(nil).GetItem), arg0, arg1)
 }
 
-// UpdateBankCard mocks base method.
-func (m *MockDataAccessor) UpdateBankCard(arg0 context.Context, arg1 *domain.EncryptedBankCard) error {
+// GetRevision mocks base method.
+func (m *MockDataAccessor) GetRevision(arg0 context.Context, arg1 string, arg2 int64) (*domain.EncryptedSecretItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBankCard", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetRevision", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockDataAccessorMockRecorder) GetRevision(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockDataAccessor)(nil).GetRevision), arg0, arg1, arg2)
+}
+
+// GetSecureNote mocks base method.
+func (m *MockDataAccessor) GetSecureNote(arg0 context.Context, arg1 string) (*domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecureNote", arg0, arg1)
+	ret0, _ := ret[0].(*domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecureNote indicates an expected call of GetSecureNote.
+func (mr *MockDataAccessorMockRecorder) GetSecureNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecureNote", reflect.TypeOf((*MockDataAccessor)(nil).GetSecureNote), arg0, arg1)
+}
+
+// ListItems mocks base method.
+func (m *MockDataAccessor) ListItems(arg0 context.Context, arg1 domain.SecretItemType) ([]domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItems", arg0, arg1)
+	ret0, _ := ret[0].([]domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockDataAccessorMockRecorder) ListItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockDataAccessor)(nil).ListItems), arg0, arg1)
+}
+
+// ListRevisions mocks base method.
+func (m *MockDataAccessor) ListRevisions(arg0 context.Context, arg1 string) ([]domain.SecretItemHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.SecretItemHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockDataAccessorMockRecorder) ListRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockDataAccessor)(nil).ListRevisions), arg0, arg1)
+}
+
+// ListSecureNotes mocks base method.
+func (m *MockDataAccessor) ListSecureNotes(arg0 context.Context) ([]domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecureNotes", arg0)
+	ret0, _ := ret[0].([]domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateBankCard indicates an expected call of UpdateBankCard.
-func (mr *MockDataAccessorMockRecorder) UpdateBankCard(arg0, arg1 interface{}) *gomock.Call {
+// ListSecureNotes indicates an expected call of ListSecureNotes.
+func (mr *MockDataAccessorMockRecorder) ListSecureNotes(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBankCard", reflect.TypeOf((*MockDataAccessor)(nil).UpdateBankCard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecureNotes", reflect.TypeOf((*MockDataAccessor)(nil).ListSecureNotes), arg0)
 }
 
-// UpdateUserPasswordData mocks base method.
-func (m *MockDataAccessor) UpdateUserPasswordData(arg0 context.Context, arg1 *domain.EncryptedUserPasswordData) error {
+// RestoreRevision mocks base method.
+func (m *MockDataAccessor) RestoreRevision(arg0 context.Context, arg1 string, arg2, arg3 int64) (*domain.SecretItemRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserPasswordData", arg0, arg1)
+	ret := m.ctrl.Call(m, "RestoreRevision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockDataAccessorMockRecorder) RestoreRevision(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockDataAccessor)(nil).RestoreRevision), arg0, arg1, arg2, arg3)
+}
+
+// UpdateItem mocks base method.
+func (m *MockDataAccessor) UpdateItem(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockDataAccessorMockRecorder) UpdateItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockDataAccessor)(nil).UpdateItem), arg0, arg1)
+}
+
+// UpdateSecureNote mocks base method.
+func (m *MockDataAccessor) UpdateSecureNote(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecureNote", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecureNote indicates an expected call of UpdateSecureNote.
+func (mr *MockDataAccessorMockRecorder) UpdateSecureNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecureNote", reflect.TypeOf((*MockDataAccessor)(nil).UpdateSecureNote), arg0, arg1)
+}
+
+// Watch mocks base method.
+func (m *MockDataAccessor) Watch(arg0 context.Context, arg1 int64, arg2 func(*domain.ItemChangeEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateUserPasswordData indicates an expected call of UpdateUserPasswordData.
-func (mr *MockDataAccessorMockRecorder) UpdateUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// Watch indicates an expected call of Watch.
+func (mr *MockDataAccessorMockRecorder) Watch(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordData", reflect.TypeOf((*MockDataAccessor)(nil).UpdateUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockDataAccessor)(nil).Watch), arg0, arg1, arg2)
 }
 
 // MockAuthService is a mock of AuthService interface.
@@ -270,10 +378,10 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 }
 
 // CheckOTP mocks base method.
-func (m *MockAuthService) CheckOTP(arg0 context.Context, arg1 domain.SessionID, arg2 string) (domain.JWTToken, error) {
+func (m *MockAuthService) CheckOTP(arg0 context.Context, arg1 domain.SessionID, arg2 string) (*domain.AuthTokens, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CheckOTP", arg0, arg1, arg2)
-	ret0, _ := ret[0].(domain.JWTToken)
+	ret0, _ := ret[0].(*domain.AuthTokens)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -299,6 +407,35 @@ func (mr *MockAuthServiceMockRecorder) Login(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), arg0, arg1)
 }
 
+// Logout mocks base method.
+func (m *MockAuthService) Logout(arg0 context.Context, arg1 domain.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceMockRecorder) Logout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), arg0, arg1)
+}
+
+// Refresh mocks base method.
+func (m *MockAuthService) Refresh(arg0 context.Context, arg1 domain.RefreshToken) (*domain.AuthTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AuthTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthServiceMockRecorder) Refresh(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthService)(nil).Refresh), arg0, arg1)
+}
+
 // MockFileAccessor is a mock of FileAccessor interface.
 type MockFileAccessor struct {
 	ctrl     *gomock.Controller
@@ -380,3 +517,121 @@ func (mr *MockFileAccessorMockRecorder) GetFileInfoList(arg0 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfoList", reflect.TypeOf((*MockFileAccessor)(nil).GetFileInfoList), arg0)
 }
+
+// MockSessionAccessor is a mock of SessionAccessor interface.
+type MockSessionAccessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionAccessorMockRecorder
+}
+
+// MockSessionAccessorMockRecorder is the mock recorder for MockSessionAccessor.
+type MockSessionAccessorMockRecorder struct {
+	mock *MockSessionAccessor
+}
+
+// NewMockSessionAccessor creates a new mock instance.
+func NewMockSessionAccessor(ctrl *gomock.Controller) *MockSessionAccessor {
+	mock := &MockSessionAccessor{ctrl: ctrl}
+	mock.recorder = &MockSessionAccessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionAccessor) EXPECT() *MockSessionAccessorMockRecorder {
+	return m.recorder
+}
+
+// GetSessionList mocks base method.
+func (m *MockSessionAccessor) GetSessionList(arg0 context.Context) ([]domain.SessionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionList", arg0)
+	ret0, _ := ret[0].([]domain.SessionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionList indicates an expected call of GetSessionList.
+func (mr *MockSessionAccessorMockRecorder) GetSessionList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionList", reflect.TypeOf((*MockSessionAccessor)(nil).GetSessionList), arg0)
+}
+
+// TerminateSession mocks base method.
+func (m *MockSessionAccessor) TerminateSession(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateSession indicates an expected call of TerminateSession.
+func (mr *MockSessionAccessorMockRecorder) TerminateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateSession", reflect.TypeOf((*MockSessionAccessor)(nil).TerminateSession), arg0, arg1)
+}
+
+// MockTrashAccessor is a mock of TrashAccessor interface.
+type MockTrashAccessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashAccessorMockRecorder
+}
+
+// MockTrashAccessorMockRecorder is the mock recorder for MockTrashAccessor.
+type MockTrashAccessorMockRecorder struct {
+	mock *MockTrashAccessor
+}
+
+// NewMockTrashAccessor creates a new mock instance.
+func NewMockTrashAccessor(ctrl *gomock.Controller) *MockTrashAccessor {
+	mock := &MockTrashAccessor{ctrl: ctrl}
+	mock.recorder = &MockTrashAccessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashAccessor) EXPECT() *MockTrashAccessorMockRecorder {
+	return m.recorder
+}
+
+// ListTrash mocks base method.
+func (m *MockTrashAccessor) ListTrash(arg0 context.Context) ([]domain.TrashEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0)
+	ret0, _ := ret[0].([]domain.TrashEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockTrashAccessorMockRecorder) ListTrash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockTrashAccessor)(nil).ListTrash), arg0)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockTrashAccessor) PurgeTrash(arg0 context.Context, arg1 domain.SecretItemType, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockTrashAccessorMockRecorder) PurgeTrash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockTrashAccessor)(nil).PurgeTrash), arg0, arg1, arg2)
+}
+
+// Restore mocks base method.
+func (m *MockTrashAccessor) Restore(arg0 context.Context, arg1 domain.SecretItemType, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTrashAccessorMockRecorder) Restore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTrashAccessor)(nil).Restore), arg0, arg1, arg2)
+}
@@ -3,11 +3,14 @@ package handler
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
+	"os"
 	"sync"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/interceptor"
 	"google.golang.org/grpc"
@@ -40,14 +43,44 @@ func (gh *grpcHandler) FileAccessor(fileAccessor *fileAccessor) *grpcHandler {
 	return gh
 }
 
+func (gh *grpcHandler) SessionService(sessionService *sessionService) *grpcHandler {
+	gh.sessionService = sessionService
+	return gh
+}
+
+func (gh *grpcHandler) TrashService(trashService *trashService) *grpcHandler {
+	gh.trashService = trashService
+	return gh
+}
+
+func (gh *grpcHandler) JWTKeys(keys domain.JWTKeyProvider) *grpcHandler {
+	gh.jwtKeys = keys
+	return gh
+}
+
+func (gh *grpcHandler) RevocationChecker(revChecker interceptor.TokenRevocationChecker) *grpcHandler {
+	gh.revChecker = revChecker
+	return gh
+}
+
+const (
+	tlsClientAuthNone     = "none"
+	tlsClientAuthOptional = "optional"
+	tlsClientAuthRequire  = "require"
+)
+
 type grpcHandler struct {
-	conf         *config.ServerConf
-	s            *grpc.Server
-	regHandler   *regHandler
-	dataAccessor *dataAccessor
-	authService  *authService
-	fileAccessor *fileAccessor
-	wg           sync.WaitGroup
+	conf           *config.ServerConf
+	s              *grpc.Server
+	regHandler     *regHandler
+	dataAccessor   *dataAccessor
+	authService    *authService
+	fileAccessor   *fileAccessor
+	sessionService *sessionService
+	trashService   *trashService
+	revChecker     interceptor.TokenRevocationChecker
+	jwtKeys        domain.JWTKeyProvider
+	wg             sync.WaitGroup
 }
 
 func (grpcHandler *grpcHandler) loadTLSCredentials() (credentials.TransportCredentials, error) {
@@ -57,15 +90,51 @@ func (grpcHandler *grpcHandler) loadTLSCredentials() (credentials.TransportCrede
 		return nil, fmt.Errorf("can't loadTLSCrdentials %w", err)
 	}
 
+	clientAuth, err := tlsClientAuthType(grpcHandler.conf.TLSClientAuth)
+	if err != nil {
+		return nil, fmt.Errorf("can't loadTLSCrdentials %w", err)
+	}
+
 	// Create the credentials and return it
 	config := &tls.Config{
 		Certificates: []tls.Certificate{serverCert},
-		ClientAuth:   tls.NoClientCert,
+		ClientAuth:   clientAuth,
+	}
+
+	if clientAuth != tls.NoClientCert {
+		if grpcHandler.conf.TLSClientCA == "" {
+			return nil, fmt.Errorf("can't loadTLSCrdentials - client CA is not set for client auth mode %s", grpcHandler.conf.TLSClientAuth)
+		}
+
+		pemClientCA, err := os.ReadFile(grpcHandler.conf.TLSClientCA)
+		if err != nil {
+			return nil, fmt.Errorf("can't loadTLSCrdentials %w", err)
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(pemClientCA) {
+			return nil, fmt.Errorf("can't loadTLSCrdentials - failed to add client CA's certificate")
+		}
+		config.ClientCAs = certPool
 	}
 
 	return credentials.NewTLS(config), nil
 }
 
+// tlsClientAuthType maps client auth mode from configuration to tls.ClientAuthType.
+func tlsClientAuthType(mode string) (tls.ClientAuthType, error) {
+	switch mode {
+	case "", tlsClientAuthNone:
+		return tls.NoClientCert, nil
+	case tlsClientAuthOptional:
+		return tls.VerifyClientCertIfGiven, nil
+	case tlsClientAuthRequire:
+		return tls.RequireAndVerifyClientCert, nil
+	default:
+		return tls.NoClientCert, fmt.Errorf("unknown client auth mode %s", mode)
+	}
+}
+
 func (grpcHandler *grpcHandler) Start(srcCtx context.Context) {
 	grpcHandler.wg.Add(1)
 	go func() {
@@ -79,31 +148,9 @@ func (grpcHandler *grpcHandler) Start(srcCtx context.Context) {
 			if err != nil {
 				panic(err)
 			}
-			grpcHandler.s = grpc.NewServer(
-				grpc.Creds(tlsCredentials),
-				grpc.ChainUnaryInterceptor(
-					interceptor.EncrichWithRequestIDUnaryInterceptor(),
-					interceptor.ErrorCodeUnaryInteceptor(),
-					interceptor.JWTUnaryInterceptor([]byte(grpcHandler.conf.TokenSecret),
-						[]string{"proto.DataAccessor", "proto.FileAccessor"},
-					),
-				),
-				grpc.ChainStreamInterceptor(
-					interceptor.EncrichWithRequestIDStreamInterceptor(),
-					interceptor.ErrorCodeStreamInterceptor(),
-					interceptor.JWTStreamInterceptor([]byte(grpcHandler.conf.TokenSecret)),
-				),
-			)
+			grpcHandler.s = grpc.NewServer(append(grpcHandler.interceptors(true), grpc.Creds(tlsCredentials))...)
 		} else {
-			grpcHandler.s = grpc.NewServer(
-				grpc.ChainUnaryInterceptor(
-					interceptor.EncrichWithRequestIDUnaryInterceptor(),
-					interceptor.ErrorCodeUnaryInteceptor(),
-					interceptor.JWTUnaryInterceptor([]byte(grpcHandler.conf.TokenSecret),
-						[]string{"proto.DataAccessor"},
-					),
-				),
-			)
+			grpcHandler.s = grpc.NewServer(grpcHandler.interceptors(false)...)
 		}
 
 		proto.RegisterPingerServer(grpcHandler.s, &pinger{})
@@ -111,12 +158,44 @@ func (grpcHandler *grpcHandler) Start(srcCtx context.Context) {
 		proto.RegisterDataAccessorServer(grpcHandler.s, grpcHandler.dataAccessor)
 		proto.RegisterAuthServiceServer(grpcHandler.s, grpcHandler.authService)
 		proto.RegisterFileAccessorServer(grpcHandler.s, grpcHandler.fileAccessor)
+		proto.RegisterSessionServiceServer(grpcHandler.s, grpcHandler.sessionService)
+		proto.RegisterTrashServiceServer(grpcHandler.s, grpcHandler.trashService)
 		if err := grpcHandler.s.Serve(listen); err != nil {
 			panic(err)
 		}
 	}()
 }
 
+// interceptors returns the same unary and stream interceptor chains with and without TLS;
+// the peer certificate is taken only with TLS.
+func (grpcHandler *grpcHandler) interceptors(withTLS bool) []grpc.ServerOption {
+	unary := []grpc.UnaryServerInterceptor{
+		interceptor.EncrichWithRequestIDUnaryInterceptor(),
+		interceptor.EnrichWithPeerIPUnaryInterceptor(),
+	}
+	if withTLS {
+		unary = append(unary, interceptor.EnrichWithPeerCertUnaryInterceptor())
+	}
+	unary = append(unary,
+		interceptor.EnrichWithClientInfoUnaryInterceptor(),
+		interceptor.ErrorCodeUnaryInteceptor(),
+		interceptor.JWTUnaryInterceptor(grpcHandler.jwtKeys, grpcHandler.revChecker,
+			[]string{"proto.DataAccessor", "proto.FileAccessor", "proto.SessionService", "proto.TrashService", "proto.AuthService/Logout"},
+		),
+		interceptor.ValidationUnaryInterceptor(),
+	)
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(
+			interceptor.EncrichWithRequestIDStreamInterceptor(),
+			interceptor.ErrorCodeStreamInterceptor(),
+			interceptor.JWTStreamInterceptor(grpcHandler.jwtKeys, grpcHandler.revChecker, interceptor.StreamRevocationCheckInterval),
+			interceptor.ValidationStreamInterceptor(),
+		),
+	}
+}
+
 func (grpcHandler *grpcHandler) Stop() {
 	grpcHandler.s.GracefulStop()
 	grpcHandler.wg.Wait()
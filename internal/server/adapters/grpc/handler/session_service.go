@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewSessionService(accessor SessionAccessor) *sessionService {
+	return &sessionService{
+		accessor: accessor,
+	}
+}
+
+type sessionService struct {
+	proto.UnimplementedSessionServiceServer
+	accessor SessionAccessor
+}
+
+func (ss *sessionService) GetSessionList(ctx context.Context, emtpy *empty.Empty) (*proto.SessionListResponse, error) {
+	action := domain.GetAction(1)
+
+	list, err := ss.accessor.GetSessionList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := &proto.SessionListResponse{}
+	for _, session := range list {
+		resp.Sessions = append(resp.Sessions, &proto.SessionInfo{
+			SessionId:     session.SessionID,
+			DeviceName:    session.DeviceName,
+			ClientVersion: session.ClientVersion,
+			PeerIp:        session.PeerIP,
+			CreatedAt:     timestamppb.New(session.CreatedAt),
+			LastSeenAt:    timestamppb.New(session.LastSeenAt),
+			Current:       session.Current,
+		})
+	}
+	return resp, nil
+}
+
+func (ss *sessionService) TerminateSession(ctx context.Context, req *proto.TerminateSessionRequest) (*empty.Empty, error) {
+	action := domain.GetAction(1)
+
+	if err := ss.accessor.TerminateSession(ctx, req.SessionId); err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &empty.Empty{}, nil
+}
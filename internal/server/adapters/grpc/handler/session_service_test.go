@@ -0,0 +1,90 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/handler"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		createdAt := time.Now().Add(-time.Hour).UTC()
+		lastSeenAt := time.Now().UTC()
+
+		mockService := NewMockSessionAccessor(ctrl)
+		mockService.EXPECT().GetSessionList(gomock.Any()).Times(1).Return([]domain.SessionInfo{
+			{
+				SessionID:     "sessionID",
+				DeviceName:    "laptop",
+				ClientVersion: "v1.0.0",
+				PeerIP:        "127.0.0.1",
+				CreatedAt:     createdAt,
+				LastSeenAt:    lastSeenAt,
+				Current:       true,
+			},
+		}, nil)
+
+		sService := handler.NewSessionService(mockService)
+		resp, err := sService.GetSessionList(context.Background(), nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Sessions))
+
+		session := resp.Sessions[0]
+		assert.Equal(t, "sessionID", session.SessionId)
+		assert.Equal(t, "laptop", session.DeviceName)
+		assert.Equal(t, "v1.0.0", session.ClientVersion)
+		assert.Equal(t, "127.0.0.1", session.PeerIp)
+		assert.True(t, createdAt.Equal(session.CreatedAt.AsTime()))
+		assert.True(t, lastSeenAt.Equal(session.LastSeenAt.AsTime()))
+		assert.True(t, session.Current)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockService := NewMockSessionAccessor(ctrl)
+
+		testErr := errors.New("testErr")
+		mockService.EXPECT().GetSessionList(gomock.Any()).Times(1).Return(nil, testErr)
+
+		sService := handler.NewSessionService(mockService)
+		_, err := sService.GetSessionList(context.Background(), nil)
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestTerminateSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockService := NewMockSessionAccessor(ctrl)
+		mockService.EXPECT().TerminateSession(gomock.Any(), gomock.Eq("sessionID")).Times(1).Return(nil)
+
+		sService := handler.NewSessionService(mockService)
+		_, err := sService.TerminateSession(context.Background(), &proto.TerminateSessionRequest{
+			SessionId: "sessionID",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockService := NewMockSessionAccessor(ctrl)
+		mockService.EXPECT().TerminateSession(gomock.Any(), gomock.Any()).Times(1).Return(domain.ErrClientDataIncorrect)
+
+		sService := handler.NewSessionService(mockService)
+		_, err := sService.TerminateSession(context.Background(), &proto.TerminateSessionRequest{
+			SessionId: "sessionID",
+		})
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
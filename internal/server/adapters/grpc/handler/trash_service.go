@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewTrashService(accessor TrashAccessor) *trashService {
+	return &trashService{
+		accessor: accessor,
+	}
+}
+
+type trashService struct {
+	proto.UnimplementedTrashServiceServer
+	accessor TrashAccessor
+}
+
+func (ts *trashService) ListTrash(ctx context.Context, emtpy *empty.Empty) (*proto.ListTrashResponse, error) {
+	action := domain.GetAction(1)
+
+	entries, err := ts.accessor.ListTrash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+
+	resp := &proto.ListTrashResponse{}
+	for _, entry := range entries {
+		pEntry := &proto.TrashEntry{
+			Id:        entry.ID,
+			Name:      entry.Name,
+			DeletedAt: timestamppb.New(entry.DeletedAt),
+			ExpiresAt: timestamppb.New(entry.ExpiresAt),
+		}
+		if entry.Type == domain.TrashFile {
+			pEntry.File = true
+		} else {
+			pEntry.Type = secretItemTypeToProto(entry.Type)
+		}
+		resp.Entries = append(resp.Entries, pEntry)
+	}
+	return resp, nil
+}
+
+func (ts *trashService) Restore(ctx context.Context, req *proto.RestoreRequest) (*empty.Empty, error) {
+	action := domain.GetAction(1)
+
+	if err := ts.accessor.Restore(ctx, trashEntryType(req.File), req.Id); err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (ts *trashService) PurgeTrash(ctx context.Context, req *proto.PurgeTrashRequest) (*empty.Empty, error) {
+	action := domain.GetAction(1)
+
+	if err := ts.accessor.PurgeTrash(ctx, trashEntryType(req.File), req.Id); err != nil {
+		return nil, fmt.Errorf("%v err - %w", action, err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// trashEntryType returns domain.TrashFile for files; the type of the item is not needed to find it by id.
+func trashEntryType(file bool) domain.SecretItemType {
+	if file {
+		return domain.TrashFile
+	}
+	return ""
+}
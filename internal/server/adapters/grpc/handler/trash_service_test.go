@@ -0,0 +1,106 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/handler"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		deletedAt := time.Now().UTC()
+		expiresAt := deletedAt.Add(time.Hour)
+
+		mockService := NewMockTrashAccessor(ctrl)
+		mockService.EXPECT().ListTrash(gomock.Any()).Times(1).Return([]domain.TrashEntry{
+			{ID: "fileID", Type: domain.TrashFile, Name: "file", DeletedAt: deletedAt, ExpiresAt: expiresAt},
+			{ID: "itemID", Type: domain.SecretItemBankCard, Name: "encrypted", DeletedAt: deletedAt, ExpiresAt: expiresAt},
+		}, nil)
+
+		tService := handler.NewTrashService(mockService)
+		resp, err := tService.ListTrash(context.Background(), nil)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Entries))
+
+		file := resp.Entries[0]
+		assert.Equal(t, "fileID", file.Id)
+		assert.True(t, file.File)
+		assert.Equal(t, proto.SecretItemType_SECRET_ITEM_TYPE_UNSPECIFIED, file.Type)
+		assert.Equal(t, "file", file.Name)
+		assert.True(t, deletedAt.Equal(file.DeletedAt.AsTime()))
+		assert.True(t, expiresAt.Equal(file.ExpiresAt.AsTime()))
+
+		item := resp.Entries[1]
+		assert.Equal(t, "itemID", item.Id)
+		assert.False(t, item.File)
+		assert.Equal(t, proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD, item.Type)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockService := NewMockTrashAccessor(ctrl)
+
+		testErr := errors.New("testErr")
+		mockService.EXPECT().ListTrash(gomock.Any()).Times(1).Return(nil, testErr)
+
+		tService := handler.NewTrashService(mockService)
+		_, err := tService.ListTrash(context.Background(), nil)
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestRestore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("file", func(t *testing.T) {
+		mockService := NewMockTrashAccessor(ctrl)
+		mockService.EXPECT().Restore(gomock.Any(), domain.TrashFile, "fileID").Times(1).Return(nil)
+
+		tService := handler.NewTrashService(mockService)
+		_, err := tService.Restore(context.Background(), &proto.RestoreRequest{Id: "fileID", File: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("item_err", func(t *testing.T) {
+		mockService := NewMockTrashAccessor(ctrl)
+		mockService.EXPECT().Restore(gomock.Any(), domain.SecretItemType(""), "itemID").Times(1).Return(domain.ErrClientDataIncorrect)
+
+		tService := handler.NewTrashService(mockService)
+		_, err := tService.Restore(context.Background(), &proto.RestoreRequest{Id: "itemID"})
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
+
+func TestPurgeTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("all", func(t *testing.T) {
+		mockService := NewMockTrashAccessor(ctrl)
+		mockService.EXPECT().PurgeTrash(gomock.Any(), domain.SecretItemType(""), "").Times(1).Return(nil)
+
+		tService := handler.NewTrashService(mockService)
+		_, err := tService.PurgeTrash(context.Background(), &proto.PurgeTrashRequest{})
+		require.NoError(t, err)
+	})
+
+	t.Run("file_err", func(t *testing.T) {
+		mockService := NewMockTrashAccessor(ctrl)
+		mockService.EXPECT().PurgeTrash(gomock.Any(), domain.TrashFile, "fileID").Times(1).Return(domain.ErrDataNotExists)
+
+		tService := handler.NewTrashService(mockService)
+		_, err := tService.PurgeTrash(context.Background(), &proto.PurgeTrashRequest{Id: "fileID", File: true})
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+}
@@ -4,7 +4,11 @@ import (
 	"errors"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	gp "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func MapDomainErrorToGRPCCodeErr(err error) gp.Code {
@@ -29,6 +33,18 @@ func MapDomainErrorToGRPCCodeErr(err error) gp.Code {
 		return gp.InvalidArgument
 	}
 
+	if errors.Is(err, domain.ErrDataConflict) {
+		return gp.Aborted
+	}
+
+	if errors.Is(err, domain.ErrDeprecatedOperation) {
+		return gp.FailedPrecondition
+	}
+
+	if errors.Is(err, domain.ErrAuthTooManyAttempts) {
+		return gp.ResourceExhausted
+	}
+
 	if errors.Is(err, domain.ErrAuthDataIncorrect) {
 		return gp.InvalidArgument
 	}
@@ -39,3 +55,42 @@ func MapDomainErrorToGRPCCodeErr(err error) gp.Code {
 
 	return gp.Internal
 }
+
+// ErrorStatus converts domain error to grpc status.
+// The status message is user-safe; reason, wrong fields and retry delay are sent as
+// errdetails.ErrorInfo, errdetails.BadRequest and errdetails.RetryInfo. Wrapped error details stay on the server.
+func ErrorStatus(err error) *status.Status {
+	st := status.New(MapDomainErrorToGRPCCodeErr(err), domain.UserErrorMessage(err))
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason: string(domain.ErrorReasonOf(err)),
+			Domain: domain.ErrorDomain,
+		},
+	}
+
+	var dErr *domain.DetailedError
+	if errors.As(err, &dErr) {
+		if len(dErr.Fields) > 0 {
+			badRequest := &errdetails.BadRequest{}
+			for _, f := range dErr.Fields {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       f.Field,
+					Description: f.Description,
+				})
+			}
+			details = append(details, badRequest)
+		}
+
+		if dErr.RetryAfter > 0 {
+			details = append(details, &errdetails.RetryInfo{
+				RetryDelay: durationpb.New(dErr.RetryAfter),
+			})
+		}
+	}
+
+	if detailed, dtErr := st.WithDetails(details...); dtErr == nil {
+		return detailed
+	}
+	return st
+}
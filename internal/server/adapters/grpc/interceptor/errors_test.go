@@ -3,10 +3,13 @@ package interceptor_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/interceptor"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 )
 
@@ -16,7 +19,64 @@ func TestMapDomainErrorToGRPCCodeErr(t *testing.T) {
 	assert.Equal(t, codes.PermissionDenied, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("%w err", domain.ErrNotAuthorized)))
 	assert.Equal(t, codes.Internal, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("%w err", domain.ErrDublicateKeyViolation)))
 	assert.Equal(t, codes.InvalidArgument, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("%w err", domain.ErrDataNotExists)))
+	assert.Equal(t, codes.Aborted, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("%w err", domain.ErrDataConflict)))
 	assert.Equal(t, codes.InvalidArgument, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("%w err", domain.ErrAuthDataIncorrect)))
+	assert.Equal(t, codes.ResourceExhausted, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("%w err", domain.ErrAuthTooManyAttempts)))
 	assert.Equal(t, codes.InvalidArgument, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("%w err", domain.ErrClientDataIncorrect)))
+	assert.Equal(t, codes.FailedPrecondition, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("%w err", domain.ErrDeprecatedOperation)))
 	assert.Equal(t, codes.Internal, interceptor.MapDomainErrorToGRPCCodeErr(fmt.Errorf("new")))
 }
+
+func TestErrorStatus(t *testing.T) {
+	t.Run("internal", func(t *testing.T) {
+		st := interceptor.ErrorStatus(fmt.Errorf("%w - pgx: connection refused on 10.0.0.5", domain.ErrServerInternal))
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, domain.ErrServerInternal.Error(), st.Message())
+
+		require.Len(t, st.Details(), 1)
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		assert.Equal(t, string(domain.ReasonInternal), info.Reason)
+		assert.Equal(t, domain.ErrorDomain, info.Domain)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		st := interceptor.ErrorStatus(fmt.Errorf("secret details"))
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, domain.ErrServerInternal.Error(), st.Message())
+	})
+
+	t.Run("domain_error", func(t *testing.T) {
+		st := interceptor.ErrorStatus(fmt.Errorf("%w - card 4000 not found for user 10", domain.ErrDataNotExists))
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, domain.ErrDataNotExists.Error(), st.Message())
+
+		require.Len(t, st.Details(), 1)
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		assert.Equal(t, string(domain.ReasonDataNotExists), info.Reason)
+	})
+
+	t.Run("detailed_error", func(t *testing.T) {
+		dErr := domain.NewDetailedError(domain.ErrAuthTooManyAttempts, "locked").
+			WithRetryAfter(time.Minute).
+			WithFields(domain.FieldViolation{Field: "Email", Description: "locked"})
+		st := interceptor.ErrorStatus(fmt.Errorf("%w - account:test@email is locked", dErr))
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Equal(t, "locked", st.Message())
+
+		require.Len(t, st.Details(), 3)
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		assert.Equal(t, string(domain.ReasonTooManyAttempts), info.Reason)
+
+		badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.FieldViolations, 1)
+		assert.Equal(t, "Email", badRequest.FieldViolations[0].Field)
+
+		retryInfo, ok := st.Details()[2].(*errdetails.RetryInfo)
+		require.True(t, ok)
+		assert.Equal(t, time.Minute, retryInfo.RetryDelay.AsDuration())
+	})
+}
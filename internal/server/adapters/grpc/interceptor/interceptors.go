@@ -2,14 +2,18 @@ package interceptor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"strings"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/peer"
 )
 
 func EncrichWithRequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
@@ -36,14 +40,78 @@ func EncrichWithRequestIDStreamInterceptor() grpc.StreamServerInterceptor {
 	}
 }
 
+// EnrichWithPeerIPUnaryInterceptor stores client ip address in context; is used by brute-force protection.
+func EnrichWithPeerIPUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		return handler(enrichWithPeerIP(ctx), req)
+	}
+}
+
+func enrichWithPeerIP(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ctx
+	}
+
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return domain.EnrichWithPeerIP(ctx, addr)
+}
+
+// EnrichWithPeerCertUnaryInterceptor stores fingerprint of the verified client certificate in context; is used to check account binding on login.
+func EnrichWithPeerCertUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		return handler(enrichWithPeerCert(ctx), req)
+	}
+}
+
+func enrichWithPeerCert(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return ctx
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ctx
+	}
+
+	// only certificates verified against client CA are taken into account
+	if len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ctx
+	}
+
+	return domain.EnrichWithPeerCertFingerprint(ctx, domain.CertFingerprint(tlsInfo.State.VerifiedChains[0][0]))
+}
+
+// EnrichWithClientInfoUnaryInterceptor stores client device name and version from metadata in context; is used on session creation.
+func EnrichWithClientInfoUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		clientInfo := &domain.ClientInfo{}
+		if values := md[domain.DeviceNameMetadataName]; len(values) > 0 {
+			clientInfo.DeviceName = values[0]
+		}
+		if values := md[domain.ClientVersionMetadataName]; len(values) > 0 {
+			clientInfo.ClientVersion = values[0]
+		}
+		return handler(domain.EnrichWithClientInfo(ctx, clientInfo), req)
+	}
+}
+
 func ErrorCodeUnaryInteceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		resp, err := handler(ctx, req)
 		if err != nil {
 			log := domain.GetCtxLogger(ctx)
 			log.Infow("error", "err", err.Error())
-			resCode := MapDomainErrorToGRPCCodeErr(err)
-			return resp, status.Error(resCode, "")
+			return resp, ErrorStatus(err).Err()
 		}
 		return resp, nil
 	}
@@ -55,14 +123,19 @@ func ErrorCodeStreamInterceptor() grpc.StreamServerInterceptor {
 		if err != nil {
 			log := domain.GetCtxLogger(ss.Context())
 			log.Infow("error", "err", err.Error())
-			resCode := MapDomainErrorToGRPCCodeErr(err)
-			return status.Error(resCode, "")
+			return ErrorStatus(err).Err()
 		}
 		return nil
 	}
 }
 
-func JWTUnaryInterceptor(tokenSecret []byte, needToBeAuthentificated []string) grpc.UnaryServerInterceptor {
+// TokenRevocationChecker checks jwt token id in revocation list and the token session
+type TokenRevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, claims *domain.Claims) (bool, error)
+}
+
+// JWTUnaryInterceptor checks jwt token for listed methods; revocation check is skipped if revChecker is nil.
+func JWTUnaryInterceptor(keys domain.JWTKeyProvider, revChecker TokenRevocationChecker, needToBeAuthentificated []string) grpc.UnaryServerInterceptor {
 	needAuthentificatedFn := func(method string) bool {
 		for _, v := range needToBeAuthentificated {
 			if strings.Contains(method, v) {
@@ -74,20 +147,10 @@ func JWTUnaryInterceptor(tokenSecret []byte, needToBeAuthentificated []string) g
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		method := info.FullMethod
 		if needAuthentificatedFn(method) {
-			md, ok := metadata.FromIncomingContext(ctx)
-			if !ok {
-				return nil, fmt.Errorf("%w - %v", domain.ErrNotAuthorized, "metadata is not provided")
-			}
-			values := md[domain.AuthorizationMetadataTokenName]
-			if len(values) == 0 {
-				return nil, fmt.Errorf("%w - %v", domain.ErrNotAuthorized, "authorization token is not provided")
-			}
-			accessToken := values[0]
-			userID, err := domain.ParseJWTToken(tokenSecret, domain.JWTToken(accessToken))
+			eCtx, err := authentificate(ctx, keys, revChecker)
 			if err != nil {
-				return nil, fmt.Errorf("%w - %v", domain.ErrNotAuthorized, err.Error())
+				return nil, err
 			}
-			eCtx := domain.EnrichWithUserID(ctx, userID)
 			return handler(eCtx, req)
 		} else {
 			return handler(ctx, req)
@@ -95,7 +158,12 @@ func JWTUnaryInterceptor(tokenSecret []byte, needToBeAuthentificated []string) g
 	}
 }
 
-func JWTStreamInterceptor(tokenSecret []byte) grpc.StreamServerInterceptor {
+// StreamRevocationCheckInterval is the period of the revocation check for the open streams.
+const StreamRevocationCheckInterval = 30 * time.Second
+
+// JWTStreamInterceptor checks jwt token when the stream is opened. The revocation is checked again every
+// checkInterval: the stream of the revoked token or of the terminated session is closed with ErrNotAuthorized.
+func JWTStreamInterceptor(keys domain.JWTKeyProvider, revChecker TokenRevocationChecker, checkInterval time.Duration) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 
 		// pass stream context complexity
@@ -103,26 +171,90 @@ func JWTStreamInterceptor(tokenSecret []byte) grpc.StreamServerInterceptor {
 		// see https://github.com/fru-io/api-common/blob/main/interceptors/state.go#L207
 		w := newStreamContextWrapper(ss)
 
-		md, ok := metadata.FromIncomingContext(w.Context())
-		if !ok {
-			return fmt.Errorf("%w - %v", domain.ErrNotAuthorized, "metadata is not provided")
+		eCtx, err := authentificate(w.Context(), keys, revChecker)
+		if err != nil {
+			return err
 		}
-		values := md[domain.AuthorizationMetadataTokenName]
-		if len(values) == 0 {
-			return fmt.Errorf("%w - %v", domain.ErrNotAuthorized, "authorization token is not provided")
+
+		if revChecker == nil || checkInterval <= 0 {
+			w.SetContext(eCtx)
+			return handler(srv, w)
 		}
-		accessToken := values[0]
-		userID, err := domain.ParseJWTToken(tokenSecret, domain.JWTToken(accessToken))
+
+		claims, err := domain.GetClaims(eCtx)
 		if err != nil {
-			return fmt.Errorf("%w - %v", domain.ErrNotAuthorized, err.Error())
+			return err
 		}
-		eCtx := domain.EnrichWithUserID(w.Context(), userID)
-		w.SetContext(eCtx)
 
-		return handler(srv, w)
+		streamCtx, cancelFn := context.WithCancelCause(eCtx)
+		defer cancelFn(nil)
+		w.SetContext(streamCtx)
+		go watchRevocation(streamCtx, cancelFn, revChecker, claims, checkInterval)
+
+		err = handler(srv, w)
+		if cause := context.Cause(streamCtx); errors.Is(cause, domain.ErrNotAuthorized) {
+			return cause
+		}
+		return err
+	}
+}
+
+// watchRevocation cancels the stream context when the token is revoked or the session is terminated;
+// the stream is not closed if the check is failed.
+func watchRevocation(ctx context.Context, cancelFn context.CancelCauseFunc, revChecker TokenRevocationChecker,
+	claims *domain.Claims, checkInterval time.Duration) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			revoked, err := revChecker.IsTokenRevoked(ctx, claims)
+			if err != nil {
+				log := domain.GetCtxLogger(ctx)
+				log.Infow("watchRevocation", "err", err.Error())
+				continue
+			}
+			if revoked {
+				cancelFn(fmt.Errorf("%w - %v", domain.ErrNotAuthorized, "token is revoked"))
+				return
+			}
+		}
 	}
 }
 
+// authentificate checks jwt token from metadata and enriches context with user id and token claims.
+func authentificate(ctx context.Context, keys domain.JWTKeyProvider, revChecker TokenRevocationChecker) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w - %v", domain.ErrNotAuthorized, "metadata is not provided")
+	}
+	values := md[domain.AuthorizationMetadataTokenName]
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%w - %v", domain.ErrNotAuthorized, "authorization token is not provided")
+	}
+	accessToken := values[0]
+	claims, err := domain.ParseJWTClaims(keys, domain.JWTToken(accessToken))
+	if err != nil {
+		return nil, fmt.Errorf("%w - %v", domain.ErrNotAuthorized, err.Error())
+	}
+
+	if revChecker != nil {
+		revoked, err := revChecker.IsTokenRevoked(ctx, claims)
+		if err != nil {
+			return nil, fmt.Errorf("revocation check err - %w", err)
+		}
+		if revoked {
+			return nil, fmt.Errorf("%w - %v", domain.ErrNotAuthorized, "token is revoked")
+		}
+	}
+
+	eCtx := domain.EnrichWithUserID(ctx, claims.UserID)
+	return domain.EnrichWithClaims(eCtx, claims), nil
+}
+
 type StreamContextWrapper interface {
 	grpc.ServerStream
 	SetContext(context.Context)
@@ -141,6 +273,22 @@ func (w *wrapper) SetContext(ctx context.Context) {
 	w.ctx = ctx
 }
 
+// SendMsg fails if the stream context is cancelled, e.g. the session is terminated.
+func (w *wrapper) SendMsg(m any) error {
+	if w.ctx.Err() != nil {
+		return context.Cause(w.ctx)
+	}
+	return w.ServerStream.SendMsg(m)
+}
+
+// RecvMsg fails if the stream context is cancelled, e.g. the session is terminated.
+func (w *wrapper) RecvMsg(m any) error {
+	if w.ctx.Err() != nil {
+		return context.Cause(w.ctx)
+	}
+	return w.ServerStream.RecvMsg(m)
+}
+
 func newStreamContextWrapper(inner grpc.ServerStream) StreamContextWrapper {
 	ctx := inner.Context()
 	return &wrapper{
@@ -2,10 +2,18 @@ package interceptor_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"fmt"
+	"math/big"
 	"net"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -16,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	gp "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -86,6 +95,258 @@ func TestEncrichWithRequestIDUnaryInterceptor(t *testing.T) {
 	wg.Wait()
 }
 
+type testPeerIPPinger struct {
+	proto.UnimplementedPingerServer
+}
+
+func (tr *testPeerIPPinger) Ping(ctx context.Context, empty *empty.Empty) (*empty.Empty, error) {
+	ip := domain.GetPeerIP(ctx)
+	if ip != "127.0.0.1" && ip != "::1" {
+		return nil, fmt.Errorf("unexpected peer ip %s", ip)
+	}
+	return nil, nil
+}
+
+func TestEnrichWithPeerIPUnaryInterceptor(t *testing.T) {
+	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	l, err := net.ListenTCP("tcp", addr)
+	require.NoError(t, err)
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.EnrichWithPeerIPUnaryInterceptor(),
+		),
+	)
+	ctx, stopFn := context.WithCancel(context.Background())
+	defer stopFn()
+
+	proto.RegisterPingerServer(s, &testPeerIPPinger{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err = s.Serve(l)
+		require.NoError(t, err)
+
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+
+	// client
+	port := l.Addr().(*net.TCPAddr).Port
+	client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	pinger := proto.NewPingerClient(client)
+
+	_, err = pinger.Ping(ctx, nil)
+	require.NoError(t, err)
+
+	stopFn()
+	wg.Wait()
+}
+
+type testPeerCertPinger struct {
+	proto.UnimplementedPingerServer
+	fingerprint string
+}
+
+func (tr *testPeerCertPinger) Ping(ctx context.Context, empty *empty.Empty) (*empty.Empty, error) {
+	fingerprint := domain.GetPeerCertFingerprint(ctx)
+	if fingerprint != tr.fingerprint {
+		return nil, fmt.Errorf("unexpected peer cert fingerprint %s", fingerprint)
+	}
+	return nil, nil
+}
+
+func TestEnrichWithPeerCertUnaryInterceptor(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	caPool := x509.NewCertPool()
+	caPool.AddCert(caCert)
+
+	issue := func(serial int64, extKeyUsage x509.ExtKeyUsage) tls.Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "localhost"},
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+
+		leaf, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+
+		return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+	}
+
+	serverCert := issue(2, x509.ExtKeyUsageServerAuth)
+	clientCert := issue(3, x509.ExtKeyUsageClientAuth)
+
+	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	l, err := net.ListenTCP("tcp", addr)
+	require.NoError(t, err)
+
+	s := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{serverCert},
+			ClientAuth:   tls.VerifyClientCertIfGiven,
+			ClientCAs:    caPool,
+		})),
+		grpc.ChainUnaryInterceptor(
+			interceptor.EnrichWithPeerCertUnaryInterceptor(),
+		),
+	)
+	ctx, stopFn := context.WithCancel(context.Background())
+	defer stopFn()
+
+	pinger := &testPeerCertPinger{}
+	proto.RegisterPingerServer(s, pinger)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err = s.Serve(l)
+		require.NoError(t, err)
+
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+
+	port := l.Addr().(*net.TCPAddr).Port
+
+	// client with certificate
+	pinger.fingerprint = domain.CertFingerprint(clientCert.Leaf)
+	client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:      caPool,
+			Certificates: []tls.Certificate{clientCert},
+		})))
+	require.NoError(t, err)
+
+	_, err = proto.NewPingerClient(client).Ping(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, client.Close())
+
+	// client without certificate
+	pinger.fingerprint = ""
+	client, err = grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs: caPool,
+		})))
+	require.NoError(t, err)
+
+	_, err = proto.NewPingerClient(client).Ping(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, client.Close())
+
+	stopFn()
+	wg.Wait()
+}
+
+type testClientInfoPinger struct {
+	proto.UnimplementedPingerServer
+}
+
+func (tr *testClientInfoPinger) Ping(ctx context.Context, empty *empty.Empty) (*empty.Empty, error) {
+	info := domain.GetClientInfo(ctx)
+	if info.DeviceName != "laptop" || info.ClientVersion != "v1.0.0" {
+		return nil, fmt.Errorf("unexpected client info %v", info)
+	}
+	return nil, nil
+}
+
+func TestEnrichWithClientInfoUnaryInterceptor(t *testing.T) {
+	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	l, err := net.ListenTCP("tcp", addr)
+	require.NoError(t, err)
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.EnrichWithClientInfoUnaryInterceptor(),
+		),
+	)
+	ctx, stopFn := context.WithCancel(context.Background())
+	defer stopFn()
+
+	proto.RegisterPingerServer(s, &testClientInfoPinger{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err = s.Serve(l)
+		require.NoError(t, err)
+
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+
+	// client
+	port := l.Addr().(*net.TCPAddr).Port
+	client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	pinger := proto.NewPingerClient(client)
+
+	infoCtx := metadata.AppendToOutgoingContext(ctx,
+		domain.DeviceNameMetadataName, "laptop",
+		domain.ClientVersionMetadataName, "v1.0.0")
+	_, err = pinger.Ping(infoCtx, nil)
+	require.NoError(t, err)
+
+	stopFn()
+	wg.Wait()
+}
+
 type testErrPinger struct {
 	proto.UnimplementedPingerServer
 	err error
@@ -220,11 +481,11 @@ func TestJWTInterceptor(t *testing.T) {
 		l, err := net.ListenTCP("tcp", addr)
 		require.NoError(t, err)
 
-		tokenSecret := "tokenSecret"
+		keys := newTestJWTKeys(t)
 
 		s := grpc.NewServer(
 			grpc.ChainUnaryInterceptor(
-				interceptor.JWTUnaryInterceptor([]byte(tokenSecret), []string{}),
+				interceptor.JWTUnaryInterceptor(keys, nil, []string{}),
 			),
 		)
 		ctx, stopFn := context.WithCancel(context.Background())
@@ -270,12 +531,12 @@ func TestJWTInterceptor(t *testing.T) {
 		l, err := net.ListenTCP("tcp", addr)
 		require.NoError(t, err)
 
-		tokenSecret := "tokenSecret"
+		keys := newTestJWTKeys(t)
 
 		s := grpc.NewServer(
 			grpc.ChainUnaryInterceptor(
 				interceptor.ErrorCodeUnaryInteceptor(),
-				interceptor.JWTUnaryInterceptor([]byte(tokenSecret), []string{"proto.Pinger"}),
+				interceptor.JWTUnaryInterceptor(keys, nil, []string{"proto.Pinger"}),
 			),
 		)
 		ctx, stopFn := context.WithCancel(context.Background())
@@ -324,12 +585,12 @@ func TestJWTInterceptor(t *testing.T) {
 		l, err := net.ListenTCP("tcp", addr)
 		require.NoError(t, err)
 
-		tokenSecret := "tokenSecret"
+		keys := newTestJWTKeys(t)
 
 		s := grpc.NewServer(
 			grpc.ChainUnaryInterceptor(
 				interceptor.ErrorCodeUnaryInteceptor(),
-				interceptor.JWTUnaryInterceptor([]byte(tokenSecret), []string{"proto.Pinger"}),
+				interceptor.JWTUnaryInterceptor(keys, nil, []string{"proto.Pinger"}),
 			),
 		)
 		ctx, stopFn := context.WithCancel(context.Background())
@@ -380,12 +641,12 @@ func TestJWTInterceptor(t *testing.T) {
 		l, err := net.ListenTCP("tcp", addr)
 		require.NoError(t, err)
 
-		tokenSecret := "tokenSecret"
+		keys := newTestJWTKeys(t)
 
 		s := grpc.NewServer(
 			grpc.ChainUnaryInterceptor(
 				interceptor.ErrorCodeUnaryInteceptor(),
-				interceptor.JWTUnaryInterceptor([]byte(tokenSecret), []string{"proto.Pinger"}),
+				interceptor.JWTUnaryInterceptor(keys, nil, []string{"proto.Pinger"}),
 			),
 		)
 		ctx, stopFn := context.WithCancel(context.Background())
@@ -417,7 +678,7 @@ func TestJWTInterceptor(t *testing.T) {
 
 		pinger := proto.NewPingerClient(client)
 
-		jwtTok, err := domain.CreateJWTToken([]byte(tokenSecret), 10*time.Second, 1)
+		jwtTok, err := domain.CreateJWTToken(keys, 10*time.Second, 1, "sessionID")
 		require.NoError(t, err)
 		authCtx := metadata.AppendToOutgoingContext(ctx, domain.AuthorizationMetadataTokenName, string(jwtTok))
 
@@ -429,6 +690,137 @@ func TestJWTInterceptor(t *testing.T) {
 		stopFn()
 		wg.Wait()
 	})
+
+	t.Run("jwt_unknown_key", func(t *testing.T) {
+		addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
+		require.NoError(t, err)
+
+		l, err := net.ListenTCP("tcp", addr)
+		require.NoError(t, err)
+
+		keys := newTestJWTKeys(t)
+
+		s := grpc.NewServer(
+			grpc.ChainUnaryInterceptor(
+				interceptor.ErrorCodeUnaryInteceptor(),
+				interceptor.JWTUnaryInterceptor(keys, nil, []string{"proto.Pinger"}),
+			),
+		)
+		ctx, stopFn := context.WithCancel(context.Background())
+		defer stopFn()
+
+		proto.RegisterPingerServer(s, &testErrPinger{err: nil})
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err = s.Serve(l)
+			require.NoError(t, err)
+
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ctx.Done()
+			s.GracefulStop()
+		}()
+
+		// client
+		port := l.Addr().(*net.TCPAddr).Port
+		client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+
+		pinger := proto.NewPingerClient(client)
+
+		// token is signed by the key which is not in the key set
+		jwtTok, err := domain.CreateJWTToken(newTestJWTKeys(t), 10*time.Second, 1, "sessionID")
+		require.NoError(t, err)
+		authCtx := metadata.AppendToOutgoingContext(ctx, domain.AuthorizationMetadataTokenName, string(jwtTok))
+
+		_, err = pinger.Ping(authCtx, nil)
+		e, ok := status.FromError(err)
+		require.True(t, ok)
+
+		require.Equal(t, gp.PermissionDenied, e.Code())
+
+		stopFn()
+		wg.Wait()
+	})
+
+	t.Run("jwt_revoked", func(t *testing.T) {
+		addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
+		require.NoError(t, err)
+
+		l, err := net.ListenTCP("tcp", addr)
+		require.NoError(t, err)
+
+		keys := newTestJWTKeys(t)
+
+		jwtTok, err := domain.CreateJWTToken(keys, 10*time.Second, 1, "sessionID")
+		require.NoError(t, err)
+
+		claims, err := domain.ParseJWTClaims(keys, jwtTok)
+		require.NoError(t, err)
+
+		revChecker := &testRevocationChecker{revoked: claims.ID}
+
+		s := grpc.NewServer(
+			grpc.ChainUnaryInterceptor(
+				interceptor.ErrorCodeUnaryInteceptor(),
+				interceptor.JWTUnaryInterceptor(keys, revChecker, []string{"proto.Pinger"}),
+			),
+		)
+		ctx, stopFn := context.WithCancel(context.Background())
+		defer stopFn()
+
+		proto.RegisterPingerServer(s, &testErrPinger{err: nil})
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err = s.Serve(l)
+			require.NoError(t, err)
+
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ctx.Done()
+			s.GracefulStop()
+		}()
+
+		// client
+		port := l.Addr().(*net.TCPAddr).Port
+		client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+
+		pinger := proto.NewPingerClient(client)
+
+		authCtx := metadata.AppendToOutgoingContext(ctx, domain.AuthorizationMetadataTokenName, string(jwtTok))
+
+		_, err = pinger.Ping(authCtx, nil)
+		e, ok := status.FromError(err)
+		require.True(t, ok)
+
+		require.Equal(t, gp.PermissionDenied, e.Code())
+
+		stopFn()
+		wg.Wait()
+	})
+}
+
+type testRevocationChecker struct {
+	revoked string
+}
+
+func (rc *testRevocationChecker) IsTokenRevoked(ctx context.Context, claims *domain.Claims) (bool, error) {
+	return claims.ID == rc.revoked, nil
 }
 
 type testLoggerKeyAccessor struct {
@@ -609,14 +1001,14 @@ func TestJWTStreamInterceptor(t *testing.T) {
 		l, err := net.ListenTCP("tcp", addr)
 		require.NoError(t, err)
 
-		tokenSecret := []byte("tokenSecret")
+		keys := newTestJWTKeys(t)
 		userID := domain.UserID(10)
-		jwtTok, err := domain.CreateJWTToken(tokenSecret, 5*time.Second, userID)
+		jwtTok, err := domain.CreateJWTToken(keys, 5*time.Second, userID, "sessionID")
 		require.NoError(t, err)
 
 		s := grpc.NewServer(
 			grpc.ChainStreamInterceptor(
-				interceptor.JWTStreamInterceptor(tokenSecret),
+				interceptor.JWTStreamInterceptor(keys, nil, interceptor.StreamRevocationCheckInterval),
 			),
 		)
 		ctx, stopFn := context.WithTimeout(context.Background(), 3*time.Second)
@@ -678,3 +1070,90 @@ func TestJWTStreamInterceptor(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+type testWatchAccessor struct {
+	proto.UnimplementedDataAccessorServer
+	started chan struct{}
+}
+
+func (wa *testWatchAccessor) Watch(req *proto.WatchRequest, stream proto.DataAccessor_WatchServer) error {
+	close(wa.started)
+	<-stream.Context().Done()
+	return stream.Context().Err()
+}
+
+// testSessionChecker reports the token revoked after the session is terminated
+type testSessionChecker struct {
+	terminated atomic.Bool
+}
+
+func (sc *testSessionChecker) IsTokenRevoked(ctx context.Context, claims *domain.Claims) (bool, error) {
+	return sc.terminated.Load(), nil
+}
+
+func TestJWTStreamInterceptorSessionTerminated(t *testing.T) {
+	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	l, err := net.ListenTCP("tcp", addr)
+	require.NoError(t, err)
+
+	keys := newTestJWTKeys(t)
+	jwtTok, err := domain.CreateJWTToken(keys, 10*time.Second, 1, "sessionID")
+	require.NoError(t, err)
+
+	revChecker := &testSessionChecker{}
+	s := grpc.NewServer(
+		grpc.ChainStreamInterceptor(
+			interceptor.ErrorCodeStreamInterceptor(),
+			interceptor.JWTStreamInterceptor(keys, revChecker, 50*time.Millisecond),
+		),
+	)
+
+	watchAccessor := &testWatchAccessor{started: make(chan struct{})}
+	proto.RegisterDataAccessorServer(s, watchAccessor)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = s.Serve(l)
+	}()
+	defer func() {
+		s.Stop()
+		wg.Wait()
+	}()
+
+	port := l.Addr().(*net.TCPAddr).Port
+	client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancelFn()
+
+	authCtx := metadata.AppendToOutgoingContext(ctx, domain.AuthorizationMetadataTokenName, string(jwtTok))
+	watchClient, err := proto.NewDataAccessorClient(client).Watch(authCtx, &proto.WatchRequest{})
+	require.NoError(t, err)
+
+	select {
+	case <-watchAccessor.started:
+	case <-ctx.Done():
+		require.NoError(t, ctx.Err())
+	}
+
+	// the open stream is closed after the session is terminated
+	revChecker.terminated.Store(true)
+
+	_, err = watchClient.Recv()
+	e, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, gp.PermissionDenied, e.Code())
+}
+
+func newTestJWTKeys(t *testing.T) *domain.JWTKeySet {
+	signingKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmEdDSA)
+	require.NoError(t, err)
+	return domain.NewJWTKeySet(signingKey)
+}
@@ -0,0 +1,94 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"google.golang.org/grpc"
+)
+
+// validatorAll is implemented by messages generated by protoc-gen-validate.
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// fieldValidationError is implemented by generated <Message>ValidationError types.
+type fieldValidationError interface {
+	Field() string
+	Reason() string
+}
+
+// multiValidationError is implemented by generated <Message>MultiError types.
+type multiValidationError interface {
+	AllErrors() []error
+}
+
+// ValidationUnaryInterceptor checks request against proto validate rules.
+func ValidationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if err := validateRequest(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamInterceptor checks every received message against proto validate rules.
+func ValidationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{
+			ServerStream: ss,
+		})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m)
+}
+
+// validateRequest returns nil for messages without generated validators.
+func validateRequest(req any) error {
+	v, ok := req.(validatorAll)
+	if !ok {
+		return nil
+	}
+
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	return newRequestValidationError(err)
+}
+
+// newRequestValidationError returns [domain.DetailedError] with field violations;
+// ErrorCode interceptors send them to the client as errdetails.BadRequest.
+func newRequestValidationError(err error) *domain.DetailedError {
+	dErr := domain.NewDetailedErrorByReason(domain.ReasonValidationFailed, "request validation failed")
+	for _, e := range flattenValidationErrors(err) {
+		var fErr fieldValidationError
+		if errors.As(e, &fErr) {
+			dErr.WithFields(domain.FieldViolation{
+				Field:       fErr.Field(),
+				Description: fErr.Reason(),
+			})
+		}
+	}
+	return dErr
+}
+
+func flattenValidationErrors(err error) []error {
+	var multiErr multiValidationError
+	if errors.As(err, &multiErr) {
+		return multiErr.AllErrors()
+	}
+	return []error{err}
+}
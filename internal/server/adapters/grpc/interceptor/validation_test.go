@@ -0,0 +1,195 @@
+package interceptor_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/proto"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/grpc/interceptor"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	gp "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type testValidationServer struct {
+	proto.UnimplementedDataAccessorServer
+	proto.UnimplementedFileAccessorServer
+}
+
+func (ts *testValidationServer) CreateItem(ctx context.Context, req *proto.CreateItemRequest) (*proto.CreateItemResponse, error) {
+	return &proto.CreateItemResponse{Id: "00000000-0000-0000-0000-000000000001"}, nil
+}
+
+func (ts *testValidationServer) LoadFile(req *proto.LoadFileRequest, stream proto.FileAccessor_LoadFileServer) error {
+	return nil
+}
+
+func startValidationServer(t *testing.T) (*grpc.ClientConn, func()) {
+	t.Helper()
+	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	l, err := net.ListenTCP("tcp", addr)
+	require.NoError(t, err)
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.ErrorCodeUnaryInteceptor(),
+			interceptor.ValidationUnaryInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.ErrorCodeStreamInterceptor(),
+			interceptor.ValidationStreamInterceptor(),
+		),
+	)
+
+	srv := &testValidationServer{}
+	proto.RegisterDataAccessorServer(s, srv)
+	proto.RegisterFileAccessorServer(s, srv)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := s.Serve(l)
+		require.NoError(t, err)
+	}()
+
+	port := l.Addr().(*net.TCPAddr).Port
+	client, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	return client, func() {
+		require.NoError(t, client.Close())
+		s.GracefulStop()
+		wg.Wait()
+	}
+}
+
+func requireFieldViolations(t *testing.T, err error, fields ...string) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, gp.InvalidArgument, st.Code())
+
+	var violations []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				require.NotEmpty(t, v.Description)
+				violations = append(violations, v.Field)
+			}
+		}
+	}
+	require.ElementsMatch(t, fields, violations)
+}
+
+func TestValidationUnaryInterceptor(t *testing.T) {
+	client, stopFn := startValidationServer(t)
+	defer stopFn()
+
+	dataClient := proto.NewDataAccessorClient(client)
+
+	t.Run("ok", func(t *testing.T) {
+		_, err := dataClient.CreateItem(context.Background(), &proto.CreateItemRequest{
+			Type:      proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD,
+			Name:      "name",
+			LookupKey: "key",
+			Content:   "content",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("lookup_key_too_long", func(t *testing.T) {
+		_, err := dataClient.CreateItem(context.Background(), &proto.CreateItemRequest{
+			Type:      proto.SecretItemType_SECRET_ITEM_TYPE_BANK_CARD,
+			Name:      "name",
+			LookupKey: strings.Repeat("k", 129),
+			Content:   "content",
+		})
+		requireFieldViolations(t, err, "LookupKey")
+	})
+
+	t.Run("all_violations", func(t *testing.T) {
+		_, err := dataClient.CreateItem(context.Background(), &proto.CreateItemRequest{})
+		requireFieldViolations(t, err, "Type", "Name", "Content")
+	})
+
+	t.Run("deprecated_wrong_card_number", func(t *testing.T) {
+		_, err := dataClient.CreateBankCard(context.Background(), &proto.CreateBankCardRequest{
+			Number:  "4000-0000",
+			Content: "content",
+		})
+		requireFieldViolations(t, err, "Number")
+	})
+
+	t.Run("deprecated_all_violations", func(t *testing.T) {
+		_, err := dataClient.CreateUserPasswordData(context.Background(), &proto.CreateUserPasswordDataRequest{})
+		requireFieldViolations(t, err, "Hint", "Content")
+	})
+
+	t.Run("wrong_item_id", func(t *testing.T) {
+		_, err := dataClient.GetItem(context.Background(), &proto.GetItemRequest{
+			Id: "1",
+		})
+		requireFieldViolations(t, err, "Id")
+	})
+
+	t.Run("revision_not_set", func(t *testing.T) {
+		_, err := dataClient.UpdateItem(context.Background(), &proto.UpdateItemRequest{
+			Id:      "00000000-0000-0000-0000-000000000001",
+			Name:    "name",
+			Content: "content",
+		})
+		requireFieldViolations(t, err, "Revision")
+	})
+
+	t.Run("negative_change_seq", func(t *testing.T) {
+		_, err := dataClient.GetChangesSince(context.Background(), &proto.GetChangesSinceRequest{
+			Seq: -1,
+		})
+		requireFieldViolations(t, err, "Seq")
+	})
+
+	t.Run("item_type_not_set", func(t *testing.T) {
+		_, err := dataClient.CreateItem(context.Background(), &proto.CreateItemRequest{
+			Name:    "name",
+			Content: "content",
+		})
+		requireFieldViolations(t, err, "Type")
+	})
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	client, stopFn := startValidationServer(t)
+	defer stopFn()
+
+	fileClient := proto.NewFileAccessorClient(client)
+
+	t.Run("ok", func(t *testing.T) {
+		stream, err := fileClient.LoadFile(context.Background(), &proto.LoadFileRequest{
+			Name: "file.txt",
+		})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("wrong_name", func(t *testing.T) {
+		stream, err := fileClient.LoadFile(context.Background(), &proto.LoadFileRequest{
+			Name: "../file.txt",
+		})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		requireFieldViolations(t, err, "Name")
+	})
+}
@@ -0,0 +1,2 @@
+// Package jwtkeys contains jwt signing keys storage based on file system
+package jwtkeys
@@ -0,0 +1,317 @@
+package jwtkeys
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// Key directory layout:
+//
+//	active      - id of the key new tokens are signed with
+//	<kid>.key   - PKCS8 private key of the active key
+//	<kid>.pub   - PKIX public key; retired keys have only public part
+const (
+	activeFileName   = "active"
+	privateKeySuffix = ".key"
+	publicKeySuffix  = ".pub"
+)
+
+// NewKeyStore loads keys from the directory and reloads them periodically, so a rotation
+// made by another process is picked up without restart.
+func NewKeyStore(ctx context.Context, conf *config.ServerConf) (*keyStore, error) {
+	ks := &keyStore{
+		dir: conf.JWTKeysDir,
+	}
+
+	if err := ks.Reload(); err != nil {
+		return nil, err
+	}
+
+	if conf.JWTKeysReload > 0 {
+		go ks.reloadLoop(ctx, conf.JWTKeysReload)
+	}
+	return ks, nil
+}
+
+var _ domain.JWTKeyProvider = (*keyStore)(nil)
+
+type keyStore struct {
+	dir  string
+	keys atomic.Pointer[domain.JWTKeySet]
+}
+
+func (ks *keyStore) SigningKey() *domain.JWTSigningKey {
+	return ks.keys.Load().SigningKey()
+}
+
+func (ks *keyStore) VerificationKey(keyID string) (*domain.JWTVerificationKey, bool) {
+	return ks.keys.Load().VerificationKey(keyID)
+}
+
+// Reload reads keys from the directory; the previous key set is kept on error.
+func (ks *keyStore) Reload() error {
+	keys, err := LoadKeySet(ks.dir)
+	if err != nil {
+		return err
+	}
+	ks.keys.Store(keys)
+	return nil
+}
+
+func (ks *keyStore) reloadLoop(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+			if err := ks.Reload(); err != nil {
+				log := domain.GetApplicationLogger()
+				log.Errorw("jwt keys reload", "err", err.Error())
+			}
+		}
+	}
+}
+
+// LoadKeySet reads the active signing key and all public keys from the directory.
+func LoadKeySet(dir string) (*domain.JWTKeySet, error) {
+	activeID, err := readActiveKeyID(dir)
+	if err != nil {
+		return nil, err
+	}
+	if activeID == "" {
+		return nil, fmt.Errorf("%w: no active jwt key in %s, run 'server keys rotate'", domain.ErrServerInternal, dir)
+	}
+
+	signingKey, err := readPrivateKey(dir, activeID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyIDs, err := listPublicKeyIDs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var retiredKeys []*domain.JWTVerificationKey
+	for _, keyID := range keyIDs {
+		if keyID == activeID {
+			continue
+		}
+		key, err := readPublicKey(dir, keyID)
+		if err != nil {
+			return nil, err
+		}
+		retiredKeys = append(retiredKeys, key)
+	}
+
+	return domain.NewJWTKeySet(signingKey, retiredKeys...), nil
+}
+
+// Rotate creates new active key and retires the previous one. Retired public keys are kept
+// while tokens signed by them may be valid (retiredTTL - jwt token lifetime) and are removed after.
+func Rotate(dir string, alg domain.JWTKeyAlgorithm, retiredTTL time.Duration) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("%w: can't create jwt keys dir - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	previousID, err := readActiveKeyID(dir)
+	if err != nil {
+		return "", err
+	}
+
+	signingKey, err := domain.GenerateJWTSigningKey(alg)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writeKey(dir, signingKey); err != nil {
+		return "", err
+	}
+
+	if err := writeFileAtomic(filepath.Join(dir, activeFileName), []byte(signingKey.KeyID+"\n"), 0600); err != nil {
+		return "", err
+	}
+
+	if previousID != "" {
+		if err := retireKey(dir, previousID); err != nil {
+			return "", err
+		}
+	}
+
+	if err := pruneRetiredKeys(dir, signingKey.KeyID, retiredTTL); err != nil {
+		return "", err
+	}
+
+	return signingKey.KeyID, nil
+}
+
+func readActiveKeyID(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, activeFileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("%w: can't read active jwt key id - %s", domain.ErrServerInternal, err.Error())
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func listPublicKeyIDs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: can't read jwt keys dir - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	var keyIDs []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), publicKeySuffix) {
+			keyIDs = append(keyIDs, strings.TrimSuffix(e.Name(), publicKeySuffix))
+		}
+	}
+	return keyIDs, nil
+}
+
+func readPrivateKey(dir string, keyID string) (*domain.JWTSigningKey, error) {
+	der, err := readPEM(filepath.Join(dir, keyID+privateKeySuffix), "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: can't parse jwt key %s - %s", domain.ErrServerInternal, keyID, err.Error())
+	}
+
+	signingKey := &domain.JWTSigningKey{
+		KeyID: keyID,
+	}
+
+	var ok bool
+	if signingKey.PrivateKey, ok = key.(crypto.Signer); !ok {
+		return nil, fmt.Errorf("%w: jwt key %s is not a signer", domain.ErrServerInternal, keyID)
+	}
+
+	if signingKey.Algorithm, err = domain.JWTKeyAlgorithmOf(signingKey.PrivateKey.Public()); err != nil {
+		return nil, err
+	}
+	return signingKey, nil
+}
+
+func readPublicKey(dir string, keyID string) (*domain.JWTVerificationKey, error) {
+	der, err := readPEM(filepath.Join(dir, keyID+publicKeySuffix), "PUBLIC KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: can't parse jwt public key %s - %s", domain.ErrServerInternal, keyID, err.Error())
+	}
+
+	alg, err := domain.JWTKeyAlgorithmOf(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.JWTVerificationKey{
+		KeyID:     keyID,
+		Algorithm: alg,
+		PublicKey: key,
+	}, nil
+}
+
+func readPEM(fileName string, blockType string) ([]byte, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: can't read jwt key - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != blockType {
+		return nil, fmt.Errorf("%w: %s is not a PEM %s", domain.ErrServerInternal, fileName, blockType)
+	}
+	return block.Bytes, nil
+}
+
+func writeKey(dir string, signingKey *domain.JWTSigningKey) error {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(signingKey.PrivateKey)
+	if err != nil {
+		return fmt.Errorf("%w: can't marshal jwt key - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	publicDER, err := x509.MarshalPKIXPublicKey(signingKey.PrivateKey.Public())
+	if err != nil {
+		return fmt.Errorf("%w: can't marshal jwt public key - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	// public key is written first: the key must be verifiable as soon as it becomes active
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+	if err := writeFileAtomic(filepath.Join(dir, signingKey.KeyID+publicKeySuffix), publicPEM, 0644); err != nil {
+		return err
+	}
+
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})
+	return writeFileAtomic(filepath.Join(dir, signingKey.KeyID+privateKeySuffix), privatePEM, 0600)
+}
+
+// retireKey removes private part of the key; modification time of the public key is the retirement time.
+func retireKey(dir string, keyID string) error {
+	if err := os.Remove(filepath.Join(dir, keyID+privateKeySuffix)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: can't remove jwt key %s - %s", domain.ErrServerInternal, keyID, err.Error())
+	}
+
+	now := time.Now()
+	if err := os.Chtimes(filepath.Join(dir, keyID+publicKeySuffix), now, now); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: can't retire jwt key %s - %s", domain.ErrServerInternal, keyID, err.Error())
+	}
+	return nil
+}
+
+func pruneRetiredKeys(dir string, activeID string, retiredTTL time.Duration) error {
+	keyIDs, err := listPublicKeyIDs(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, keyID := range keyIDs {
+		if keyID == activeID {
+			continue
+		}
+
+		fileName := filepath.Join(dir, keyID+publicKeySuffix)
+		info, err := os.Stat(fileName)
+		if err != nil {
+			return fmt.Errorf("%w: can't stat jwt key %s - %s", domain.ErrServerInternal, keyID, err.Error())
+		}
+
+		if time.Since(info.ModTime()) > retiredTTL {
+			if err := os.Remove(fileName); err != nil {
+				return fmt.Errorf("%w: can't remove jwt key %s - %s", domain.ErrServerInternal, keyID, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func writeFileAtomic(fileName string, data []byte, perm os.FileMode) error {
+	tmpName := fileName + ".tmp"
+	if err := os.WriteFile(tmpName, data, perm); err != nil {
+		return fmt.Errorf("%w: can't write %s - %s", domain.ErrServerInternal, fileName, err.Error())
+	}
+	if err := os.Rename(tmpName, fileName); err != nil {
+		return fmt.Errorf("%w: can't write %s - %s", domain.ErrServerInternal, fileName, err.Error())
+	}
+	return nil
+}
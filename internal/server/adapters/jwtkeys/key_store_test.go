@@ -0,0 +1,128 @@
+package jwtkeys_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/jwtkeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadKeySet(t *testing.T) {
+	t.Run("no_keys", func(t *testing.T) {
+		_, err := jwtkeys.LoadKeySet(t.TempDir())
+		require.ErrorIs(t, err, domain.ErrServerInternal)
+	})
+
+	for _, alg := range []domain.JWTKeyAlgorithm{domain.JWTKeyAlgorithmEdDSA, domain.JWTKeyAlgorithmES256} {
+		t.Run(string(alg), func(t *testing.T) {
+			dir := t.TempDir()
+			keyID, err := jwtkeys.Rotate(dir, alg, time.Hour)
+			require.NoError(t, err)
+
+			keys, err := jwtkeys.LoadKeySet(dir)
+			require.NoError(t, err)
+			assert.Equal(t, keyID, keys.SigningKey().KeyID)
+			assert.Equal(t, alg, keys.SigningKey().Algorithm)
+
+			jwtTok, err := domain.CreateJWTToken(keys, time.Minute, 1, "sessionID")
+			require.NoError(t, err)
+
+			_, err = domain.ParseJWTClaims(keys, jwtTok)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	t.Run("old_tokens_are_valid", func(t *testing.T) {
+		dir := t.TempDir()
+		oldKeyID, err := jwtkeys.Rotate(dir, domain.JWTKeyAlgorithmEdDSA, time.Hour)
+		require.NoError(t, err)
+
+		oldKeys, err := jwtkeys.LoadKeySet(dir)
+		require.NoError(t, err)
+		oldTok, err := domain.CreateJWTToken(oldKeys, time.Minute, 1, "sessionID")
+		require.NoError(t, err)
+
+		newKeyID, err := jwtkeys.Rotate(dir, domain.JWTKeyAlgorithmES256, time.Hour)
+		require.NoError(t, err)
+		require.NotEqual(t, oldKeyID, newKeyID)
+
+		// private part of the retired key is removed
+		_, err = os.Stat(filepath.Join(dir, oldKeyID+".key"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		keys, err := jwtkeys.LoadKeySet(dir)
+		require.NoError(t, err)
+		assert.Equal(t, newKeyID, keys.SigningKey().KeyID)
+
+		uID, err := domain.ParseJWTToken(keys, oldTok)
+		require.NoError(t, err)
+		assert.Equal(t, domain.UserID(1), uID)
+	})
+
+	t.Run("expired_keys_are_pruned", func(t *testing.T) {
+		dir := t.TempDir()
+		oldKeyID, err := jwtkeys.Rotate(dir, domain.JWTKeyAlgorithmEdDSA, time.Hour)
+		require.NoError(t, err)
+
+		_, err = jwtkeys.Rotate(dir, domain.JWTKeyAlgorithmEdDSA, time.Hour)
+		require.NoError(t, err)
+
+		// the key was retired two hours ago
+		retiredAt := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, oldKeyID+".pub"), retiredAt, retiredAt))
+
+		_, err = jwtkeys.Rotate(dir, domain.JWTKeyAlgorithmEdDSA, time.Hour)
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(dir, oldKeyID+".pub"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		keys, err := jwtkeys.LoadKeySet(dir)
+		require.NoError(t, err)
+		_, ok := keys.VerificationKey(oldKeyID)
+		assert.False(t, ok)
+	})
+
+	t.Run("unsupported_alg", func(t *testing.T) {
+		_, err := jwtkeys.Rotate(t.TempDir(), "HS256", time.Hour)
+		require.ErrorIs(t, err, domain.ErrServerInternal)
+	})
+}
+
+func TestKeyStore(t *testing.T) {
+	dir := t.TempDir()
+	_, err := jwtkeys.Rotate(dir, domain.JWTKeyAlgorithmEdDSA, time.Hour)
+	require.NoError(t, err)
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	ks, err := jwtkeys.NewKeyStore(ctx, &config.ServerConf{
+		JWTKeysDir:    dir,
+		JWTKeysReload: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	oldTok, err := domain.CreateJWTToken(ks, time.Minute, 1, "sessionID")
+	require.NoError(t, err)
+
+	// rotation made by another process is picked up
+	newKeyID, err := jwtkeys.Rotate(dir, domain.JWTKeyAlgorithmEdDSA, time.Hour)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return ks.SigningKey().KeyID == newKeyID
+	}, time.Second, 10*time.Millisecond)
+
+	_, err = domain.ParseJWTToken(ks, oldTok)
+	require.NoError(t, err)
+}
@@ -0,0 +1,2 @@
+// Package notifier define in-memory delivery of item change events to the connections of the user
+package notifier
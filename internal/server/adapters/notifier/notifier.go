@@ -0,0 +1,72 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// subscriberBufferSize is the number of undelivered events kept for a slow subscriber;
+// the oldest event is dropped on overflow - events are hints, the client loads changes by the sequence number.
+const subscriberBufferSize = 16
+
+func NewNotifier() *notifier {
+	return &notifier{
+		subscribers: make(map[domain.UserID]map[chan domain.ItemChangeEvent]struct{}),
+	}
+}
+
+type notifier struct {
+	subscribers map[domain.UserID]map[chan domain.ItemChangeEvent]struct{}
+	mtx         sync.Mutex
+}
+
+// Publish sends the event to all subscribers of the user without blocking.
+func (nt *notifier) Publish(userID domain.UserID, event *domain.ItemChangeEvent) {
+	nt.mtx.Lock()
+	defer nt.mtx.Unlock()
+
+	for ch := range nt.subscribers[userID] {
+		select {
+		case ch <- *event:
+		default:
+			// drop the oldest event
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- *event:
+			default:
+			}
+		}
+	}
+}
+
+// Subscribe returns events of the user published after the call; the channel is closed when ctx is done.
+func (nt *notifier) Subscribe(ctx context.Context, userID domain.UserID) <-chan domain.ItemChangeEvent {
+	ch := make(chan domain.ItemChangeEvent, subscriberBufferSize)
+
+	nt.mtx.Lock()
+	userSubscribers, ok := nt.subscribers[userID]
+	if !ok {
+		userSubscribers = make(map[chan domain.ItemChangeEvent]struct{})
+		nt.subscribers[userID] = userSubscribers
+	}
+	userSubscribers[ch] = struct{}{}
+	nt.mtx.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		nt.mtx.Lock()
+		defer nt.mtx.Unlock()
+		delete(userSubscribers, ch)
+		if len(userSubscribers) == 0 {
+			delete(nt.subscribers, userID)
+		}
+		close(ch)
+	}()
+
+	return ch
+}
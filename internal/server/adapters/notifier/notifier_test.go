@@ -0,0 +1,82 @@
+package notifier_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/notifier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifier(t *testing.T) {
+
+	t.Run("fan_out", func(t *testing.T) {
+		nt := notifier.NewNotifier()
+		ctx, cancelFn := context.WithCancel(context.Background())
+		defer cancelFn()
+
+		ch1 := nt.Subscribe(ctx, domain.UserID(1))
+		ch2 := nt.Subscribe(ctx, domain.UserID(1))
+		otherCh := nt.Subscribe(ctx, domain.UserID(2))
+
+		nt.Publish(domain.UserID(1), &domain.ItemChangeEvent{
+			Seq:    1,
+			Kind:   domain.ItemCreated,
+			ItemID: "id1",
+			Type:   domain.SecretItemBankCard,
+		})
+
+		for _, ch := range []<-chan domain.ItemChangeEvent{ch1, ch2} {
+			select {
+			case ev := <-ch:
+				assert.Equal(t, int64(1), ev.Seq)
+				assert.Equal(t, "id1", ev.ItemID)
+			case <-time.After(time.Second):
+				t.Fatal("event is not received")
+			}
+		}
+
+		select {
+		case <-otherCh:
+			t.Fatal("event of another user received")
+		default:
+		}
+	})
+
+	t.Run("slow_subscriber", func(t *testing.T) {
+		nt := notifier.NewNotifier()
+		ctx, cancelFn := context.WithCancel(context.Background())
+		defer cancelFn()
+
+		ch := nt.Subscribe(ctx, domain.UserID(1))
+		for i := 1; i <= 100; i++ {
+			nt.Publish(domain.UserID(1), &domain.ItemChangeEvent{Seq: int64(i)})
+		}
+
+		var last domain.ItemChangeEvent
+		for len(ch) > 0 {
+			last = <-ch
+		}
+		require.Equal(t, int64(100), last.Seq)
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		nt := notifier.NewNotifier()
+		ctx, cancelFn := context.WithCancel(context.Background())
+
+		ch := nt.Subscribe(ctx, domain.UserID(1))
+		cancelFn()
+
+		select {
+		case _, ok := <-ch:
+			require.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("channel is not closed")
+		}
+
+		nt.Publish(domain.UserID(1), &domain.ItemChangeEvent{Seq: 1})
+	})
+}
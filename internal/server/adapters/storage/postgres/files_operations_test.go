@@ -50,10 +50,10 @@ func TestFileOperations(t *testing.T) {
 		HelloEncrypted:     "HelloEncrypted",
 	}
 
-	err = storage.Registrate(ctx, regData)
+	_, err = storage.Registrate(ctx, regData)
 	require.NoError(t, err)
 
-	err = storage.Registrate(ctx, regData)
+	_, err = storage.Registrate(ctx, regData)
 	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 	lData, err := storage.GetLoginData(ctx, testEmail)
 	require.NoError(t, err)
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListSecretItemRevisions returns the previous revisions of the item kept in the history, the last one first.
+func (st *storage) ListSecretItemRevisions(ctx context.Context, itemID string) ([]domain.SecretItemHistoryEntry, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	rows, err := st.pPool.Query(ctx,
+		`select item_id, revision, updated_at, replaced_at from (
+		   select h.item_id::text, h.revision, h.updated_at, h.replaced_at from secret_item_history h
+		   join secret_item s on s.item_id = h.item_id
+		   where h.item_id = $1 and h.user_id = $2 and s.deleted_at is null
+		   union all
+		   select h.note_id::text, h.revision, h.updated_at, h.replaced_at from secure_note_history h
+		   join secure_note s on s.note_id = h.note_id
+		   where h.note_id = $1 and h.user_id = $2 and s.deleted_at is null
+		 ) revisions order by revision desc`, itemID, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	result, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.SecretItemHistoryEntry, error) {
+		var entry domain.SecretItemHistoryEntry
+		err := row.Scan(&entry.ItemID, &entry.Revision, &entry.UpdatedAt, &entry.ReplacedAt)
+		return entry, err
+	})
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	return result, nil
+}
+
+// GetSecretItemRevision returns the content of the previous revision of the item.
+func (st *storage) GetSecretItemRevision(ctx context.Context, itemID string, revision int64) (*domain.EncryptedSecretItem, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	item, err := getSecretItemRevision(ctx, st.pPool, userID, itemID, revision)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+	return item, nil
+}
+
+// RestoreSecretItemRevision replaces the item content with the previous revision if the stored revision
+// equals expected; the replaced content is kept in the history, the restored item gets the next revision.
+func (st *storage) RestoreSecretItemRevision(ctx context.Context, itemID string, revision int64, expected int64) (*domain.SecretItemRevision, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	item, err := getSecretItemRevision(ctx, tx, userID, itemID, revision)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	seq, err := nextChangeSeq(ctx, tx, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	item.Revision = expected
+	var rev *domain.SecretItemRevision
+	if item.Type == domain.SecretItemSecureNote {
+		rev, err = st.updateSecureNoteTx(ctx, tx, userID, item, seq)
+	} else {
+		rev, err = st.updateSecretItemTx(ctx, tx, userID, item, seq)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("item %v for userID %v restored from revision %v to revision %v, seq %v",
+		itemID, userID, revision, rev.Revision, seq))
+	return rev, nil
+}
+
+// PruneSecretItemHistory keeps at most maxCount last revisions of the item replaced not before the time;
+// zero maxCount and zero time mean no limit.
+func (st *storage) PruneSecretItemHistory(ctx context.Context, itemID string, maxCount int, before time.Time) (int64, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return 0, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	var beforeArg any
+	if !before.IsZero() {
+		beforeArg = before
+	}
+
+	var deleted int64
+	if err := st.pPool.QueryRow(ctx,
+		`with items as (
+		   delete from secret_item_history where item_id = $1 and user_id = $2 and (
+		     ($3::timestamptz is not null and replaced_at < $3) or
+		     ($4 > 0 and revision <= coalesce((select revision from secret_item_history
+		       where item_id = $1 and user_id = $2 order by revision desc offset $4 limit 1), 0))) returning 1
+		 ), notes as (
+		   delete from secure_note_history where note_id = $1 and user_id = $2 and (
+		     ($3::timestamptz is not null and replaced_at < $3) or
+		     ($4 > 0 and revision <= coalesce((select revision from secure_note_history
+		       where note_id = $1 and user_id = $2 order by revision desc offset $4 limit 1), 0))) returning 1
+		 ) select (select count(*) from items) + (select count(*) from notes)`,
+		itemID, userID, beforeArg, maxCount).Scan(&deleted); err != nil {
+		log.Infow(action, "err", err.Error())
+		return 0, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%v revisions of item %v for userID %v deleted", deleted, itemID, userID))
+	return deleted, nil
+}
+
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func getSecretItemRevision(ctx context.Context, q rowQuerier, userID domain.UserID, itemID string, revision int64) (*domain.EncryptedSecretItem, error) {
+	var item domain.EncryptedSecretItem
+	if err := q.QueryRow(ctx,
+		`select h.item_id::text, s.item_type, h.name, h.lookup_key, h.content, h.revision, h.updated_at from secret_item_history h
+		 join secret_item s on s.item_id = h.item_id
+		 where h.item_id = $1 and h.user_id = $2 and h.revision = $3 and s.deleted_at is null
+		 union all
+		 select h.note_id::text, 'secure_note', h.title, h.lookup_key, h.content, h.revision, h.updated_at from secure_note_history h
+		 join secure_note s on s.note_id = h.note_id
+		 where h.note_id = $1 and h.user_id = $2 and h.revision = $3 and s.deleted_at is null`,
+		itemID, userID, revision).Scan(&item.ID, &item.Type, &item.Name, &item.LookupKey, &item.Content, &item.Revision, &item.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w - revision %v of item %v not exists", domain.ErrDataNotExists, revision, itemID)
+		}
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return &item, nil
+}
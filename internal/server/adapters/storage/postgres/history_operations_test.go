@@ -0,0 +1,162 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryOperations(t *testing.T) {
+	ctx, cancelFN := context.WithCancel(context.Background())
+
+	defer cancelFN()
+
+	connString, err := postgresContainer.ConnectionString(ctx)
+
+	require.NoError(t, err)
+
+	storage := postgres.NewStorage(ctx, &config.ServerConf{
+		MaxConns:        5,
+		DatabaseDN:      connString,
+		MaxConnLifetime: 2 * time.Minute,
+		MaxConnIdleTime: 2 * time.Minute,
+	})
+
+	defer func() {
+		storage.Close()
+		err = clear(ctx)
+		require.NoError(t, err)
+	}()
+
+	err = storage.Ping(ctx)
+	require.NoError(t, err)
+
+	err = clear(ctx)
+	require.NoError(t, err)
+
+	testEmail := "email@email"
+
+	_, err = storage.Registrate(ctx, &domain.FullRegistrationData{
+		EMail:              testEmail,
+		PasswordHash:       "PasswordHash",
+		PasswordSalt:       "PasswordSalt",
+		EncryptedOTPKey:    "EncryptedOTPKey",
+		MasterPasswordHint: "MasterPasswordHint",
+		HelloEncrypted:     "HelloEncrypted",
+	})
+	require.NoError(t, err)
+
+	lData, err := storage.GetLoginData(ctx, testEmail)
+	require.NoError(t, err)
+
+	userIdCtx := domain.EnrichWithUserID(ctx, lData.UserID)
+
+	rev, err := storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:      domain.SecretItemUserPassword,
+		Name:      "name1",
+		LookupKey: "key1",
+		Content:   "content1",
+	})
+	require.NoError(t, err)
+
+	history, err := storage.ListSecretItemRevisions(userIdCtx, rev.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(history))
+
+	firstRevision := rev.Revision
+	for i := 2; i <= 4; i++ {
+		rev, err = storage.UpdateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+			ID:        rev.ID,
+			Name:      fmt.Sprintf("name%d", i),
+			LookupKey: "key1",
+			Content:   fmt.Sprintf("content%d", i),
+			Revision:  rev.Revision,
+		})
+		require.NoError(t, err)
+	}
+
+	// the conflicting update is not kept in the history
+	_, err = storage.UpdateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		ID:       rev.ID,
+		Name:     "name5",
+		Content:  "content5",
+		Revision: firstRevision,
+	})
+	require.ErrorIs(t, err, domain.ErrDataConflict)
+
+	history, err = storage.ListSecretItemRevisions(userIdCtx, rev.ID)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(history))
+	require.Equal(t, rev.Revision-1, history[0].Revision)
+	require.Equal(t, firstRevision, history[2].Revision)
+	require.False(t, history[0].ReplacedAt.Before(history[0].UpdatedAt))
+
+	item, err := storage.GetSecretItemRevision(userIdCtx, rev.ID, firstRevision)
+	require.NoError(t, err)
+	require.Equal(t, domain.SecretItemUserPassword, item.Type)
+	require.Equal(t, "name1", item.Name)
+	require.Equal(t, "content1", item.Content)
+
+	_, err = storage.GetSecretItemRevision(userIdCtx, rev.ID, rev.Revision)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	_, err = storage.RestoreSecretItemRevision(userIdCtx, rev.ID, firstRevision, firstRevision)
+	require.ErrorIs(t, err, domain.ErrDataConflict)
+
+	restored, err := storage.RestoreSecretItemRevision(userIdCtx, rev.ID, firstRevision, rev.Revision)
+	require.NoError(t, err)
+	require.Equal(t, rev.Revision+1, restored.Revision)
+	require.Greater(t, restored.ChangeSeq, rev.ChangeSeq)
+
+	item, err = storage.GetSecretItem(userIdCtx, rev.ID)
+	require.NoError(t, err)
+	require.Equal(t, "name1", item.Name)
+	require.Equal(t, "content1", item.Content)
+
+	// the replaced content is kept in the history too
+	item, err = storage.GetSecretItemRevision(userIdCtx, rev.ID, rev.Revision)
+	require.NoError(t, err)
+	require.Equal(t, "content4", item.Content)
+
+	cnt, err := storage.PruneSecretItemHistory(userIdCtx, rev.ID, 0, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), cnt)
+
+	cnt, err = storage.PruneSecretItemHistory(userIdCtx, rev.ID, 2, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), cnt)
+
+	history, err = storage.ListSecretItemRevisions(userIdCtx, rev.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	require.Equal(t, rev.Revision, history[0].Revision)
+
+	cnt, err = storage.PruneSecretItemHistory(userIdCtx, rev.ID, 0, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), cnt)
+
+	// the history of the item in the trash is hidden
+	_, err = storage.UpdateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		ID:       rev.ID,
+		Name:     "name6",
+		Content:  "content6",
+		Revision: restored.Revision,
+	})
+	require.NoError(t, err)
+
+	_, err = storage.DeleteSecretItem(userIdCtx, rev.ID, restored.Revision+1)
+	require.NoError(t, err)
+
+	history, err = storage.ListSecretItemRevisions(userIdCtx, rev.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(history))
+
+	_, err = storage.RestoreSecretItemRevision(userIdCtx, rev.ID, restored.Revision, restored.Revision+2)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+}
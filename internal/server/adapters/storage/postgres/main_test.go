@@ -72,6 +72,10 @@ func clear(ctx context.Context) error {
 		pPool, _ = pgxpool.NewWithConfig(ctx, pConf)
 	})
 
+	if _, err := pPool.Exec(ctx, "delete from revoked_token"); err != nil {
+		return err
+	}
+
 	_, err := pPool.Exec(ctx, "delete from user_info")
 	return err
 }
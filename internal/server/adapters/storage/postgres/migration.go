@@ -0,0 +1,293 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+const migrationDir = "migrations"
+
+// migrationLockID is the pg_advisory_lock key; it prevents concurrent migration by several server instances.
+const migrationLockID int64 = 0x676f70686b6565 // "gophkee"
+
+// migration file name format: <version>_<name>.<up|down>.sql
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus describes migration state in database.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// NewMigrator create migrator with own connection pool. Is used by the migrate command.
+func NewMigrator(ctx context.Context, conf *config.ServerConf) (*migrator, error) {
+	pPool, err := newPGXPool(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr, err := newMigrator(pPool)
+	if err != nil {
+		pPool.Close()
+		return nil, err
+	}
+	mgr.ownPool = true
+	return mgr, nil
+}
+
+func newMigrator(pPool *pgxpool.Pool) (*migrator, error) {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		return nil, err
+	}
+	return &migrator{
+		pPool:      pPool,
+		migrations: migrations,
+	}, nil
+}
+
+type migrator struct {
+	pPool      *pgxpool.Pool
+	migrations []migration
+	ownPool    bool
+}
+
+func (mgr *migrator) Close() {
+	if mgr.ownPool {
+		mgr.pPool.Close()
+	}
+}
+
+// Up applies all not applied migrations.
+func (mgr *migrator) Up(ctx context.Context) error {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	return mgr.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := mgr.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range mgr.migrations {
+			if _, ok := applied[m.Version]; ok {
+				continue
+			}
+			log.Infow(action, "msg", fmt.Sprintf("apply migration %d_%s", m.Version, m.Name))
+			if err := mgr.exec(ctx, conn, m.Up,
+				"insert into schema_migrations(version, name) values ($1, $2)", m.Version, m.Name); err != nil {
+				return fmt.Errorf("%w - migration %d_%s up err %s", domain.ErrServerInternal, m.Version, m.Name, err.Error())
+			}
+		}
+		return nil
+	})
+}
+
+// Down reverts the last applied migration.
+func (mgr *migrator) Down(ctx context.Context) error {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	return mgr.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := mgr.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(mgr.migrations) - 1; i >= 0; i-- {
+			m := mgr.migrations[i]
+			if _, ok := applied[m.Version]; !ok {
+				continue
+			}
+			log.Infow(action, "msg", fmt.Sprintf("revert migration %d_%s", m.Version, m.Name))
+			if err := mgr.exec(ctx, conn, m.Down,
+				"delete from schema_migrations where version = $1", m.Version); err != nil {
+				return fmt.Errorf("%w - migration %d_%s down err %s", domain.ErrServerInternal, m.Version, m.Name, err.Error())
+			}
+			return nil
+		}
+
+		log.Infow(action, "msg", "no migrations to revert")
+		return nil
+	})
+}
+
+// Status returns all known migrations with their state.
+func (mgr *migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var result []MigrationStatus
+	err := mgr.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := mgr.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, m := range mgr.migrations {
+			appliedAt, ok := applied[m.Version]
+			result = append(result, MigrationStatus{
+				Version:   m.Version,
+				Name:      m.Name,
+				Applied:   ok,
+				AppliedAt: appliedAt,
+			})
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (mgr *migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := mgr.pPool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	defer conn.Release()
+
+	// session level lock - waits until other instance complete migration
+	if _, err := conn.Exec(ctx, "select pg_advisory_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("%w - can't get migration lock %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		if _, err := conn.Exec(context.Background(), "select pg_advisory_unlock($1)", migrationLockID); err != nil {
+			domain.GetCtxLogger(ctx).Errorw("withLock", "err", fmt.Sprintf("can't release migration lock %s", err.Error()))
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, `
+	create table if not exists schema_migrations (
+		version bigint not null,
+		name text not null,
+		applied_at timestamptz not null default now(),
+		primary key(version)
+	);`); err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	return fn(conn)
+}
+
+func (mgr *migrator) applied(ctx context.Context, conn *pgxpool.Conn) (map[int64]time.Time, error) {
+	rows, err := conn.Query(ctx, "select version, applied_at from schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	defer rows.Close()
+
+	result := make(map[int64]time.Time)
+	for rows.Next() {
+		var version int64
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+		}
+		result[version] = appliedAt
+	}
+
+	if err := rows.Err(); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return result, nil
+}
+
+// exec run migration script and schema_migrations update in one transaction.
+func (mgr *migrator) exec(ctx context.Context, conn *pgxpool.Conn, script string, versionSQL string, args ...any) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, script); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, versionSQL, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func loadMigrations(fsys fs.FS) ([]migration, error) {
+	entries, err := fs.ReadDir(fsys, migrationDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w - can't read migrations %s", domain.ErrServerInternal, err.Error())
+	}
+
+	byVersion := make(map[int64]*migration)
+	for _, e := range entries {
+		parts := migrationFileRegexp.FindStringSubmatch(e.Name())
+		if parts == nil {
+			return nil, fmt.Errorf("%w - wrong migration file name %s", domain.ErrServerInternal, e.Name())
+		}
+
+		version, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w - wrong migration version %s", domain.ErrServerInternal, e.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(migrationDir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("%w - can't read migration %s", domain.ErrServerInternal, e.Name())
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{
+				Version: version,
+				Name:    parts[2],
+			}
+			byVersion[version] = m
+		}
+
+		if m.Name != parts[2] {
+			return nil, fmt.Errorf("%w - migration %d has different names", domain.ErrServerInternal, version)
+		}
+
+		switch parts[3] {
+		case "up":
+			m.Up = string(content)
+		case "down":
+			m.Down = string(content)
+		}
+	}
+
+	result := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("%w - migration %d_%s must have up and down scripts", domain.ErrServerInternal, m.Version, m.Name)
+		}
+		result = append(result, *m)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	return result, nil
+}
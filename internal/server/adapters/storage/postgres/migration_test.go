@@ -0,0 +1,81 @@
+package postgres_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator(t *testing.T) {
+	ctx, cancelFN := context.WithCancel(context.Background())
+	defer cancelFN()
+
+	connString, err := postgresContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	conf := &config.ServerConf{
+		MaxConns:        5,
+		DatabaseDN:      connString,
+		MaxConnLifetime: 2 * time.Minute,
+		MaxConnIdleTime: 2 * time.Minute,
+	}
+
+	mgr, err := postgres.NewMigrator(ctx, conf)
+	require.NoError(t, err)
+	defer mgr.Close()
+
+	// concurrent up is protected by advisory lock
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- mgr.Up(ctx)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	statuses, err := mgr.Status(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, statuses)
+	for _, st := range statuses {
+		require.True(t, st.Applied)
+	}
+
+	last := statuses[len(statuses)-1]
+
+	storage, err := postgres.OpenStorage(ctx, conf)
+	require.NoError(t, err)
+	storage.Close()
+
+	err = mgr.Down(ctx)
+	require.NoError(t, err)
+
+	statuses, err = mgr.Status(ctx)
+	require.NoError(t, err)
+	require.False(t, statuses[len(statuses)-1].Applied)
+	require.Equal(t, last.Version, statuses[len(statuses)-1].Version)
+
+	// the administration commands don't migrate the database
+	_, err = postgres.OpenStorage(ctx, conf)
+	require.ErrorIs(t, err, domain.ErrServerInternal)
+	require.ErrorContains(t, err, "server migrate up")
+
+	err = mgr.Up(ctx)
+	require.NoError(t, err)
+
+	statuses, err = mgr.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[len(statuses)-1].Applied)
+}
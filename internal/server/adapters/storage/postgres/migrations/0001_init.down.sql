@@ -0,0 +1,5 @@
+drop table if exists user_password_data;
+
+drop table if exists bank_card;
+
+drop table if exists user_info;
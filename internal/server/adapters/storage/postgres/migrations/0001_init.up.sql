@@ -0,0 +1,37 @@
+create table if not exists user_info (
+	user_id bigserial,
+	email text not null,
+	pass_hash text not null,
+	pass_salt text not null,
+	otp_key text not null,
+	master_hint text not null,
+	hello_encrypted text not null,
+	bucket text not null,
+	primary key(user_id)
+);
+
+create unique index if not exists idx_user_info_email on user_info(email);
+
+create table if not exists bank_card (
+	id bigserial,
+	number text not null,
+	content text not null,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	primary key(id)
+);
+
+create unique index if not exists idx_bank_card_number on bank_card(number,user_id);
+
+create index if not exists idx_bank_card_user_id on bank_card(user_id);
+
+create table if not exists user_password_data (
+	id bigserial,
+	hint text not null,
+	content text not null,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	primary key(id)
+);
+
+create unique index if not exists idx_user_password_data_hint on user_password_data(hint,user_id);
+
+create index if not exists idx_user_password_data_user_id on user_password_data(user_id);
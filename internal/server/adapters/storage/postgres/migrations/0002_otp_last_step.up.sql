@@ -0,0 +1 @@
+alter table user_info add column if not exists otp_last_step bigint not null default 0;
@@ -0,0 +1,18 @@
+create table if not exists refresh_token (
+	token_hash text not null,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	family_id text not null,
+	used boolean not null default false,
+	expires_at timestamp with time zone not null,
+	primary key(token_hash)
+);
+
+create index if not exists idx_refresh_token_family_id on refresh_token(family_id);
+
+create index if not exists idx_refresh_token_user_id on refresh_token(user_id);
+
+create table if not exists revoked_token (
+	jti text not null,
+	expires_at timestamp with time zone not null,
+	primary key(jti)
+);
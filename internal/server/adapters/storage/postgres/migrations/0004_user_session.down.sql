@@ -0,0 +1,2 @@
+alter table refresh_token drop constraint if exists fk_refresh_token_session;
+drop table if exists user_session;
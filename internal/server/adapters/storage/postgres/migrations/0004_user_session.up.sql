@@ -0,0 +1,18 @@
+create table if not exists user_session (
+	session_id text not null,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	device_name text not null,
+	client_version text not null,
+	peer_ip text not null,
+	created_at timestamp with time zone not null default now(),
+	last_seen_at timestamp with time zone not null default now(),
+	primary key(session_id)
+);
+
+create index if not exists idx_user_session_user_id on user_session(user_id);
+
+-- refresh token family is a session now; tokens issued before sessions are dropped, users login again
+delete from refresh_token;
+
+alter table refresh_token add constraint fk_refresh_token_session
+	foreign key (family_id) references user_session (session_id) on delete cascade;
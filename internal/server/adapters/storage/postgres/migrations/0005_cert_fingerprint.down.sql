@@ -0,0 +1 @@
+alter table user_info drop column if exists cert_fingerprint;
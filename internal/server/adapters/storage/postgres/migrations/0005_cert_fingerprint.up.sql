@@ -0,0 +1 @@
+alter table user_info add column if not exists cert_fingerprint text not null default '';
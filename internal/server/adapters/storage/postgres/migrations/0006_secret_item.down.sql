@@ -0,0 +1,36 @@
+create table if not exists bank_card (
+	id bigserial,
+	number text not null,
+	content text not null,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	primary key(id)
+);
+
+create unique index if not exists idx_bank_card_number on bank_card(number,user_id);
+
+create index if not exists idx_bank_card_user_id on bank_card(user_id);
+
+create table if not exists user_password_data (
+	id bigserial,
+	hint text not null,
+	content text not null,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	primary key(id)
+);
+
+create unique index if not exists idx_user_password_data_hint on user_password_data(hint,user_id);
+
+create index if not exists idx_user_password_data_user_id on user_password_data(user_id);
+
+-- only one item with the same name is kept; names encrypted by the client are moved as is
+insert into bank_card(number, content, user_id)
+	select distinct on (user_id, name) name, content, user_id from secret_item
+	where item_type = 'bank_card'
+	order by user_id, name, updated_at desc;
+
+insert into user_password_data(hint, content, user_id)
+	select distinct on (user_id, name) name, content, user_id from secret_item
+	where item_type = 'user_password'
+	order by user_id, name, updated_at desc;
+
+drop table if exists secret_item;
@@ -0,0 +1,22 @@
+create table if not exists secret_item (
+	item_id uuid not null default gen_random_uuid(),
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	item_type text not null,
+	name text not null,
+	content text not null,
+	created_at timestamp with time zone not null default now(),
+	updated_at timestamp with time zone not null default now(),
+	primary key(item_id)
+);
+
+create index if not exists idx_secret_item_user_id on secret_item(user_id, item_type);
+
+-- records of the old tables get ids; their names stay in clear text until the client rewrites them
+insert into secret_item(user_id, item_type, name, content)
+	select user_id, 'bank_card', number, content from bank_card;
+
+insert into secret_item(user_id, item_type, name, content)
+	select user_id, 'user_password', hint, content from user_password_data;
+
+drop table if exists bank_card;
+drop table if exists user_password_data;
@@ -0,0 +1,3 @@
+drop index if exists idx_secret_item_lookup_key;
+
+alter table secret_item drop column if exists lookup_key;
@@ -0,0 +1,7 @@
+alter table secret_item add column if not exists lookup_key text not null default '';
+
+create unique index if not exists idx_secret_item_lookup_key on secret_item(user_id, item_type, lookup_key) where lookup_key <> '';
+
+-- names of the migrated records may contain card numbers or hints in clear text; the content keeps all the data,
+-- so names are wiped and the client re-uploads the records with the encrypted name and the lookup key
+update secret_item set name = '' where lookup_key = '';
@@ -0,0 +1 @@
+alter table secret_item drop column if exists revision;
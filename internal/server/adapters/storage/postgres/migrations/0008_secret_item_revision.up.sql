@@ -0,0 +1 @@
+alter table secret_item add column if not exists revision bigint not null default 1;
@@ -0,0 +1,7 @@
+drop index if exists idx_secret_item_seq;
+
+drop table if exists secret_item_tombstone;
+
+alter table secret_item drop column if exists change_seq;
+
+alter table user_info drop column if exists change_seq;
@@ -0,0 +1,23 @@
+alter table user_info add column if not exists change_seq bigint not null default 0;
+
+alter table secret_item add column if not exists change_seq bigint not null default 0;
+
+create table if not exists secret_item_tombstone (
+	item_id uuid not null,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	item_type text not null,
+	change_seq bigint not null,
+	deleted_at timestamp with time zone not null default now(),
+	primary key(item_id)
+);
+
+create index if not exists idx_secret_item_tombstone_seq on secret_item_tombstone(user_id, change_seq);
+
+-- existing items get sequence numbers in the creation order
+update secret_item s set change_seq = r.seq
+	from (select item_id, row_number() over (partition by user_id order by created_at, item_id) as seq from secret_item) r
+	where s.item_id = r.item_id;
+
+update user_info u set change_seq = coalesce((select max(change_seq) from secret_item s where s.user_id = u.user_id), 0);
+
+create index if not exists idx_secret_item_seq on secret_item(user_id, change_seq);
@@ -0,0 +1,8 @@
+drop index if exists idx_secret_item_deleted_at;
+
+delete from secret_item where deleted_at is not null;
+
+drop index if exists idx_secret_item_lookup_key;
+create unique index if not exists idx_secret_item_lookup_key on secret_item(user_id, item_type, lookup_key) where lookup_key <> '';
+
+alter table secret_item drop column if exists deleted_at;
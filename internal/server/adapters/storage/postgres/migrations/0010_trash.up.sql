@@ -0,0 +1,8 @@
+alter table secret_item add column if not exists deleted_at timestamp with time zone;
+
+-- items in the trash don't hold their lookup keys, the same key can be used by a new item
+drop index if exists idx_secret_item_lookup_key;
+create unique index if not exists idx_secret_item_lookup_key on secret_item(user_id, item_type, lookup_key)
+	where lookup_key <> '' and deleted_at is null;
+
+create index if not exists idx_secret_item_deleted_at on secret_item(deleted_at) where deleted_at is not null;
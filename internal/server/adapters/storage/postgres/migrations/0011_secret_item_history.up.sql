@@ -0,0 +1,11 @@
+create table if not exists secret_item_history (
+	item_id uuid not null references secret_item (item_id) on delete cascade,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	revision bigint not null,
+	name text not null,
+	lookup_key text not null default '',
+	content text not null,
+	updated_at timestamp with time zone not null,
+	replaced_at timestamp with time zone not null default now(),
+	primary key(item_id, revision)
+);
@@ -0,0 +1,9 @@
+insert into secret_item(item_id, user_id, item_type, name, lookup_key, content, revision, change_seq, created_at, updated_at, deleted_at)
+	select note_id, user_id, 'secure_note', title, lookup_key, content, revision, change_seq, created_at, updated_at, deleted_at
+	from secure_note;
+
+insert into secret_item_history(item_id, user_id, revision, name, lookup_key, content, updated_at, replaced_at)
+	select note_id, user_id, revision, title, lookup_key, content, updated_at, replaced_at from secure_note_history;
+
+drop table if exists secure_note_history;
+drop table if exists secure_note;
@@ -0,0 +1,41 @@
+create table if not exists secure_note (
+	note_id uuid not null default gen_random_uuid(),
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	title text not null,
+	lookup_key text not null,
+	content text not null,
+	revision bigint not null default 1,
+	change_seq bigint not null default 0,
+	created_at timestamp with time zone not null default now(),
+	updated_at timestamp with time zone not null default now(),
+	deleted_at timestamp with time zone,
+	primary key(note_id)
+);
+
+create unique index if not exists idx_secure_note_lookup_key on secure_note(user_id, lookup_key)
+	where lookup_key <> '' and deleted_at is null;
+create index if not exists idx_secure_note_seq on secure_note(user_id, change_seq);
+create index if not exists idx_secure_note_deleted_at on secure_note(deleted_at) where deleted_at is not null;
+
+create table if not exists secure_note_history (
+	note_id uuid not null references secure_note (note_id) on delete cascade,
+	user_id bigint not null references user_info (user_id) on delete cascade,
+	revision bigint not null,
+	title text not null,
+	lookup_key text not null,
+	content text not null,
+	updated_at timestamp with time zone not null,
+	replaced_at timestamp with time zone not null default now(),
+	primary key(note_id, revision)
+);
+
+-- notes keep their ids, revisions and sequence numbers, so the clients don't see the move
+insert into secure_note(note_id, user_id, title, lookup_key, content, revision, change_seq, created_at, updated_at, deleted_at)
+	select item_id, user_id, name, lookup_key, content, revision, change_seq, created_at, updated_at, deleted_at
+	from secret_item where item_type = 'secure_note';
+
+insert into secure_note_history(note_id, user_id, revision, title, lookup_key, content, updated_at, replaced_at)
+	select h.item_id, h.user_id, h.revision, h.name, h.lookup_key, h.content, h.updated_at, h.replaced_at
+	from secret_item_history h join secret_item s on s.item_id = h.item_id where s.item_type = 'secure_note';
+
+delete from secret_item where item_type = 'secure_note';
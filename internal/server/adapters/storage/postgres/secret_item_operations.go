@@ -0,0 +1,409 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolationCode is postgres error code for duplicate key value.
+const uniqueViolationCode = "23505"
+
+// ListSecretItems returns items of the user ordered by creation time; all items are returned for the empty itemType.
+// Secure notes are read from their own table.
+func (st *storage) ListSecretItems(ctx context.Context, itemType domain.SecretItemType) ([]domain.EncryptedSecretItem, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	rows, err := st.pPool.Query(ctx,
+		`select item_id, item_type, name, lookup_key, content, revision, updated_at from (
+		   select item_id::text, item_type, name, lookup_key, content, revision, updated_at, created_at from secret_item
+		   where user_id = $1 and ($2::text = '' or item_type = $2) and deleted_at is null
+		   union all
+		   select note_id::text, 'secure_note', title, lookup_key, content, revision, updated_at, created_at from secure_note
+		   where user_id = $1 and $2::text in ('', 'secure_note') and deleted_at is null
+		 ) items order by created_at, item_id`, userID, string(itemType))
+
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer rows.Close()
+
+	var result []domain.EncryptedSecretItem
+
+	for rows.Next() {
+		var item domain.EncryptedSecretItem
+		err = rows.Scan(&item.ID, &item.Type, &item.Name, &item.LookupKey, &item.Content, &item.Revision, &item.UpdatedAt)
+		if err != nil {
+			log.Infow(action, "err", err.Error())
+			return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+		}
+		result = append(result, item)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return result, nil
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	return result, nil
+}
+
+func (st *storage) GetSecretItem(ctx context.Context, itemID string) (*domain.EncryptedSecretItem, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	var item domain.EncryptedSecretItem
+	if err := st.pPool.QueryRow(ctx,
+		`select item_id::text, item_type, name, lookup_key, content, revision, updated_at from secret_item
+		 where item_id = $1 and user_id = $2 and deleted_at is null
+		 union all
+		 select note_id::text, 'secure_note', title, lookup_key, content, revision, updated_at from secure_note
+		 where note_id = $1 and user_id = $2 and deleted_at is null`,
+		itemID, userID).Scan(&item.ID, &item.Type, &item.Name, &item.LookupKey, &item.Content, &item.Revision, &item.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Infow(action, "err", fmt.Sprintf("item %v for user %v not exists", itemID, userID))
+			return nil, fmt.Errorf("%w - item %v not exists", domain.ErrDataNotExists, itemID)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	return &item, nil
+}
+
+// CreateSecretItem stores the item and returns id generated by the database and the initial revision;
+// the not empty lookup key must be unique among the items of the same type.
+func (st *storage) CreateSecretItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	seq, err := nextChangeSeq(ctx, tx, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	rev := domain.SecretItemRevision{Type: item.Type, ChangeSeq: seq}
+	if err := tx.QueryRow(ctx,
+		`insert into secret_item(user_id, item_type, name, lookup_key, content, change_seq) values ($1, $2, $3, $4, $5, $6)
+		 on conflict (user_id, item_type, lookup_key) where lookup_key <> '' and deleted_at is null do nothing returning item_id::text, revision`,
+		userID, string(item.Type), item.Name, item.LookupKey, item.Content, seq).Scan(&rev.ID, &rev.Revision); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Infow(action, "err", fmt.Sprintf("%v item with the same lookup key for user %v already exists", item.Type, userID))
+			return nil, fmt.Errorf("%w - item already exists", domain.ErrClientDataIncorrect)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%v item %v for userID %v created, seq %v", item.Type, rev.ID, userID, seq))
+	return &rev, nil
+}
+
+// UpdateSecretItem replaces name, lookup key and content of the item if the stored revision equals item.Revision;
+// returns the increased revision. The replaced revision is kept in the item history. Item type can't be changed.
+func (st *storage) UpdateSecretItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	seq, err := nextChangeSeq(ctx, tx, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	rev, err := st.updateSecretItemTx(ctx, tx, userID, item, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("item %v for userID %v updated to revision %v, seq %v", rev.ID, userID, rev.Revision, seq))
+	return rev, nil
+}
+
+// updateSecretItemTx keeps the stored revision of the item in the history and replaces it with the item data;
+// the update is applied only to the stored revision item.Revision.
+func (st *storage) updateSecretItemTx(ctx context.Context, tx pgx.Tx, userID domain.UserID,
+	item *domain.EncryptedSecretItem, seq int64) (*domain.SecretItemRevision, error) {
+	action := domain.GetAction(2)
+	log := domain.GetCtxLogger(ctx)
+
+	if _, err := tx.Exec(ctx,
+		`insert into secret_item_history(item_id, user_id, revision, name, lookup_key, content, updated_at)
+		 select item_id, user_id, revision, name, lookup_key, content, updated_at from secret_item
+		 where item_id = $1 and user_id = $2 and revision = $3 and deleted_at is null
+		 on conflict (item_id, revision) do nothing`,
+		item.ID, userID, item.Revision); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	rev := domain.SecretItemRevision{ID: item.ID, ChangeSeq: seq}
+	if err := tx.QueryRow(ctx,
+		`update secret_item set name = $1, lookup_key = $2, content = $3, revision = revision + 1, change_seq = $4, updated_at = now()
+		 where item_id = $5 and user_id = $6 and revision = $7 and deleted_at is null returning revision, item_type`,
+		item.Name, item.LookupKey, item.Content, seq, item.ID, userID, item.Revision).Scan(&rev.Revision, &rev.Type); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, st.revisionMismatchError(ctx, item.ID, item.Revision)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			log.Infow(action, "err", fmt.Sprintf("item with the same lookup key for user %v already exists", userID))
+			return nil, fmt.Errorf("%w - item already exists", domain.ErrClientDataIncorrect)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return &rev, nil
+}
+
+// DeleteSecretItem moves the item to the trash if the stored revision equals the expected one and returns
+// the deleted state; the tombstone is kept for the change feed.
+func (st *storage) DeleteSecretItem(ctx context.Context, itemID string, revision int64) (*domain.SecretItemRevision, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	seq, err := nextChangeSeq(ctx, tx, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	rev := domain.SecretItemRevision{ID: itemID, ChangeSeq: seq}
+	if err := tx.QueryRow(ctx,
+		`update secret_item set deleted_at = now(), revision = revision + 1, change_seq = $1
+		 where item_id = $2 and user_id = $3 and revision = $4 and deleted_at is null returning item_type, revision`,
+		seq, itemID, userID, revision).Scan(&rev.Type, &rev.Revision); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, st.revisionMismatchError(ctx, itemID, revision)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if _, err := tx.Exec(ctx,
+		`insert into secret_item_tombstone(item_id, user_id, item_type, change_seq) values ($1, $2, $3, $4)
+		 on conflict (item_id) do update set change_seq = excluded.change_seq, deleted_at = now()`,
+		itemID, userID, string(rev.Type), seq); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("item %v for userID %v moved to trash, seq %v", itemID, userID, seq))
+	return &rev, nil
+}
+
+// GetSecretItemChanges returns items changed after sinceSeq and tombstones of the deleted items.
+// The data is read in one repeatable read transaction, so Seq matches the returned changes.
+func (st *storage) GetSecretItemChanges(ctx context.Context, sinceSeq int64) (*domain.SecretItemChanges, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	changes := &domain.SecretItemChanges{}
+	if err := tx.QueryRow(ctx, `select change_seq from user_info where user_id = $1`, userID).Scan(&changes.Seq); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w - user %d is not exists", domain.ErrClientDataIncorrect, userID)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if sinceSeq > changes.Seq {
+		// the client knows the sequence the server never issued (the database was restored) - full reload
+		log.Infow(action, "msg", fmt.Sprintf("unknown seq %v for user %v, last seq %v", sinceSeq, userID, changes.Seq))
+		changes.Reset = true
+		sinceSeq = 0
+	}
+
+	rows, err := tx.Query(ctx,
+		`select item_id, item_type, name, lookup_key, content, revision, updated_at from (
+		   select item_id::text, item_type, name, lookup_key, content, revision, updated_at, change_seq from secret_item
+		   where user_id = $1 and change_seq > $2 and deleted_at is null
+		   union all
+		   select note_id::text, 'secure_note', title, lookup_key, content, revision, updated_at, change_seq from secure_note
+		   where user_id = $1 and change_seq > $2 and deleted_at is null
+		 ) items order by change_seq`, userID, sinceSeq)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	changes.Items, err = pgx.CollectRows(rows, scanSecretItem)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if !changes.Reset {
+		rows, err = tx.Query(ctx,
+			`select item_id::text, item_type from secret_item_tombstone
+			 where user_id = $1 and change_seq > $2 order by change_seq`, userID, sinceSeq)
+		if err != nil {
+			log.Infow(action, "err", err.Error())
+			return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+		}
+
+		changes.Deleted, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.DeletedSecretItem, error) {
+			var item domain.DeletedSecretItem
+			err := row.Scan(&item.ID, &item.Type)
+			return item, err
+		})
+		if err != nil {
+			log.Infow(action, "err", err.Error())
+			return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+		}
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("user %v changes since %v: %v items, %v deleted, seq %v",
+		userID, sinceSeq, len(changes.Items), len(changes.Deleted), changes.Seq))
+	return changes, nil
+}
+
+// GetChangeSeq returns the last change sequence number of the user.
+func (st *storage) GetChangeSeq(ctx context.Context) (int64, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	var seq int64
+	if err := st.pPool.QueryRow(ctx, `select change_seq from user_info where user_id = $1`, userID).Scan(&seq); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%w - user %d is not exists", domain.ErrClientDataIncorrect, userID)
+		}
+		log.Infow(action, "err", err.Error())
+		return 0, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return seq, nil
+}
+
+// nextChangeSeq increases the change sequence number of the user; the user row stays locked till the end
+// of the transaction, so the changes of the user are committed in the sequence order.
+func nextChangeSeq(ctx context.Context, tx pgx.Tx, userID domain.UserID) (int64, error) {
+	var seq int64
+	if err := tx.QueryRow(ctx,
+		`update user_info set change_seq = change_seq + 1 where user_id = $1 returning change_seq`, userID).Scan(&seq); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%w - user %d is not exists", domain.ErrClientDataIncorrect, userID)
+		}
+		return 0, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return seq, nil
+}
+
+// revisionMismatchError is called when the update or delete didn't find the item with the expected revision:
+// returns ErrDataNotExists if there is no item, otherwise ErrDataConflict.
+func (st *storage) revisionMismatchError(ctx context.Context, itemID string, revision int64) error {
+	userID, _ := domain.GetUserID(ctx)
+	action := domain.GetAction(2)
+	log := domain.GetCtxLogger(ctx)
+
+	var stored int64
+	if err := st.pPool.QueryRow(ctx,
+		`select revision from secret_item where item_id = $1 and user_id = $2 and deleted_at is null`, itemID, userID).Scan(&stored); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Infow(action, "err", fmt.Sprintf("item %v for user %v not exists", itemID, userID))
+			return fmt.Errorf("%w - item %v not exists", domain.ErrDataNotExists, itemID)
+		}
+		log.Infow(action, "err", err.Error())
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Infow(action, "err", fmt.Sprintf("item %v for user %v has revision %v, expected %v", itemID, userID, stored, revision))
+	return fmt.Errorf("%w - item %v has revision %v, expected %v", domain.ErrDataConflict, itemID, stored, revision)
+}
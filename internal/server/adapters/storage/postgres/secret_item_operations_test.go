@@ -0,0 +1,266 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretItemOperations(t *testing.T) {
+	ctx, cancelFN := context.WithCancel(context.Background())
+
+	defer cancelFN()
+
+	connString, err := postgresContainer.ConnectionString(ctx)
+
+	require.NoError(t, err)
+
+	storage := postgres.NewStorage(ctx, &config.ServerConf{
+		MaxConns:        5,
+		DatabaseDN:      connString,
+		MaxConnLifetime: 2 * time.Minute,
+		MaxConnIdleTime: 2 * time.Minute,
+	})
+
+	defer func() {
+		storage.Close()
+		err = clear(ctx)
+		require.NoError(t, err)
+	}()
+
+	err = storage.Ping(ctx)
+	require.NoError(t, err)
+
+	err = clear(ctx)
+	require.NoError(t, err)
+
+	testEmail := "email@email"
+	testEmail2 := "email2@email"
+
+	regData := &domain.FullRegistrationData{
+		EMail:              testEmail,
+		PasswordHash:       "PasswordHash",
+		PasswordSalt:       "PasswordSalt",
+		EncryptedOTPKey:    "EncryptedOTPKey",
+		MasterPasswordHint: "MasterPasswordHint",
+		HelloEncrypted:     "HelloEncrypted",
+	}
+
+	_, err = storage.Registrate(ctx, regData)
+	require.NoError(t, err)
+
+	lData, err := storage.GetLoginData(ctx, testEmail)
+	require.NoError(t, err)
+	require.NotNil(t, lData)
+
+	regData2 := &domain.FullRegistrationData{
+		EMail:              testEmail2,
+		PasswordHash:       "PasswordHash",
+		PasswordSalt:       "PasswordSalt",
+		EncryptedOTPKey:    "EncryptedOTPKey",
+		MasterPasswordHint: "MasterPasswordHint",
+		HelloEncrypted:     "HelloEncrypted",
+	}
+
+	_, err = storage.Registrate(ctx, regData2)
+	require.NoError(t, err)
+
+	lData2, err := storage.GetLoginData(ctx, testEmail2)
+	require.NoError(t, err)
+	require.NotNil(t, lData2)
+
+	userIdCtx := domain.EnrichWithUserID(ctx, lData.UserID)
+	userId2Ctx := domain.EnrichWithUserID(ctx, lData2.UserID)
+
+	_, err = storage.ListSecretItems(ctx, "")
+	require.ErrorIs(t, err, domain.ErrServerInternal)
+
+	resp, err := storage.ListSecretItems(userIdCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp))
+
+	cardRev, err := storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:    domain.SecretItemBankCard,
+		Name:    "name1",
+		Content: "content1",
+	})
+	require.NoError(t, err)
+	_, err = uuid.Parse(cardRev.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cardRev.Revision)
+	cardID := cardRev.ID
+
+	// the same name is allowed, items are distinguished by id
+	cardRev2, err := storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:    domain.SecretItemBankCard,
+		Name:    "name1",
+		Content: "content2",
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, cardID, cardRev2.ID)
+	cardID2 := cardRev2.ID
+
+	passRev, err := storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:      domain.SecretItemUserPassword,
+		Name:      "name3",
+		LookupKey: "key3",
+		Content:   "content3",
+	})
+	require.NoError(t, err)
+
+	// lookup key is unique for the item type
+	_, err = storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:      domain.SecretItemUserPassword,
+		Name:      "name4",
+		LookupKey: "key3",
+		Content:   "content4",
+	})
+	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+
+	// the same lookup key is allowed for another user
+	_, err = storage.CreateSecretItem(userId2Ctx, &domain.EncryptedSecretItem{
+		Type:      domain.SecretItemUserPassword,
+		Name:      "name4",
+		LookupKey: "key3",
+		Content:   "content4",
+	})
+	require.NoError(t, err)
+
+	resp, err = storage.ListSecretItems(userIdCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp))
+
+	resp, err = storage.ListSecretItems(userIdCtx, domain.SecretItemUserPassword)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp))
+	require.Equal(t, passRev.ID, resp[0].ID)
+	require.Equal(t, domain.SecretItemUserPassword, resp[0].Type)
+	require.Equal(t, "name3", resp[0].Name)
+	require.Equal(t, "key3", resp[0].LookupKey)
+	require.Equal(t, "content3", resp[0].Content)
+	require.Equal(t, int64(1), resp[0].Revision)
+
+	resp, err = storage.ListSecretItems(userId2Ctx, domain.SecretItemBankCard)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp))
+
+	item, err := storage.GetSecretItem(userIdCtx, cardID)
+	require.NoError(t, err)
+	require.Equal(t, cardID, item.ID)
+	require.Equal(t, domain.SecretItemBankCard, item.Type)
+	require.Equal(t, "content1", item.Content)
+
+	_, err = storage.GetSecretItem(userId2Ctx, cardID)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	rev, err := storage.UpdateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		ID:        cardID,
+		Name:      "name2",
+		LookupKey: "key1",
+		Content:   "content4",
+		Revision:  1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rev.Revision)
+
+	item, err = storage.GetSecretItem(userIdCtx, cardID)
+	require.NoError(t, err)
+	require.Equal(t, domain.SecretItemBankCard, item.Type)
+	require.Equal(t, "name2", item.Name)
+	require.Equal(t, "key1", item.LookupKey)
+	require.Equal(t, "content4", item.Content)
+	require.Equal(t, int64(2), item.Revision)
+
+	// update based on the old revision
+	_, err = storage.UpdateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		ID:       cardID,
+		Name:     "name2",
+		Content:  "content5",
+		Revision: 1,
+	})
+	require.ErrorIs(t, err, domain.ErrDataConflict)
+
+	_, err = storage.UpdateSecretItem(userId2Ctx, &domain.EncryptedSecretItem{
+		ID:       cardID,
+		Name:     "name2",
+		Content:  "content5",
+		Revision: 2,
+	})
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	_, err = storage.UpdateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		ID:        cardID2,
+		Name:      "name2",
+		LookupKey: "key1",
+		Content:   "content5",
+		Revision:  1,
+	})
+	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+
+	_, err = storage.DeleteSecretItem(userId2Ctx, cardID, 2)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	_, err = storage.DeleteSecretItem(userIdCtx, cardID, 1)
+	require.ErrorIs(t, err, domain.ErrDataConflict)
+
+	deleted, err := storage.DeleteSecretItem(userIdCtx, cardID, 2)
+	require.NoError(t, err)
+	require.Equal(t, domain.SecretItemBankCard, deleted.Type)
+
+	_, err = storage.DeleteSecretItem(userIdCtx, cardID, 2)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	resp, err = storage.ListSecretItems(userIdCtx, domain.SecretItemBankCard)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp))
+	require.Equal(t, cardID2, resp[0].ID)
+
+	allItems, err := storage.ListSecretItems(userIdCtx, "")
+	require.NoError(t, err)
+
+	changes, err := storage.GetSecretItemChanges(userIdCtx, 0)
+	require.NoError(t, err)
+	require.False(t, changes.Reset)
+	require.True(t, changes.Seq > 0)
+	require.Equal(t, len(allItems), len(changes.Items))
+	require.Equal(t, 1, len(changes.Deleted))
+	require.Equal(t, cardID, changes.Deleted[0].ID)
+	require.Equal(t, domain.SecretItemBankCard, changes.Deleted[0].Type)
+
+	rev, err = storage.UpdateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		ID:       cardID2,
+		Name:     "name3",
+		Content:  "content6",
+		Revision: resp[0].Revision,
+	})
+	require.NoError(t, err)
+
+	delta, err := storage.GetSecretItemChanges(userIdCtx, changes.Seq)
+	require.NoError(t, err)
+	require.False(t, delta.Reset)
+	require.Equal(t, changes.Seq+1, delta.Seq)
+	require.Equal(t, delta.Seq, rev.ChangeSeq)
+	require.Equal(t, domain.SecretItemBankCard, rev.Type)
+	require.Equal(t, 1, len(delta.Items))
+	require.Equal(t, cardID2, delta.Items[0].ID)
+	require.Equal(t, rev.Revision, delta.Items[0].Revision)
+	require.Equal(t, 0, len(delta.Deleted))
+
+	seq, err := storage.GetChangeSeq(userIdCtx)
+	require.NoError(t, err)
+	require.Equal(t, delta.Seq, seq)
+
+	// the sequence the server never issued
+	reset, err := storage.GetSecretItemChanges(userIdCtx, delta.Seq+100)
+	require.NoError(t, err)
+	require.True(t, reset.Reset)
+	require.Equal(t, delta.Seq, reset.Seq)
+	require.Equal(t, len(allItems), len(reset.Items))
+	require.Equal(t, 0, len(reset.Deleted))
+}
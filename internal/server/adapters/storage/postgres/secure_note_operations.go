@@ -0,0 +1,275 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Secure notes are stored in their own table. A note is returned as a secret item of type secure_note
+// with the encrypted title as the name; the notes share the change sequence, the tombstones, the trash
+// and the history lookups with the other items.
+
+// ListSecureNotes returns notes of the user ordered by creation time.
+func (st *storage) ListSecureNotes(ctx context.Context) ([]domain.EncryptedSecretItem, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	rows, err := st.pPool.Query(ctx,
+		`select note_id::text, 'secure_note', title, lookup_key, content, revision, updated_at from secure_note
+		 where user_id = $1 and deleted_at is null order by created_at, note_id`, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	result, err := pgx.CollectRows(rows, scanSecretItem)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	return result, nil
+}
+
+func (st *storage) GetSecureNote(ctx context.Context, noteID string) (*domain.EncryptedSecretItem, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	var note domain.EncryptedSecretItem
+	if err := st.pPool.QueryRow(ctx,
+		`select note_id::text, 'secure_note', title, lookup_key, content, revision, updated_at from secure_note
+		 where note_id = $1 and user_id = $2 and deleted_at is null`,
+		noteID, userID).Scan(&note.ID, &note.Type, &note.Name, &note.LookupKey, &note.Content, &note.Revision, &note.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Infow(action, "err", fmt.Sprintf("note %v for user %v not exists", noteID, userID))
+			return nil, fmt.Errorf("%w - note %v not exists", domain.ErrDataNotExists, noteID)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	return &note, nil
+}
+
+// CreateSecureNote stores the note and returns id generated by the database and the initial revision;
+// the lookup key must be unique among the notes of the user.
+func (st *storage) CreateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	seq, err := nextChangeSeq(ctx, tx, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	rev := domain.SecretItemRevision{Type: domain.SecretItemSecureNote, ChangeSeq: seq}
+	if err := tx.QueryRow(ctx,
+		`insert into secure_note(user_id, title, lookup_key, content, change_seq) values ($1, $2, $3, $4, $5)
+		 on conflict (user_id, lookup_key) where lookup_key <> '' and deleted_at is null do nothing returning note_id::text, revision`,
+		userID, note.Name, note.LookupKey, note.Content, seq).Scan(&rev.ID, &rev.Revision); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Infow(action, "err", fmt.Sprintf("note with the same lookup key for user %v already exists", userID))
+			return nil, fmt.Errorf("%w - note already exists", domain.ErrClientDataIncorrect)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("note %v for userID %v created, seq %v", rev.ID, userID, seq))
+	return &rev, nil
+}
+
+// UpdateSecureNote replaces title, lookup key and content of the note if the stored revision equals note.Revision;
+// returns the increased revision. The replaced revision is kept in the note history.
+func (st *storage) UpdateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	seq, err := nextChangeSeq(ctx, tx, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	rev, err := st.updateSecureNoteTx(ctx, tx, userID, note, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("note %v for userID %v updated to revision %v, seq %v", rev.ID, userID, rev.Revision, seq))
+	return rev, nil
+}
+
+// updateSecureNoteTx keeps the stored revision of the note in the history and replaces it with the note data;
+// the update is applied only to the stored revision note.Revision.
+func (st *storage) updateSecureNoteTx(ctx context.Context, tx pgx.Tx, userID domain.UserID,
+	note *domain.EncryptedSecretItem, seq int64) (*domain.SecretItemRevision, error) {
+	action := domain.GetAction(2)
+	log := domain.GetCtxLogger(ctx)
+
+	if _, err := tx.Exec(ctx,
+		`insert into secure_note_history(note_id, user_id, revision, title, lookup_key, content, updated_at)
+		 select note_id, user_id, revision, title, lookup_key, content, updated_at from secure_note
+		 where note_id = $1 and user_id = $2 and revision = $3 and deleted_at is null
+		 on conflict (note_id, revision) do nothing`,
+		note.ID, userID, note.Revision); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	rev := domain.SecretItemRevision{ID: note.ID, Type: domain.SecretItemSecureNote, ChangeSeq: seq}
+	if err := tx.QueryRow(ctx,
+		`update secure_note set title = $1, lookup_key = $2, content = $3, revision = revision + 1, change_seq = $4, updated_at = now()
+		 where note_id = $5 and user_id = $6 and revision = $7 and deleted_at is null returning revision`,
+		note.Name, note.LookupKey, note.Content, seq, note.ID, userID, note.Revision).Scan(&rev.Revision); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, st.noteRevisionMismatchError(ctx, note.ID, note.Revision)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			log.Infow(action, "err", fmt.Sprintf("note with the same lookup key for user %v already exists", userID))
+			return nil, fmt.Errorf("%w - note already exists", domain.ErrClientDataIncorrect)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return &rev, nil
+}
+
+// DeleteSecureNote moves the note to the trash if the stored revision equals the expected one and returns
+// the deleted state; the tombstone is kept for the change feed.
+func (st *storage) DeleteSecureNote(ctx context.Context, noteID string, revision int64) (*domain.SecretItemRevision, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	seq, err := nextChangeSeq(ctx, tx, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	rev := domain.SecretItemRevision{ID: noteID, Type: domain.SecretItemSecureNote, ChangeSeq: seq}
+	if err := tx.QueryRow(ctx,
+		`update secure_note set deleted_at = now(), revision = revision + 1, change_seq = $1
+		 where note_id = $2 and user_id = $3 and revision = $4 and deleted_at is null returning revision`,
+		seq, noteID, userID, revision).Scan(&rev.Revision); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, st.noteRevisionMismatchError(ctx, noteID, revision)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if _, err := tx.Exec(ctx,
+		`insert into secret_item_tombstone(item_id, user_id, item_type, change_seq) values ($1, $2, $3, $4)
+		 on conflict (item_id) do update set change_seq = excluded.change_seq, deleted_at = now()`,
+		noteID, userID, string(domain.SecretItemSecureNote), seq); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("note %v for userID %v moved to trash, seq %v", noteID, userID, seq))
+	return &rev, nil
+}
+
+// noteRevisionMismatchError is called when the update or delete didn't find the note with the expected revision:
+// returns ErrDataNotExists if there is no note, otherwise ErrDataConflict.
+func (st *storage) noteRevisionMismatchError(ctx context.Context, noteID string, revision int64) error {
+	userID, _ := domain.GetUserID(ctx)
+	action := domain.GetAction(2)
+	log := domain.GetCtxLogger(ctx)
+
+	var stored int64
+	if err := st.pPool.QueryRow(ctx,
+		`select revision from secure_note where note_id = $1 and user_id = $2 and deleted_at is null`, noteID, userID).Scan(&stored); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Infow(action, "err", fmt.Sprintf("note %v for user %v not exists", noteID, userID))
+			return fmt.Errorf("%w - note %v not exists", domain.ErrDataNotExists, noteID)
+		}
+		log.Infow(action, "err", err.Error())
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Infow(action, "err", fmt.Sprintf("note %v for user %v has revision %v, expected %v", noteID, userID, stored, revision))
+	return fmt.Errorf("%w - note %v has revision %v, expected %v", domain.ErrDataConflict, noteID, stored, revision)
+}
+
+func scanSecretItem(row pgx.CollectableRow) (domain.EncryptedSecretItem, error) {
+	var item domain.EncryptedSecretItem
+	err := row.Scan(&item.ID, &item.Type, &item.Name, &item.LookupKey, &item.Content, &item.Revision, &item.UpdatedAt)
+	return item, err
+}
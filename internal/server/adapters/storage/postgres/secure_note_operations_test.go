@@ -0,0 +1,170 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecureNoteOperations(t *testing.T) {
+	ctx, cancelFN := context.WithCancel(context.Background())
+
+	defer cancelFN()
+
+	connString, err := postgresContainer.ConnectionString(ctx)
+
+	require.NoError(t, err)
+
+	storage := postgres.NewStorage(ctx, &config.ServerConf{
+		MaxConns:        5,
+		DatabaseDN:      connString,
+		MaxConnLifetime: 2 * time.Minute,
+		MaxConnIdleTime: 2 * time.Minute,
+	})
+
+	defer func() {
+		storage.Close()
+		err = clear(ctx)
+		require.NoError(t, err)
+	}()
+
+	err = storage.Ping(ctx)
+	require.NoError(t, err)
+
+	err = clear(ctx)
+	require.NoError(t, err)
+
+	testEmail := "email@email"
+
+	_, err = storage.Registrate(ctx, &domain.FullRegistrationData{
+		EMail:              testEmail,
+		PasswordHash:       "PasswordHash",
+		PasswordSalt:       "PasswordSalt",
+		EncryptedOTPKey:    "EncryptedOTPKey",
+		MasterPasswordHint: "MasterPasswordHint",
+		HelloEncrypted:     "HelloEncrypted",
+	})
+	require.NoError(t, err)
+
+	lData, err := storage.GetLoginData(ctx, testEmail)
+	require.NoError(t, err)
+
+	userIdCtx := domain.EnrichWithUserID(ctx, lData.UserID)
+
+	passRev, err := storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:      domain.SecretItemUserPassword,
+		Name:      "name1",
+		LookupKey: "key1",
+		Content:   "content1",
+	})
+	require.NoError(t, err)
+
+	noteRev, err := storage.CreateSecureNote(userIdCtx, &domain.EncryptedSecretItem{
+		Name:      "title1",
+		LookupKey: "key1",
+		Content:   "text1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, domain.SecretItemSecureNote, noteRev.Type)
+	require.Equal(t, int64(1), noteRev.Revision)
+	require.Greater(t, noteRev.ChangeSeq, passRev.ChangeSeq)
+
+	_, err = storage.CreateSecureNote(userIdCtx, &domain.EncryptedSecretItem{
+		Name:      "title2",
+		LookupKey: "key1",
+		Content:   "text2",
+	})
+	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+
+	// the note is stored apart from the other items
+	notes, err := storage.ListSecureNotes(userIdCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(notes))
+	require.Equal(t, noteRev.ID, notes[0].ID)
+	require.Equal(t, domain.SecretItemSecureNote, notes[0].Type)
+	require.Equal(t, "title1", notes[0].Name)
+
+	items, err := storage.ListSecretItems(userIdCtx, domain.SecretItemUserPassword)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+
+	changes, err := storage.GetSecretItemChanges(userIdCtx, passRev.ChangeSeq)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes.Items))
+	require.Equal(t, noteRev.ID, changes.Items[0].ID)
+	require.Equal(t, domain.SecretItemSecureNote, changes.Items[0].Type)
+
+	updated, err := storage.UpdateSecureNote(userIdCtx, &domain.EncryptedSecretItem{
+		ID:        noteRev.ID,
+		Name:      "title1",
+		LookupKey: "key1",
+		Content:   "text3",
+		Revision:  noteRev.Revision,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.Revision)
+
+	_, err = storage.UpdateSecureNote(userIdCtx, &domain.EncryptedSecretItem{
+		ID:        noteRev.ID,
+		Name:      "title1",
+		LookupKey: "key1",
+		Content:   "text4",
+		Revision:  noteRev.Revision,
+	})
+	require.ErrorIs(t, err, domain.ErrDataConflict)
+
+	// the history keeps the replaced revision
+	revisions, err := storage.ListSecretItemRevisions(userIdCtx, noteRev.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(revisions))
+
+	old, err := storage.GetSecretItemRevision(userIdCtx, noteRev.ID, 1)
+	require.NoError(t, err)
+	require.Equal(t, "text1", old.Content)
+
+	restored, err := storage.RestoreSecretItemRevision(userIdCtx, noteRev.ID, 1, updated.Revision)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), restored.Revision)
+
+	note, err := storage.GetSecureNote(userIdCtx, noteRev.ID)
+	require.NoError(t, err)
+	require.Equal(t, "text1", note.Content)
+
+	deleted, err := storage.DeleteSecureNote(userIdCtx, noteRev.ID, restored.Revision)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), deleted.Revision)
+
+	_, err = storage.GetSecureNote(userIdCtx, noteRev.ID)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	changes, err = storage.GetSecretItemChanges(userIdCtx, restored.ChangeSeq)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes.Items))
+	require.Equal(t, []domain.DeletedSecretItem{{ID: noteRev.ID, Type: domain.SecretItemSecureNote}}, changes.Deleted)
+
+	// the trash covers the notes
+	trash, err := storage.ListTrashItems(userIdCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trash))
+	require.Equal(t, domain.SecretItemSecureNote, trash[0].Type)
+
+	rev, err := storage.RestoreSecretItem(userIdCtx, noteRev.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.SecretItemSecureNote, rev.Type)
+
+	_, err = storage.DeleteSecureNote(userIdCtx, noteRev.ID, rev.Revision)
+	require.NoError(t, err)
+
+	count, err := storage.DeleteTrashItems(userIdCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	notes, err = storage.ListSecureNotes(userIdCtx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(notes))
+}
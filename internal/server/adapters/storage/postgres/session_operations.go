@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// SessionTouchInterval limits the updates of the session last-seen time; the session is written at most once
+// per interval, not on every request.
+const SessionTouchInterval = time.Minute
+
+// staleSession selects the sessions without a live refresh token that were not seen for SessionTouchInterval:
+// the client was closed or crashed and the session can't be continued. The new session is not stale
+// until its first refresh token is stored.
+const staleSession = `not exists (select 1 from refresh_token t where t.family_id = s.session_id and t.expires_at >= now())
+	and s.last_seen_at < now() - make_interval(secs => $1)`
+
+func (st *storage) CreateSession(ctx context.Context, session *domain.SessionInfo) error {
+	if _, err := st.pPool.Exec(ctx,
+		`insert into user_session(session_id, user_id, device_name, client_version, peer_ip) 
+		 values ($1, $2, $3, $4, $5)`,
+		session.SessionID, session.UserID, session.DeviceName, session.ClientVersion, session.PeerIP); err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+// TouchSession updates session last-seen time once per SessionTouchInterval. Returns false if the session is terminated.
+func (st *storage) TouchSession(ctx context.Context, sessionID string) (bool, error) {
+	var active bool
+	if err := st.pPool.QueryRow(ctx,
+		`with touched as (
+			update user_session set last_seen_at = now()
+			where session_id = $1 and last_seen_at < now() - make_interval(secs => $2)
+		)
+		select exists(select 1 from user_session where session_id = $1)`,
+		sessionID, SessionTouchInterval.Seconds()).Scan(&active); err != nil {
+		return false, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return active, nil
+}
+
+// deleteStaleSessions removes the sessions left by the clients that were closed without logout.
+func (st *storage) deleteStaleSessions(ctx context.Context) error {
+	if _, err := st.pPool.Exec(ctx, "delete from user_session s where "+staleSession,
+		SessionTouchInterval.Seconds()); err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+func (st *storage) GetSessionList(ctx context.Context) ([]domain.SessionInfo, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	rows, err := st.pPool.Query(ctx,
+		`select session_id, device_name, client_version, peer_ip, created_at, last_seen_at 
+		 from user_session s where not (`+staleSession+`) and user_id = $2 order by created_at`,
+		SessionTouchInterval.Seconds(), userID)
+
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer rows.Close()
+
+	var result []domain.SessionInfo
+
+	for rows.Next() {
+		session := domain.SessionInfo{
+			UserID: userID,
+		}
+		err = rows.Scan(&session.SessionID, &session.DeviceName, &session.ClientVersion,
+			&session.PeerIP, &session.CreatedAt, &session.LastSeenAt)
+		if err != nil {
+			log.Infow(action, "err", err.Error())
+			return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+		}
+		result = append(result, session)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	return result, nil
+}
+
+// DeleteSession terminates user session; session refresh tokens are removed by cascade.
+func (st *storage) DeleteSession(ctx context.Context, sessionID string) error {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	var id string
+	if err := st.pPool.QueryRow(ctx,
+		"delete from user_session where session_id = $1 and user_id = $2 returning session_id",
+		sessionID, userID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Infow(action, "err", fmt.Sprintf("session %v for user %v not exists", sessionID, userID))
+			return fmt.Errorf("%w - session %v for user %v not exists", domain.ErrClientDataIncorrect, sessionID, userID)
+		}
+		log.Infow(action, "err", err.Error())
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionOperations(t *testing.T) {
+	ctx, cancelFN := context.WithCancel(context.Background())
+
+	defer cancelFN()
+
+	connString, err := postgresContainer.ConnectionString(ctx)
+
+	require.NoError(t, err)
+
+	storage := postgres.NewStorage(ctx, &config.ServerConf{
+		MaxConns:        5,
+		DatabaseDN:      connString,
+		MaxConnLifetime: 2 * time.Minute,
+		MaxConnIdleTime: 2 * time.Minute,
+	})
+
+	defer func() {
+		storage.Close()
+		err = clear(ctx)
+		require.NoError(t, err)
+	}()
+
+	err = clear(ctx)
+	require.NoError(t, err)
+
+	testEmail := "email@email"
+	_, err = storage.Registrate(ctx, &domain.FullRegistrationData{
+		EMail:              testEmail,
+		PasswordHash:       "PasswordHash",
+		PasswordSalt:       "PasswordSalt",
+		EncryptedOTPKey:    "EncryptedOTPKey",
+		MasterPasswordHint: "MasterPasswordHint",
+		HelloEncrypted:     "HelloEncrypted",
+	})
+	require.NoError(t, err)
+
+	lData, err := storage.GetLoginData(ctx, testEmail)
+	require.NoError(t, err)
+
+	session := &domain.SessionInfo{
+		SessionID:     "sessionID",
+		UserID:        lData.UserID,
+		DeviceName:    "laptop",
+		ClientVersion: "v1.0.0",
+		PeerIP:        "127.0.0.1",
+	}
+
+	err = storage.CreateSession(ctx, session)
+	require.NoError(t, err)
+
+	err = storage.CreateRefreshToken(ctx, &domain.RefreshTokenData{
+		Hash:      "hash",
+		UserID:    lData.UserID,
+		FamilyID:  session.SessionID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	ok, err := storage.TouchSession(ctx, session.SessionID)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = storage.GetSessionList(ctx)
+	require.ErrorIs(t, err, domain.ErrServerInternal)
+
+	ctxWithID := domain.EnrichWithUserID(ctx, lData.UserID)
+	sessions, err := storage.GetSessionList(ctxWithID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session.SessionID, sessions[0].SessionID)
+	require.Equal(t, session.DeviceName, sessions[0].DeviceName)
+	require.Equal(t, session.ClientVersion, sessions[0].ClientVersion)
+	require.Equal(t, session.PeerIP, sessions[0].PeerIP)
+	require.False(t, sessions[0].LastSeenAt.Before(sessions[0].CreatedAt))
+
+	// last-seen time is updated once per interval
+	_, err = pPool.Exec(ctx, "update user_session set last_seen_at = now() - interval '30 seconds' where session_id = $1", session.SessionID)
+	require.NoError(t, err)
+	ok, err = storage.TouchSession(ctx, session.SessionID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	sessions, err = storage.GetSessionList(ctxWithID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.True(t, sessions[0].LastSeenAt.Before(time.Now().Add(-20*time.Second)))
+
+	_, err = pPool.Exec(ctx, "update user_session set last_seen_at = now() - interval '2 minutes' where session_id = $1", session.SessionID)
+	require.NoError(t, err)
+	ok, err = storage.TouchSession(ctx, session.SessionID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	sessions, err = storage.GetSessionList(ctxWithID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.True(t, sessions[0].LastSeenAt.After(time.Now().Add(-20*time.Second)))
+
+	// the session of a closed client without a live refresh token is not listed and is removed
+	staleSession := &domain.SessionInfo{
+		SessionID:     "staleSessionID",
+		UserID:        lData.UserID,
+		DeviceName:    "phone",
+		ClientVersion: "v1.0.0",
+		PeerIP:        "127.0.0.2",
+	}
+	err = storage.CreateSession(ctx, staleSession)
+	require.NoError(t, err)
+	sessions, err = storage.GetSessionList(ctxWithID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	_, err = pPool.Exec(ctx, "update user_session set last_seen_at = now() - interval '2 minutes' where session_id = $1", staleSession.SessionID)
+	require.NoError(t, err)
+	sessions, err = storage.GetSessionList(ctxWithID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session.SessionID, sessions[0].SessionID)
+
+	err = storage.CreateRefreshToken(ctx, &domain.RefreshTokenData{
+		Hash:      "otherHash",
+		UserID:    lData.UserID,
+		FamilyID:  session.SessionID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	ok, err = storage.TouchSession(ctx, staleSession.SessionID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	err = storage.DeleteSession(domain.EnrichWithUserID(ctx, lData.UserID+100), session.SessionID)
+	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+
+	err = storage.DeleteSession(ctxWithID, session.SessionID)
+	require.NoError(t, err)
+
+	ok, err = storage.TouchSession(ctx, session.SessionID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// refresh tokens are removed with the session
+	_, err = storage.RotateRefreshToken(ctx, "hash", "newHash", time.Now().Add(time.Hour))
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	sessions, err = storage.GetSessionList(ctxWithID)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
@@ -23,33 +24,44 @@ func NewStorage(appCnf context.Context, conf *config.ServerConf) *storage {
 	return st
 }
 
-func initializePGXConf(ctx context.Context, conf *config.ServerConf) *storage {
-
-	logger := domain.GetApplicationLogger()
+// OpenStorage opens the storage with own connection pool without applying the migrations; the error is returned
+// if the database schema is not up to date. Is used by the administration commands.
+func OpenStorage(ctx context.Context, conf *config.ServerConf) (*storage, error) {
+	pPool, err := newPGXPool(ctx, conf)
+	if err != nil {
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
 
-	logger.Infow("initializePGXConf", "status", "start")
+	mgr, err := newMigrator(pPool)
+	if err != nil {
+		pPool.Close()
+		return nil, err
+	}
 
-	pConf, err := pgxpool.ParseConfig(conf.DatabaseDN)
+	statuses, err := mgr.Status(ctx)
 	if err != nil {
-		panic(err)
+		pPool.Close()
+		return nil, err
 	}
 
-	// Конфигурация по мотивам
-	// https://habr.com/ru/companies/oleg-bunin/articles/461935/
-	pConf.MaxConns = int32(conf.MaxConns)
-	pConf.ConnConfig.RuntimeParams["standard_conforming_strings"] = "on"
-	pConf.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
+	for _, status := range statuses {
+		if !status.Applied {
+			pPool.Close()
+			return nil, fmt.Errorf("%w - migration %d %s is not applied, run `server migrate up`",
+				domain.ErrServerInternal, status.Version, status.Name)
+		}
+	}
 
-	pConf.MaxConnLifetime = conf.MaxConnIdleTime
-	pConf.MaxConnIdleTime = conf.MaxConnIdleTime
+	return &storage{pPool: pPool}, nil
+}
 
-	pConf.ConnConfig.Tracer = &tracelog.TraceLog{
-		Logger:   NewLogAdapter(logger),
-		LogLevel: tracelog.LogLevelError,
-	}
+func initializePGXConf(ctx context.Context, conf *config.ServerConf) *storage {
+
+	logger := domain.GetApplicationLogger()
 
-	pPool, err := pgxpool.NewWithConfig(ctx, pConf)
+	logger.Infow("initializePGXConf", "status", "start")
 
+	pPool, err := newPGXPool(ctx, conf)
 	if err != nil {
 		panic(err)
 	}
@@ -66,79 +78,48 @@ func initializePGXConf(ctx context.Context, conf *config.ServerConf) *storage {
 	return st
 }
 
-type storage struct {
-	pPool *pgxpool.Pool
-}
-
-func (st *storage) init(ctx context.Context, logger domain.Logger) error {
-	logger.Infow("init", "status", "start")
-
-	tx, err := st.pPool.Begin(ctx)
+func newPGXPool(ctx context.Context, conf *config.ServerConf) (*pgxpool.Pool, error) {
+	pConf, err := pgxpool.ParseConfig(conf.DatabaseDN)
 	if err != nil {
-		return err
-	}
-	defer func() {
-		_ = tx.Rollback(ctx)
-	}()
-
-	if _, err = tx.Exec(ctx, `
-	create table if not exists user_info (
-		user_id bigserial,
-		email text not null,
-		pass_hash text not null,
-		pass_salt text not null,
-		otp_key text not null,
-		master_hint text not null,
-		hello_encrypted text not null,
-		bucket text not null,
-		primary key(user_id)
-	);`); err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	if _, err = tx.Exec(ctx, `create unique index if not exists idx_user_info_email on user_info(email);`); err != nil {
-		panic(err)
-	}
+	// Конфигурация по мотивам
+	// https://habr.com/ru/companies/oleg-bunin/articles/461935/
+	pConf.MaxConns = int32(conf.MaxConns)
+	pConf.ConnConfig.RuntimeParams["standard_conforming_strings"] = "on"
+	pConf.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
 
-	if _, err = tx.Exec(ctx, `
-	create table if not exists bank_card (
-		id bigserial,
-		number text not null,
-		content text not null,
-		user_id bigint not null references user_info (user_id) on delete cascade,
-		primary key(id)
-	);`); err != nil {
-		panic(err)
-	}
+	pConf.MaxConnLifetime = conf.MaxConnIdleTime
+	pConf.MaxConnIdleTime = conf.MaxConnIdleTime
 
-	if _, err = tx.Exec(ctx, `create unique index if not exists idx_bank_card_number on bank_card(number,user_id);`); err != nil {
-		panic(err)
+	pConf.ConnConfig.Tracer = &tracelog.TraceLog{
+		Logger:   NewLogAdapter(domain.GetApplicationLogger()),
+		LogLevel: tracelog.LogLevelError,
 	}
 
-	if _, err = tx.Exec(ctx, `create index if not exists idx_bank_card_user_id on bank_card(user_id);`); err != nil {
-		panic(err)
-	}
+	return pgxpool.NewWithConfig(ctx, pConf)
+}
 
-	if _, err = tx.Exec(ctx, `
-	create table if not exists user_password_data (
-		id bigserial,
-		hint text not null,
-		content text not null,
-		user_id bigint not null references user_info (user_id) on delete cascade,
-		primary key(id)
-	);`); err != nil {
-		panic(err)
-	}
+type storage struct {
+	pPool *pgxpool.Pool
+}
 
-	if _, err = tx.Exec(ctx, `create unique index if not exists idx_user_password_data_hint on user_password_data(hint,user_id);`); err != nil {
-		panic(err)
+// init applies all pending schema migrations.
+func (st *storage) init(ctx context.Context, logger domain.Logger) error {
+	logger.Infow("init", "status", "start")
+
+	mgr, err := newMigrator(st.pPool)
+	if err != nil {
+		return err
 	}
 
-	if _, err = tx.Exec(ctx, `create index if not exists idx_user_password_data_user_id on user_password_data(user_id);`); err != nil {
-		panic(err)
+	if err := mgr.Up(ctx); err != nil {
+		return err
 	}
 
-	return tx.Commit(ctx)
+	logger.Infow("init", "status", "complete")
+	return nil
 }
 
 func (st *storage) Ping(ctx context.Context) error {
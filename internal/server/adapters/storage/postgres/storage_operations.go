@@ -21,7 +21,7 @@ func (st *storage) IsEMailAvailable(ctx context.Context, email string) (bool, er
 	}
 }
 
-func (st *storage) Registrate(ctx context.Context, data *domain.FullRegistrationData) error {
+func (st *storage) Registrate(ctx context.Context, data *domain.FullRegistrationData) (domain.UserID, error) {
 
 	var userID int64
 
@@ -35,19 +35,19 @@ func (st *storage) Registrate(ctx context.Context, data *domain.FullRegistration
 		data.MasterPasswordHint, data.HelloEncrypted, uuid).Scan(&userID); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			// email already registered
-			return fmt.Errorf("%w - email %s already registered", domain.ErrClientDataIncorrect, data.EMail)
+			return 0, fmt.Errorf("%w - email %s already registered", domain.ErrClientDataIncorrect, data.EMail)
 		}
-		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+		return 0, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
 	} else {
-		return nil
+		return domain.UserID(userID), nil
 	}
 }
 
 func (st *storage) GetLoginData(ctx context.Context, email string) (*domain.LoginData, error) {
 
 	var loginData domain.LoginData
-	err := st.pPool.QueryRow(ctx, "select user_id, email, pass_hash, pass_salt, otp_key from user_info where email = $1", email).
-		Scan(&loginData.UserID, &loginData.EMail, &loginData.PasswordHash, &loginData.PasswordSalt, &loginData.EncryptedOTPKey)
+	err := st.pPool.QueryRow(ctx, "select user_id, email, pass_hash, pass_salt, otp_key, cert_fingerprint from user_info where email = $1", email).
+		Scan(&loginData.UserID, &loginData.EMail, &loginData.PasswordHash, &loginData.PasswordSalt, &loginData.EncryptedOTPKey, &loginData.CertFingerprint)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			// email already registered
@@ -59,6 +59,47 @@ func (st *storage) GetLoginData(ctx context.Context, email string) (*domain.Logi
 	}
 }
 
+func (st *storage) UpdatePasswordHash(ctx context.Context, userID domain.UserID, data *domain.HashData) error {
+	var id domain.UserID
+	if err := st.pPool.QueryRow(ctx,
+		"update user_info set pass_hash = $1, pass_salt = $2 where user_id = $3 returning user_id",
+		data.Hash, data.Salt, userID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w - user %v not exists", domain.ErrClientDataIncorrect, userID)
+		}
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+// SetCertFingerprint binds account to the client certificate; empty fingerprint removes the binding.
+func (st *storage) SetCertFingerprint(ctx context.Context, email string, fingerprint string) error {
+	var id domain.UserID
+	if err := st.pPool.QueryRow(ctx,
+		"update user_info set cert_fingerprint = $1 where email = $2 returning user_id",
+		fingerprint, email).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w - email %s not registered", domain.ErrClientDataIncorrect, email)
+		}
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+// AcceptOTPStep stores the last accepted TOTP time-step. Returns false if the step (or later) is already used.
+func (st *storage) AcceptOTPStep(ctx context.Context, userID domain.UserID, step int64) (bool, error) {
+	var id domain.UserID
+	if err := st.pPool.QueryRow(ctx,
+		"update user_info set otp_last_step = $1 where user_id = $2 and otp_last_step < $1 returning user_id",
+		step, userID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return true, nil
+}
+
 func (st *storage) GetHelloData(ctx context.Context) (*domain.HelloData, error) {
 	userID, err := domain.GetUserID(ctx)
 	if err != nil {
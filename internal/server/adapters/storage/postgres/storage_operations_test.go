@@ -57,10 +57,10 @@ func TestStorageOperations(t *testing.T) {
 	require.Nil(t, lData)
 	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 
-	err = storage.Registrate(ctx, regData)
+	_, err = storage.Registrate(ctx, regData)
 	require.NoError(t, err)
 
-	err = storage.Registrate(ctx, regData)
+	_, err = storage.Registrate(ctx, regData)
 	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 
 	ok, err = storage.IsEMailAvailable(ctx, testEmail)
@@ -75,6 +75,44 @@ func TestStorageOperations(t *testing.T) {
 	require.Equal(t, regData.PasswordHash, lData.PasswordHash)
 	require.Equal(t, regData.PasswordSalt, lData.PasswordSalt)
 	require.True(t, lData.UserID > 0)
+	require.Equal(t, "", lData.CertFingerprint)
+
+	err = storage.UpdatePasswordHash(ctx, lData.UserID, &domain.HashData{Hash: "$argon2id$NewHash"})
+	require.NoError(t, err)
+
+	lData2, err := storage.GetLoginData(ctx, testEmail)
+	require.NoError(t, err)
+	require.Equal(t, "$argon2id$NewHash", lData2.PasswordHash)
+	require.Equal(t, "", lData2.PasswordSalt)
+
+	err = storage.UpdatePasswordHash(ctx, lData.UserID+100, &domain.HashData{Hash: "$argon2id$NewHash"})
+	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+
+	ok, err = storage.AcceptOTPStep(ctx, lData.UserID, 100)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = storage.AcceptOTPStep(ctx, lData.UserID, 100)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = storage.AcceptOTPStep(ctx, lData.UserID, 99)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = storage.AcceptOTPStep(ctx, lData.UserID, 101)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	err = storage.SetCertFingerprint(ctx, testEmail, "fingerprint")
+	require.NoError(t, err)
+
+	lData2, err = storage.GetLoginData(ctx, testEmail)
+	require.NoError(t, err)
+	require.Equal(t, "fingerprint", lData2.CertFingerprint)
+
+	err = storage.SetCertFingerprint(ctx, "testEmail", "fingerprint")
+	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
 
 	_, err = storage.GetLoginData(ctx, "testEmail")
 	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
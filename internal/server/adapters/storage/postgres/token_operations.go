@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateRefreshToken stores new refresh token. Expired tokens and the sessions left without a live token
+// are removed at the same time.
+func (st *storage) CreateRefreshToken(ctx context.Context, data *domain.RefreshTokenData) error {
+	if _, err := st.pPool.Exec(ctx, "delete from refresh_token where expires_at < now()"); err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := st.deleteStaleSessions(ctx); err != nil {
+		return err
+	}
+
+	if _, err := st.pPool.Exec(ctx,
+		"insert into refresh_token(token_hash, user_id, family_id, expires_at) values ($1, $2, $3, $4)",
+		data.Hash, data.UserID, data.FamilyID, data.ExpiresAt); err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+// RotateRefreshToken marks the old token as used and stores the new one in the same family.
+// Reuse of an already rotated token means the token is stolen, so the whole session is terminated.
+func (st *storage) RotateRefreshToken(ctx context.Context, oldHash string, newHash string, expiresAt time.Time) (*domain.RefreshTokenData, error) {
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var userID domain.UserID
+	var familyID string
+	var used bool
+	var tokenExpiresAt time.Time
+
+	if err := tx.QueryRow(ctx,
+		"select user_id, family_id, used, expires_at from refresh_token where token_hash = $1 for update",
+		oldHash).Scan(&userID, &familyID, &used, &tokenExpiresAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w - refresh token not found", domain.ErrNotAuthorized)
+		}
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if used {
+		if _, err := tx.Exec(ctx, "delete from user_session where session_id = $1", familyID); err != nil {
+			return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+		}
+		return nil, fmt.Errorf("%w - refresh token reuse detected, user %v", domain.ErrNotAuthorized, userID)
+	}
+
+	if tokenExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("%w - refresh token expired", domain.ErrNotAuthorized)
+	}
+
+	if _, err := tx.Exec(ctx, "update refresh_token set used = true where token_hash = $1", oldHash); err != nil {
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if _, err := tx.Exec(ctx,
+		"insert into refresh_token(token_hash, user_id, family_id, expires_at) values ($1, $2, $3, $4)",
+		newHash, userID, familyID, expiresAt); err != nil {
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return &domain.RefreshTokenData{
+		Hash:      newHash,
+		UserID:    userID,
+		FamilyID:  familyID,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// GetRefreshTokenCertBinding returns the fingerprint of the client certificate the token owner is bound to;
+// the empty string is returned if the account is not bound.
+func (st *storage) GetRefreshTokenCertBinding(ctx context.Context, hash string) (string, error) {
+	var certFingerprint string
+	if err := st.pPool.QueryRow(ctx,
+		`select u.cert_fingerprint from refresh_token t join user_info u on u.user_id = t.user_id
+		 where t.token_hash = $1`, hash).Scan(&certFingerprint); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%w - refresh token not found", domain.ErrNotAuthorized)
+		}
+		return "", fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return certFingerprint, nil
+}
+
+// DeleteRefreshTokenFamily removes all tokens issued by rotation of the given one.
+func (st *storage) DeleteRefreshTokenFamily(ctx context.Context, userID domain.UserID, hash string) error {
+	if _, err := st.pPool.Exec(ctx,
+		`delete from refresh_token where family_id in 
+		   (select family_id from refresh_token where token_hash = $1 and user_id = $2)`,
+		hash, userID); err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+// RevokeToken adds jwt token id to revocation list. Records are kept until token expiration.
+func (st *storage) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if _, err := st.pPool.Exec(ctx, "delete from revoked_token where expires_at < now()"); err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if _, err := st.pPool.Exec(ctx,
+		"insert into revoked_token(jti, expires_at) values ($1, $2) on conflict(jti) do nothing",
+		jti, expiresAt); err != nil {
+		return fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return nil
+}
+
+func (st *storage) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var count int
+	if err := st.pPool.QueryRow(ctx, "select count(jti) from revoked_token where jti = $1", jti).Scan(&count); err != nil {
+		return false, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,142 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenOperations(t *testing.T) {
+	ctx, cancelFN := context.WithCancel(context.Background())
+
+	defer cancelFN()
+
+	connString, err := postgresContainer.ConnectionString(ctx)
+
+	require.NoError(t, err)
+
+	storage := postgres.NewStorage(ctx, &config.ServerConf{
+		MaxConns:        5,
+		DatabaseDN:      connString,
+		MaxConnLifetime: 2 * time.Minute,
+		MaxConnIdleTime: 2 * time.Minute,
+	})
+
+	defer func() {
+		storage.Close()
+		err = clear(ctx)
+		require.NoError(t, err)
+	}()
+
+	err = clear(ctx)
+	require.NoError(t, err)
+
+	testEmail := "email@email"
+	_, err = storage.Registrate(ctx, &domain.FullRegistrationData{
+		EMail:              testEmail,
+		PasswordHash:       "PasswordHash",
+		PasswordSalt:       "PasswordSalt",
+		EncryptedOTPKey:    "EncryptedOTPKey",
+		MasterPasswordHint: "MasterPasswordHint",
+		HelloEncrypted:     "HelloEncrypted",
+	})
+	require.NoError(t, err)
+
+	lData, err := storage.GetLoginData(ctx, testEmail)
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	for _, sessionID := range []string{"family1", "family2", "family3"} {
+		err = storage.CreateSession(ctx, &domain.SessionInfo{
+			SessionID: sessionID,
+			UserID:    lData.UserID,
+		})
+		require.NoError(t, err)
+	}
+
+	err = storage.CreateRefreshToken(ctx, &domain.RefreshTokenData{
+		Hash:      "hash1",
+		UserID:    lData.UserID,
+		FamilyID:  "family1",
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+
+	certFingerprint, err := storage.GetRefreshTokenCertBinding(ctx, "hash1")
+	require.NoError(t, err)
+	require.Empty(t, certFingerprint)
+
+	err = storage.SetCertFingerprint(ctx, testEmail, "fingerprint")
+	require.NoError(t, err)
+
+	certFingerprint, err = storage.GetRefreshTokenCertBinding(ctx, "hash1")
+	require.NoError(t, err)
+	require.Equal(t, "fingerprint", certFingerprint)
+
+	_, err = storage.GetRefreshTokenCertBinding(ctx, "unknown")
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	rotated, err := storage.RotateRefreshToken(ctx, "hash1", "hash2", expiresAt)
+	require.NoError(t, err)
+	require.Equal(t, lData.UserID, rotated.UserID)
+	require.Equal(t, "family1", rotated.FamilyID)
+
+	_, err = storage.RotateRefreshToken(ctx, "unknown", "hash3", expiresAt)
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	// reuse of rotated token terminates the session
+	_, err = storage.RotateRefreshToken(ctx, "hash1", "hash3", expiresAt)
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	ok, err := storage.TouchSession(ctx, "family1")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = storage.RotateRefreshToken(ctx, "hash2", "hash3", expiresAt)
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	err = storage.CreateRefreshToken(ctx, &domain.RefreshTokenData{
+		Hash:      "hash4",
+		UserID:    lData.UserID,
+		FamilyID:  "family2",
+		ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = storage.RotateRefreshToken(ctx, "hash4", "hash5", expiresAt)
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	err = storage.CreateRefreshToken(ctx, &domain.RefreshTokenData{
+		Hash:      "hash6",
+		UserID:    lData.UserID,
+		FamilyID:  "family3",
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+
+	err = storage.DeleteRefreshTokenFamily(ctx, lData.UserID, "hash6")
+	require.NoError(t, err)
+
+	_, err = storage.RotateRefreshToken(ctx, "hash6", "hash7", expiresAt)
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	ok, err = storage.IsTokenRevoked(ctx, "jti")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	err = storage.RevokeToken(ctx, "jti", expiresAt)
+	require.NoError(t, err)
+
+	err = storage.RevokeToken(ctx, "jti", expiresAt)
+	require.NoError(t, err)
+
+	ok, err = storage.IsTokenRevoked(ctx, "jti")
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ListTrashItems returns items of the user moved to the trash, the last deleted first.
+func (st *storage) ListTrashItems(ctx context.Context) ([]domain.TrashEntry, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	rows, err := st.pPool.Query(ctx,
+		`select item_id, item_type, name, deleted_at from (
+		   select item_id::text, item_type, name, deleted_at from secret_item where user_id = $1 and deleted_at is not null
+		   union all
+		   select note_id::text, 'secure_note', title, deleted_at from secure_note where user_id = $1 and deleted_at is not null
+		 ) items order by deleted_at desc, item_id`, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	result, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.TrashEntry, error) {
+		var entry domain.TrashEntry
+		err := row.Scan(&entry.ID, &entry.Type, &entry.Name, &entry.DeletedAt)
+		return entry, err
+	})
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	return result, nil
+}
+
+// RestoreSecretItem moves the item back from the trash; the restored item gets the next revision and
+// the next change sequence number, so clients receive it as changed. Returns domain.ErrClientDataIncorrect
+// if an item with the same lookup key was created after the deletion.
+func (st *storage) RestoreSecretItem(ctx context.Context, itemID string) (*domain.SecretItemRevision, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	tx, err := st.pPool.Begin(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	seq, err := nextChangeSeq(ctx, tx, userID)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	rev := domain.SecretItemRevision{ID: itemID, ChangeSeq: seq}
+	if err := tx.QueryRow(ctx,
+		`update secret_item set deleted_at = null, revision = revision + 1, change_seq = $1, updated_at = now()
+		 where item_id::text = $2 and user_id = $3 and deleted_at is not null returning item_type, revision`,
+		seq, itemID, userID).Scan(&rev.Type, &rev.Revision); errors.Is(err, pgx.ErrNoRows) {
+		// secure notes are stored in their own table
+		err = tx.QueryRow(ctx,
+			`update secure_note set deleted_at = null, revision = revision + 1, change_seq = $1, updated_at = now()
+			 where note_id::text = $2 and user_id = $3 and deleted_at is not null returning 'secure_note', revision`,
+			seq, itemID, userID).Scan(&rev.Type, &rev.Revision)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Infow(action, "err", fmt.Sprintf("item %v for user %v is not in the trash", itemID, userID))
+			return nil, fmt.Errorf("%w - item %v is not in the trash", domain.ErrDataNotExists, itemID)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			log.Infow(action, "err", fmt.Sprintf("item with the same lookup key for user %v already exists", userID))
+			return nil, fmt.Errorf("%w - item already exists", domain.ErrClientDataIncorrect)
+		}
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if _, err := tx.Exec(ctx, `delete from secret_item_tombstone where item_id::text = $1`, itemID); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Infow(action, "err", err.Error())
+		return nil, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("item %v for userID %v restored, seq %v", itemID, userID, seq))
+	return &rev, nil
+}
+
+// DeleteTrashItems deletes the item from the trash of the user permanently; all items of the trash are
+// deleted for the empty itemID. Returns the number of deleted items.
+func (st *storage) DeleteTrashItems(ctx context.Context, itemID string) (int64, error) {
+	userID, err := domain.GetUserID(ctx)
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+	if err != nil {
+		log.Infow(action, "err", err.Error())
+		return 0, fmt.Errorf("%w userID is not set", domain.ErrServerInternal)
+	}
+
+	var deleted int64
+	if err := st.pPool.QueryRow(ctx,
+		`with items as (
+		   delete from secret_item where user_id = $1 and deleted_at is not null and ($2::text = '' or item_id::text = $2) returning 1
+		 ), notes as (
+		   delete from secure_note where user_id = $1 and deleted_at is not null and ($2::text = '' or note_id::text = $2) returning 1
+		 ) select (select count(*) from items) + (select count(*) from notes)`,
+		userID, itemID).Scan(&deleted); err != nil {
+		log.Infow(action, "err", err.Error())
+		return 0, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if itemID != "" && deleted == 0 {
+		log.Infow(action, "err", fmt.Sprintf("item %v for user %v is not in the trash", itemID, userID))
+		return 0, fmt.Errorf("%w - item %v is not in the trash", domain.ErrDataNotExists, itemID)
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%v trash items for userID %v deleted", deleted, userID))
+	return deleted, nil
+}
+
+// DeleteExpiredTrashItems deletes items of all users moved to the trash before the time.
+// Returns the number of deleted items.
+func (st *storage) DeleteExpiredTrashItems(ctx context.Context, before time.Time) (int64, error) {
+	action := domain.GetAction(1)
+	log := domain.GetCtxLogger(ctx)
+
+	var deleted int64
+	if err := st.pPool.QueryRow(ctx,
+		`with items as (delete from secret_item where deleted_at < $1 returning 1),
+		 notes as (delete from secure_note where deleted_at < $1 returning 1)
+		 select (select count(*) from items) + (select count(*) from notes)`, before).Scan(&deleted); err != nil {
+		log.Infow(action, "err", err.Error())
+		return 0, fmt.Errorf("%w - %s", domain.ErrServerInternal, err.Error())
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%v expired trash items deleted", deleted))
+	return deleted, nil
+}
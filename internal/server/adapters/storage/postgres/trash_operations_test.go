@@ -0,0 +1,168 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/adapters/storage/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashOperations(t *testing.T) {
+	ctx, cancelFN := context.WithCancel(context.Background())
+
+	defer cancelFN()
+
+	connString, err := postgresContainer.ConnectionString(ctx)
+
+	require.NoError(t, err)
+
+	storage := postgres.NewStorage(ctx, &config.ServerConf{
+		MaxConns:        5,
+		DatabaseDN:      connString,
+		MaxConnLifetime: 2 * time.Minute,
+		MaxConnIdleTime: 2 * time.Minute,
+	})
+
+	defer func() {
+		storage.Close()
+		err = clear(ctx)
+		require.NoError(t, err)
+	}()
+
+	err = storage.Ping(ctx)
+	require.NoError(t, err)
+
+	err = clear(ctx)
+	require.NoError(t, err)
+
+	testEmail := "email@email"
+
+	_, err = storage.Registrate(ctx, &domain.FullRegistrationData{
+		EMail:              testEmail,
+		PasswordHash:       "PasswordHash",
+		PasswordSalt:       "PasswordSalt",
+		EncryptedOTPKey:    "EncryptedOTPKey",
+		MasterPasswordHint: "MasterPasswordHint",
+		HelloEncrypted:     "HelloEncrypted",
+	})
+	require.NoError(t, err)
+
+	lData, err := storage.GetLoginData(ctx, testEmail)
+	require.NoError(t, err)
+
+	userIdCtx := domain.EnrichWithUserID(ctx, lData.UserID)
+
+	passRev, err := storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:      domain.SecretItemUserPassword,
+		Name:      "name1",
+		LookupKey: "key1",
+		Content:   "content1",
+	})
+	require.NoError(t, err)
+
+	cardRev, err := storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:    domain.SecretItemBankCard,
+		Name:    "name2",
+		Content: "content2",
+	})
+	require.NoError(t, err)
+
+	trash, err := storage.ListTrashItems(userIdCtx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(trash))
+
+	deleted, err := storage.DeleteSecretItem(userIdCtx, passRev.ID, passRev.Revision)
+	require.NoError(t, err)
+	require.Equal(t, passRev.Revision+1, deleted.Revision)
+
+	_, err = storage.DeleteSecretItem(userIdCtx, cardRev.ID, cardRev.Revision)
+	require.NoError(t, err)
+
+	// the items in the trash are hidden
+	items, err := storage.ListSecretItems(userIdCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(items))
+
+	_, err = storage.GetSecretItem(userIdCtx, passRev.ID)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	_, err = storage.UpdateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		ID:       passRev.ID,
+		Name:     "name3",
+		Content:  "content3",
+		Revision: deleted.Revision,
+	})
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	trash, err = storage.ListTrashItems(userIdCtx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(trash))
+	require.Equal(t, cardRev.ID, trash[0].ID)
+	require.Equal(t, domain.SecretItemBankCard, trash[0].Type)
+	require.Equal(t, "name2", trash[0].Name)
+	require.False(t, trash[0].DeletedAt.IsZero())
+
+	changes, err := storage.GetSecretItemChanges(userIdCtx, 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes.Items))
+	require.Equal(t, 2, len(changes.Deleted))
+
+	// the lookup key of the item in the trash is free
+	newRev, err := storage.CreateSecretItem(userIdCtx, &domain.EncryptedSecretItem{
+		Type:      domain.SecretItemUserPassword,
+		Name:      "name4",
+		LookupKey: "key1",
+		Content:   "content4",
+	})
+	require.NoError(t, err)
+
+	_, err = storage.RestoreSecretItem(userIdCtx, passRev.ID)
+	require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+
+	_, err = storage.DeleteSecretItem(userIdCtx, newRev.ID, newRev.Revision)
+	require.NoError(t, err)
+
+	restored, err := storage.RestoreSecretItem(userIdCtx, passRev.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.SecretItemUserPassword, restored.Type)
+	require.Equal(t, deleted.Revision+1, restored.Revision)
+
+	_, err = storage.RestoreSecretItem(userIdCtx, passRev.ID)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	delta, err := storage.GetSecretItemChanges(userIdCtx, changes.Seq)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(delta.Items))
+	require.Equal(t, passRev.ID, delta.Items[0].ID)
+
+	item, err := storage.GetSecretItem(userIdCtx, passRev.ID)
+	require.NoError(t, err)
+	require.Equal(t, "content1", item.Content)
+
+	_, err = storage.DeleteTrashItems(userIdCtx, passRev.ID)
+	require.ErrorIs(t, err, domain.ErrDataNotExists)
+
+	cnt, err := storage.DeleteTrashItems(userIdCtx, cardRev.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cnt)
+
+	cnt, err = storage.DeleteExpiredTrashItems(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), cnt)
+
+	cnt, err = storage.DeleteExpiredTrashItems(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cnt)
+
+	trash, err = storage.ListTrashItems(userIdCtx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(trash))
+
+	cnt, err = storage.DeleteTrashItems(userIdCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), cnt)
+}
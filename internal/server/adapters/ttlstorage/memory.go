@@ -3,6 +3,8 @@ package ttlstorage
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
@@ -10,12 +12,15 @@ import (
 
 func NewMemStorage(ctx context.Context, conf *config.ServerConf) *memStorage {
 	return &memStorage{
-		ttlMap: NewTTLMap(ctx, conf.AuthStageTimeout),
+		ttlMap:   NewTTLMap(ctx, conf.AuthStageTimeout),
+		attempts: NewTTLMap(ctx, conf.AuthStageTimeout),
 	}
 }
 
 type memStorage struct {
-	ttlMap *TTLMap
+	ttlMap      *TTLMap
+	attempts    *TTLMap
+	attemptsMtx sync.Mutex
 }
 
 // Create create new key in storage.
@@ -81,3 +86,40 @@ func (mSt *memStorage) LoadAndDelete(ctx context.Context, sessionID domain.Sessi
 func (mSt *memStorage) Delete(ctx context.Context, sessionID domain.SessionID) {
 	mSt.ttlMap.Delete(string(sessionID))
 }
+
+// IncAttempts increments failed attempts counter by key.
+// The counter is removed after ttl since the last failure.
+func (mSt *memStorage) IncAttempts(ctx context.Context, key string, ttl time.Duration) (*domain.AuthAttempts, error) {
+	mSt.attemptsMtx.Lock()
+	defer mSt.attemptsMtx.Unlock()
+
+	var attempts domain.AuthAttempts
+	if val, ok := mSt.attempts.Load(key); ok {
+		attempts = val.(domain.AuthAttempts)
+	}
+
+	attempts.Count++
+	attempts.LastFailure = time.Now()
+	mSt.attempts.StoreWithTTL(key, attempts, ttl)
+	return &attempts, nil
+}
+
+// LoadAttempts returns failed attempts counter by key; zero counter if no failures registered.
+func (mSt *memStorage) LoadAttempts(ctx context.Context, key string) (*domain.AuthAttempts, error) {
+	mSt.attemptsMtx.Lock()
+	defer mSt.attemptsMtx.Unlock()
+
+	var attempts domain.AuthAttempts
+	if val, ok := mSt.attempts.Load(key); ok {
+		attempts = val.(domain.AuthAttempts)
+	}
+	return &attempts, nil
+}
+
+// ResetAttempts removes failed attempts counter by key.
+func (mSt *memStorage) ResetAttempts(ctx context.Context, key string) {
+	mSt.attemptsMtx.Lock()
+	defer mSt.attemptsMtx.Unlock()
+
+	mSt.attempts.Delete(key)
+}
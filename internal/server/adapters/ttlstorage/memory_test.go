@@ -116,3 +116,45 @@ func TestMemStorage2(t *testing.T) {
 	_, err = memStorage.Load(ctx, stage1ID)
 	require.ErrorIs(t, err, domain.ErrDataNotExists)
 }
+
+func TestMemStorageAttempts(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	srvConf := &config.ServerConf{
+		AuthStageTimeout: 5 * time.Second,
+	}
+
+	memStorage := ttlstorage.NewMemStorage(ctx, srvConf)
+
+	key := "account:test@test.com"
+	attempts, err := memStorage.LoadAttempts(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 0, attempts.Count)
+
+	attempts, err = memStorage.IncAttempts(ctx, key, 2*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, 1, attempts.Count)
+
+	attempts, err = memStorage.IncAttempts(ctx, key, 2*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts.Count)
+	require.False(t, attempts.LastFailure.IsZero())
+
+	attempts, err = memStorage.LoadAttempts(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts.Count)
+
+	memStorage.ResetAttempts(ctx, key)
+	attempts, err = memStorage.LoadAttempts(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 0, attempts.Count)
+
+	_, err = memStorage.IncAttempts(ctx, key, 1*time.Second)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		attempts, err := memStorage.LoadAttempts(ctx, key)
+		return err == nil && attempts.Count == 0
+	}, 4*time.Second, 100*time.Millisecond)
+}
@@ -55,6 +55,14 @@ func (t *TTLMap) Store(key string, val any) {
 	})
 }
 
+// StoreWithTTL stores value with custom ttl.
+func (t *TTLMap) StoreWithTTL(key string, val any, ttl time.Duration) {
+	t.data.Store(key, expireEntry{
+		ExpiresAt: time.Now().Add(ttl),
+		Value:     val,
+	})
+}
+
 func (t *TTLMap) Delete(key string) {
 	t.data.Delete(key)
 }
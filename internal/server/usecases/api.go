@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 
@@ -9,32 +10,73 @@ import (
 	_ "github.com/golang/mock/mockgen/model"
 )
 
-//go:generate mockgen -destination "./generated_mocks_test.go" -package ${GOPACKAGE}_test . StateFullStorage,TemporaryStorage,EMailSender,RegistrationHelper,FileStorage
+//go:generate mockgen -destination "./generated_mocks_test.go" -package ${GOPACKAGE}_test . StateFullStorage,TemporaryStorage,EMailSender,RegistrationHelper,FileStorage,ChangeNotifier
 
 type StateFullStorage interface {
 	IsEMailAvailable(ctx context.Context, email string) (bool, error)
-	Registrate(ctx context.Context, data *domain.FullRegistrationData) error
+	Registrate(ctx context.Context, data *domain.FullRegistrationData) (domain.UserID, error)
 	GetLoginData(ctx context.Context, email string) (*domain.LoginData, error)
+	UpdatePasswordHash(ctx context.Context, userID domain.UserID, data *domain.HashData) error
+	AcceptOTPStep(ctx context.Context, userID domain.UserID, step int64) (bool, error)
+
+	CreateRefreshToken(ctx context.Context, data *domain.RefreshTokenData) error
+	RotateRefreshToken(ctx context.Context, oldHash string, newHash string, expiresAt time.Time) (*domain.RefreshTokenData, error)
+	GetRefreshTokenCertBinding(ctx context.Context, hash string) (string, error)
+	DeleteRefreshTokenFamily(ctx context.Context, userID domain.UserID, hash string) error
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+
+	CreateSession(ctx context.Context, session *domain.SessionInfo) error
+	TouchSession(ctx context.Context, sessionID string) (bool, error)
+	GetSessionList(ctx context.Context) ([]domain.SessionInfo, error)
+	DeleteSession(ctx context.Context, sessionID string) error
+
 	GetHelloData(ctx context.Context) (*domain.HelloData, error)
 
-	GetBankCardList(ctx context.Context) ([]domain.EncryptedBankCard, error)
-	CreateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error
-	UpdateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error
-	DeleteBankCard(ctx context.Context, number string) error
+	ListSecretItems(ctx context.Context, itemType domain.SecretItemType) ([]domain.EncryptedSecretItem, error)
+	GetSecretItem(ctx context.Context, itemID string) (*domain.EncryptedSecretItem, error)
+	CreateSecretItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	UpdateSecretItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	DeleteSecretItem(ctx context.Context, itemID string, revision int64) (*domain.SecretItemRevision, error)
+	GetSecretItemChanges(ctx context.Context, sinceSeq int64) (*domain.SecretItemChanges, error)
+	GetChangeSeq(ctx context.Context) (int64, error)
+
+	ListSecureNotes(ctx context.Context) ([]domain.EncryptedSecretItem, error)
+	GetSecureNote(ctx context.Context, noteID string) (*domain.EncryptedSecretItem, error)
+	CreateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	UpdateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error)
+	DeleteSecureNote(ctx context.Context, noteID string, revision int64) (*domain.SecretItemRevision, error)
+
+	ListSecretItemRevisions(ctx context.Context, itemID string) ([]domain.SecretItemHistoryEntry, error)
+	GetSecretItemRevision(ctx context.Context, itemID string, revision int64) (*domain.EncryptedSecretItem, error)
+	RestoreSecretItemRevision(ctx context.Context, itemID string, revision int64, expected int64) (*domain.SecretItemRevision, error)
+	PruneSecretItemHistory(ctx context.Context, itemID string, maxCount int, before time.Time) (int64, error)
 
-	GetUserPasswordDataList(ctx context.Context) ([]domain.EncryptedUserPasswordData, error)
-	CreateUserPasswordData(ctx context.Context, bnkCard *domain.EncryptedUserPasswordData) error
-	UpdateUserPasswordData(ctx context.Context, bnkCard *domain.EncryptedUserPasswordData) error
-	DeleteUserPasswordData(ctx context.Context, hint string) error
+	ListTrashItems(ctx context.Context) ([]domain.TrashEntry, error)
+	RestoreSecretItem(ctx context.Context, itemID string) (*domain.SecretItemRevision, error)
+	DeleteTrashItems(ctx context.Context, itemID string) (int64, error)
+	DeleteExpiredTrashItems(ctx context.Context, before time.Time) (int64, error)
 
 	GetUserFilesBucket(ctx context.Context) (string, error)
 }
 
+// ChangeNotifier delivers item change events to the watching connections of the same user.
+type ChangeNotifier interface {
+	Publish(userID domain.UserID, event *domain.ItemChangeEvent)
+	// Subscribe returns events of the user until ctx is done; the channel is closed then.
+	Subscribe(ctx context.Context, userID domain.UserID) <-chan domain.ItemChangeEvent
+}
+
 type FileStorage interface {
 	GetFileInfoList(ctx context.Context, bucket string) ([]domain.FileInfo, error)
-	DeleteFileInfo(ctx context.Context, bucket string, name string) error
+	MoveToTrash(ctx context.Context, bucket string, name string) error
 	CreateStreamFileWriter(ctx context.Context, bucket string) (domain.StreamFileWriter, error)
 	CreateStreamFileReader(ctx context.Context, bucket string, name string) (domain.StreamFileReader, error)
+
+	ListTrash(ctx context.Context, bucket string) ([]domain.TrashEntry, error)
+	RestoreFromTrash(ctx context.Context, bucket string, id string) error
+	DeleteFromTrash(ctx context.Context, bucket string, id string) error
+	DeleteExpiredTrash(ctx context.Context, before time.Time) (int, error)
 }
 
 type TemporaryStorage interface {
@@ -49,6 +91,10 @@ type TemporaryStorage interface {
 	) (any, error)
 	Delete(ctx context.Context, sessionID domain.SessionID)
 	Load(ctx context.Context, sessionID domain.SessionID) (any, error)
+
+	IncAttempts(ctx context.Context, key string, ttl time.Duration) (*domain.AuthAttempts, error)
+	LoadAttempts(ctx context.Context, key string) (*domain.AuthAttempts, error)
+	ResetAttempts(ctx context.Context, key string)
 }
 
 type EMailSender interface {
@@ -61,9 +107,12 @@ type RegistrationHelper interface {
 	GenerateQR(accountName string) (string, []byte, error)
 	EncryptOTPKey(plaintext string) (string, error)
 	DecryptOTPKey(ciphertext string) (string, error)
-	ValidateAccountPass(pass string, hashB64 string, saltB64 string) (bool, error)
+	ValidateAccountPass(pass string, hash string, saltB64 string) (bool, error)
+	PasswordNeedsRehash(hash string) bool
 	NewSessionID() domain.SessionID
-	ValidateOTPCode(keyURL string, passcode string) (bool, error)
-	CreateJWTToken(userID domain.UserID) (domain.JWTToken, error)
+	ValidateOTPCodeStep(keyURL string, passcode string) (int64, bool, error)
+	CreateJWTToken(userID domain.UserID, sessionID string) (domain.JWTToken, error)
 	ParseJWTToken(jwtToken domain.JWTToken) (domain.UserID, error)
+	NewRefreshToken() (domain.RefreshToken, error)
+	HashRefreshToken(token domain.RefreshToken) string
 }
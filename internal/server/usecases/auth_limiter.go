@@ -0,0 +1,160 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// attempts counter key prefixes
+const (
+	attemptsAccountPrefix = "account:"
+	attemptsPeerPrefix    = "peer:"
+	attemptsOTPPrefix     = "otp:"
+)
+
+func accountAttemptsKey(email string) string {
+	return attemptsAccountPrefix + strings.ToLower(email)
+}
+
+func peerAttemptsKey(ip string) string {
+	return attemptsPeerPrefix + ip
+}
+
+func otpAttemptsKey(sessionID domain.SessionID) string {
+	return attemptsOTPPrefix + string(sessionID)
+}
+
+// lockedUntil returns lockout end time. Lockout begins after maxFailures failed attempts,
+// its duration starts from base and is doubled with every next failure up to max.
+func lockedUntil(attempts *domain.AuthAttempts, maxFailures int, base time.Duration, max time.Duration) time.Time {
+	if maxFailures <= 0 || attempts.Count < maxFailures {
+		return time.Time{}
+	}
+
+	backoff := base
+	for i := maxFailures; i < attempts.Count && backoff < max; i++ {
+		backoff *= 2
+	}
+
+	if backoff > max {
+		backoff = max
+	}
+
+	return attempts.LastFailure.Add(backoff)
+}
+
+// checkLockout returns [domain.ErrAuthTooManyAttempts] if account or client ip is locked.
+func (auth *auth) checkLockout(ctx context.Context, email string) error {
+	if auth.conf == nil {
+		return nil
+	}
+
+	if err := auth.checkKeyLockout(ctx, accountAttemptsKey(email), auth.conf.AuthMaxFailures); err != nil {
+		return err
+	}
+
+	if ip := domain.GetPeerIP(ctx); ip != "" {
+		if err := auth.checkKeyLockout(ctx, peerAttemptsKey(ip), auth.conf.AuthMaxPeerFails); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (auth *auth) checkKeyLockout(ctx context.Context, key string, maxFailures int) error {
+	if maxFailures <= 0 {
+		return nil
+	}
+
+	attempts, err := auth.tempStorage.LoadAttempts(ctx, key)
+	if err != nil {
+		return fmt.Errorf("%w - can't load attempts %s", domain.ErrServerInternal, err.Error())
+	}
+
+	until := lockedUntil(attempts, maxFailures, auth.conf.AuthLockoutBase, auth.conf.AuthLockoutMax)
+	if retryAfter := time.Until(until); retryAfter > 0 {
+		dErr := domain.NewDetailedError(domain.ErrAuthTooManyAttempts, "too many failed attempts, authentification is temporary locked").
+			WithRetryAfter(retryAfter)
+		return fmt.Errorf("%w - %s is locked until %s", dErr, key, until.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// registerFailure increments account and client ip failed attempts counters.
+func (auth *auth) registerFailure(ctx context.Context, email string) {
+	if auth.conf == nil {
+		return
+	}
+
+	auth.incAttempts(ctx, accountAttemptsKey(email), auth.conf.AuthMaxFailures)
+
+	if ip := domain.GetPeerIP(ctx); ip != "" {
+		auth.incAttempts(ctx, peerAttemptsKey(ip), auth.conf.AuthMaxPeerFails)
+	}
+}
+
+func (auth *auth) incAttempts(ctx context.Context, key string, maxFailures int) {
+	if maxFailures <= 0 {
+		return
+	}
+
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	attempts, err := auth.tempStorage.IncAttempts(ctx, key, auth.conf.AuthLockoutMax)
+	if err != nil {
+		log.Infow(action, "err", fmt.Sprintf("can't register failed attempt for %s - %s", key, err.Error()))
+		return
+	}
+
+	if until := lockedUntil(attempts, maxFailures, auth.conf.AuthLockoutBase, auth.conf.AuthLockoutMax); !until.IsZero() {
+		log.Infow(action, "msg", "lockout", "key", key, "failures", attempts.Count, "until", until.Format(time.RFC3339))
+	}
+}
+
+// resetFailures resets account and OTP failed attempts counters after successful authentification.
+// The client ip counter is not reset - it expires by itself.
+func (auth *auth) resetFailures(ctx context.Context, email string, sessionID domain.SessionID) {
+	if auth.conf == nil {
+		return
+	}
+
+	if auth.conf.AuthMaxFailures > 0 {
+		auth.tempStorage.ResetAttempts(ctx, accountAttemptsKey(email))
+	}
+
+	if auth.conf.AuthMaxOTPTries > 0 {
+		auth.tempStorage.ResetAttempts(ctx, otpAttemptsKey(sessionID))
+	}
+}
+
+// registerOTPFailure counts OTP attempts per login session.
+// Returns [domain.ErrAuthTooManyAttempts] and drops the session when the limit is reached.
+func (auth *auth) registerOTPFailure(ctx context.Context, sessionID domain.SessionID) error {
+	if auth.conf == nil || auth.conf.AuthMaxOTPTries <= 0 {
+		return nil
+	}
+
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	key := otpAttemptsKey(sessionID)
+	attempts, err := auth.tempStorage.IncAttempts(ctx, key, auth.conf.AuthStageTimeout)
+	if err != nil {
+		return fmt.Errorf("%w - can't register otp attempt %s", domain.ErrServerInternal, err.Error())
+	}
+
+	if attempts.Count < auth.conf.AuthMaxOTPTries {
+		return nil
+	}
+
+	auth.tempStorage.Delete(ctx, sessionID)
+	auth.tempStorage.ResetAttempts(ctx, key)
+	log.Infow(action, "msg", "lockout", "key", key, "failures", attempts.Count)
+	dErr := domain.NewDetailedError(domain.ErrAuthTooManyAttempts, "too many wrong one-time passwords, log in again")
+	return fmt.Errorf("%w - otp attempts limit reached, session %s is closed", dErr, sessionID)
+}
@@ -0,0 +1,172 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/usecases"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthLockout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	conf := &config.ServerConf{
+		AuthStageTimeout: time.Minute,
+		AuthMaxFailures:  2,
+		AuthMaxPeerFails: 5,
+		AuthLockoutBase:  time.Minute,
+		AuthLockoutMax:   10 * time.Minute,
+		AuthMaxOTPTries:  3,
+	}
+
+	data := &domain.EMailData{
+		EMail:    "Test@email",
+		Password: "test_pass",
+	}
+
+	accountKey := "account:test@email"
+	peerKey := "peer:10.0.0.1"
+
+	t.Run("account_locked", func(t *testing.T) {
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		mockTempStorage.EXPECT().LoadAttempts(gomock.Any(), gomock.Eq(accountKey)).Times(1).
+			Return(&domain.AuthAttempts{Count: 3, LastFailure: time.Now()}, nil)
+
+		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage)
+		_, err := auth.Login(context.Background(), data)
+		require.ErrorIs(t, err, domain.ErrAuthTooManyAttempts)
+
+		var dErr *domain.DetailedError
+		require.ErrorAs(t, err, &dErr)
+		require.Equal(t, domain.ReasonTooManyAttempts, dErr.Reason)
+		require.Positive(t, dErr.RetryAfter)
+	})
+
+	t.Run("peer_locked", func(t *testing.T) {
+		ctx := domain.EnrichWithPeerIP(context.Background(), "10.0.0.1")
+
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		mockTempStorage.EXPECT().LoadAttempts(gomock.Any(), gomock.Eq(accountKey)).Times(1).
+			Return(&domain.AuthAttempts{}, nil)
+		mockTempStorage.EXPECT().LoadAttempts(gomock.Any(), gomock.Eq(peerKey)).Times(1).
+			Return(&domain.AuthAttempts{Count: 5, LastFailure: time.Now()}, nil)
+
+		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage)
+		_, err := auth.Login(ctx, data)
+		require.ErrorIs(t, err, domain.ErrAuthTooManyAttempts)
+	})
+
+	t.Run("lockout_expired", func(t *testing.T) {
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		// 3 failures - lockout is 2 minutes
+		mockTempStorage.EXPECT().LoadAttempts(gomock.Any(), gomock.Eq(accountKey)).Times(1).
+			Return(&domain.AuthAttempts{Count: 3, LastFailure: time.Now().Add(-3 * time.Minute)}, nil)
+
+		testErr := errors.New("testErr")
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().GetLoginData(gomock.Any(), gomock.Eq(data.EMail)).Times(1).Return(nil, testErr)
+
+		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).StateFullStorage(mockStflStorage)
+		_, err := auth.Login(context.Background(), data)
+		require.ErrorIs(t, err, testErr)
+	})
+
+	t.Run("wrong_pass_registered", func(t *testing.T) {
+		ctx := domain.EnrichWithPeerIP(context.Background(), "10.0.0.1")
+		loginData := &domain.LoginData{
+			UserID:       1,
+			EMail:        data.EMail,
+			PasswordHash: "Hash",
+			PasswordSalt: "Salt",
+		}
+
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		mockTempStorage.EXPECT().LoadAttempts(gomock.Any(), gomock.Any()).Times(2).
+			Return(&domain.AuthAttempts{}, nil)
+		mockTempStorage.EXPECT().IncAttempts(gomock.Any(), gomock.Eq(accountKey), gomock.Eq(conf.AuthLockoutMax)).Times(1).
+			Return(&domain.AuthAttempts{Count: 2, LastFailure: time.Now()}, nil)
+		mockTempStorage.EXPECT().IncAttempts(gomock.Any(), gomock.Eq(peerKey), gomock.Eq(conf.AuthLockoutMax)).Times(1).
+			Return(&domain.AuthAttempts{Count: 1, LastFailure: time.Now()}, nil)
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().GetLoginData(gomock.Any(), gomock.Eq(data.EMail)).Times(1).Return(loginData, nil)
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().ValidateAccountPass(gomock.Eq(data.Password), gomock.Any(), gomock.Any()).Times(1).
+			Return(false, domain.ErrAuthDataIncorrect)
+
+		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).StateFullStorage(mockStflStorage).RegistrationHelper(mockHelper)
+		_, err := auth.Login(ctx, data)
+		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
+	})
+
+	t.Run("otp_tries_limit", func(t *testing.T) {
+		sessionID := domain.SessionID("sessionID")
+		loginData := domain.LoginData{
+			UserID:          1,
+			EMail:           data.EMail,
+			EncryptedOTPKey: "encryptedOTPKey",
+		}
+
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		mockTempStorage.EXPECT().Load(gomock.Any(), gomock.Eq(sessionID)).Times(1).Return(loginData, nil)
+		mockTempStorage.EXPECT().LoadAttempts(gomock.Any(), gomock.Eq(accountKey)).Times(1).
+			Return(&domain.AuthAttempts{}, nil)
+		mockTempStorage.EXPECT().IncAttempts(gomock.Any(), gomock.Eq(accountKey), gomock.Any()).Times(1).
+			Return(&domain.AuthAttempts{Count: 1, LastFailure: time.Now()}, nil)
+		mockTempStorage.EXPECT().IncAttempts(gomock.Any(), gomock.Eq("otp:sessionID"), gomock.Eq(conf.AuthStageTimeout)).Times(1).
+			Return(&domain.AuthAttempts{Count: 3, LastFailure: time.Now()}, nil)
+		mockTempStorage.EXPECT().Delete(gomock.Any(), gomock.Eq(sessionID)).Times(1)
+		mockTempStorage.EXPECT().ResetAttempts(gomock.Any(), gomock.Eq("otp:sessionID")).Times(1)
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().DecryptOTPKey(gomock.Any()).Times(1).Return("otpKey", nil)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq("otpKey"), gomock.Eq("123456")).Times(1).Return(int64(0), false, nil)
+
+		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).RegistrationHelper(mockHelper)
+		_, err := auth.CheckOTP(context.Background(), sessionID, "123456")
+		require.ErrorIs(t, err, domain.ErrAuthTooManyAttempts)
+	})
+
+	t.Run("otp_ok_reset", func(t *testing.T) {
+		sessionID := domain.SessionID("sessionID")
+		loginData := domain.LoginData{
+			UserID:          1,
+			EMail:           data.EMail,
+			EncryptedOTPKey: "encryptedOTPKey",
+		}
+
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		mockTempStorage.EXPECT().Load(gomock.Any(), gomock.Eq(sessionID)).Times(1).Return(loginData, nil)
+		mockTempStorage.EXPECT().LoadAttempts(gomock.Any(), gomock.Eq(accountKey)).Times(1).
+			Return(&domain.AuthAttempts{Count: 1, LastFailure: time.Now()}, nil)
+		mockTempStorage.EXPECT().Delete(gomock.Any(), gomock.Eq(sessionID)).Times(1)
+		mockTempStorage.EXPECT().ResetAttempts(gomock.Any(), gomock.Eq(accountKey)).Times(1)
+		mockTempStorage.EXPECT().ResetAttempts(gomock.Any(), gomock.Eq("otp:sessionID")).Times(1)
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().DecryptOTPKey(gomock.Any()).Times(1).Return("otpKey", nil)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq("otpKey"), gomock.Eq("123456")).Times(1).Return(int64(100), true, nil)
+		mockHelper.EXPECT().CreateJWTToken(gomock.Eq(loginData.UserID), gomock.Eq("familyID")).Times(1).Return(domain.JWTToken("token"), nil)
+		mockHelper.EXPECT().NewRefreshToken().Times(1).Return(domain.RefreshToken("refreshToken"), nil)
+		mockHelper.EXPECT().HashRefreshToken(gomock.Any()).Times(1).Return("refreshHash")
+		mockHelper.EXPECT().NewSessionID().Times(1).Return(domain.SessionID("familyID"))
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().AcceptOTPStep(gomock.Any(), gomock.Eq(loginData.UserID), gomock.Eq(int64(100))).Times(1).Return(true, nil)
+		mockStflStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+		mockStflStorage.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
+		tokens, err := auth.CheckOTP(context.Background(), sessionID, "123456")
+		require.NoError(t, err)
+		require.Equal(t, domain.JWTToken("token"), tokens.Token)
+	})
+}
@@ -2,7 +2,9 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
@@ -45,8 +47,18 @@ func (auth *auth) Login(ctx context.Context, data *domain.EMailData) (domain.Ses
 
 	log.Debugw(action, "email", data.EMail, "msg", "authentification started")
 
+	if err := auth.checkLockout(ctx, data.EMail); err != nil {
+		err = fmt.Errorf("login err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return "", err
+	}
+
 	loginData, err := auth.stflStorage.GetLoginData(ctx, data.EMail)
 	if err != nil {
+		if errors.Is(err, domain.ErrClientDataIncorrect) {
+			// unknown email
+			auth.registerFailure(ctx, data.EMail)
+		}
 		err = fmt.Errorf("login err - GetLoginData err %w", err)
 		log.Infow(action, "err", err.Error())
 		return "", err
@@ -54,17 +66,33 @@ func (auth *auth) Login(ctx context.Context, data *domain.EMailData) (domain.Ses
 
 	ok, err := auth.regHelper.ValidateAccountPass(data.Password, loginData.PasswordHash, loginData.PasswordSalt)
 	if err != nil {
+		if errors.Is(err, domain.ErrAuthDataIncorrect) {
+			auth.registerFailure(ctx, data.EMail)
+		}
 		err = fmt.Errorf("login err -ValidateAccountPass err %w", err)
 		log.Infow(action, "err", err.Error())
 		return "", err
 	}
 
 	if !ok {
+		auth.registerFailure(ctx, data.EMail)
 		err := fmt.Errorf("login err - wrong login or pass %w", domain.ErrAuthDataIncorrect)
 		log.Infow(action, "err", err.Error())
 		return "", err
 	}
 
+	if !auth.checkCertBinding(ctx, loginData) {
+		auth.registerFailure(ctx, data.EMail)
+		err := fmt.Errorf("login err - client certificate doesn't match the account %w", domain.ErrAuthDataIncorrect)
+		log.Infow(action, "err", err.Error())
+		return "", err
+	}
+
+	if auth.regHelper.PasswordNeedsRehash(loginData.PasswordHash) {
+		// legacy or weak hash; login is not failed on rehash error
+		auth.rehashPassword(ctx, loginData, data.Password)
+	}
+
 	sessionID := auth.regHelper.NewSessionID()
 	if err = auth.tempStorage.Create(ctx, sessionID, *loginData); err != nil {
 		err = fmt.Errorf("login err - can't create data %w", err)
@@ -76,8 +104,37 @@ func (auth *auth) Login(ctx context.Context, data *domain.EMailData) (domain.Ses
 	return sessionID, nil
 }
 
+// checkCertBinding returns false if the account is bound to a client certificate and the request is made with another one.
+func (auth *auth) checkCertBinding(ctx context.Context, loginData *domain.LoginData) bool {
+	if loginData.CertFingerprint == "" {
+		return true
+	}
+	return loginData.CertFingerprint == domain.GetPeerCertFingerprint(ctx)
+}
+
+// rehashPassword updates account password hash with the current algorithm.
+func (auth *auth) rehashPassword(ctx context.Context, loginData *domain.LoginData, pass string) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	hashData, err := auth.regHelper.HashPassword(pass)
+	if err != nil {
+		log.Infow(action, "err", fmt.Sprintf("rehash err - HashPassword err %s", err.Error()))
+		return
+	}
+
+	if err := auth.stflStorage.UpdatePasswordHash(ctx, loginData.UserID, hashData); err != nil {
+		log.Infow(action, "err", fmt.Sprintf("rehash err - UpdatePasswordHash err %s", err.Error()))
+		return
+	}
+
+	loginData.PasswordHash = hashData.Hash
+	loginData.PasswordSalt = hashData.Salt
+	log.Debugw(action, "msg", "password hash updated")
+}
+
 // Second part of the authentification process. Check OTP code.
-func (auth *auth) CheckOTP(ctx context.Context, currentID domain.SessionID, otpPass string) (domain.JWTToken, error) {
+func (auth *auth) CheckOTP(ctx context.Context, currentID domain.SessionID, otpPass string) (*domain.AuthTokens, error) {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
 	log.Debugw(action, "msg", "chek otp started")
@@ -86,43 +143,224 @@ func (auth *auth) CheckOTP(ctx context.Context, currentID domain.SessionID, otpP
 	if err != nil {
 		err = fmt.Errorf("checkOTP err - %w", err)
 		log.Infow(action, "err", err.Error())
-		return "", err
+		return nil, err
 	}
 
 	authData, ok := data.(domain.LoginData)
 	if !ok {
 		err := fmt.Errorf("%w checkOTP err - unexpected data type by id %s", domain.ErrAuthDataIncorrect, currentID)
 		log.Infow(action, "err", err.Error())
-		return "", err
+		return nil, err
+	}
+
+	if err := auth.checkLockout(ctx, authData.EMail); err != nil {
+		err = fmt.Errorf("checkOTP err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
 	}
 
 	otpKey, err := auth.regHelper.DecryptOTPKey(authData.EncryptedOTPKey)
 	if err != nil {
 		err = fmt.Errorf("checkOTP decryptOTPKey err - %w", err)
 		log.Infow(action, "err", err.Error())
-		return "", err
+		return nil, err
 	}
 
-	ok, err = auth.regHelper.ValidateOTPCode(otpKey, otpPass)
+	step, ok, err := auth.regHelper.ValidateOTPCodeStep(otpKey, otpPass)
 	if err != nil {
 		err = fmt.Errorf("checkOTP validateOTPCode err - %w", err)
 		log.Infow(action, "err", err.Error())
-		return "", err
+		return nil, err
+	}
+
+	if ok {
+		// the code can't be used twice, e.g. replayed in a parallel session
+		if ok, err = auth.stflStorage.AcceptOTPStep(ctx, authData.UserID, step); err != nil {
+			err = fmt.Errorf("checkOTP acceptOTPStep err - %w", err)
+			log.Infow(action, "err", err.Error())
+			return nil, err
+		}
+
+		if !ok {
+			log.Infow(action, "msg", "otp code reuse rejected")
+		}
 	}
 
 	if !ok {
+		auth.registerFailure(ctx, authData.EMail)
+		if err := auth.registerOTPFailure(ctx, currentID); err != nil {
+			err = fmt.Errorf("checkOTP err - %w", err)
+			log.Infow(action, "err", err.Error())
+			return nil, err
+		}
 		err := fmt.Errorf("%w - checkOTP err - wrong otp pass", domain.ErrAuthDataIncorrect)
 		log.Infow(action, "err", err.Error())
-		return "", err
+		return nil, err
 	}
 
-	jwtTok, err := auth.regHelper.CreateJWTToken(authData.UserID)
+	// every login starts a new session; refresh tokens of the session are one family
+	sessionID := string(auth.regHelper.NewSessionID())
+	clientInfo := domain.GetClientInfo(ctx)
+	if err = auth.stflStorage.CreateSession(ctx, &domain.SessionInfo{
+		SessionID:     sessionID,
+		UserID:        authData.UserID,
+		DeviceName:    clientInfo.DeviceName,
+		ClientVersion: clientInfo.ClientVersion,
+		PeerIP:        domain.GetPeerIP(ctx),
+	}); err != nil {
+		err = fmt.Errorf("checkOTP createSession err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	jwtTok, err := auth.regHelper.CreateJWTToken(authData.UserID, sessionID)
 	if err != nil {
 		err = fmt.Errorf("checkOTP createJWT err - %w", err)
 		log.Infow(action, "err", err.Error())
-		return "", err
+		return nil, err
 	}
+
+	refreshTok, err := auth.regHelper.NewRefreshToken()
+	if err != nil {
+		err = fmt.Errorf("checkOTP newRefreshToken err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	if err = auth.stflStorage.CreateRefreshToken(ctx, &domain.RefreshTokenData{
+		Hash:      auth.regHelper.HashRefreshToken(refreshTok),
+		UserID:    authData.UserID,
+		FamilyID:  sessionID,
+		ExpiresAt: time.Now().Add(auth.conf.RefreshTokenExp),
+	}); err != nil {
+		err = fmt.Errorf("checkOTP createRefreshToken err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
 	auth.tempStorage.Delete(ctx, currentID)
+	auth.resetFailures(ctx, authData.EMail, currentID)
 	log.Debugw(action, "msg", "authentification complete")
-	return jwtTok, nil
+	return &domain.AuthTokens{
+		Token:        jwtTok,
+		RefreshToken: refreshTok,
+	}, nil
+}
+
+// Refresh rotates refresh token and issues new jwt token.
+func (auth *auth) Refresh(ctx context.Context, refreshTok domain.RefreshToken) (*domain.AuthTokens, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	if refreshTok == "" {
+		err := fmt.Errorf("%w - refresh err - refresh token is not set", domain.ErrNotAuthorized)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	oldHash := auth.regHelper.HashRefreshToken(refreshTok)
+
+	// the stolen refresh token can't be used without the certificate the account is bound to
+	certFingerprint, err := auth.stflStorage.GetRefreshTokenCertBinding(ctx, oldHash)
+	if err != nil {
+		err = fmt.Errorf("refresh getRefreshTokenCertBinding err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	if certFingerprint != "" && certFingerprint != domain.GetPeerCertFingerprint(ctx) {
+		err := fmt.Errorf("%w - refresh err - client certificate doesn't match the account", domain.ErrNotAuthorized)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	newRefreshTok, err := auth.regHelper.NewRefreshToken()
+	if err != nil {
+		err = fmt.Errorf("refresh newRefreshToken err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	tokenData, err := auth.stflStorage.RotateRefreshToken(ctx,
+		oldHash,
+		auth.regHelper.HashRefreshToken(newRefreshTok),
+		time.Now().Add(auth.conf.RefreshTokenExp))
+	if err != nil {
+		err = fmt.Errorf("refresh rotateRefreshToken err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	jwtTok, err := auth.regHelper.CreateJWTToken(tokenData.UserID, tokenData.FamilyID)
+	if err != nil {
+		err = fmt.Errorf("refresh createJWT err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	log.Debugw(action, "msg", "token refreshed")
+	return &domain.AuthTokens{
+		Token:        jwtTok,
+		RefreshToken: newRefreshTok,
+	}, nil
+}
+
+// Logout revokes the current jwt token and terminates the session.
+func (auth *auth) Logout(ctx context.Context, refreshTok domain.RefreshToken) error {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	claims, err := domain.GetClaims(ctx)
+	if err != nil {
+		err = fmt.Errorf("logout err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return err
+	}
+
+	if claims.ID != "" && claims.ExpiresAt != nil {
+		if err := auth.stflStorage.RevokeToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+			err = fmt.Errorf("logout revokeToken err - %w", err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+	}
+
+	if claims.SessionID != "" {
+		// refresh tokens of the session are removed with it
+		if err := auth.stflStorage.DeleteSession(ctx, claims.SessionID); err != nil && !errors.Is(err, domain.ErrClientDataIncorrect) {
+			err = fmt.Errorf("logout deleteSession err - %w", err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+	} else if refreshTok != "" {
+		if err := auth.stflStorage.DeleteRefreshTokenFamily(ctx, claims.UserID, auth.regHelper.HashRefreshToken(refreshTok)); err != nil {
+			err = fmt.Errorf("logout deleteRefreshTokenFamily err - %w", err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+	}
+
+	log.Debugw(action, "msg", "logout complete")
+	return nil
+}
+
+// IsTokenRevoked checks jwt token id in revocation list and the token session; is used by jwt interceptors.
+// Session last-seen time is updated by the check, at most once a minute.
+func (auth *auth) IsTokenRevoked(ctx context.Context, claims *domain.Claims) (bool, error) {
+	if claims.ID != "" {
+		revoked, err := auth.stflStorage.IsTokenRevoked(ctx, claims.ID)
+		if err != nil || revoked {
+			return revoked, err
+		}
+	}
+
+	if claims.SessionID != "" {
+		active, err := auth.stflStorage.TouchSession(ctx, claims.SessionID)
+		if err != nil {
+			return false, err
+		}
+		return !active, nil
+	}
+
+	return false, nil
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/StasMerzlyakov/gophkeeper/internal/server/usecases"
+	"github.com/golang-jwt/jwt/v4"
 	gomock "github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,6 +46,7 @@ func TestAuthentification_Login(t *testing.T) {
 			gomock.Eq(loginData.PasswordHash),
 			gomock.Eq(loginData.PasswordSalt)).Times(1).
 			Return(true, nil)
+		mockHelper.EXPECT().PasswordNeedsRehash(gomock.Eq(loginData.PasswordHash)).Times(1).Return(false)
 
 		mockTempStorage := NewMockTemporaryStorage(ctrl)
 		mockTempStorage.EXPECT().Create(gomock.Any(), gomock.Eq(sessionID), gomock.Any()).Times(1).
@@ -139,6 +142,172 @@ func TestAuthentification_Login(t *testing.T) {
 		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
 	})
 
+	t.Run("cert_mismatch", func(t *testing.T) {
+		data := &domain.EMailData{
+			EMail:    "test@email",
+			Password: "test_pass",
+		}
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		loginData := &domain.LoginData{
+			UserID:          1,
+			EncryptedOTPKey: "encryptedOTPKey",
+			EMail:           "test@email",
+			PasswordHash:    "Hash",
+			PasswordSalt:    "Salt",
+			CertFingerprint: "fingerprint",
+		}
+
+		mockStflStorage.EXPECT().GetLoginData(gomock.Any(), gomock.Eq(data.EMail)).Times(2).Return(loginData, nil)
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().ValidateAccountPass(gomock.Eq(data.Password),
+			gomock.Eq(loginData.PasswordHash),
+			gomock.Eq(loginData.PasswordSalt)).Times(2).
+			Return(true, nil)
+
+		auth := usecases.NewAuth(nil).StateFullStorage(mockStflStorage).RegistrationHelper(mockHelper)
+
+		// no client certificate
+		_, err := auth.Login(context.Background(), data)
+		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
+
+		// another client certificate
+		ctx := domain.EnrichWithPeerCertFingerprint(context.Background(), "another")
+		_, err = auth.Login(ctx, data)
+		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
+	})
+
+	t.Run("cert_match", func(t *testing.T) {
+		data := &domain.EMailData{
+			EMail:    "test@email",
+			Password: "test_pass",
+		}
+
+		sessionID := domain.SessionID("sessionID")
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		loginData := &domain.LoginData{
+			UserID:          1,
+			EncryptedOTPKey: "encryptedOTPKey",
+			EMail:           "test@email",
+			PasswordHash:    "Hash",
+			PasswordSalt:    "Salt",
+			CertFingerprint: "fingerprint",
+		}
+
+		mockStflStorage.EXPECT().GetLoginData(gomock.Any(), gomock.Eq(data.EMail)).Times(1).Return(loginData, nil)
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().NewSessionID().Times(1).Return(sessionID)
+		mockHelper.EXPECT().ValidateAccountPass(gomock.Eq(data.Password),
+			gomock.Eq(loginData.PasswordHash),
+			gomock.Eq(loginData.PasswordSalt)).Times(1).
+			Return(true, nil)
+		mockHelper.EXPECT().PasswordNeedsRehash(gomock.Eq(loginData.PasswordHash)).Times(1).Return(false)
+
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		mockTempStorage.EXPECT().Create(gomock.Any(), gomock.Eq(sessionID), gomock.Any()).Times(1).Return(nil)
+
+		auth := usecases.NewAuth(nil).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage).TemporaryStorage(mockTempStorage)
+
+		ctx := domain.EnrichWithPeerCertFingerprint(context.Background(), "fingerprint")
+		sID, err := auth.Login(ctx, data)
+		require.NoError(t, err)
+		assert.Equal(t, sessionID, sID)
+	})
+
+	t.Run("rehash", func(t *testing.T) {
+		data := &domain.EMailData{
+			EMail:    "test@email",
+			Password: "test_pass",
+		}
+
+		sessionID := domain.SessionID("sessionID")
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		loginData := &domain.LoginData{
+			UserID:          1,
+			EncryptedOTPKey: "encryptedOTPKey",
+			EMail:           "test@email",
+			PasswordHash:    "Hash",
+			PasswordSalt:    "Salt",
+		}
+		newHash := &domain.HashData{
+			Hash: "$argon2id$NewHash",
+		}
+
+		mockStflStorage.EXPECT().GetLoginData(gomock.Any(), gomock.Eq(data.EMail)).Times(1).Return(loginData, nil)
+		mockStflStorage.EXPECT().UpdatePasswordHash(gomock.Any(), gomock.Eq(loginData.UserID), gomock.Eq(newHash)).Times(1).Return(nil)
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().NewSessionID().Times(1).Return(sessionID)
+		mockHelper.EXPECT().ValidateAccountPass(gomock.Eq(data.Password),
+			gomock.Eq(loginData.PasswordHash),
+			gomock.Eq(loginData.PasswordSalt)).Times(1).
+			Return(true, nil)
+		mockHelper.EXPECT().PasswordNeedsRehash(gomock.Eq(loginData.PasswordHash)).Times(1).Return(true)
+		mockHelper.EXPECT().HashPassword(gomock.Eq(data.Password)).Times(1).Return(newHash, nil)
+
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		mockTempStorage.EXPECT().Create(gomock.Any(), gomock.Eq(sessionID), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, sID domain.SessionID, input any) error {
+				lData, ok := input.(domain.LoginData)
+				require.True(t, ok)
+				assert.Equal(t, newHash.Hash, lData.PasswordHash)
+				assert.Equal(t, newHash.Salt, lData.PasswordSalt)
+				return nil
+			})
+
+		auth := usecases.NewAuth(nil).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage).TemporaryStorage(mockTempStorage)
+		sID, err := auth.Login(context.Background(), data)
+		require.NoError(t, err)
+		assert.Equal(t, sessionID, sID)
+	})
+
+	t.Run("rehash_err", func(t *testing.T) {
+		data := &domain.EMailData{
+			EMail:    "test@email",
+			Password: "test_pass",
+		}
+
+		sessionID := domain.SessionID("sessionID")
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		loginData := &domain.LoginData{
+			UserID:          1,
+			EncryptedOTPKey: "encryptedOTPKey",
+			EMail:           "test@email",
+			PasswordHash:    "Hash",
+			PasswordSalt:    "Salt",
+		}
+		newHash := &domain.HashData{
+			Hash: "$argon2id$NewHash",
+		}
+
+		testErr := errors.New("testErr")
+		mockStflStorage.EXPECT().GetLoginData(gomock.Any(), gomock.Eq(data.EMail)).Times(1).Return(loginData, nil)
+		mockStflStorage.EXPECT().UpdatePasswordHash(gomock.Any(), gomock.Eq(loginData.UserID), gomock.Eq(newHash)).Times(1).Return(testErr)
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().NewSessionID().Times(1).Return(sessionID)
+		mockHelper.EXPECT().ValidateAccountPass(gomock.Eq(data.Password),
+			gomock.Eq(loginData.PasswordHash),
+			gomock.Eq(loginData.PasswordSalt)).Times(1).
+			Return(true, nil)
+		mockHelper.EXPECT().PasswordNeedsRehash(gomock.Eq(loginData.PasswordHash)).Times(1).Return(true)
+		mockHelper.EXPECT().HashPassword(gomock.Eq(data.Password)).Times(1).Return(newHash, nil)
+
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+		mockTempStorage.EXPECT().Create(gomock.Any(), gomock.Eq(sessionID), gomock.Any()).Times(1).Return(nil)
+
+		// login is not failed on rehash error
+		auth := usecases.NewAuth(nil).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage).TemporaryStorage(mockTempStorage)
+		sID, err := auth.Login(context.Background(), data)
+		require.NoError(t, err)
+		assert.Equal(t, sessionID, sID)
+	})
+
 	t.Run("create_err", func(t *testing.T) {
 		data := &domain.EMailData{
 			EMail:    "test@email",
@@ -165,6 +334,7 @@ func TestAuthentification_Login(t *testing.T) {
 			gomock.Eq(loginData.PasswordHash),
 			gomock.Eq(loginData.PasswordSalt)).Times(1).
 			Return(true, nil)
+		mockHelper.EXPECT().PasswordNeedsRehash(gomock.Eq(loginData.PasswordHash)).Times(1).Return(false)
 
 		mockTempStorage := NewMockTemporaryStorage(ctrl)
 		testErr := errors.New("testErr")
@@ -214,17 +384,48 @@ func TestCheckOTP(t *testing.T) {
 			decryptedOTPKey, nil,
 		)
 
-		mockHelper.EXPECT().ValidateOTPCode(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(true, nil)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(100), true, nil)
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().AcceptOTPStep(gomock.Any(), gomock.Eq(userID), gomock.Eq(int64(100))).Times(1).Return(true, nil)
 
 		jwtTok := domain.JWTToken("jwtTok")
-		mockHelper.EXPECT().CreateJWTToken(gomock.Eq(userID)).Times(1).Return(jwtTok, nil)
+		mockHelper.EXPECT().CreateJWTToken(gomock.Eq(userID), gomock.Eq("familyID")).Times(1).Return(jwtTok, nil)
+
+		refreshTok := domain.RefreshToken("refreshTok")
+		mockHelper.EXPECT().NewRefreshToken().Times(1).Return(refreshTok, nil)
+		mockHelper.EXPECT().HashRefreshToken(gomock.Eq(refreshTok)).Times(1).Return("refreshHash")
+		mockHelper.EXPECT().NewSessionID().Times(1).Return(domain.SessionID("familyID"))
+
+		mockStflStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, session *domain.SessionInfo) error {
+				assert.Equal(t, "familyID", session.SessionID)
+				assert.Equal(t, userID, session.UserID)
+				assert.Equal(t, "laptop", session.DeviceName)
+				assert.Equal(t, "v1.0.0", session.ClientVersion)
+				assert.Equal(t, "127.0.0.1", session.PeerIP)
+				return nil
+			})
 
-		auth := usecases.NewAuth(conf).RegistrationHelper(mockHelper).TemporaryStorage(mockTempStorage)
+		mockStflStorage.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, data *domain.RefreshTokenData) error {
+				assert.Equal(t, "refreshHash", data.Hash)
+				assert.Equal(t, userID, data.UserID)
+				assert.Equal(t, "familyID", data.FamilyID)
+				return nil
+			})
 
-		ctx := context.Background()
-		jTok, err := auth.CheckOTP(ctx, currentID, otpPass)
+		auth := usecases.NewAuth(conf).RegistrationHelper(mockHelper).TemporaryStorage(mockTempStorage).StateFullStorage(mockStflStorage)
+
+		ctx := domain.EnrichWithPeerIP(context.Background(), "127.0.0.1")
+		ctx = domain.EnrichWithClientInfo(ctx, &domain.ClientInfo{
+			DeviceName:    "laptop",
+			ClientVersion: "v1.0.0",
+		})
+		tokens, err := auth.CheckOTP(ctx, currentID, otpPass)
 		require.NoError(t, err)
-		assert.Equal(t, jwtTok, jTok)
+		assert.Equal(t, jwtTok, tokens.Token)
+		assert.Equal(t, refreshTok, tokens.RefreshToken)
 	})
 
 	t.Run("load_err", func(t *testing.T) {
@@ -303,7 +504,7 @@ func TestCheckOTP(t *testing.T) {
 		)
 
 		testErr := errors.New("testErr")
-		mockHelper.EXPECT().ValidateOTPCode(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(false, testErr)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(0), false, testErr)
 
 		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).RegistrationHelper(mockHelper)
 
@@ -342,7 +543,7 @@ func TestCheckOTP(t *testing.T) {
 			decryptedOTPKey, nil,
 		)
 
-		mockHelper.EXPECT().ValidateOTPCode(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(false, nil)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(0), false, nil)
 
 		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).RegistrationHelper(mockHelper)
 
@@ -351,6 +552,48 @@ func TestCheckOTP(t *testing.T) {
 		require.Error(t, err, domain.ErrAuthDataIncorrect)
 	})
 
+	t.Run("otp_reuse", func(t *testing.T) {
+		currentID := domain.SessionID("currentID")
+		conf := &config.ServerConf{
+			ServerSecret: "serverKey",
+		}
+		encryptedOTPKey := "asdasdasd!iasd"
+		decryptedOTPKey := "otp_key"
+		otpPass := "123345"
+
+		userID := domain.UserID(1)
+
+		mockTempStorage := NewMockTemporaryStorage(ctrl)
+
+		data := domain.LoginData{
+			UserID:          userID,
+			EncryptedOTPKey: encryptedOTPKey,
+			EMail:           "Email",
+			PasswordHash:    "Hash",
+			PasswordSalt:    "Salt",
+		}
+
+		mockTempStorage.EXPECT().Load(gomock.Any(), gomock.Eq(currentID)).Times(1).Return(
+			data, nil,
+		)
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().DecryptOTPKey(gomock.Eq(encryptedOTPKey)).Times(1).Return(
+			decryptedOTPKey, nil,
+		)
+
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(100), true, nil)
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().AcceptOTPStep(gomock.Any(), gomock.Eq(userID), gomock.Eq(int64(100))).Times(1).Return(false, nil)
+
+		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
+
+		ctx := context.Background()
+		_, err := auth.CheckOTP(ctx, currentID, otpPass)
+		require.ErrorIs(t, err, domain.ErrAuthDataIncorrect)
+	})
+
 	t.Run("jwt_create_err", func(t *testing.T) {
 		currentID := domain.SessionID("currentID")
 		conf := &config.ServerConf{
@@ -381,15 +624,219 @@ func TestCheckOTP(t *testing.T) {
 			decryptedOTPKey, nil,
 		)
 
-		mockHelper.EXPECT().ValidateOTPCode(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(true, nil)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(100), true, nil)
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().AcceptOTPStep(gomock.Any(), gomock.Eq(userID), gomock.Eq(int64(100))).Times(1).Return(true, nil)
+
+		mockHelper.EXPECT().NewSessionID().Times(1).Return(domain.SessionID("sessionID"))
+		mockStflStorage.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 
 		testErr := errors.New("testErr")
-		mockHelper.EXPECT().CreateJWTToken(gomock.Eq(userID)).Times(1).Return(domain.JWTToken(""), testErr)
+		mockHelper.EXPECT().CreateJWTToken(gomock.Eq(userID), gomock.Eq("sessionID")).Times(1).Return(domain.JWTToken(""), testErr)
 
-		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).RegistrationHelper(mockHelper)
+		auth := usecases.NewAuth(conf).TemporaryStorage(mockTempStorage).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
 
 		ctx := context.Background()
 		_, err := auth.CheckOTP(ctx, currentID, otpPass)
 		require.ErrorIs(t, err, testErr)
 	})
 }
+
+func TestRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	conf := &config.ServerConf{
+		RefreshTokenExp: time.Hour,
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		userID := domain.UserID(1)
+		refreshTok := domain.RefreshToken("refreshTok")
+		newRefreshTok := domain.RefreshToken("newRefreshTok")
+		jwtTok := domain.JWTToken("jwtTok")
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().NewRefreshToken().Times(1).Return(newRefreshTok, nil)
+		mockHelper.EXPECT().HashRefreshToken(gomock.Eq(refreshTok)).Times(1).Return("hash")
+		mockHelper.EXPECT().HashRefreshToken(gomock.Eq(newRefreshTok)).Times(1).Return("newHash")
+		mockHelper.EXPECT().CreateJWTToken(gomock.Eq(userID), gomock.Eq("sessionID")).Times(1).Return(jwtTok, nil)
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().GetRefreshTokenCertBinding(gomock.Any(), gomock.Eq("hash")).Times(1).Return("", nil)
+		mockStflStorage.EXPECT().RotateRefreshToken(gomock.Any(), gomock.Eq("hash"), gomock.Eq("newHash"), gomock.Any()).
+			Times(1).Return(&domain.RefreshTokenData{UserID: userID, FamilyID: "sessionID"}, nil)
+
+		auth := usecases.NewAuth(conf).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
+		tokens, err := auth.Refresh(context.Background(), refreshTok)
+		require.NoError(t, err)
+		assert.Equal(t, jwtTok, tokens.Token)
+		assert.Equal(t, newRefreshTok, tokens.RefreshToken)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		auth := usecases.NewAuth(conf)
+		_, err := auth.Refresh(context.Background(), "")
+		require.ErrorIs(t, err, domain.ErrNotAuthorized)
+	})
+
+	t.Run("rotate_err", func(t *testing.T) {
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().NewRefreshToken().Times(1).Return(domain.RefreshToken("newRefreshTok"), nil)
+		mockHelper.EXPECT().HashRefreshToken(gomock.Any()).Times(2).Return("hash")
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().GetRefreshTokenCertBinding(gomock.Any(), gomock.Any()).Times(1).Return("", nil)
+		mockStflStorage.EXPECT().RotateRefreshToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).Return(nil, domain.ErrNotAuthorized)
+
+		auth := usecases.NewAuth(conf).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
+		_, err := auth.Refresh(context.Background(), "refreshTok")
+		require.ErrorIs(t, err, domain.ErrNotAuthorized)
+	})
+
+	t.Run("cert_mismatch", func(t *testing.T) {
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().HashRefreshToken(gomock.Eq(domain.RefreshToken("refreshTok"))).Times(1).Return("hash")
+
+		// the token is not rotated
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().GetRefreshTokenCertBinding(gomock.Any(), gomock.Eq("hash")).Times(1).Return("boundFingerprint", nil)
+
+		ctx := domain.EnrichWithPeerCertFingerprint(context.Background(), "otherFingerprint")
+		auth := usecases.NewAuth(conf).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
+		_, err := auth.Refresh(ctx, "refreshTok")
+		require.ErrorIs(t, err, domain.ErrNotAuthorized)
+	})
+
+	t.Run("no_cert", func(t *testing.T) {
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().HashRefreshToken(gomock.Any()).Times(1).Return("hash")
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().GetRefreshTokenCertBinding(gomock.Any(), gomock.Eq("hash")).Times(1).Return("boundFingerprint", nil)
+
+		auth := usecases.NewAuth(conf).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
+		_, err := auth.Refresh(context.Background(), "refreshTok")
+		require.ErrorIs(t, err, domain.ErrNotAuthorized)
+	})
+
+	t.Run("cert_match", func(t *testing.T) {
+		userID := domain.UserID(1)
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().NewRefreshToken().Times(1).Return(domain.RefreshToken("newRefreshTok"), nil)
+		mockHelper.EXPECT().HashRefreshToken(gomock.Any()).Times(2).Return("hash")
+		mockHelper.EXPECT().CreateJWTToken(gomock.Eq(userID), gomock.Eq("sessionID")).Times(1).Return(domain.JWTToken("jwtTok"), nil)
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().GetRefreshTokenCertBinding(gomock.Any(), gomock.Any()).Times(1).Return("boundFingerprint", nil)
+		mockStflStorage.EXPECT().RotateRefreshToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(1).Return(&domain.RefreshTokenData{UserID: userID, FamilyID: "sessionID"}, nil)
+
+		ctx := domain.EnrichWithPeerCertFingerprint(context.Background(), "boundFingerprint")
+		auth := usecases.NewAuth(conf).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
+		_, err := auth.Refresh(ctx, "refreshTok")
+		require.NoError(t, err)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		userID := domain.UserID(1)
+		expiresAt := time.Now().Add(time.Hour)
+		claims := &domain.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti",
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+			UserID: userID,
+		}
+
+		mockHelper := NewMockRegistrationHelper(ctrl)
+		mockHelper.EXPECT().HashRefreshToken(gomock.Eq(domain.RefreshToken("refreshTok"))).Times(1).Return("hash")
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().RevokeToken(gomock.Any(), gomock.Eq("jti"), gomock.Any()).Times(1).Return(nil)
+		mockStflStorage.EXPECT().DeleteRefreshTokenFamily(gomock.Any(), gomock.Eq(userID), gomock.Eq("hash")).Times(1).Return(nil)
+
+		auth := usecases.NewAuth(nil).RegistrationHelper(mockHelper).StateFullStorage(mockStflStorage)
+		ctx := domain.EnrichWithClaims(context.Background(), claims)
+		err := auth.Logout(ctx, "refreshTok")
+		require.NoError(t, err)
+	})
+
+	t.Run("session", func(t *testing.T) {
+		claims := &domain.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			UserID:    1,
+			SessionID: "sessionID",
+		}
+
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().RevokeToken(gomock.Any(), gomock.Eq("jti"), gomock.Any()).Times(1).Return(nil)
+		mockStflStorage.EXPECT().DeleteSession(gomock.Any(), gomock.Eq("sessionID")).Times(1).Return(domain.ErrClientDataIncorrect)
+
+		auth := usecases.NewAuth(nil).StateFullStorage(mockStflStorage)
+		ctx := domain.EnrichWithClaims(context.Background(), claims)
+		err := auth.Logout(ctx, "refreshTok")
+		require.NoError(t, err)
+	})
+
+	t.Run("no_claims", func(t *testing.T) {
+		auth := usecases.NewAuth(nil)
+		err := auth.Logout(context.Background(), "refreshTok")
+		require.ErrorIs(t, err, domain.ErrNotAuthorized)
+	})
+}
+
+func TestIsTokenRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	claims := &domain.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID: "jti",
+		},
+		UserID:    1,
+		SessionID: "sessionID",
+	}
+
+	t.Run("active", func(t *testing.T) {
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().IsTokenRevoked(gomock.Any(), gomock.Eq("jti")).Times(1).Return(false, nil)
+		mockStflStorage.EXPECT().TouchSession(gomock.Any(), gomock.Eq("sessionID")).Times(1).Return(true, nil)
+
+		auth := usecases.NewAuth(nil).StateFullStorage(mockStflStorage)
+		revoked, err := auth.IsTokenRevoked(context.Background(), claims)
+		require.NoError(t, err)
+		require.False(t, revoked)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().IsTokenRevoked(gomock.Any(), gomock.Eq("jti")).Times(1).Return(true, nil)
+
+		auth := usecases.NewAuth(nil).StateFullStorage(mockStflStorage)
+		revoked, err := auth.IsTokenRevoked(context.Background(), claims)
+		require.NoError(t, err)
+		require.True(t, revoked)
+	})
+
+	t.Run("session_terminated", func(t *testing.T) {
+		mockStflStorage := NewMockStateFullStorage(ctrl)
+		mockStflStorage.EXPECT().IsTokenRevoked(gomock.Any(), gomock.Eq("jti")).Times(1).Return(false, nil)
+		mockStflStorage.EXPECT().TouchSession(gomock.Any(), gomock.Eq("sessionID")).Times(1).Return(false, nil)
+
+		auth := usecases.NewAuth(nil).StateFullStorage(mockStflStorage)
+		revoked, err := auth.IsTokenRevoked(context.Background(), claims)
+		require.NoError(t, err)
+		require.True(t, revoked)
+	})
+}
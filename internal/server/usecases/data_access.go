@@ -19,9 +19,15 @@ func (dAcc *dataAccessor) StateFullStorage(stflStorage StateFullStorage) *dataAc
 	return dAcc
 }
 
+func (dAcc *dataAccessor) ChangeNotifier(notifier ChangeNotifier) *dataAccessor {
+	dAcc.notifier = notifier
+	return dAcc
+}
+
 type dataAccessor struct {
 	conf        *config.ServerConf
 	stflStorage StateFullStorage
+	notifier    ChangeNotifier
 }
 
 func (da *dataAccessor) GetHelloData(ctx context.Context) (*domain.HelloData, error) {
@@ -41,12 +47,12 @@ func (da *dataAccessor) GetHelloData(ctx context.Context) (*domain.HelloData, er
 	return res, nil
 }
 
-func (da *dataAccessor) GetBankCardList(ctx context.Context) ([]domain.EncryptedBankCard, error) {
+func (da *dataAccessor) ListItems(ctx context.Context, itemType domain.SecretItemType) ([]domain.EncryptedSecretItem, error) {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
-	data, err := da.stflStorage.GetBankCardList(ctx)
+	data, err := da.stflStorage.ListSecretItems(ctx, itemType)
 	if err != nil {
 		err := fmt.Errorf("%s err %w", action, err)
 		log.Infow(action, "err", err.Error())
@@ -57,108 +63,171 @@ func (da *dataAccessor) GetBankCardList(ctx context.Context) ([]domain.Encrypted
 	return data, nil
 }
 
-func (da *dataAccessor) CreateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error {
+func (da *dataAccessor) GetItem(ctx context.Context, itemID string) (*domain.EncryptedSecretItem, error) {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
-	if err := da.stflStorage.CreateBankCard(ctx, bnkCard); err != nil {
+	item, err := da.stflStorage.GetSecretItem(ctx, itemID)
+	if err != nil {
 		err := fmt.Errorf("%s err %w", action, err)
 		log.Infow(action, "err", err.Error())
-		return err
+		return nil, err
 	}
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
-	return nil
+	return item, nil
 }
 
-func (da *dataAccessor) UpdateBankCard(ctx context.Context, bnkCard *domain.EncryptedBankCard) error {
+// CreateItem stores the item and returns id generated by the server and the initial revision;
+// secure notes are created by CreateSecureNote.
+func (da *dataAccessor) CreateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
-	if err := da.stflStorage.UpdateBankCard(ctx, bnkCard); err != nil {
+	if item.Type == domain.SecretItemSecureNote {
+		err := fmt.Errorf("%s err %w - secure notes are created by CreateSecureNote", action, domain.ErrClientDataIncorrect)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+	rev, err := da.stflStorage.CreateSecretItem(ctx, item)
+	if err != nil {
 		err := fmt.Errorf("%s err %w", action, err)
 		log.Infow(action, "err", err.Error())
-		return err
+		return nil, err
 	}
+	da.publish(ctx, domain.ItemCreated, rev)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
-	return nil
+	return rev, nil
 }
 
-func (da *dataAccessor) DeleteBankCard(ctx context.Context, number string) error {
+// UpdateItem updates the item of the expected revision item.Revision;
+// domain.ErrDataConflict is returned if the item was changed after the revision.
+func (da *dataAccessor) UpdateItem(ctx context.Context, item *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
-	if err := da.stflStorage.DeleteBankCard(ctx, number); err != nil {
+	rev, err := da.stflStorage.UpdateSecretItem(ctx, item)
+	if err != nil {
 		err := fmt.Errorf("%s err %w", action, err)
 		log.Infow(action, "err", err.Error())
-		return err
+		return nil, err
 	}
+	da.publish(ctx, domain.ItemUpdated, rev)
+	da.pruneHistory(ctx, rev.ID)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
-	return nil
+	return rev, nil
 }
 
-func (da *dataAccessor) GetUserPasswordDataList(ctx context.Context) ([]domain.EncryptedUserPasswordData, error) {
+// DeleteItem deletes the item of the expected revision; domain.ErrDataConflict is returned if the item was changed.
+func (da *dataAccessor) DeleteItem(ctx context.Context, itemID string, revision int64) error {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
-	data, err := da.stflStorage.GetUserPasswordDataList(ctx)
+	rev, err := da.stflStorage.DeleteSecretItem(ctx, itemID, revision)
 	if err != nil {
 		err := fmt.Errorf("%s err %w", action, err)
 		log.Infow(action, "err", err.Error())
-		return nil, err
+		return err
 	}
+	da.publish(ctx, domain.ItemDeleted, rev)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
-	return data, nil
+	return nil
 }
 
-func (da *dataAccessor) CreateUserPasswordData(ctx context.Context, bnkCard *domain.EncryptedUserPasswordData) error {
+// GetChangesSince returns the items changed after the sequence number seq and the deleted items.
+func (da *dataAccessor) GetChangesSince(ctx context.Context, seq int64) (*domain.SecretItemChanges, error) {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
-	if err := da.stflStorage.CreateUserPasswordData(ctx, bnkCard); err != nil {
+	changes, err := da.stflStorage.GetSecretItemChanges(ctx, seq)
+	if err != nil {
 		err := fmt.Errorf("%s err %w", action, err)
 		log.Infow(action, "err", err.Error())
-		return err
+		return nil, err
 	}
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
-	return nil
+	return changes, nil
 }
 
-func (da *dataAccessor) UpdateUserPasswordData(ctx context.Context, bnkCard *domain.EncryptedUserPasswordData) error {
+// Watch sends the item change events of the user until ctx is done or send fails.
+// If the user changes were committed after seq, the ItemsChanged event is sent first.
+func (da *dataAccessor) Watch(ctx context.Context, seq int64, send func(event *domain.ItemChangeEvent) error) error {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
 
 	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
-	if err := da.stflStorage.UpdateUserPasswordData(ctx, bnkCard); err != nil {
-		err := fmt.Errorf("%s err %w", action, err)
+	userID, err := domain.GetUserID(ctx)
+	if err != nil {
+		err := fmt.Errorf("%s err %w - userID is not set", action, domain.ErrServerInternal)
 		log.Infow(action, "err", err.Error())
 		return err
 	}
 
-	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
-	return nil
-}
+	if da.notifier == nil {
+		err := fmt.Errorf("%s err %w - change notifier is not set", action, domain.ErrServerInternal)
+		log.Infow(action, "err", err.Error())
+		return err
+	}
 
-func (da *dataAccessor) DeleteUserPasswordData(ctx context.Context, hint string) error {
-	log := domain.GetCtxLogger(ctx)
-	action := domain.GetAction(1)
+	// subscribe before reading the sequence number - changes committed in between are not lost
+	events := da.notifier.Subscribe(ctx, userID)
 
-	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
-	if err := da.stflStorage.DeleteUserPasswordData(ctx, hint); err != nil {
+	lastSeq, err := da.stflStorage.GetChangeSeq(ctx)
+	if err != nil {
 		err := fmt.Errorf("%s err %w", action, err)
 		log.Infow(action, "err", err.Error())
 		return err
 	}
 
-	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	if lastSeq > seq {
+		seq = lastSeq
+		if err := send(&domain.ItemChangeEvent{Seq: lastSeq, Kind: domain.ItemsChanged}); err != nil {
+			err := fmt.Errorf("%s err %w", action, err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+	}
+
+	for event := range events {
+		if event.Seq <= seq {
+			// already reported by ItemsChanged
+			continue
+		}
+		seq = event.Seq
+		if err := send(&event); err != nil {
+			err := fmt.Errorf("%s err %w", action, err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s finished", action))
 	return nil
 }
+
+func (da *dataAccessor) publish(ctx context.Context, kind domain.ItemChangeKind, rev *domain.SecretItemRevision) {
+	if da.notifier == nil {
+		return
+	}
+
+	userID, err := domain.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	da.notifier.Publish(userID, &domain.ItemChangeEvent{
+		Seq:    rev.ChangeSeq,
+		Kind:   kind,
+		ItemID: rev.ID,
+		Type:   rev.Type,
+	})
+}
@@ -46,367 +46,298 @@ func TestDataAccessor_GetHelloData(t *testing.T) {
 	})
 }
 
-func TestDataAccessor_GetBankCardList(t *testing.T) {
+func TestDataAccessor_ListItems(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	t.Run("ok", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		list := []domain.EncryptedBankCard{
+		list := []domain.EncryptedSecretItem{
 			{
-				Number:  "Number1",
+				ID:      "id1",
+				Type:    domain.SecretItemBankCard,
+				Name:    "Name1",
 				Content: "Content1",
 			},
 			{
-				Number:  "Number2",
-				Content: "Content1",
+				ID:      "id2",
+				Type:    domain.SecretItemBankCard,
+				Name:    "Name2",
+				Content: "Content2",
 			},
 		}
 
-		mockStorage.EXPECT().GetBankCardList(gomock.Any()).Times(1).Return(list, nil)
+		mockStorage.EXPECT().ListSecretItems(gomock.Any(), domain.SecretItemBankCard).Times(1).Return(list, nil)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		res, err := da.GetBankCardList(context.Background())
+		res, err := da.ListItems(context.Background(), domain.SecretItemBankCard)
 		require.NoError(t, err)
-		require.Equal(t, 2, len(res))
-		require.Equal(t, "Number1", res[0].Number)
-		require.Equal(t, "Number2", res[1].Number)
+		require.Equal(t, list, res)
 	})
 
 	t.Run("err", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		list := []domain.EncryptedBankCard{
-			{
-				Number:  "Number1",
-				Content: "Content1",
-			},
-			{
-				Number:  "Number2",
-				Content: "Content1",
-			},
-		}
-
 		testErr := errors.New("testErr")
-		mockStorage.EXPECT().GetBankCardList(gomock.Any()).Times(1).Return(list, testErr)
+
+		mockStorage.EXPECT().ListSecretItems(gomock.Any(), gomock.Any()).Times(1).Return(nil, testErr)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		res, err := da.GetBankCardList(context.Background())
-		require.Nil(t, res)
+		_, err := da.ListItems(context.Background(), "")
 		require.ErrorIs(t, err, testErr)
 	})
 }
 
-func TestDataAccessor_GetUserPasswordDataList(t *testing.T) {
+func TestDataAccessor_GetItem(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	t.Run("ok", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		list := []domain.EncryptedUserPasswordData{
-			{
-				Hint:    "Hint1",
-				Content: "Content1",
-			},
-			{
-				Hint:    "Hint2",
-				Content: "Content1",
-			},
+		item := &domain.EncryptedSecretItem{
+			ID:      "id1",
+			Type:    domain.SecretItemUserPassword,
+			Name:    "Name1",
+			Content: "Content1",
 		}
 
-		mockStorage.EXPECT().GetUserPasswordDataList(gomock.Any()).Times(1).Return(list, nil)
+		mockStorage.EXPECT().GetSecretItem(gomock.Any(), "id1").Times(1).Return(item, nil)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		res, err := da.GetUserPasswordDataList(context.Background())
+		res, err := da.GetItem(context.Background(), "id1")
 		require.NoError(t, err)
-		require.Equal(t, 2, len(res))
-		require.Equal(t, "Hint1", res[0].Hint)
-		require.Equal(t, "Hint2", res[1].Hint)
+		require.Equal(t, item, res)
 	})
 
 	t.Run("err", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		list := []domain.EncryptedUserPasswordData{
-			{
-				Hint:    "Hint1",
-				Content: "Content1",
-			},
-			{
-				Hint:    "Hint2",
-				Content: "Content1",
-			},
-		}
 
-		testErr := errors.New("testErr")
-		mockStorage.EXPECT().GetUserPasswordDataList(gomock.Any()).Times(1).Return(list, testErr)
+		mockStorage.EXPECT().GetSecretItem(gomock.Any(), "id1").Times(1).Return(nil, domain.ErrDataNotExists)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		res, err := da.GetUserPasswordDataList(context.Background())
-		require.Nil(t, res)
-		require.ErrorIs(t, err, testErr)
+		_, err := da.GetItem(context.Background(), "id1")
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
 	})
 }
 
-func TestDataAccessor_CreateBankCard(t *testing.T) {
+func TestDataAccessor_CreateItem(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	item := &domain.EncryptedSecretItem{
+		Type:    domain.SecretItemUserPassword,
+		Name:    "Name1",
+		Content: "Content1",
+	}
+
 	t.Run("ok", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		dt := &domain.EncryptedBankCard{
-			Number:  "Number1",
-			Content: "Content1",
-		}
-
-		mockStorage.EXPECT().CreateBankCard(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, data *domain.EncryptedBankCard) error {
-				assert.Equal(t, dt.Number, data.Number)
-				assert.Equal(t, dt.Content, data.Content)
-				return nil
-			}).Times(1)
 
+		mockStorage.EXPECT().CreateSecretItem(gomock.Any(), item).Times(1).Return(&domain.SecretItemRevision{
+			ID:       "id1",
+			Revision: 1,
+		}, nil)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		err := da.CreateBankCard(context.Background(), dt)
+		rev, err := da.CreateItem(context.Background(), item)
 		require.NoError(t, err)
+		require.Equal(t, "id1", rev.ID)
+		require.Equal(t, int64(1), rev.Revision)
 	})
 
 	t.Run("err", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		dt := &domain.EncryptedBankCard{
-			Number:  "Number1",
-			Content: "Content1",
-		}
-
 		testErr := errors.New("testErr")
-		mockStorage.EXPECT().CreateBankCard(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, data *domain.EncryptedBankCard) error {
-				assert.Equal(t, dt.Number, data.Number)
-				assert.Equal(t, dt.Content, data.Content)
-				return testErr
-			}).Times(1)
 
+		mockStorage.EXPECT().CreateSecretItem(gomock.Any(), item).Times(1).Return(nil, testErr)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		err := da.CreateBankCard(context.Background(), dt)
+		_, err := da.CreateItem(context.Background(), item)
 		require.ErrorIs(t, err, testErr)
 	})
 }
 
-func TestDataAccessor_CreateUserPasswordData(t *testing.T) {
+func TestDataAccessor_UpdateItem(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	item := &domain.EncryptedSecretItem{
+		ID:       "id1",
+		Name:     "Name1",
+		Content:  "Content1",
+		Revision: 1,
+	}
+
 	t.Run("ok", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		dt := &domain.EncryptedUserPasswordData{
-			Hint:    "Hint1",
-			Content: "Content1",
-		}
-
-		mockStorage.EXPECT().CreateUserPasswordData(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
-				assert.Equal(t, dt.Hint, data.Hint)
-				assert.Equal(t, dt.Content, data.Content)
-				return nil
-			}).Times(1)
 
+		mockStorage.EXPECT().UpdateSecretItem(gomock.Any(), item).Times(1).Return(&domain.SecretItemRevision{
+			ID:       "id1",
+			Revision: 2,
+		}, nil)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		err := da.CreateUserPasswordData(context.Background(), dt)
+		rev, err := da.UpdateItem(context.Background(), item)
 		require.NoError(t, err)
+		require.Equal(t, int64(2), rev.Revision)
 	})
 
 	t.Run("err", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		dt := &domain.EncryptedUserPasswordData{
-			Hint:    "Hint1",
-			Content: "Content1",
-		}
 
-		testErr := errors.New("testErr")
-		mockStorage.EXPECT().CreateUserPasswordData(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
-				assert.Equal(t, dt.Hint, data.Hint)
-				assert.Equal(t, dt.Content, data.Content)
-				return testErr
-			}).Times(1)
+		mockStorage.EXPECT().UpdateSecretItem(gomock.Any(), item).Times(1).Return(nil, domain.ErrDataNotExists)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
+		_, err := da.UpdateItem(context.Background(), item)
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+
+		mockStorage.EXPECT().UpdateSecretItem(gomock.Any(), item).Times(1).Return(nil, domain.ErrDataConflict)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		err := da.CreateUserPasswordData(context.Background(), dt)
-		require.ErrorIs(t, err, testErr)
+		_, err := da.UpdateItem(context.Background(), item)
+		require.ErrorIs(t, err, domain.ErrDataConflict)
 	})
 }
 
-func TestDataAccessor_UpdateBankCard(t *testing.T) {
+func TestDataAccessor_DeleteItem(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	t.Run("ok", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		dt := &domain.EncryptedBankCard{
-			Number:  "Number1",
-			Content: "Content1",
-		}
-
-		mockStorage.EXPECT().UpdateBankCard(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, data *domain.EncryptedBankCard) error {
-				assert.Equal(t, dt.Number, data.Number)
-				assert.Equal(t, dt.Content, data.Content)
-				return nil
-			}).Times(1)
-
-		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		err := da.UpdateBankCard(context.Background(), dt)
+		mockStorage.EXPECT().DeleteSecretItem(gomock.Any(), "id1", int64(1)).Times(1).Return(&domain.SecretItemRevision{
+			ID:        "id1",
+			Type:      domain.SecretItemBankCard,
+			Revision:  1,
+			ChangeSeq: 3,
+		}, nil)
+
+		mockNotifier := NewMockChangeNotifier(ctrl)
+		mockNotifier.EXPECT().Publish(domain.UserID(1), &domain.ItemChangeEvent{
+			Seq:    3,
+			Kind:   domain.ItemDeleted,
+			ItemID: "id1",
+			Type:   domain.SecretItemBankCard,
+		}).Times(1)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage).ChangeNotifier(mockNotifier)
+
+		err := da.DeleteItem(domain.EnrichWithUserID(context.Background(), domain.UserID(1)), "id1", 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("err", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		dt := &domain.EncryptedBankCard{
-			Number:  "Number1",
-			Content: "Content1",
-		}
-
-		testErr := errors.New("testErr")
-		mockStorage.EXPECT().UpdateBankCard(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, data *domain.EncryptedBankCard) error {
-				assert.Equal(t, dt.Number, data.Number)
-				assert.Equal(t, dt.Content, data.Content)
-				return testErr
-			}).Times(1)
 
+		mockStorage.EXPECT().DeleteSecretItem(gomock.Any(), "id1", int64(1)).Times(1).Return(nil, domain.ErrDataConflict)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		err := da.UpdateBankCard(context.Background(), dt)
-		require.ErrorIs(t, err, testErr)
+		err := da.DeleteItem(context.Background(), "id1", 1)
+		require.ErrorIs(t, err, domain.ErrDataConflict)
 	})
 }
 
-func TestDataAccessor_UpdateUserPasswordData(t *testing.T) {
+func TestDataAccessor_GetChangesSince(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	t.Run("ok", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		dt := &domain.EncryptedUserPasswordData{
-			Hint:    "Hint1",
-			Content: "Content1",
-		}
-
-		mockStorage.EXPECT().UpdateUserPasswordData(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
-				assert.Equal(t, dt.Hint, data.Hint)
-				assert.Equal(t, dt.Content, data.Content)
-				return nil
-			}).Times(1)
 
+		changes := &domain.SecretItemChanges{
+			Seq: 2,
+			Deleted: []domain.DeletedSecretItem{
+				{
+					ID:   "id1",
+					Type: domain.SecretItemBankCard,
+				},
+			},
+		}
+		mockStorage.EXPECT().GetSecretItemChanges(gomock.Any(), int64(1)).Times(1).Return(changes, nil)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		err := da.UpdateUserPasswordData(context.Background(), dt)
+		res, err := da.GetChangesSince(context.Background(), 1)
 		require.NoError(t, err)
+		require.Equal(t, changes, res)
 	})
 
 	t.Run("err", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
-		dt := &domain.EncryptedUserPasswordData{
-			Hint:    "Hint1",
-			Content: "Content1",
-		}
-
-		testErr := errors.New("testErr")
-		mockStorage.EXPECT().UpdateUserPasswordData(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, data *domain.EncryptedUserPasswordData) error {
-				assert.Equal(t, dt.Hint, data.Hint)
-				assert.Equal(t, dt.Content, data.Content)
-				return testErr
-			}).Times(1)
 
+		mockStorage.EXPECT().GetSecretItemChanges(gomock.Any(), int64(1)).Times(1).Return(nil, domain.ErrServerInternal)
 		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
 
-		err := da.UpdateUserPasswordData(context.Background(), dt)
-		require.ErrorIs(t, err, testErr)
+		_, err := da.GetChangesSince(context.Background(), 1)
+		require.ErrorIs(t, err, domain.ErrServerInternal)
 	})
 }
 
-func TestDataAccessor_DeleteBankCard(t *testing.T) {
+func TestDataAccessor_Watch(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	t.Run("ok", func(t *testing.T) {
+	userCtx := domain.EnrichWithUserID(context.Background(), domain.UserID(1))
+
+	t.Run("missed_changes", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().GetChangeSeq(gomock.Any()).Times(1).Return(int64(5), nil)
 
-		number := "Number1"
+		events := make(chan domain.ItemChangeEvent, 3)
+		events <- domain.ItemChangeEvent{Seq: 5, Kind: domain.ItemCreated, ItemID: "id1"}
+		events <- domain.ItemChangeEvent{Seq: 6, Kind: domain.ItemUpdated, ItemID: "id1"}
+		close(events)
 
-		mockStorage.EXPECT().DeleteBankCard(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, num string) error {
-				assert.Equal(t, number, num)
-				return nil
-			}).Times(1)
+		mockNotifier := NewMockChangeNotifier(ctrl)
+		mockNotifier.EXPECT().Subscribe(gomock.Any(), domain.UserID(1)).Times(1).Return(events)
 
-		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage).ChangeNotifier(mockNotifier)
 
-		err := da.DeleteBankCard(context.Background(), number)
+		var sent []domain.ItemChangeEvent
+		err := da.Watch(userCtx, 3, func(event *domain.ItemChangeEvent) error {
+			sent = append(sent, *event)
+			return nil
+		})
 		require.NoError(t, err)
+		require.Equal(t, []domain.ItemChangeEvent{
+			{Seq: 5, Kind: domain.ItemsChanged},
+			{Seq: 6, Kind: domain.ItemUpdated, ItemID: "id1"},
+		}, sent)
 	})
 
-	t.Run("err", func(t *testing.T) {
-
+	t.Run("send_err", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().GetChangeSeq(gomock.Any()).Times(1).Return(int64(3), nil)
 
-		number := "Number1"
+		events := make(chan domain.ItemChangeEvent, 1)
+		events <- domain.ItemChangeEvent{Seq: 4, Kind: domain.ItemDeleted, ItemID: "id1"}
 
-		testErr := errors.New("testErr")
-		mockStorage.EXPECT().DeleteBankCard(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, num string) error {
-				assert.Equal(t, number, num)
-				return testErr
-			}).Times(1)
+		mockNotifier := NewMockChangeNotifier(ctrl)
+		mockNotifier.EXPECT().Subscribe(gomock.Any(), domain.UserID(1)).Times(1).Return(events)
 
-		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage).ChangeNotifier(mockNotifier)
 
-		err := da.DeleteBankCard(context.Background(), number)
+		testErr := errors.New("testErr")
+		err := da.Watch(userCtx, 3, func(event *domain.ItemChangeEvent) error {
+			return testErr
+		})
 		require.ErrorIs(t, err, testErr)
 	})
-}
-
-func TestDataAccessor_DeleteUserPasswordData(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
 
-	t.Run("ok", func(t *testing.T) {
+	t.Run("storage_err", func(t *testing.T) {
 		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().GetChangeSeq(gomock.Any()).Times(1).Return(int64(0), domain.ErrServerInternal)
 
-		hint := "Hint1"
+		mockNotifier := NewMockChangeNotifier(ctrl)
+		mockNotifier.EXPECT().Subscribe(gomock.Any(), domain.UserID(1)).Times(1).Return(make(chan domain.ItemChangeEvent))
 
-		mockStorage.EXPECT().DeleteUserPasswordData(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, hnt string) error {
-				assert.Equal(t, hint, hnt)
-				return nil
-			}).Times(1)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage).ChangeNotifier(mockNotifier)
 
-		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
-
-		err := da.DeleteUserPasswordData(context.Background(), hint)
-		require.NoError(t, err)
-	})
-
-	t.Run("err", func(t *testing.T) {
-
-		mockStorage := NewMockStateFullStorage(ctrl)
-
-		hint := "Hint1"
-
-		testErr := errors.New("testErr")
-		mockStorage.EXPECT().DeleteUserPasswordData(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, hnt string) error {
-				assert.Equal(t, hint, hnt)
-				return testErr
-			}).Times(1)
-
-		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
-		err := da.DeleteUserPasswordData(context.Background(), hint)
-		require.ErrorIs(t, err, testErr)
+		err := da.Watch(userCtx, 3, func(event *domain.ItemChangeEvent) error {
+			return nil
+		})
+		require.ErrorIs(t, err, domain.ErrServerInternal)
 	})
 }
@@ -52,6 +52,7 @@ func (fa *fileAccessor) GetFileInfoList(ctx context.Context) ([]domain.FileInfo,
 	return lst, nil
 }
 
+// DeleteFileInfo moves the file to the trash of the user.
 func (fa *fileAccessor) DeleteFileInfo(ctx context.Context, name string) error {
 	log := domain.GetCtxLogger(ctx)
 	action := domain.GetAction(1)
@@ -64,7 +65,7 @@ func (fa *fileAccessor) DeleteFileInfo(ctx context.Context, name string) error {
 		return err
 	}
 
-	if err = fa.fileStorage.DeleteFileInfo(ctx, bucket, name); err != nil {
+	if err = fa.fileStorage.MoveToTrash(ctx, bucket, name); err != nil {
 		log.Infow(action, "delete err", err.Error())
 		return err
 	}
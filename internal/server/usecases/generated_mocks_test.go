@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/StasMerzlyakov/gophkeeper/internal/server/usecases (interfaces: StateFullStorage,TemporaryStorage,EMailSender,RegistrationHelper,FileStorage)
+// Source: github.com/StasMerzlyakov/gophkeeper/internal/server/usecases (interfaces: StateFullStorage,TemporaryStorage,EMailSender,RegistrationHelper,FileStorage,ChangeNotifier)
 
 // Package usecases_test is a generated GoMock package.
 package usecases_test
@@ -7,6 +7,7 @@ package usecases_test
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	gomock "github.com/golang/mock/gomock"
@@ -35,75 +36,180 @@ func (m *MockStateFullStorage) EXPECT() *MockStateFullStorageMockRecorder {
 	return m.recorder
 }
 
-// CreateBankCard mocks base method.
-func (m *MockStateFullStorage) CreateBankCard(arg0 context.Context, arg1 *domain.EncryptedBankCard) error {
+// AcceptOTPStep mocks base method.
+func (m *MockStateFullStorage) AcceptOTPStep(arg0 context.Context, arg1 domain.UserID, arg2 int64) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBankCard", arg0, arg1)
+	ret := m.ctrl.Call(m, "AcceptOTPStep", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOTPStep indicates an expected call of AcceptOTPStep.
+func (mr *MockStateFullStorageMockRecorder) AcceptOTPStep(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOTPStep", reflect.TypeOf((*MockStateFullStorage)(nil).AcceptOTPStep), arg0, arg1, arg2)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockStateFullStorage) CreateRefreshToken(arg0 context.Context, arg1 *domain.RefreshTokenData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateBankCard indicates an expected call of CreateBankCard.
-func (mr *MockStateFullStorageMockRecorder) CreateBankCard(arg0, arg1 interface{}) *gomock.Call {
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockStateFullStorageMockRecorder) CreateRefreshToken(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBankCard", reflect.TypeOf((*MockStateFullStorage)(nil).CreateBankCard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockStateFullStorage)(nil).CreateRefreshToken), arg0, arg1)
 }
 
-// CreateUserPasswordData mocks base method.
-func (m *MockStateFullStorage) CreateUserPasswordData(arg0 context.Context, arg1 *domain.EncryptedUserPasswordData) error {
+// CreateSecretItem mocks base method.
+func (m *MockStateFullStorage) CreateSecretItem(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserPasswordData", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateSecretItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecretItem indicates an expected call of CreateSecretItem.
+func (mr *MockStateFullStorageMockRecorder) CreateSecretItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecretItem", reflect.TypeOf((*MockStateFullStorage)(nil).CreateSecretItem), arg0, arg1)
+}
+
+// CreateSecureNote mocks base method.
+func (m *MockStateFullStorage) CreateSecureNote(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecureNote", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecureNote indicates an expected call of CreateSecureNote.
+func (mr *MockStateFullStorageMockRecorder) CreateSecureNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecureNote", reflect.TypeOf((*MockStateFullStorage)(nil).CreateSecureNote), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStateFullStorage) CreateSession(arg0 context.Context, arg1 *domain.SessionInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateUserPasswordData indicates an expected call of CreateUserPasswordData.
-func (mr *MockStateFullStorageMockRecorder) CreateUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStateFullStorageMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStateFullStorage)(nil).CreateSession), arg0, arg1)
+}
+
+// DeleteExpiredTrashItems mocks base method.
+func (m *MockStateFullStorage) DeleteExpiredTrashItems(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredTrashItems", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredTrashItems indicates an expected call of DeleteExpiredTrashItems.
+func (mr *MockStateFullStorageMockRecorder) DeleteExpiredTrashItems(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserPasswordData", reflect.TypeOf((*MockStateFullStorage)(nil).CreateUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredTrashItems", reflect.TypeOf((*MockStateFullStorage)(nil).DeleteExpiredTrashItems), arg0, arg1)
 }
 
-// DeleteBankCard mocks base method.
-func (m *MockStateFullStorage) DeleteBankCard(arg0 context.Context, arg1 string) error {
+// DeleteRefreshTokenFamily mocks base method.
+func (m *MockStateFullStorage) DeleteRefreshTokenFamily(arg0 context.Context, arg1 domain.UserID, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBankCard", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteRefreshTokenFamily", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteBankCard indicates an expected call of DeleteBankCard.
-func (mr *MockStateFullStorageMockRecorder) DeleteBankCard(arg0, arg1 interface{}) *gomock.Call {
+// DeleteRefreshTokenFamily indicates an expected call of DeleteRefreshTokenFamily.
+func (mr *MockStateFullStorageMockRecorder) DeleteRefreshTokenFamily(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRefreshTokenFamily", reflect.TypeOf((*MockStateFullStorage)(nil).DeleteRefreshTokenFamily), arg0, arg1, arg2)
+}
+
+// DeleteSecretItem mocks base method.
+func (m *MockStateFullStorage) DeleteSecretItem(arg0 context.Context, arg1 string, arg2 int64) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecretItem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecretItem indicates an expected call of DeleteSecretItem.
+func (mr *MockStateFullStorageMockRecorder) DeleteSecretItem(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankCard", reflect.TypeOf((*MockStateFullStorage)(nil).DeleteBankCard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretItem", reflect.TypeOf((*MockStateFullStorage)(nil).DeleteSecretItem), arg0, arg1, arg2)
 }
 
-// DeleteUserPasswordData mocks base method.
-func (m *MockStateFullStorage) DeleteUserPasswordData(arg0 context.Context, arg1 string) error {
+// DeleteSecureNote mocks base method.
+func (m *MockStateFullStorage) DeleteSecureNote(arg0 context.Context, arg1 string, arg2 int64) (*domain.SecretItemRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUserPasswordData", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteSecureNote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecureNote indicates an expected call of DeleteSecureNote.
+func (mr *MockStateFullStorageMockRecorder) DeleteSecureNote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecureNote", reflect.TypeOf((*MockStateFullStorage)(nil).DeleteSecureNote), arg0, arg1, arg2)
+}
+
+// DeleteSession mocks base method.
+func (m *MockStateFullStorage) DeleteSession(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUserPasswordData indicates an expected call of DeleteUserPasswordData.
-func (mr *MockStateFullStorageMockRecorder) DeleteUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockStateFullStorageMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockStateFullStorage)(nil).DeleteSession), arg0, arg1)
+}
+
+// DeleteTrashItems mocks base method.
+func (m *MockStateFullStorage) DeleteTrashItems(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrashItems", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrashItems indicates an expected call of DeleteTrashItems.
+func (mr *MockStateFullStorageMockRecorder) DeleteTrashItems(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPasswordData", reflect.TypeOf((*MockStateFullStorage)(nil).DeleteUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrashItems", reflect.TypeOf((*MockStateFullStorage)(nil).DeleteTrashItems), arg0, arg1)
 }
 
-// GetBankCardList mocks base method.
-func (m *MockStateFullStorage) GetBankCardList(arg0 context.Context) ([]domain.EncryptedBankCard, error) {
+// GetChangeSeq mocks base method.
+func (m *MockStateFullStorage) GetChangeSeq(arg0 context.Context) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBankCardList", arg0)
-	ret0, _ := ret[0].([]domain.EncryptedBankCard)
+	ret := m.ctrl.Call(m, "GetChangeSeq", arg0)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBankCardList indicates an expected call of GetBankCardList.
-func (mr *MockStateFullStorageMockRecorder) GetBankCardList(arg0 interface{}) *gomock.Call {
+// GetChangeSeq indicates an expected call of GetChangeSeq.
+func (mr *MockStateFullStorageMockRecorder) GetChangeSeq(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankCardList", reflect.TypeOf((*MockStateFullStorage)(nil).GetBankCardList), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeSeq", reflect.TypeOf((*MockStateFullStorage)(nil).GetChangeSeq), arg0)
 }
 
 // GetHelloData mocks base method.
@@ -136,34 +242,109 @@ func (mr *MockStateFullStorageMockRecorder) GetLoginData(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginData", reflect.TypeOf((*MockStateFullStorage)(nil).GetLoginData), arg0, arg1)
 }
 
-// GetUserFilesBucket mocks base method.
-func (m *MockStateFullStorage) GetUserFilesBucket(arg0 context.Context) (string, error) {
+// GetRefreshTokenCertBinding mocks base method.
+func (m *MockStateFullStorage) GetRefreshTokenCertBinding(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFilesBucket", arg0)
+	ret := m.ctrl.Call(m, "GetRefreshTokenCertBinding", arg0, arg1)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserFilesBucket indicates an expected call of GetUserFilesBucket.
-func (mr *MockStateFullStorageMockRecorder) GetUserFilesBucket(arg0 interface{}) *gomock.Call {
+// GetRefreshTokenCertBinding indicates an expected call of GetRefreshTokenCertBinding.
+func (mr *MockStateFullStorageMockRecorder) GetRefreshTokenCertBinding(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFilesBucket", reflect.TypeOf((*MockStateFullStorage)(nil).GetUserFilesBucket), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshTokenCertBinding", reflect.TypeOf((*MockStateFullStorage)(nil).GetRefreshTokenCertBinding), arg0, arg1)
+}
+
+// GetSecretItem mocks base method.
+func (m *MockStateFullStorage) GetSecretItem(arg0 context.Context, arg1 string) (*domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretItem indicates an expected call of GetSecretItem.
+func (mr *MockStateFullStorageMockRecorder) GetSecretItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretItem", reflect.TypeOf((*MockStateFullStorage)(nil).GetSecretItem), arg0, arg1)
 }
 
-// GetUserPasswordDataList mocks base method.
-func (m *MockStateFullStorage) GetUserPasswordDataList(arg0 context.Context) ([]domain.EncryptedUserPasswordData, error) {
+// GetSecretItemChanges mocks base method.
+func (m *MockStateFullStorage) GetSecretItemChanges(arg0 context.Context, arg1 int64) (*domain.SecretItemChanges, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserPasswordDataList", arg0)
-	ret0, _ := ret[0].([]domain.EncryptedUserPasswordData)
+	ret := m.ctrl.Call(m, "GetSecretItemChanges", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemChanges)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserPasswordDataList indicates an expected call of GetUserPasswordDataList.
-func (mr *MockStateFullStorageMockRecorder) GetUserPasswordDataList(arg0 interface{}) *gomock.Call {
+// GetSecretItemChanges indicates an expected call of GetSecretItemChanges.
+func (mr *MockStateFullStorageMockRecorder) GetSecretItemChanges(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPasswordDataList", reflect.TypeOf((*MockStateFullStorage)(nil).GetUserPasswordDataList), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretItemChanges", reflect.TypeOf((*MockStateFullStorage)(nil).GetSecretItemChanges), arg0, arg1)
+}
+
+// GetSecretItemRevision mocks base method.
+func (m *MockStateFullStorage) GetSecretItemRevision(arg0 context.Context, arg1 string, arg2 int64) (*domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretItemRevision", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretItemRevision indicates an expected call of GetSecretItemRevision.
+func (mr *MockStateFullStorageMockRecorder) GetSecretItemRevision(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretItemRevision", reflect.TypeOf((*MockStateFullStorage)(nil).GetSecretItemRevision), arg0, arg1, arg2)
+}
+
+// GetSecureNote mocks base method.
+func (m *MockStateFullStorage) GetSecureNote(arg0 context.Context, arg1 string) (*domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecureNote", arg0, arg1)
+	ret0, _ := ret[0].(*domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecureNote indicates an expected call of GetSecureNote.
+func (mr *MockStateFullStorageMockRecorder) GetSecureNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecureNote", reflect.TypeOf((*MockStateFullStorage)(nil).GetSecureNote), arg0, arg1)
+}
+
+// GetSessionList mocks base method.
+func (m *MockStateFullStorage) GetSessionList(arg0 context.Context) ([]domain.SessionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionList", arg0)
+	ret0, _ := ret[0].([]domain.SessionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionList indicates an expected call of GetSessionList.
+func (mr *MockStateFullStorageMockRecorder) GetSessionList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionList", reflect.TypeOf((*MockStateFullStorage)(nil).GetSessionList), arg0)
+}
+
+// GetUserFilesBucket mocks base method.
+func (m *MockStateFullStorage) GetUserFilesBucket(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFilesBucket", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFilesBucket indicates an expected call of GetUserFilesBucket.
+func (mr *MockStateFullStorageMockRecorder) GetUserFilesBucket(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFilesBucket", reflect.TypeOf((*MockStateFullStorage)(nil).GetUserFilesBucket), arg0)
 }
 
 // IsEMailAvailable mocks base method.
@@ -181,12 +362,103 @@ func (mr *MockStateFullStorageMockRecorder) IsEMailAvailable(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEMailAvailable", reflect.TypeOf((*MockStateFullStorage)(nil).IsEMailAvailable), arg0, arg1)
 }
 
+// IsTokenRevoked mocks base method.
+func (m *MockStateFullStorage) IsTokenRevoked(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockStateFullStorageMockRecorder) IsTokenRevoked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockStateFullStorage)(nil).IsTokenRevoked), arg0, arg1)
+}
+
+// ListSecretItemRevisions mocks base method.
+func (m *MockStateFullStorage) ListSecretItemRevisions(arg0 context.Context, arg1 string) ([]domain.SecretItemHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretItemRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.SecretItemHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretItemRevisions indicates an expected call of ListSecretItemRevisions.
+func (mr *MockStateFullStorageMockRecorder) ListSecretItemRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretItemRevisions", reflect.TypeOf((*MockStateFullStorage)(nil).ListSecretItemRevisions), arg0, arg1)
+}
+
+// ListSecretItems mocks base method.
+func (m *MockStateFullStorage) ListSecretItems(arg0 context.Context, arg1 domain.SecretItemType) ([]domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretItems", arg0, arg1)
+	ret0, _ := ret[0].([]domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretItems indicates an expected call of ListSecretItems.
+func (mr *MockStateFullStorageMockRecorder) ListSecretItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretItems", reflect.TypeOf((*MockStateFullStorage)(nil).ListSecretItems), arg0, arg1)
+}
+
+// ListSecureNotes mocks base method.
+func (m *MockStateFullStorage) ListSecureNotes(arg0 context.Context) ([]domain.EncryptedSecretItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecureNotes", arg0)
+	ret0, _ := ret[0].([]domain.EncryptedSecretItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecureNotes indicates an expected call of ListSecureNotes.
+func (mr *MockStateFullStorageMockRecorder) ListSecureNotes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecureNotes", reflect.TypeOf((*MockStateFullStorage)(nil).ListSecureNotes), arg0)
+}
+
+// ListTrashItems mocks base method.
+func (m *MockStateFullStorage) ListTrashItems(arg0 context.Context) ([]domain.TrashEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashItems", arg0)
+	ret0, _ := ret[0].([]domain.TrashEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrashItems indicates an expected call of ListTrashItems.
+func (mr *MockStateFullStorageMockRecorder) ListTrashItems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashItems", reflect.TypeOf((*MockStateFullStorage)(nil).ListTrashItems), arg0)
+}
+
+// PruneSecretItemHistory mocks base method.
+func (m *MockStateFullStorage) PruneSecretItemHistory(arg0 context.Context, arg1 string, arg2 int, arg3 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneSecretItemHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneSecretItemHistory indicates an expected call of PruneSecretItemHistory.
+func (mr *MockStateFullStorageMockRecorder) PruneSecretItemHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneSecretItemHistory", reflect.TypeOf((*MockStateFullStorage)(nil).PruneSecretItemHistory), arg0, arg1, arg2, arg3)
+}
+
 // Registrate mocks base method.
-func (m *MockStateFullStorage) Registrate(arg0 context.Context, arg1 *domain.FullRegistrationData) error {
+func (m *MockStateFullStorage) Registrate(arg0 context.Context, arg1 *domain.FullRegistrationData) (domain.UserID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Registrate", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(domain.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Registrate indicates an expected call of Registrate.
@@ -195,32 +467,122 @@ func (mr *MockStateFullStorageMockRecorder) Registrate(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Registrate", reflect.TypeOf((*MockStateFullStorage)(nil).Registrate), arg0, arg1)
 }
 
-// UpdateBankCard mocks base method.
-func (m *MockStateFullStorage) UpdateBankCard(arg0 context.Context, arg1 *domain.EncryptedBankCard) error {
+// RestoreSecretItem mocks base method.
+func (m *MockStateFullStorage) RestoreSecretItem(arg0 context.Context, arg1 string) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSecretItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSecretItem indicates an expected call of RestoreSecretItem.
+func (mr *MockStateFullStorageMockRecorder) RestoreSecretItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecretItem", reflect.TypeOf((*MockStateFullStorage)(nil).RestoreSecretItem), arg0, arg1)
+}
+
+// RestoreSecretItemRevision mocks base method.
+func (m *MockStateFullStorage) RestoreSecretItemRevision(arg0 context.Context, arg1 string, arg2, arg3 int64) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSecretItemRevision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSecretItemRevision indicates an expected call of RestoreSecretItemRevision.
+func (mr *MockStateFullStorageMockRecorder) RestoreSecretItemRevision(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecretItemRevision", reflect.TypeOf((*MockStateFullStorage)(nil).RestoreSecretItemRevision), arg0, arg1, arg2, arg3)
+}
+
+// RevokeToken mocks base method.
+func (m *MockStateFullStorage) RevokeToken(arg0 context.Context, arg1 string, arg2 time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBankCard", arg0, arg1)
+	ret := m.ctrl.Call(m, "RevokeToken", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateBankCard indicates an expected call of UpdateBankCard.
-func (mr *MockStateFullStorageMockRecorder) UpdateBankCard(arg0, arg1 interface{}) *gomock.Call {
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockStateFullStorageMockRecorder) RevokeToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockStateFullStorage)(nil).RevokeToken), arg0, arg1, arg2)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockStateFullStorage) RotateRefreshToken(arg0 context.Context, arg1, arg2 string, arg3 time.Time) (*domain.RefreshTokenData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.RefreshTokenData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockStateFullStorageMockRecorder) RotateRefreshToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockStateFullStorage)(nil).RotateRefreshToken), arg0, arg1, arg2, arg3)
+}
+
+// TouchSession mocks base method.
+func (m *MockStateFullStorage) TouchSession(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockStateFullStorageMockRecorder) TouchSession(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBankCard", reflect.TypeOf((*MockStateFullStorage)(nil).UpdateBankCard), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockStateFullStorage)(nil).TouchSession), arg0, arg1)
 }
 
-// UpdateUserPasswordData mocks base method.
-func (m *MockStateFullStorage) UpdateUserPasswordData(arg0 context.Context, arg1 *domain.EncryptedUserPasswordData) error {
+// UpdatePasswordHash mocks base method.
+func (m *MockStateFullStorage) UpdatePasswordHash(arg0 context.Context, arg1 domain.UserID, arg2 *domain.HashData) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserPasswordData", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdatePasswordHash", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateUserPasswordData indicates an expected call of UpdateUserPasswordData.
-func (mr *MockStateFullStorageMockRecorder) UpdateUserPasswordData(arg0, arg1 interface{}) *gomock.Call {
+// UpdatePasswordHash indicates an expected call of UpdatePasswordHash.
+func (mr *MockStateFullStorageMockRecorder) UpdatePasswordHash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordHash", reflect.TypeOf((*MockStateFullStorage)(nil).UpdatePasswordHash), arg0, arg1, arg2)
+}
+
+// UpdateSecretItem mocks base method.
+func (m *MockStateFullStorage) UpdateSecretItem(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecretItem", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecretItem indicates an expected call of UpdateSecretItem.
+func (mr *MockStateFullStorageMockRecorder) UpdateSecretItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecretItem", reflect.TypeOf((*MockStateFullStorage)(nil).UpdateSecretItem), arg0, arg1)
+}
+
+// UpdateSecureNote mocks base method.
+func (m *MockStateFullStorage) UpdateSecureNote(arg0 context.Context, arg1 *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecureNote", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SecretItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecureNote indicates an expected call of UpdateSecureNote.
+func (mr *MockStateFullStorageMockRecorder) UpdateSecureNote(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordData", reflect.TypeOf((*MockStateFullStorage)(nil).UpdateUserPasswordData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecureNote", reflect.TypeOf((*MockStateFullStorage)(nil).UpdateSecureNote), arg0, arg1)
 }
 
 // MockTemporaryStorage is a mock of TemporaryStorage interface.
@@ -286,6 +648,21 @@ func (mr *MockTemporaryStorageMockRecorder) DeleteAndCreate(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAndCreate", reflect.TypeOf((*MockTemporaryStorage)(nil).DeleteAndCreate), arg0, arg1, arg2, arg3)
 }
 
+// IncAttempts mocks base method.
+func (m *MockTemporaryStorage) IncAttempts(arg0 context.Context, arg1 string, arg2 time.Duration) (*domain.AuthAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncAttempts", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.AuthAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncAttempts indicates an expected call of IncAttempts.
+func (mr *MockTemporaryStorageMockRecorder) IncAttempts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncAttempts", reflect.TypeOf((*MockTemporaryStorage)(nil).IncAttempts), arg0, arg1, arg2)
+}
+
 // Load mocks base method.
 func (m *MockTemporaryStorage) Load(arg0 context.Context, arg1 domain.SessionID) (interface{}, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +693,33 @@ func (mr *MockTemporaryStorageMockRecorder) LoadAndDelete(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadAndDelete", reflect.TypeOf((*MockTemporaryStorage)(nil).LoadAndDelete), arg0, arg1)
 }
 
+// LoadAttempts mocks base method.
+func (m *MockTemporaryStorage) LoadAttempts(arg0 context.Context, arg1 string) (*domain.AuthAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadAttempts", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AuthAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadAttempts indicates an expected call of LoadAttempts.
+func (mr *MockTemporaryStorageMockRecorder) LoadAttempts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadAttempts", reflect.TypeOf((*MockTemporaryStorage)(nil).LoadAttempts), arg0, arg1)
+}
+
+// ResetAttempts mocks base method.
+func (m *MockTemporaryStorage) ResetAttempts(arg0 context.Context, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetAttempts", arg0, arg1)
+}
+
+// ResetAttempts indicates an expected call of ResetAttempts.
+func (mr *MockTemporaryStorageMockRecorder) ResetAttempts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetAttempts", reflect.TypeOf((*MockTemporaryStorage)(nil).ResetAttempts), arg0, arg1)
+}
+
 // MockEMailSender is a mock of EMailSender interface.
 type MockEMailSender struct {
 	ctrl     *gomock.Controller
@@ -392,18 +796,18 @@ func (mr *MockRegistrationHelperMockRecorder) CheckEMailData(arg0 interface{}) *
 }
 
 // CreateJWTToken mocks base method.
-func (m *MockRegistrationHelper) CreateJWTToken(arg0 domain.UserID) (domain.JWTToken, error) {
+func (m *MockRegistrationHelper) CreateJWTToken(arg0 domain.UserID, arg1 string) (domain.JWTToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateJWTToken", arg0)
+	ret := m.ctrl.Call(m, "CreateJWTToken", arg0, arg1)
 	ret0, _ := ret[0].(domain.JWTToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateJWTToken indicates an expected call of CreateJWTToken.
-func (mr *MockRegistrationHelperMockRecorder) CreateJWTToken(arg0 interface{}) *gomock.Call {
+func (mr *MockRegistrationHelperMockRecorder) CreateJWTToken(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJWTToken", reflect.TypeOf((*MockRegistrationHelper)(nil).CreateJWTToken), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJWTToken", reflect.TypeOf((*MockRegistrationHelper)(nil).CreateJWTToken), arg0, arg1)
 }
 
 // DecryptOTPKey mocks base method.
@@ -467,6 +871,35 @@ func (mr *MockRegistrationHelperMockRecorder) HashPassword(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPassword", reflect.TypeOf((*MockRegistrationHelper)(nil).HashPassword), arg0)
 }
 
+// HashRefreshToken mocks base method.
+func (m *MockRegistrationHelper) HashRefreshToken(arg0 domain.RefreshToken) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashRefreshToken", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashRefreshToken indicates an expected call of HashRefreshToken.
+func (mr *MockRegistrationHelperMockRecorder) HashRefreshToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashRefreshToken", reflect.TypeOf((*MockRegistrationHelper)(nil).HashRefreshToken), arg0)
+}
+
+// NewRefreshToken mocks base method.
+func (m *MockRegistrationHelper) NewRefreshToken() (domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRefreshToken")
+	ret0, _ := ret[0].(domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewRefreshToken indicates an expected call of NewRefreshToken.
+func (mr *MockRegistrationHelperMockRecorder) NewRefreshToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRefreshToken", reflect.TypeOf((*MockRegistrationHelper)(nil).NewRefreshToken))
+}
+
 // NewSessionID mocks base method.
 func (m *MockRegistrationHelper) NewSessionID() domain.SessionID {
 	m.ctrl.T.Helper()
@@ -496,6 +929,20 @@ func (mr *MockRegistrationHelperMockRecorder) ParseJWTToken(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseJWTToken", reflect.TypeOf((*MockRegistrationHelper)(nil).ParseJWTToken), arg0)
 }
 
+// PasswordNeedsRehash mocks base method.
+func (m *MockRegistrationHelper) PasswordNeedsRehash(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordNeedsRehash", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// PasswordNeedsRehash indicates an expected call of PasswordNeedsRehash.
+func (mr *MockRegistrationHelperMockRecorder) PasswordNeedsRehash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordNeedsRehash", reflect.TypeOf((*MockRegistrationHelper)(nil).PasswordNeedsRehash), arg0)
+}
+
 // ValidateAccountPass mocks base method.
 func (m *MockRegistrationHelper) ValidateAccountPass(arg0, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -511,19 +958,20 @@ func (mr *MockRegistrationHelperMockRecorder) ValidateAccountPass(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAccountPass", reflect.TypeOf((*MockRegistrationHelper)(nil).ValidateAccountPass), arg0, arg1, arg2)
 }
 
-// ValidateOTPCode mocks base method.
-func (m *MockRegistrationHelper) ValidateOTPCode(arg0, arg1 string) (bool, error) {
+// ValidateOTPCodeStep mocks base method.
+func (m *MockRegistrationHelper) ValidateOTPCodeStep(arg0, arg1 string) (int64, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateOTPCode", arg0, arg1)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ValidateOTPCodeStep", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// ValidateOTPCode indicates an expected call of ValidateOTPCode.
-func (mr *MockRegistrationHelperMockRecorder) ValidateOTPCode(arg0, arg1 interface{}) *gomock.Call {
+// ValidateOTPCodeStep indicates an expected call of ValidateOTPCodeStep.
+func (mr *MockRegistrationHelperMockRecorder) ValidateOTPCodeStep(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateOTPCode", reflect.TypeOf((*MockRegistrationHelper)(nil).ValidateOTPCode), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateOTPCodeStep", reflect.TypeOf((*MockRegistrationHelper)(nil).ValidateOTPCodeStep), arg0, arg1)
 }
 
 // MockFileStorage is a mock of FileStorage interface.
@@ -579,18 +1027,33 @@ func (mr *MockFileStorageMockRecorder) CreateStreamFileWriter(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreamFileWriter", reflect.TypeOf((*MockFileStorage)(nil).CreateStreamFileWriter), arg0, arg1)
 }
 
-// DeleteFileInfo mocks base method.
-func (m *MockFileStorage) DeleteFileInfo(arg0 context.Context, arg1, arg2 string) error {
+// DeleteExpiredTrash mocks base method.
+func (m *MockFileStorage) DeleteExpiredTrash(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredTrash", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredTrash indicates an expected call of DeleteExpiredTrash.
+func (mr *MockFileStorageMockRecorder) DeleteExpiredTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredTrash", reflect.TypeOf((*MockFileStorage)(nil).DeleteExpiredTrash), arg0, arg1)
+}
+
+// DeleteFromTrash mocks base method.
+func (m *MockFileStorage) DeleteFromTrash(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFileInfo", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "DeleteFromTrash", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteFileInfo indicates an expected call of DeleteFileInfo.
-func (mr *MockFileStorageMockRecorder) DeleteFileInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+// DeleteFromTrash indicates an expected call of DeleteFromTrash.
+func (mr *MockFileStorageMockRecorder) DeleteFromTrash(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileInfo", reflect.TypeOf((*MockFileStorage)(nil).DeleteFileInfo), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromTrash", reflect.TypeOf((*MockFileStorage)(nil).DeleteFromTrash), arg0, arg1, arg2)
 }
 
 // GetFileInfoList mocks base method.
@@ -607,3 +1070,95 @@ func (mr *MockFileStorageMockRecorder) GetFileInfoList(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInfoList", reflect.TypeOf((*MockFileStorage)(nil).GetFileInfoList), arg0, arg1)
 }
+
+// ListTrash mocks base method.
+func (m *MockFileStorage) ListTrash(arg0 context.Context, arg1 string) ([]domain.TrashEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0, arg1)
+	ret0, _ := ret[0].([]domain.TrashEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockFileStorageMockRecorder) ListTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockFileStorage)(nil).ListTrash), arg0, arg1)
+}
+
+// MoveToTrash mocks base method.
+func (m *MockFileStorage) MoveToTrash(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToTrash", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToTrash indicates an expected call of MoveToTrash.
+func (mr *MockFileStorageMockRecorder) MoveToTrash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToTrash", reflect.TypeOf((*MockFileStorage)(nil).MoveToTrash), arg0, arg1, arg2)
+}
+
+// RestoreFromTrash mocks base method.
+func (m *MockFileStorage) RestoreFromTrash(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFromTrash", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFromTrash indicates an expected call of RestoreFromTrash.
+func (mr *MockFileStorageMockRecorder) RestoreFromTrash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFromTrash", reflect.TypeOf((*MockFileStorage)(nil).RestoreFromTrash), arg0, arg1, arg2)
+}
+
+// MockChangeNotifier is a mock of ChangeNotifier interface.
+type MockChangeNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeNotifierMockRecorder
+}
+
+// MockChangeNotifierMockRecorder is the mock recorder for MockChangeNotifier.
+type MockChangeNotifierMockRecorder struct {
+	mock *MockChangeNotifier
+}
+
+// NewMockChangeNotifier creates a new mock instance.
+func NewMockChangeNotifier(ctrl *gomock.Controller) *MockChangeNotifier {
+	mock := &MockChangeNotifier{ctrl: ctrl}
+	mock.recorder = &MockChangeNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeNotifier) EXPECT() *MockChangeNotifierMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockChangeNotifier) Publish(arg0 domain.UserID, arg1 *domain.ItemChangeEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", arg0, arg1)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockChangeNotifierMockRecorder) Publish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockChangeNotifier)(nil).Publish), arg0, arg1)
+}
+
+// Subscribe mocks base method.
+func (m *MockChangeNotifier) Subscribe(arg0 context.Context, arg1 domain.UserID) <-chan domain.ItemChangeEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
+	ret0, _ := ret[0].(<-chan domain.ItemChangeEvent)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockChangeNotifierMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockChangeNotifier)(nil).Subscribe), arg0, arg1)
+}
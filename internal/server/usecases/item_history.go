@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// ListRevisions returns the previous revisions of the item, the last one first; revisions older than
+// the configured history age are skipped even if they are not pruned yet.
+func (da *dataAccessor) ListRevisions(ctx context.Context, itemID string) ([]domain.SecretItemHistoryEntry, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	revisions, err := da.stflStorage.ListSecretItemRevisions(ctx, itemID)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	if before := da.historyBefore(); !before.IsZero() {
+		actual := revisions[:0]
+		for _, rev := range revisions {
+			if !rev.ReplacedAt.Before(before) {
+				actual = append(actual, rev)
+			}
+		}
+		revisions = actual
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return revisions, nil
+}
+
+// GetRevision returns the encrypted content of the previous revision of the item.
+func (da *dataAccessor) GetRevision(ctx context.Context, itemID string, revision int64) (*domain.EncryptedSecretItem, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	item, err := da.stflStorage.GetSecretItemRevision(ctx, itemID, revision)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return item, nil
+}
+
+// RestoreRevision replaces the item of the expected revision with the content of the previous revision;
+// domain.ErrDataConflict is returned if the item was changed after the expected revision.
+func (da *dataAccessor) RestoreRevision(ctx context.Context, itemID string, revision int64, expected int64) (*domain.SecretItemRevision, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	rev, err := da.stflStorage.RestoreSecretItemRevision(ctx, itemID, revision, expected)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+	da.publish(ctx, domain.ItemUpdated, rev)
+	da.pruneHistory(ctx, rev.ID)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return rev, nil
+}
+
+// pruneHistory removes revisions of the item over the configured limits; the error is only logged -
+// the extra revisions are removed on the next update.
+func (da *dataAccessor) pruneHistory(ctx context.Context, itemID string) {
+	if da.conf == nil || (da.conf.HistoryMaxCount <= 0 && da.conf.HistoryMaxAge <= 0) {
+		return
+	}
+
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	if _, err := da.stflStorage.PruneSecretItemHistory(ctx, itemID, max(da.conf.HistoryMaxCount, 0), da.historyBefore()); err != nil {
+		log.Infow(action, "err", fmt.Sprintf("%s err %s", action, err.Error()))
+	}
+}
+
+// historyBefore returns the time revisions replaced before are expired; zero time if the age is not limited.
+func (da *dataAccessor) historyBefore() time.Time {
+	if da.conf == nil || da.conf.HistoryMaxAge <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-da.conf.HistoryMaxAge)
+}
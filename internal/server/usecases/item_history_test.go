@@ -0,0 +1,142 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/usecases"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataAccessor_ListRevisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	revisions := []domain.SecretItemHistoryEntry{
+		{ItemID: "id1", Revision: 3, UpdatedAt: now.Add(-2 * time.Hour), ReplacedAt: now.Add(-time.Hour)},
+		{ItemID: "id1", Revision: 2, UpdatedAt: now.Add(-72 * time.Hour), ReplacedAt: now.Add(-2 * time.Hour)},
+		{ItemID: "id1", Revision: 1, UpdatedAt: now.Add(-96 * time.Hour), ReplacedAt: now.Add(-72 * time.Hour)},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().ListSecretItemRevisions(gomock.Any(), "id1").Times(1).Return(revisions, nil)
+
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+		res, err := da.ListRevisions(context.Background(), "id1")
+		require.NoError(t, err)
+		require.Equal(t, 3, len(res))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().ListSecretItemRevisions(gomock.Any(), "id1").Times(1).
+			Return(append([]domain.SecretItemHistoryEntry{}, revisions...), nil)
+
+		da := usecases.NewDataAccessor(&config.ServerConf{HistoryMaxAge: 24 * time.Hour}).StateFullStorage(mockStorage)
+		res, err := da.ListRevisions(context.Background(), "id1")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(res))
+		require.Equal(t, int64(3), res[0].Revision)
+		require.Equal(t, int64(2), res[1].Revision)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		testErr := errors.New("testErr")
+		mockStorage.EXPECT().ListSecretItemRevisions(gomock.Any(), "id1").Times(1).Return(nil, testErr)
+
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+		_, err := da.ListRevisions(context.Background(), "id1")
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestDataAccessor_GetRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		item := &domain.EncryptedSecretItem{ID: "id1", Content: "Content1", Revision: 2}
+		mockStorage.EXPECT().GetSecretItemRevision(gomock.Any(), "id1", int64(2)).Times(1).Return(item, nil)
+
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+		res, err := da.GetRevision(context.Background(), "id1", 2)
+		require.NoError(t, err)
+		require.Equal(t, item, res)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().GetSecretItemRevision(gomock.Any(), "id1", int64(2)).Times(1).Return(nil, domain.ErrDataNotExists)
+
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+		_, err := da.GetRevision(context.Background(), "id1", 2)
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+}
+
+func TestDataAccessor_RestoreRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rev := &domain.SecretItemRevision{ID: "id1", Type: domain.SecretItemBankCard, Revision: 5, ChangeSeq: 7}
+
+	t.Run("ok", func(t *testing.T) {
+		userID := domain.UserID(1)
+		ctx := domain.EnrichWithUserID(context.Background(), userID)
+
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().RestoreSecretItemRevision(gomock.Any(), "id1", int64(2), int64(4)).Times(1).Return(rev, nil)
+		mockStorage.EXPECT().PruneSecretItemHistory(gomock.Any(), "id1", 10, gomock.Any()).Times(1).
+			DoAndReturn(func(_ context.Context, _ string, _ int, before time.Time) (int64, error) {
+				require.WithinDuration(t, time.Now().Add(-time.Hour), before, time.Minute)
+				return 0, errors.New("pruneErr")
+			})
+
+		mockNotifier := NewMockChangeNotifier(ctrl)
+		mockNotifier.EXPECT().Publish(userID, &domain.ItemChangeEvent{
+			Seq:    7,
+			Kind:   domain.ItemUpdated,
+			ItemID: "id1",
+			Type:   domain.SecretItemBankCard,
+		}).Times(1)
+
+		conf := &config.ServerConf{HistoryMaxCount: 10, HistoryMaxAge: time.Hour}
+		da := usecases.NewDataAccessor(conf).StateFullStorage(mockStorage).ChangeNotifier(mockNotifier)
+		res, err := da.RestoreRevision(ctx, "id1", 2, 4)
+		require.NoError(t, err, "prune error is ignored")
+		require.Equal(t, rev, res)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().RestoreSecretItemRevision(gomock.Any(), "id1", int64(2), int64(3)).Times(1).Return(nil, domain.ErrDataConflict)
+
+		da := usecases.NewDataAccessor(&config.ServerConf{HistoryMaxCount: 10}).StateFullStorage(mockStorage)
+		_, err := da.RestoreRevision(context.Background(), "id1", 2, 3)
+		require.ErrorIs(t, err, domain.ErrDataConflict)
+	})
+}
+
+func TestDataAccessor_UpdateItemPrunesHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	item := &domain.EncryptedSecretItem{ID: "id1", Name: "Name1", Content: "Content1", Revision: 1}
+
+	mockStorage := NewMockStateFullStorage(ctrl)
+	mockStorage.EXPECT().UpdateSecretItem(gomock.Any(), item).Times(1).Return(&domain.SecretItemRevision{ID: "id1", Revision: 2}, nil)
+	mockStorage.EXPECT().PruneSecretItemHistory(gomock.Any(), "id1", 3, time.Time{}).Times(1).Return(int64(1), nil)
+
+	da := usecases.NewDataAccessor(&config.ServerConf{HistoryMaxCount: 3}).StateFullStorage(mockStorage)
+	_, err := da.UpdateItem(context.Background(), item)
+	require.NoError(t, err)
+}
@@ -157,7 +157,7 @@ func (reg *registrator) PassOTP(ctx context.Context, currentID domain.SessionID,
 		return "", err
 	}
 
-	ok, err = reg.regHelper.ValidateOTPCode(otpKey, otpPass)
+	step, ok, err := reg.regHelper.ValidateOTPCodeStep(otpKey, otpPass)
 	if err != nil {
 		err = fmt.Errorf("passOTP err - %w", err)
 		log.Infow(action, "err", err.Error())
@@ -176,6 +176,7 @@ func (reg *registrator) PassOTP(ctx context.Context, currentID domain.SessionID,
 		PasswordSalt:    regData.PasswordSalt,
 		EncryptedOTPKey: regData.EncryptedOTPKey,
 		State:           domain.RegistrationStateAuth,
+		OTPStep:         step,
 	}
 
 	newSessionID := reg.regHelper.NewSessionID()
@@ -227,12 +228,20 @@ func (reg *registrator) InitMasterKey(ctx context.Context, currentID domain.Sess
 		HelloEncrypted:     mKey.HelloEncrypted,
 	}
 
-	if err := reg.stflStorage.Registrate(ctx, fullData); err != nil {
+	userID, err := reg.stflStorage.Registrate(ctx, fullData)
+	if err != nil {
 		err = fmt.Errorf("initMasterKey err - %w", err)
 		log.Infow(action, "err", err.Error())
 		return err
 	}
 
+	// the code used for the registration can't be replayed on the first login
+	if _, err := reg.stflStorage.AcceptOTPStep(ctx, userID, regData.OTPStep); err != nil {
+		err = fmt.Errorf("initMasterKey acceptOTPStep err - %w", err)
+		log.Infow(action, "err", err.Error())
+		return err
+	}
+
 	log.Debugw(action, "msg", "initMasterKey success")
 
 	return nil
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"time"
+
 	"github.com/StasMerzlyakov/gophkeeper/internal/config"
 	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
 	"github.com/google/uuid"
@@ -13,18 +15,36 @@ func NewRegistrationHelper(conf *config.ServerConf, salfFn domain.SaltFn) *regHe
 	}
 }
 
+func (rg *regHelper) JWTKeys(keys domain.JWTKeyProvider) *regHelper {
+	rg.keys = keys
+	return rg
+}
+
 var _ RegistrationHelper = (*regHelper)(nil)
 
 type regHelper struct {
 	salfFn domain.SaltFn
 	conf   *config.ServerConf
+	keys   domain.JWTKeyProvider
 }
 
 func (rg *regHelper) CheckEMailData(data *domain.EMailData) (bool, error) {
 	return domain.CheckEMailData(data)
 }
 func (rg *regHelper) HashPassword(pass string) (*domain.HashData, error) {
-	return domain.HashPassword(pass, rg.salfFn)
+	params, err := domain.NewPassHashParams(domain.PassHashAlgorithm(rg.conf.PassHashAlg))
+	if err != nil {
+		return nil, err
+	}
+	return domain.HashPasswordWithParams(pass, params, rg.salfFn)
+}
+
+func (rg *regHelper) PasswordNeedsRehash(hash string) bool {
+	params, err := domain.NewPassHashParams(domain.PassHashAlgorithm(rg.conf.PassHashAlg))
+	if err != nil {
+		return false
+	}
+	return domain.PassHashNeedsRehash(hash, params)
 }
 
 func (rg *regHelper) GenerateQR(userEmail string) (string, []byte, error) {
@@ -35,8 +55,8 @@ func (rg *regHelper) EncryptOTPKey(plaintext string) (string, error) {
 	return domain.EncryptOTPKey(rg.conf.ServerSecret, plaintext)
 }
 
-func (rg *regHelper) ValidateAccountPass(pass string, hashB64 string, saltB64 string) (bool, error) {
-	return domain.ValidateAccountPass(pass, hashB64, saltB64)
+func (rg *regHelper) ValidateAccountPass(pass string, hash string, saltB64 string) (bool, error) {
+	return domain.ValidateAccountPass(pass, hash, saltB64)
 }
 
 func (rg *regHelper) DecryptOTPKey(ciphertext string) (string, error) {
@@ -47,14 +67,26 @@ func (rg *regHelper) NewSessionID() domain.SessionID {
 	return domain.SessionID(uuid.NewString())
 }
 
-func (rg *regHelper) ValidateOTPCode(keyURL string, passcode string) (bool, error) {
-	return domain.ValidateOTPCode(keyURL, passcode)
+func (rg *regHelper) ValidateOTPCodeStep(keyURL string, passcode string) (int64, bool, error) {
+	skew := domain.OTPSkew{
+		Past:   rg.conf.OTPSkewPast,
+		Future: rg.conf.OTPSkewFuture,
+	}
+	return domain.ValidateOTPCodeStep(keyURL, passcode, skew, time.Now())
 }
 
-func (rg *regHelper) CreateJWTToken(userID domain.UserID) (domain.JWTToken, error) {
-	return domain.CreateJWTToken([]byte(rg.conf.TokenSecret), rg.conf.TokenExp, userID)
+func (rg *regHelper) CreateJWTToken(userID domain.UserID, sessionID string) (domain.JWTToken, error) {
+	return domain.CreateJWTToken(rg.keys, rg.conf.TokenExp, userID, sessionID)
 }
 
 func (rg *regHelper) ParseJWTToken(jwtToken domain.JWTToken) (domain.UserID, error) {
-	return domain.ParseJWTToken([]byte(rg.conf.TokenSecret), jwtToken)
+	return domain.ParseJWTToken(rg.keys, jwtToken)
+}
+
+func (rg *regHelper) NewRefreshToken() (domain.RefreshToken, error) {
+	return domain.NewRefreshToken()
+}
+
+func (rg *regHelper) HashRefreshToken(token domain.RefreshToken) string {
+	return domain.HashRefreshToken(token)
 }
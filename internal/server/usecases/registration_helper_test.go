@@ -1,6 +1,7 @@
 package usecases_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -34,12 +35,21 @@ func TestRegistrationHelper(t *testing.T) {
 
 		require.NotNil(t, hash)
 
-		assert.Equal(t, "In+BZhwpWKZH/S1QtMWcAOONZcrO9jVDaMDoJqgOfWM=", hash.Hash)
-		assert.Equal(t, "AAECAwQFBgcICQoLDA0ODw==", hash.Salt)
+		assert.True(t, strings.HasPrefix(hash.Hash, "$argon2id$v=19$m=19456,t=2,p=1$AAECAwQFBgcICQoLDA0ODw$"))
+		assert.Empty(t, hash.Salt)
 
 		ok, err := helper.ValidateAccountPass(pass, hash.Hash, hash.Salt)
 		require.NoError(t, err)
 		assert.True(t, ok)
+		assert.False(t, helper.PasswordNeedsRehash(hash.Hash))
+	})
+
+	t.Run("legacy_hash", func(t *testing.T) {
+		legacyHash := "In+BZhwpWKZH/S1QtMWcAOONZcrO9jVDaMDoJqgOfWM="
+		ok, err := helper.ValidateAccountPass("12345678", legacyHash, "AAECAwQFBgcICQoLDA0ODw==")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, helper.PasswordNeedsRehash(legacyHash))
 	})
 
 	t.Run("check_email_data", func(t *testing.T) {
@@ -97,9 +107,53 @@ func TestRegistrationHelper(t *testing.T) {
 		passcode, err := totp.GenerateCodeCustom(key.Secret(), time.Now(), validOpts)
 		require.NoError(t, err)
 
-		ok, err := helper.ValidateOTPCode(keyURL, passcode)
+		_, ok, err := helper.ValidateOTPCodeStep(keyURL, passcode)
 		require.NoError(t, err)
 
 		assert.True(t, ok)
 	})
+
+	t.Run("validate_pass_skew", func(t *testing.T) {
+		skewHelper := usecases.NewRegistrationHelper(&config.ServerConf{
+			DomainName:   "issuer",
+			ServerSecret: "N1PCdw3M2B1TfJhoaY2mL736p2vCUc47",
+			OTPSkewPast:  1,
+		}, testOKSaltFn)
+
+		keyURL, _, err := skewHelper.GenerateQR("userEmail")
+		require.NoError(t, err)
+
+		key, err := otp.NewKeyFromURL(keyURL)
+		require.NoError(t, err)
+
+		validOpts := totp.ValidateOpts{
+			Period:    domain.TOTPPeriod,
+			Digits:    domain.TOTPDigits,
+			Algorithm: domain.TOTPAlgorithm,
+		}
+		prevTime := time.Now().Add(-domain.TOTPPeriod * time.Second)
+		passcode, err := totp.GenerateCodeCustom(key.Secret(), prevTime, validOpts)
+		require.NoError(t, err)
+
+		step, ok, err := skewHelper.ValidateOTPCodeStep(keyURL, passcode)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, prevTime.Unix()/domain.TOTPPeriod, step)
+	})
+
+	t.Run("jwt_token", func(t *testing.T) {
+		signingKey, err := domain.GenerateJWTSigningKey(domain.JWTKeyAlgorithmES256)
+		require.NoError(t, err)
+
+		jwtHelper := usecases.NewRegistrationHelper(&config.ServerConf{
+			TokenExp: time.Minute,
+		}, testOKSaltFn).JWTKeys(domain.NewJWTKeySet(signingKey))
+
+		jwtTok, err := jwtHelper.CreateJWTToken(1, "sessionID")
+		require.NoError(t, err)
+
+		userID, err := jwtHelper.ParseJWTToken(jwtTok)
+		require.NoError(t, err)
+		assert.Equal(t, domain.UserID(1), userID)
+	})
 }
@@ -529,7 +529,7 @@ func TestRegistrator_PassOTP(t *testing.T) {
 			decryptedOTPKey, nil,
 		)
 
-		mockHelper.EXPECT().ValidateOTPCode(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(true, nil)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(100), true, nil)
 
 		newSessionID := domain.SessionID("newSessionID")
 		mockHelper.EXPECT().NewSessionID().Return(newSessionID)
@@ -549,6 +549,7 @@ func TestRegistrator_PassOTP(t *testing.T) {
 				assert.Equal(t, data.PasswordSalt, regData.PasswordSalt)
 				assert.Equal(t, data.EncryptedOTPKey, regData.EncryptedOTPKey)
 				assert.Equal(t, domain.RegistrationStateAuth, regData.State)
+				assert.Equal(t, int64(100), regData.OTPStep)
 				return nil
 			})
 
@@ -692,7 +693,7 @@ func TestRegistrator_PassOTP(t *testing.T) {
 		)
 
 		testErr := errors.New("test_err")
-		mockHelper.EXPECT().ValidateOTPCode(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(false, testErr)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(0), false, testErr)
 
 		registrator := usecases.NewRegistrator(conf).
 			RegistrationHelper(mockHelper).
@@ -731,7 +732,7 @@ func TestRegistrator_PassOTP(t *testing.T) {
 			decryptedOTPKey, nil,
 		)
 
-		mockHelper.EXPECT().ValidateOTPCode(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(false, nil)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(100), false, nil)
 
 		registrator := usecases.NewRegistrator(conf).
 			RegistrationHelper(mockHelper).
@@ -770,7 +771,7 @@ func TestRegistrator_PassOTP(t *testing.T) {
 			decryptedOTPKey, nil,
 		)
 
-		mockHelper.EXPECT().ValidateOTPCode(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(true, nil)
+		mockHelper.EXPECT().ValidateOTPCodeStep(gomock.Eq(decryptedOTPKey), gomock.Eq(otpPass)).Times(1).Return(int64(100), true, nil)
 
 		newSessionID := domain.SessionID("newSessionID")
 		mockHelper.EXPECT().NewSessionID().Return(newSessionID)
@@ -813,6 +814,7 @@ func TestRegistration_InitMasterKey(t *testing.T) {
 			EMail:           "Email",
 			PasswordHash:    "Hash",
 			PasswordSalt:    "Salt",
+			OTPStep:         100,
 		}
 
 		mockTempStorage := NewMockTemporaryStorage(ctrl)
@@ -825,16 +827,19 @@ func TestRegistration_InitMasterKey(t *testing.T) {
 		mockStflStorage := NewMockStateFullStorage(ctrl)
 
 		mockStflStorage.EXPECT().Registrate(gomock.Any(), gomock.Any()).Times(1).
-			DoAndReturn(func(ctx context.Context, fullData *domain.FullRegistrationData) error {
+			DoAndReturn(func(ctx context.Context, fullData *domain.FullRegistrationData) (domain.UserID, error) {
 				assert.Equal(t, data.EncryptedOTPKey, fullData.EncryptedOTPKey)
 				assert.Equal(t, data.EMail, fullData.EMail)
 				assert.Equal(t, data.PasswordHash, fullData.PasswordHash)
 				assert.Equal(t, data.PasswordSalt, fullData.PasswordSalt)
 				assert.Equal(t, keyData.MasterPasswordHint, fullData.MasterPasswordHint)
 				assert.Equal(t, keyData.HelloEncrypted, fullData.HelloEncrypted)
-				return nil
+				return domain.UserID(1), nil
 			})
 
+		// the registration code can't be replayed on the first login
+		mockStflStorage.EXPECT().AcceptOTPStep(gomock.Any(), domain.UserID(1), int64(100)).Times(1).Return(true, nil)
+
 		registrator := usecases.NewRegistrator(nil).
 			StateFullStorage(mockStflStorage).
 			TemporaryStorage(mockTempStorage)
@@ -963,8 +968,8 @@ func TestRegistration_InitMasterKey(t *testing.T) {
 		mockStflStorage := NewMockStateFullStorage(ctrl)
 		testErr := errors.New("testErr")
 		mockStflStorage.EXPECT().Registrate(gomock.Any(), gomock.Any()).Times(1).
-			DoAndReturn(func(ctx context.Context, fullData *domain.FullRegistrationData) error {
-				return testErr
+			DoAndReturn(func(ctx context.Context, fullData *domain.FullRegistrationData) (domain.UserID, error) {
+				return 0, testErr
 			})
 
 		mockHelper := NewMockRegistrationHelper(ctrl)
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+// Secure notes are stored apart from the other items; a note is passed as the item of type secure_note
+// with the encrypted title as the name.
+
+func (da *dataAccessor) ListSecureNotes(ctx context.Context) ([]domain.EncryptedSecretItem, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	notes, err := da.stflStorage.ListSecureNotes(ctx)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return notes, nil
+}
+
+func (da *dataAccessor) GetSecureNote(ctx context.Context, noteID string) (*domain.EncryptedSecretItem, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	note, err := da.stflStorage.GetSecureNote(ctx, noteID)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return note, nil
+}
+
+// CreateSecureNote stores the note and returns id generated by the server and the initial revision.
+func (da *dataAccessor) CreateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	rev, err := da.stflStorage.CreateSecureNote(ctx, note)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+	da.publish(ctx, domain.ItemCreated, rev)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return rev, nil
+}
+
+// UpdateSecureNote updates the note of the expected revision note.Revision;
+// domain.ErrDataConflict is returned if the note was changed after the revision.
+func (da *dataAccessor) UpdateSecureNote(ctx context.Context, note *domain.EncryptedSecretItem) (*domain.SecretItemRevision, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	rev, err := da.stflStorage.UpdateSecureNote(ctx, note)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+	da.publish(ctx, domain.ItemUpdated, rev)
+	da.pruneHistory(ctx, rev.ID)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return rev, nil
+}
+
+// DeleteSecureNote moves the note of the expected revision to the trash;
+// domain.ErrDataConflict is returned if the note was changed.
+func (da *dataAccessor) DeleteSecureNote(ctx context.Context, noteID string, revision int64) error {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	rev, err := da.stflStorage.DeleteSecureNote(ctx, noteID, revision)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return err
+	}
+	da.publish(ctx, domain.ItemDeleted, rev)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return nil
+}
@@ -0,0 +1,107 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/usecases"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataAccessor_SecureNote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	note := &domain.EncryptedSecretItem{
+		ID:        "id1",
+		Type:      domain.SecretItemSecureNote,
+		Name:      "Title1",
+		Content:   "Content1",
+		LookupKey: "Key1",
+		Revision:  1,
+	}
+
+	ctx := domain.EnrichWithUserID(context.Background(), domain.UserID(1))
+
+	t.Run("list", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().ListSecureNotes(gomock.Any()).Times(1).Return([]domain.EncryptedSecretItem{*note}, nil)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+
+		notes, err := da.ListSecureNotes(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []domain.EncryptedSecretItem{*note}, notes)
+	})
+
+	t.Run("get_err", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().GetSecureNote(gomock.Any(), "id1").Times(1).Return(nil, domain.ErrDataNotExists)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+
+		_, err := da.GetSecureNote(ctx, "id1")
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().CreateSecureNote(gomock.Any(), note).Times(1).Return(&domain.SecretItemRevision{
+			ID:        "id1",
+			Type:      domain.SecretItemSecureNote,
+			Revision:  1,
+			ChangeSeq: 2,
+		}, nil)
+
+		mockNotifier := NewMockChangeNotifier(ctrl)
+		mockNotifier.EXPECT().Publish(domain.UserID(1), &domain.ItemChangeEvent{
+			Seq:    2,
+			Kind:   domain.ItemCreated,
+			ItemID: "id1",
+			Type:   domain.SecretItemSecureNote,
+		}).Times(1)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage).ChangeNotifier(mockNotifier)
+
+		rev, err := da.CreateSecureNote(ctx, note)
+		require.NoError(t, err)
+		require.Equal(t, "id1", rev.ID)
+	})
+
+	t.Run("create_by_item", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+
+		_, err := da.CreateItem(ctx, note)
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("update_conflict", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().UpdateSecureNote(gomock.Any(), note).Times(1).Return(nil, domain.ErrDataConflict)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage)
+
+		_, err := da.UpdateSecureNote(ctx, note)
+		require.ErrorIs(t, err, domain.ErrDataConflict)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().DeleteSecureNote(gomock.Any(), "id1", int64(1)).Times(1).Return(&domain.SecretItemRevision{
+			ID:        "id1",
+			Type:      domain.SecretItemSecureNote,
+			Revision:  2,
+			ChangeSeq: 3,
+		}, nil)
+
+		mockNotifier := NewMockChangeNotifier(ctrl)
+		mockNotifier.EXPECT().Publish(domain.UserID(1), &domain.ItemChangeEvent{
+			Seq:    3,
+			Kind:   domain.ItemDeleted,
+			ItemID: "id1",
+			Type:   domain.SecretItemSecureNote,
+		}).Times(1)
+		da := usecases.NewDataAccessor(nil).StateFullStorage(mockStorage).ChangeNotifier(mockNotifier)
+
+		require.NoError(t, da.DeleteSecureNote(ctx, "id1", 1))
+	})
+}
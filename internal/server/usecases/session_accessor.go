@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+func NewSessionAccessor(conf *config.ServerConf) *sessionAccessor {
+	return &sessionAccessor{
+		conf: conf,
+	}
+}
+
+func (sAcc *sessionAccessor) StateFullStorage(stflStorage StateFullStorage) *sessionAccessor {
+	sAcc.stflStorage = stflStorage
+	return sAcc
+}
+
+type sessionAccessor struct {
+	conf        *config.ServerConf
+	stflStorage StateFullStorage
+}
+
+// GetSessionList returns active user sessions; the session of the request is marked as current.
+func (sa *sessionAccessor) GetSessionList(ctx context.Context) ([]domain.SessionInfo, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	sessions, err := sa.stflStorage.GetSessionList(ctx)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	if claims, err := domain.GetClaims(ctx); err == nil {
+		for i := range sessions {
+			sessions[i].Current = sessions[i].SessionID == claims.SessionID
+		}
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return sessions, nil
+}
+
+// TerminateSession removes user session; jwt tokens of the session are rejected by interceptors after that.
+func (sa *sessionAccessor) TerminateSession(ctx context.Context, sessionID string) error {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	if err := sa.stflStorage.DeleteSession(ctx, sessionID); err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return err
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return nil
+}
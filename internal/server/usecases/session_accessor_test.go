@@ -0,0 +1,76 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/usecases"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionAccessor_GetSessionList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		list := []domain.SessionInfo{
+			{
+				SessionID:  "sessionID1",
+				DeviceName: "laptop",
+			},
+			{
+				SessionID:  "sessionID2",
+				DeviceName: "desktop",
+			},
+		}
+		mockStorage.EXPECT().GetSessionList(gomock.Any()).Times(1).Return(list, nil)
+
+		sa := usecases.NewSessionAccessor(nil).StateFullStorage(mockStorage)
+		ctx := domain.EnrichWithClaims(context.Background(), &domain.Claims{SessionID: "sessionID2"})
+
+		res, err := sa.GetSessionList(ctx)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.False(t, res[0].Current)
+		assert.True(t, res[1].Current)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+
+		testErr := errors.New("testErr")
+		mockStorage.EXPECT().GetSessionList(gomock.Any()).Times(1).Return(nil, testErr)
+
+		sa := usecases.NewSessionAccessor(nil).StateFullStorage(mockStorage)
+		_, err := sa.GetSessionList(context.Background())
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestSessionAccessor_TerminateSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("ok", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().DeleteSession(gomock.Any(), gomock.Eq("sessionID")).Times(1).Return(nil)
+
+		sa := usecases.NewSessionAccessor(nil).StateFullStorage(mockStorage)
+		err := sa.TerminateSession(context.Background(), "sessionID")
+		require.NoError(t, err)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().DeleteSession(gomock.Any(), gomock.Eq("sessionID")).Times(1).Return(domain.ErrClientDataIncorrect)
+
+		sa := usecases.NewSessionAccessor(nil).StateFullStorage(mockStorage)
+		err := sa.TerminateSession(context.Background(), "sessionID")
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+}
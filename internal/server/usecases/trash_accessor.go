@@ -0,0 +1,206 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+)
+
+func NewTrashAccessor(conf *config.ServerConf) *trashAccessor {
+	return &trashAccessor{
+		conf: conf,
+	}
+}
+
+func (tAcc *trashAccessor) StateFullStorage(stflStorage StateFullStorage) *trashAccessor {
+	tAcc.stflStorage = stflStorage
+	return tAcc
+}
+
+func (tAcc *trashAccessor) FileStorage(fileStorage FileStorage) *trashAccessor {
+	tAcc.fileStorage = fileStorage
+	return tAcc
+}
+
+func (tAcc *trashAccessor) ChangeNotifier(notifier ChangeNotifier) *trashAccessor {
+	tAcc.notifier = notifier
+	return tAcc
+}
+
+type trashAccessor struct {
+	conf        *config.ServerConf
+	stflStorage StateFullStorage
+	fileStorage FileStorage
+	notifier    ChangeNotifier
+}
+
+// ListTrash returns items and files of the user moved to the trash, the last deleted first.
+func (ta *trashAccessor) ListTrash(ctx context.Context) ([]domain.TrashEntry, error) {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	items, err := ta.stflStorage.ListTrashItems(ctx)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	bucket, err := ta.stflStorage.GetUserFilesBucket(ctx)
+	if err != nil {
+		err := fmt.Errorf("%s err %w", action, err)
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	files, err := ta.fileStorage.ListTrash(ctx, bucket)
+	if err != nil {
+		err := fmt.Errorf("%s err %w - %s", action, domain.ErrServerInternal, err.Error())
+		log.Infow(action, "err", err.Error())
+		return nil, err
+	}
+
+	entries := append(items, files...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].DeletedAt.After(entries[j].DeletedAt)
+	})
+	for i := range entries {
+		entries[i].ExpiresAt = entries[i].DeletedAt.Add(ta.conf.TrashRetention)
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return entries, nil
+}
+
+// Restore moves the item or the file back from the trash; domain.ErrClientDataIncorrect is returned
+// if the item with the same key or the file with the same name was created after the deletion.
+func (ta *trashAccessor) Restore(ctx context.Context, itemType domain.SecretItemType, id string) error {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	if itemType == domain.TrashFile {
+		bucket, err := ta.stflStorage.GetUserFilesBucket(ctx)
+		if err != nil {
+			err := fmt.Errorf("%s err %w", action, err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+
+		if err := ta.fileStorage.RestoreFromTrash(ctx, bucket, id); err != nil {
+			err := fmt.Errorf("%s err %w", action, err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+	} else {
+		rev, err := ta.stflStorage.RestoreSecretItem(ctx, id)
+		if err != nil {
+			err := fmt.Errorf("%s err %w", action, err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+		ta.publish(ctx, rev)
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return nil
+}
+
+// PurgeTrash deletes the item or the file from the trash permanently; the whole trash is cleared for the empty id.
+func (ta *trashAccessor) PurgeTrash(ctx context.Context, itemType domain.SecretItemType, id string) error {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s start", action))
+	if id == "" || itemType != domain.TrashFile {
+		if _, err := ta.stflStorage.DeleteTrashItems(ctx, id); err != nil {
+			err := fmt.Errorf("%s err %w", action, err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+	}
+
+	if id == "" || itemType == domain.TrashFile {
+		bucket, err := ta.stflStorage.GetUserFilesBucket(ctx)
+		if err != nil {
+			err := fmt.Errorf("%s err %w", action, err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+
+		if err := ta.fileStorage.DeleteFromTrash(ctx, bucket, id); err != nil {
+			err := fmt.Errorf("%s err %w", action, err)
+			log.Infow(action, "err", err.Error())
+			return err
+		}
+	}
+
+	log.Debugw(action, "msg", fmt.Sprintf("%s success", action))
+	return nil
+}
+
+// PurgeExpired deletes items and files of all users kept in the trash longer than the retention period.
+func (ta *trashAccessor) PurgeExpired(ctx context.Context) error {
+	log := domain.GetCtxLogger(ctx)
+	action := domain.GetAction(1)
+
+	before := time.Now().Add(-ta.conf.TrashRetention)
+	items, err := ta.stflStorage.DeleteExpiredTrashItems(ctx, before)
+	if err != nil {
+		return fmt.Errorf("%s err %w", action, err)
+	}
+
+	files, err := ta.fileStorage.DeleteExpiredTrash(ctx, before)
+	if err != nil {
+		return fmt.Errorf("%s err %w - %s", action, domain.ErrServerInternal, err.Error())
+	}
+
+	if items > 0 || files > 0 {
+		log.Infow(action, "msg", fmt.Sprintf("%v items and %v files deleted before %v purged", items, files, before))
+	}
+	return nil
+}
+
+// StartPurge runs PurgeExpired periodically until ctx is done; disabled for the zero interval.
+func (ta *trashAccessor) StartPurge(ctx context.Context) {
+	if ta.conf.TrashPurge > 0 {
+		go ta.purgeLoop(ctx, ta.conf.TrashPurge)
+	}
+}
+
+func (ta *trashAccessor) purgeLoop(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+			if err := ta.PurgeExpired(ctx); err != nil {
+				log := domain.GetApplicationLogger()
+				log.Errorw("trash purge", "err", err.Error())
+			}
+		}
+	}
+}
+
+func (ta *trashAccessor) publish(ctx context.Context, rev *domain.SecretItemRevision) {
+	if ta.notifier == nil {
+		return
+	}
+
+	userID, err := domain.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	ta.notifier.Publish(userID, &domain.ItemChangeEvent{
+		Seq:    rev.ChangeSeq,
+		Kind:   domain.ItemCreated,
+		ItemID: rev.ID,
+		Type:   rev.Type,
+	})
+}
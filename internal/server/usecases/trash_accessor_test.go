@@ -0,0 +1,182 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/StasMerzlyakov/gophkeeper/internal/config"
+	"github.com/StasMerzlyakov/gophkeeper/internal/domain"
+	"github.com/StasMerzlyakov/gophkeeper/internal/server/usecases"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashAccessor_ListTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	conf := &config.ServerConf{TrashRetention: time.Hour}
+	deletedAt := time.Now()
+
+	t.Run("ok", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().ListTrashItems(gomock.Any()).Times(1).Return([]domain.TrashEntry{
+			{ID: "item1", Type: domain.SecretItemBankCard, DeletedAt: deletedAt.Add(-time.Minute)},
+		}, nil)
+		mockStorage.EXPECT().GetUserFilesBucket(gomock.Any()).Times(1).Return("bucket", nil)
+
+		mockFileStorage := NewMockFileStorage(ctrl)
+		mockFileStorage.EXPECT().ListTrash(gomock.Any(), "bucket").Times(1).Return([]domain.TrashEntry{
+			{ID: "file1", Type: domain.TrashFile, Name: "name", DeletedAt: deletedAt},
+		}, nil)
+
+		ta := usecases.NewTrashAccessor(conf).StateFullStorage(mockStorage).FileStorage(mockFileStorage)
+		res, err := ta.ListTrash(context.Background())
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, "file1", res[0].ID)
+		assert.Equal(t, deletedAt.Add(time.Hour), res[0].ExpiresAt)
+		assert.Equal(t, "item1", res[1].ID)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		testErr := errors.New("testErr")
+		mockStorage.EXPECT().ListTrashItems(gomock.Any()).Times(1).Return(nil, testErr)
+
+		ta := usecases.NewTrashAccessor(conf).StateFullStorage(mockStorage)
+		_, err := ta.ListTrash(context.Background())
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestTrashAccessor_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := domain.UserID(1)
+	ctx := domain.EnrichWithUserID(context.Background(), userID)
+
+	t.Run("item", func(t *testing.T) {
+		rev := &domain.SecretItemRevision{ID: "item1", Type: domain.SecretItemBankCard, Revision: 3, ChangeSeq: 7}
+
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().RestoreSecretItem(gomock.Any(), "item1").Times(1).Return(rev, nil)
+
+		mockNotifier := NewMockChangeNotifier(ctrl)
+		mockNotifier.EXPECT().Publish(userID, &domain.ItemChangeEvent{
+			Seq:    7,
+			Kind:   domain.ItemCreated,
+			ItemID: "item1",
+			Type:   domain.SecretItemBankCard,
+		}).Times(1)
+
+		ta := usecases.NewTrashAccessor(nil).StateFullStorage(mockStorage).ChangeNotifier(mockNotifier)
+		err := ta.Restore(ctx, domain.SecretItemBankCard, "item1")
+		require.NoError(t, err)
+	})
+
+	t.Run("item_err", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().RestoreSecretItem(gomock.Any(), "item1").Times(1).Return(nil, domain.ErrClientDataIncorrect)
+
+		ta := usecases.NewTrashAccessor(nil).StateFullStorage(mockStorage)
+		err := ta.Restore(ctx, domain.SecretItemBankCard, "item1")
+		require.ErrorIs(t, err, domain.ErrClientDataIncorrect)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().GetUserFilesBucket(gomock.Any()).Times(1).Return("bucket", nil)
+
+		mockFileStorage := NewMockFileStorage(ctrl)
+		mockFileStorage.EXPECT().RestoreFromTrash(gomock.Any(), "bucket", "file1").Times(1).Return(nil)
+
+		ta := usecases.NewTrashAccessor(nil).StateFullStorage(mockStorage).FileStorage(mockFileStorage)
+		err := ta.Restore(ctx, domain.TrashFile, "file1")
+		require.NoError(t, err)
+	})
+}
+
+func TestTrashAccessor_PurgeTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("all", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().DeleteTrashItems(gomock.Any(), "").Times(1).Return(int64(2), nil)
+		mockStorage.EXPECT().GetUserFilesBucket(gomock.Any()).Times(1).Return("bucket", nil)
+
+		mockFileStorage := NewMockFileStorage(ctrl)
+		mockFileStorage.EXPECT().DeleteFromTrash(gomock.Any(), "bucket", "").Times(1).Return(nil)
+
+		ta := usecases.NewTrashAccessor(nil).StateFullStorage(mockStorage).FileStorage(mockFileStorage)
+		err := ta.PurgeTrash(context.Background(), "", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("item", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().DeleteTrashItems(gomock.Any(), "item1").Times(1).Return(int64(0), domain.ErrDataNotExists)
+
+		ta := usecases.NewTrashAccessor(nil).StateFullStorage(mockStorage)
+		err := ta.PurgeTrash(context.Background(), domain.SecretItemUserPassword, "item1")
+		require.ErrorIs(t, err, domain.ErrDataNotExists)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		mockStorage := NewMockStateFullStorage(ctrl)
+		mockStorage.EXPECT().GetUserFilesBucket(gomock.Any()).Times(1).Return("bucket", nil)
+
+		mockFileStorage := NewMockFileStorage(ctrl)
+		mockFileStorage.EXPECT().DeleteFromTrash(gomock.Any(), "bucket", "file1").Times(1).Return(nil)
+
+		ta := usecases.NewTrashAccessor(nil).StateFullStorage(mockStorage).FileStorage(mockFileStorage)
+		err := ta.PurgeTrash(context.Background(), domain.TrashFile, "file1")
+		require.NoError(t, err)
+	})
+}
+
+func TestTrashAccessor_PurgeExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	conf := &config.ServerConf{
+		TrashRetention: 24 * time.Hour,
+		TrashPurge:     10 * time.Millisecond,
+	}
+
+	mockStorage := NewMockStateFullStorage(ctrl)
+	mockFileStorage := NewMockFileStorage(ctrl)
+
+	purged := make(chan struct{})
+	mockStorage.EXPECT().DeleteExpiredTrashItems(gomock.Any(), gomock.Any()).MinTimes(1).DoAndReturn(
+		func(ctx context.Context, before time.Time) (int64, error) {
+			assert.WithinDuration(t, time.Now().Add(-24*time.Hour), before, time.Minute)
+			return 1, nil
+		})
+	mockFileStorage.EXPECT().DeleteExpiredTrash(gomock.Any(), gomock.Any()).MinTimes(1).DoAndReturn(
+		func(ctx context.Context, before time.Time) (int, error) {
+			select {
+			case purged <- struct{}{}:
+			default:
+			}
+			return 0, nil
+		})
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	ta := usecases.NewTrashAccessor(conf).StateFullStorage(mockStorage).FileStorage(mockFileStorage)
+	ta.StartPurge(ctx)
+
+	select {
+	case <-purged:
+	case <-time.After(time.Second):
+		t.Fatal("trash is not purged")
+	}
+	cancelFn()
+}